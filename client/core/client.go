@@ -2,7 +2,10 @@ package core
 
 import (
 	"fmt"
+	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // Client 统一的客户端接口
@@ -199,6 +202,14 @@ func ClearAuth() {
 	currentUserID = 0
 }
 
+// 请求ID序号，同一纳秒内生成多个请求ID时用于区分
+var requestSeq atomic.Uint64
+
+// NewRequestID 生成客户端请求ID，服务端按请求ID去重，重试同一操作时应复用同一个ID
+func NewRequestID() string {
+	return strconv.FormatInt(time.Now().UnixNano(), 36) + "-" + strconv.FormatUint(requestSeq.Add(1), 36)
+}
+
 func GetStringInput(prompt string) string {
 	fmt.Print(prompt)
 	var input string
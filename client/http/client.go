@@ -639,8 +639,9 @@ func (c *HTTPClient) RemoveItem(itemID int64, count int32) error {
 
 func (c *HTTPClient) UseItem(itemID int64, count int32) error {
 	data := map[string]interface{}{
-		"item_id": itemID,
-		"count":   count,
+		"item_id":    itemID,
+		"count":      count,
+		"request_id": core.NewRequestID(),
 	}
 
 	return c.sendPOSTRequest("/api/user/useItem", data)
@@ -3,6 +3,7 @@ package ws
 import (
 	"encoding/binary"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -19,6 +20,7 @@ type WSClient struct {
 	closeChan chan struct{}
 	msgChan   chan *pb.WSMessage
 	loginChan chan bool
+	seq       atomic.Uint32 // 请求序号
 }
 
 var guildPage = 1
@@ -131,6 +133,7 @@ func (c *WSClient) sendMessage(service, method string, data proto.Message) error
 		Service: service,
 		Method:  method,
 		Payload: payload,
+		Seq:     c.seq.Add(1),
 	}
 
 	// 序列化消息
@@ -169,6 +172,15 @@ func (c *WSClient) Login(username, password string) error {
 	return c.sendMessage("user", "login", req)
 }
 
+// Auth 发送鉴权消息，绑定当前连接的用户身份
+func (c *WSClient) Auth(token string) error {
+	req := &pb.AuthRequest{
+		Token: token,
+	}
+
+	return c.sendMessage("ws", "auth", req)
+}
+
 func (c *WSClient) GetInventory() error {
 	req := &pb.GetInventoryRequest{
 		UserId: core.GetCurrentUserID(),
@@ -198,9 +210,10 @@ func (c *WSClient) RemoveItem(itemID int64, count int32) error {
 
 func (c *WSClient) UseItem(itemID int64, count int32) error {
 	req := &pb.UseItemRequest{
-		UserId: core.GetCurrentUserID(),
-		ItemId: itemID,
-		Count:  count,
+		UserId:    core.GetCurrentUserID(),
+		ItemId:    itemID,
+		Count:     count,
+		RequestId: core.NewRequestID(),
 	}
 
 	return c.sendMessage("user", "useItem", req)
@@ -2,10 +2,12 @@ package ws
 
 import (
 	"fmt"
+	"strings"
 
 	"github.xubinbest.com/go-game-server/client/core"
 	"github.xubinbest.com/go-game-server/internal/gateway/msgfactory"
 	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/push"
 	"github.xubinbest.com/go-game-server/internal/utils"
 
 	"go.uber.org/zap"
@@ -13,8 +15,21 @@ import (
 )
 
 func (c *WSClient) HandleMessage(msg *pb.WSMessage) {
+	if strings.HasPrefix(msg.Method, push.MethodPrefix) {
+		c.handlePush(msg)
+		return
+	}
+
 	msgType := msg.Service + "." + msg.Method
 
+	if msg.Code != pb.ErrorCode_ERROR_CODE_OK {
+		fmt.Printf("\n请求失败[seq=%d] %s: %s(%s)\n", msg.Seq, msgType, msg.ErrorMessage, msg.Code)
+		if msgType == "user.login" || msgType == "ws.auth" {
+			c.loginChan <- false
+		}
+		return
+	}
+
 	req, err := msgfactory.GetResponseMessageStruct(msgType)
 	if err != nil {
 		utils.Error("GetResponseMessageStruct failed", zap.Error(err))
@@ -30,8 +45,32 @@ func (c *WSClient) HandleMessage(msg *pb.WSMessage) {
 	case "user.login":
 		loginResp := req.(*pb.LoginResponse)
 		core.SetAuth(loginResp.Token, loginResp.UserId)
-		c.loginChan <- true
+		// 登录成功后使用令牌绑定连接身份
+		if err := c.Auth(loginResp.Token); err != nil {
+			utils.Error("Auth failed", zap.Error(err))
+			c.loginChan <- false
+		}
+	case "ws.auth":
+		authResp := req.(*pb.AuthResponse)
+		c.loginChan <- authResp.Success
 	default:
 		fmt.Printf("\nreceived message: %+v\n", req.ProtoReflect().Interface())
 	}
 }
+
+// handlePush 处理服务端推送消息
+func (c *WSClient) handlePush(msg *pb.WSMessage) {
+	event := strings.TrimPrefix(msg.Method, push.MethodPrefix)
+
+	switch event {
+	case push.EventChatMessage:
+		chatMsg := &pb.ChatMessage{}
+		if err := proto.Unmarshal(msg.Payload, chatMsg); err != nil {
+			utils.Error("Unmarshal failed", zap.Error(err))
+			return
+		}
+		fmt.Printf("\n[聊天][频道%d] %d: %s\n", chatMsg.Channel, chatMsg.SenderId, chatMsg.Content)
+	default:
+		fmt.Printf("\nreceived push: %s.%s\n", msg.Service, event)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.xubinbest.com/go-game-server/internal/cache"
 	"github.xubinbest.com/go-game-server/internal/config"
 	"github.xubinbest.com/go-game-server/internal/db"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
 	"github.xubinbest.com/go-game-server/internal/game_service"
 	"github.xubinbest.com/go-game-server/internal/pb"
 	"github.xubinbest.com/go-game-server/internal/registry"
@@ -76,6 +77,12 @@ func main() {
 	}
 	defer dbClient.Close()
 
+	// 初始化设计配置管理器
+	designConfigManager := designconfig.NewDesignConfigManager(reg, game_service.Tables)
+	if err := designConfigManager.Start(); err != nil {
+		utils.Fatal("Failed to initialize design config manager", zap.Error(err))
+	}
+
 	// 初始化OpenTelemetry
 	var metricsServer *http.Server
 	if cfg.Telemetry.Enabled {
@@ -106,12 +113,6 @@ func main() {
 	healthpb.RegisterHealthServer(grpcServer, healthServer)
 	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
 
-	gameService := game_service.NewGameGRPCService(ctx, cacheClient, cfg)
-	if err != nil {
-		utils.Fatal("Failed to create game server", zap.Error(err))
-	}
-	pb.RegisterGameServiceServer(grpcServer, gameService)
-
 	// Get pod IP from environment variable (set by Kubernetes Downward API)
 	podIP := os.Getenv("POD_IP")
 	if podIP == "" {
@@ -128,6 +129,10 @@ func main() {
 		utils.Fatal("GRPC_PORT is not a valid integer", zap.Error(err))
 	}
 
+	// 房间目录中登记本实例地址，网关据此将房间请求路由到本实例
+	gameService := game_service.NewGameGRPCService(ctx, cacheClient, dbClient, sf, cfg, designConfigManager, podIP+":"+portStr)
+	pb.RegisterGameServiceServer(grpcServer, gameService)
+
 	utils.Info("Starting game service on port", zap.Int("port", port))
 	// Start gRPC server
 	lis, err := net.Listen("tcp", ":"+strconv.Itoa(port))
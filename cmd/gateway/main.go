@@ -14,7 +14,6 @@ import (
 
 	"github.xubinbest.com/go-game-server/internal/cache"
 	"github.xubinbest.com/go-game-server/internal/config"
-	"github.xubinbest.com/go-game-server/internal/gateway/chatmessage"
 	"github.xubinbest.com/go-game-server/internal/gateway/httpserver"
 	"github.xubinbest.com/go-game-server/internal/gateway/wsserver"
 	"github.xubinbest.com/go-game-server/internal/registry"
@@ -84,8 +83,8 @@ func main() {
 	// Setup graceful shutdown
 	setupShutdownHandler(ctx, logger, httpServer, wsServer, reg, instance)
 
-	// Initialize chat message broadcast
-	initChatBroadcast(logger, cfg, cacheClient)
+	// Initialize server push
+	initPush(logger, wsServer)
 
 	// Start servers
 	startServers(logger, httpServer, wsServer, httpPort, wsPort)
@@ -181,14 +180,9 @@ func deregisterService(
 	}
 }
 
-func initChatBroadcast(logger *zap.Logger, cfg *config.Config, cacheClient cache.Cache) {
-	chatMessage, err := chatmessage.NewChatMessageBroadcast(cacheClient)
-	if err != nil {
-		logger.Fatal("Failed to create chat message broadcast", zap.Error(err))
-	}
-
-	if err := chatMessage.SubscribeChatMessageBroadcast(context.Background(), "chat_message_broadcast"); err != nil {
-		logger.Fatal("Failed to subscribe to chat message broadcast", zap.Error(err))
+func initPush(logger *zap.Logger, wsServer *wsserver.WSServer) {
+	if err := wsServer.StartPush(context.Background()); err != nil {
+		logger.Fatal("Failed to subscribe to push channel", zap.Error(err))
 	}
 }
 
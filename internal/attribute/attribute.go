@@ -0,0 +1,200 @@
+package attribute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// 战力计算中各属性的权重
+const (
+	combatPowerAtkWeight  = 5 // 每点攻击折算的战力
+	combatPowerDefWeight  = 3 // 每点防御折算的战力
+	combatPowerHpMaxRatio = 2 // 每多少点生命折算1点战力
+)
+
+// Attributes 玩家最终属性，由等级、已穿戴装备（含强化和洗练）、已激活卡牌（含羁绊加成）、所有出战位上的宠物（含星级和技能）和生效中的增益汇总而成
+type Attributes struct {
+	Atk         int64 `json:"atk"`
+	Def         int64 `json:"def"`
+	HpMax       int64 `json:"hp_max"`
+	CombatPower int64 `json:"combat_power"`
+	ExpireAt    int64 `json:"expire_at"` // 最早过期的增益属性加成的过期时间，到期后需要重新计算，0表示没有
+}
+
+// Add 累加一条配置属性
+func (a *Attributes) Add(attr designconfig.Attribute) {
+	a.Atk += int64(attr.Atk)
+	a.Def += int64(attr.Def)
+	a.HpMax += int64(attr.HpMax)
+}
+
+// calculateCombatPower 根据最终属性计算战力
+func calculateCombatPower(a *Attributes) int64 {
+	return a.Atk*combatPowerAtkWeight +
+		a.Def*combatPowerDefWeight +
+		a.HpMax/combatPowerHpMaxRatio
+}
+
+// Aggregator 玩家属性汇总，用户服务的战力和游戏服务的战斗属性共用同一套规则
+type Aggregator struct {
+	dbClient      db.Database
+	configManager *designconfig.DesignConfigManager
+}
+
+// NewAggregator 创建属性汇总器
+func NewAggregator(dbClient db.Database, configManager *designconfig.DesignConfigManager) *Aggregator {
+	return &Aggregator{
+		dbClient:      dbClient,
+		configManager: configManager,
+	}
+}
+
+// Compute 汇总玩家属性并计算战力，缺失的配置不计入属性
+func (a *Aggregator) Compute(ctx context.Context, user *models.User) (*Attributes, error) {
+	userID := user.ID
+	attrs := &Attributes{}
+
+	userLevel := models.EffectiveUserLevel(user.Level)
+	level := designconfig.FindConfig(a.configManager, "level", func(d *designconfig.LevelData) bool {
+		return int32(d.Level) == userLevel
+	})
+	if level != nil {
+		attrs.Add(level.Attribute)
+	}
+
+	equipments, err := a.dbClient.GetEquipments(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get equipments: %w", err)
+	}
+	enhances, err := a.dbClient.GetEquipmentEnhances(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get equipment enhances: %w", err)
+	}
+	enhanceByItem := make(map[int64]*models.EquipmentEnhance, len(enhances))
+	for _, enhance := range enhances {
+		enhanceByItem[enhance.ItemID] = enhance
+	}
+	for _, e := range equipments {
+		equipment := designconfig.FindConfig(a.configManager, "equipment", func(d *designconfig.EquipmentData) bool {
+			return int64(d.ID) == e.TemplateID
+		})
+		if equipment != nil {
+			attrs.Add(a.EquipmentAttribute(equipment.Attribute, enhanceByItem[e.ItemID]))
+		}
+	}
+
+	cards, err := a.dbClient.GetUserCards(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cards: %w", err)
+	}
+	for _, c := range cards {
+		card := designconfig.FindConfig(a.configManager, "card", func(d *designconfig.CardData) bool {
+			return int64(d.ID) == c.TemplateID
+		})
+		if card != nil {
+			attrs.Add(card.Attribute)
+		}
+		cardLevel := designconfig.FindConfig(a.configManager, "card_level", func(d *designconfig.CardLevelData) bool {
+			return int64(d.CardId) == c.TemplateID && int32(d.Level) == c.Level
+		})
+		if cardLevel != nil {
+			attrs.Add(cardLevel.Attribute)
+		}
+		cardStar := designconfig.FindConfig(a.configManager, "card_star", func(d *designconfig.CardStarData) bool {
+			return int64(d.CardId) == c.TemplateID && int32(d.Star) == c.Star
+		})
+		if cardStar != nil {
+			attrs.Add(cardStar.Attribute)
+		}
+	}
+	cardSets, _ := a.configManager.GetConfig("card_set").([]designconfig.CardSetData)
+	for _, set := range ActiveCardSets(cardSets, cards) {
+		attrs.Add(set.Attribute)
+	}
+
+	pets, err := a.dbClient.GetUserBattlePets(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get battle pets: %w", err)
+	}
+	var petSkills []*models.PetSkill
+	if len(pets) > 0 {
+		if petSkills, err = a.dbClient.GetPetSkills(ctx, userID); err != nil {
+			return nil, fmt.Errorf("failed to get pet skills: %w", err)
+		}
+	}
+	for _, pet := range pets {
+		petLevel := designconfig.FindConfig(a.configManager, "pet_level", func(d *designconfig.PetLevelData) bool {
+			return int64(d.PetId) == pet.TemplateID && int32(d.Level) == pet.Level
+		})
+		if petLevel != nil {
+			attrs.Add(petLevel.Attribute)
+		}
+		petStar := designconfig.FindConfig(a.configManager, "pet_star", func(d *designconfig.PetStarData) bool {
+			return int64(d.PetId) == pet.TemplateID && int32(d.Star) == pet.Star
+		})
+		if petStar != nil {
+			attrs.Add(petStar.Attribute)
+		}
+		for _, skill := range petSkills {
+			if skill.PetID != pet.ID {
+				continue
+			}
+			petSkill := designconfig.FindConfig(a.configManager, "pet_skill", func(d *designconfig.PetSkillData) bool {
+				return int64(d.ID) == skill.SkillID
+			})
+			if petSkill != nil {
+				attrs.Add(petSkill.Attribute)
+			}
+		}
+	}
+
+	buffs, err := a.dbClient.GetUserBuffs(ctx, userID, time.Now().Unix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get buffs: %w", err)
+	}
+	for _, b := range buffs {
+		buff := designconfig.FindConfig(a.configManager, "buff", func(d *designconfig.BuffData) bool {
+			return int64(d.ID) == b.BuffID
+		})
+		if buff == nil || buff.Attribute == (designconfig.Attribute{}) {
+			continue
+		}
+		attrs.Add(buff.Attribute)
+		if attrs.ExpireAt == 0 || b.ExpireAt < attrs.ExpireAt {
+			attrs.ExpireAt = b.ExpireAt
+		}
+	}
+
+	attrs.CombatPower = calculateCombatPower(attrs)
+	return attrs, nil
+}
+
+// EquipmentAttribute 计算装备实例的属性，基础属性按强化等级的万分比加成放大后再加上洗练词条
+func (a *Aggregator) EquipmentAttribute(base designconfig.Attribute, enhance *models.EquipmentEnhance) designconfig.Attribute {
+	attr := base
+	if enhance == nil {
+		return attr
+	}
+
+	if enhance.Level > 0 {
+		config := designconfig.FindConfig(a.configManager, "equip_enhance", func(d *designconfig.EquipEnhanceData) bool {
+			return int32(d.Level) == enhance.Level
+		})
+		if config != nil {
+			attr.Atk += base.Atk * config.Bonus / 10000
+			attr.Def += base.Def * config.Bonus / 10000
+			attr.HpMax += base.HpMax * config.Bonus / 10000
+		}
+	}
+	for _, affix := range enhance.Affixes {
+		attr.Atk += affix.Attribute.Atk
+		attr.Def += affix.Attribute.Def
+		attr.HpMax += affix.Attribute.HpMax
+	}
+	return attr
+}
@@ -0,0 +1,55 @@
+package attribute
+
+import (
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// ActiveCardSets 根据玩家拥有的卡牌计算生效的羁绊加成，按配置表顺序返回
+// 同一羁绊的多档加成是累计值，只取已满足的要求最高的一档
+func ActiveCardSets(sets []designconfig.CardSetData, cards []*models.Card) []designconfig.CardSetData {
+	owned := make(map[int64]*models.Card, len(cards))
+	for _, card := range cards {
+		owned[card.TemplateID] = card
+	}
+
+	best := make(map[int]int) // 羁绊ID到已满足的最高一档在 sets 中的下标
+	for i, set := range sets {
+		if len(set.Cards) == 0 || !cardSetSatisfied(set, owned) {
+			continue
+		}
+		if j, ok := best[set.SetId]; !ok || cardSetHigher(set, sets[j]) {
+			best[set.SetId] = i
+		}
+	}
+
+	var active []designconfig.CardSetData
+	for i, set := range sets {
+		if j, ok := best[set.SetId]; ok && j == i {
+			active = append(active, set)
+		}
+	}
+	return active
+}
+
+// cardSetSatisfied 是否拥有羁绊要求的全部卡牌且等级、星级都已达到
+func cardSetSatisfied(set designconfig.CardSetData, owned map[int64]*models.Card) bool {
+	for _, templateID := range set.Cards {
+		card, ok := owned[int64(templateID)]
+		if !ok || card.Level < int32(set.Level) || card.Star < int32(set.Star) {
+			return false
+		}
+	}
+	return true
+}
+
+// cardSetHigher 同一羁绊中 a 的要求是否高于 b，先比较等级再比较星级，要求相同时配置ID大的一档更高
+func cardSetHigher(a, b designconfig.CardSetData) bool {
+	if a.Level != b.Level {
+		return a.Level > b.Level
+	}
+	if a.Star != b.Star {
+		return a.Star > b.Star
+	}
+	return a.ID > b.ID
+}
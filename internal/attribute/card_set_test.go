@@ -0,0 +1,80 @@
+package attribute
+
+import (
+	"slices"
+	"testing"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+var testCardSets = []designconfig.CardSetData{
+	{ID: 1, SetId: 1, Cards: []int{101, 102}},
+	{ID: 2, SetId: 1, Cards: []int{101, 102}, Level: 10},
+	{ID: 3, SetId: 1, Cards: []int{101, 102}, Level: 10, Star: 3},
+	{ID: 4, SetId: 2, Cards: []int{103}},
+	{ID: 5, SetId: 1, Cards: []int{101, 102}, Star: 5},
+	{ID: 6, SetId: 3},
+	{ID: 7, SetId: 2, Cards: []int{103}},
+}
+
+func card(templateID int64, level, star int32) *models.Card {
+	return &models.Card{TemplateID: templateID, Level: level, Star: star}
+}
+
+func TestActiveCardSets(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []*models.Card
+		want  []int
+	}{
+		{
+			name: "no cards",
+		},
+		{
+			name:  "missing a required card",
+			cards: []*models.Card{card(101, 20, 5)},
+		},
+		{
+			name:  "base tier only",
+			cards: []*models.Card{card(101, 1, 1), card(102, 1, 1)},
+			want:  []int{1},
+		},
+		{
+			name:  "highest level tier",
+			cards: []*models.Card{card(101, 10, 1), card(102, 12, 2)},
+			want:  []int{2},
+		},
+		{
+			name:  "every card must reach the level",
+			cards: []*models.Card{card(101, 10, 1), card(102, 9, 1)},
+			want:  []int{1},
+		},
+		{
+			name:  "star tier without level requirement",
+			cards: []*models.Card{card(101, 1, 5), card(102, 1, 5)},
+			want:  []int{5},
+		},
+		{
+			name:  "level outranks star",
+			cards: []*models.Card{card(101, 10, 5), card(102, 10, 5)},
+			want:  []int{3},
+		},
+		{
+			name:  "equal requirements pick the larger ID and keep config order",
+			cards: []*models.Card{card(101, 10, 3), card(102, 10, 3), card(103, 1, 1)},
+			want:  []int{3, 7},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, set := range ActiveCardSets(testCardSets, tt.cards) {
+				got = append(got, set.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ActiveCardSets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
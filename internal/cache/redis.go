@@ -96,6 +96,11 @@ func (r *RedisCache) ZAdd(ctx context.Context, key string, members ...redis.Z) *
 	return r.client.ZAdd(ctx, key, members...)
 }
 
+// ZAddGT 只在新分数高于已有分数时更新成员
+func (r *RedisCache) ZAddGT(ctx context.Context, key string, members ...redis.Z) *redis.IntCmd {
+	return r.client.ZAddGT(ctx, key, members...)
+}
+
 func (r *RedisCache) ZCount(ctx context.Context, key string, min, max float64) *redis.IntCmd {
 	return r.client.ZCount(ctx, key, fmt.Sprintf("%.0f", min), fmt.Sprintf("%.0f", max))
 }
@@ -112,6 +117,14 @@ func (r *RedisCache) ZScore(ctx context.Context, key, member string) *redis.Floa
 	return r.client.ZScore(ctx, key, member)
 }
 
+func (r *RedisCache) ZRangeWithScores(ctx context.Context, key string, start, stop int64) *redis.ZSliceCmd {
+	return r.client.ZRangeWithScores(ctx, key, start, stop)
+}
+
+func (r *RedisCache) ZRem(ctx context.Context, key string, members ...interface{}) *redis.IntCmd {
+	return r.client.ZRem(ctx, key, members...)
+}
+
 // TryLock 尝试获取分布式锁
 func (r *RedisCache) TryLock(ctx context.Context, key string, ttl time.Duration) (bool, error) {
 	return r.client.SetNX(ctx, lockKey(key), "1", ttl).Result()
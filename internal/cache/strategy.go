@@ -132,4 +132,11 @@ var Strategies = map[string]CacheStrategy{
 		UseLock:     true,
 		LockTimeout: 5 * time.Second,
 	},
+	// 用户属性和战力 - 装备、卡牌、宠物变化时重新计算
+	"user_attributes": {
+		KeyPrefix:  "user:attributes:",
+		TTL:        30 * time.Minute,
+		CacheEmpty: false,
+		UseLock:    false,
+	},
 }
@@ -24,6 +24,7 @@ type Config struct {
 	Kafka                KafkaConfig                `yaml:"kafka"`
 	KafkaConfigs         KafkaConfigs               `yaml:"kafka_configs"`
 	Telemetry            TelemetryConfig            `yaml:"telemetry"`
+	GameClock            GameClockConfig            `yaml:"gameClock"`
 }
 
 type LoadBalancerConfig struct {
@@ -104,6 +105,8 @@ type CircuitBreakerConfig struct {
 type WebSocketConfig struct {
 	AllowedOrigins []string `yaml:"allowedOrigins"` // 允许的Origin列表，空列表表示允许所有
 	CheckOrigin    bool     `yaml:"checkOrigin"`    // 是否启用Origin检查
+
+	MaxConcurrentRequests int `yaml:"maxConcurrentRequests"` // 单个连接并发处理的请求数上限
 }
 
 type KafkaConfig struct {
@@ -121,6 +124,10 @@ type KafkaConfigs struct {
 	UserBehavior KafkaConfig `yaml:"user_behavior"`
 	// 系统通知配置（示例）
 	Notification KafkaConfig `yaml:"notification"`
+	// 玩家升级事件配置
+	UserLevelUp KafkaConfig `yaml:"user_level_up"`
+	// 游戏事件配置
+	GameplayEvent KafkaConfig `yaml:"gameplay_event"`
 }
 
 // TelemetryConfig 可观测性配置
@@ -142,6 +149,12 @@ type SamplingConfig struct {
 	Ratio float64 `yaml:"ratio"` // 采样率 (0.0-1.0)，仅用于traceidratio类型
 }
 
+// GameClockConfig 游戏时钟配置，决定每日、每周、每月玩法的重置时刻
+type GameClockConfig struct {
+	Timezone  string `yaml:"timezone"`  // 时区，如 Asia/Shanghai，为空时使用服务器本地时区
+	ResetHour int    `yaml:"resetHour"` // 每日重置的整点（0-23），默认0点
+}
+
 func LoadConfig(path string) *Config {
 	data, err := os.ReadFile(path)
 	if err != nil {
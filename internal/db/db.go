@@ -42,6 +42,36 @@ type Database interface {
 
 	// 宠物相关方法
 	interfaces.PetDatabase
+
+	// 邮件相关方法
+	interfaces.MailDatabase
+
+	// 货币钱包相关方法
+	interfaces.WalletDatabase
+
+	// 商店相关方法
+	interfaces.ShopDatabase
+
+	// 抽卡相关方法
+	interfaces.GachaDatabase
+
+	// 任务和成就相关方法
+	interfaces.QuestDatabase
+
+	// 道具使用相关方法
+	interfaces.ItemUseDatabase
+
+	// 装备强化相关方法
+	interfaces.EquipmentDatabase
+
+	// 宠物养成相关方法
+	interfaces.PetGrowthDatabase
+
+	// 请求去重相关方法
+	interfaces.ProcessedRequestDatabase
+
+	// 跨模块事务
+	interfaces.UnitOfWork
 }
 
 // DatabaseClient 实现 Database 接口，使用组合模式
@@ -64,6 +94,16 @@ type DatabaseClient struct {
 	interfaces.InventoryDatabase
 	interfaces.CardDatabase
 	interfaces.PetDatabase
+	interfaces.MailDatabase
+	interfaces.WalletDatabase
+	interfaces.ShopDatabase
+	interfaces.GachaDatabase
+	interfaces.QuestDatabase
+	interfaces.ItemUseDatabase
+	interfaces.EquipmentDatabase
+	interfaces.PetGrowthDatabase
+	interfaces.ProcessedRequestDatabase
+	interfaces.UnitOfWork
 }
 
 // NewDatabaseClient 创建数据库客户端实例
@@ -111,6 +151,16 @@ func (c *DatabaseClient) initGORM() error {
 	c.InventoryDatabase = gorm.NewGormInventoryDatabase(gormDB, c.sf)
 	c.CardDatabase = gorm.NewGormCardDatabase(gormDB, c.sf)
 	c.PetDatabase = gorm.NewGormPetDatabase(gormDB, c.sf)
+	c.MailDatabase = gorm.NewGormMailDatabase(gormDB, c.sf)
+	c.WalletDatabase = gorm.NewGormWalletDatabase(gormDB, c.sf)
+	c.ShopDatabase = gorm.NewGormShopDatabase(gormDB, c.sf)
+	c.GachaDatabase = gorm.NewGormGachaDatabase(gormDB, c.sf)
+	c.QuestDatabase = gorm.NewGormQuestDatabase(gormDB, c.sf)
+	c.ItemUseDatabase = gorm.NewGormItemUseDatabase(gormDB, c.sf)
+	c.EquipmentDatabase = gorm.NewGormEquipmentDatabase(gormDB, c.sf)
+	c.PetGrowthDatabase = gorm.NewGormPetGrowthDatabase(gormDB, c.sf)
+	c.ProcessedRequestDatabase = gorm.NewGormProcessedRequestDatabase(gormDB, c.sf)
+	c.UnitOfWork = gorm.NewGormUnitOfWork(gormDB, c.sf)
 
 	return nil
 }
@@ -139,6 +189,17 @@ func (c *DatabaseClient) initMongoDB() error {
 	c.GuildDatabase = mongodb.NewMongoDBGuildDatabase(c.mongoDB, dbName, c.sf)
 	c.InventoryDatabase = mongodb.NewMongoDBInventoryDatabase(c.mongoDB, dbName, c.sf)
 	c.CardDatabase = mongodb.NewMongoDBCardDatabase(c.mongoDB, dbName, c.sf)
+	c.PetDatabase = mongodb.NewMongoDBPetDatabase(c.mongoDB, dbName, c.sf)
+	c.MailDatabase = mongodb.NewMongoDBMailDatabase(c.mongoDB, dbName, c.sf)
+	c.WalletDatabase = mongodb.NewMongoDBWalletDatabase(c.mongoDB, dbName, c.sf)
+	c.ShopDatabase = mongodb.NewMongoDBShopDatabase(c.mongoDB, dbName, c.sf)
+	c.GachaDatabase = mongodb.NewMongoDBGachaDatabase(c.mongoDB, dbName, c.sf)
+	c.QuestDatabase = mongodb.NewMongoDBQuestDatabase(c.mongoDB, dbName, c.sf)
+	c.ItemUseDatabase = mongodb.NewMongoDBItemUseDatabase(c.mongoDB, dbName, c.sf)
+	c.EquipmentDatabase = mongodb.NewMongoDBEquipmentDatabase(c.mongoDB, dbName, c.sf)
+	c.PetGrowthDatabase = mongodb.NewMongoDBPetGrowthDatabase(c.mongoDB, dbName, c.sf)
+	c.ProcessedRequestDatabase = mongodb.NewMongoDBProcessedRequestDatabase(c.mongoDB, dbName, c.sf)
+	c.UnitOfWork = mongodb.NewMongoDBUnitOfWork(c.mongoDB, dbName, c.sf)
 
 	return nil
 }
@@ -95,34 +95,42 @@ func (g *GormCardDatabase) UpdateCard(ctx context.Context, card *models.Card) er
 }
 
 // UpgradeCard 升级卡牌等级
+// 以升级前的等级为条件更新，等级已变化说明有并发升级
 func (g *GormCardDatabase) UpgradeCard(ctx context.Context, userID int64, cardID int64, newLevel int32) error {
-	err := g.db.WithContext(ctx).
+	result := g.db.WithContext(ctx).
 		Model(&models.Card{}).
-		Where("id = ? AND user_id = ?", cardID, userID).
+		Where("id = ? AND user_id = ? AND level = ?", cardID, userID, newLevel-1).
 		Updates(map[string]interface{}{
 			"level":      newLevel,
 			"updated_at": time.Now().Unix(),
-		}).Error
+		})
 
-	if err != nil {
-		return fmt.Errorf("failed to upgrade card: %w", err)
+	if result.Error != nil {
+		return fmt.Errorf("failed to upgrade card: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return interfaces.ErrCardChanged
 	}
 
 	return nil
 }
 
 // UpgradeCardStar 升级卡牌星级
+// 以升星前的星级为条件更新，星级已变化说明有并发升星
 func (g *GormCardDatabase) UpgradeCardStar(ctx context.Context, userID int64, cardID int64, newStar int32) error {
-	err := g.db.WithContext(ctx).
+	result := g.db.WithContext(ctx).
 		Model(&models.Card{}).
-		Where("id = ? AND user_id = ?", cardID, userID).
+		Where("id = ? AND user_id = ? AND star = ?", cardID, userID, newStar-1).
 		Updates(map[string]interface{}{
 			"star":       newStar,
 			"updated_at": time.Now().Unix(),
-		}).Error
+		})
 
-	if err != nil {
-		return fmt.Errorf("failed to upgrade card star: %w", err)
+	if result.Error != nil {
+		return fmt.Errorf("failed to upgrade card star: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return interfaces.ErrCardChanged
 	}
 
 	return nil
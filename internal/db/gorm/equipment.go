@@ -0,0 +1,107 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormEquipmentDatabase GORM装备强化数据库实现
+type GormEquipmentDatabase struct {
+	db *gorm.DB
+	sf *snowflake.Snowflake
+}
+
+// NewGormEquipmentDatabase 创建GORM装备强化数据库实例
+func NewGormEquipmentDatabase(db *gorm.DB, sf *snowflake.Snowflake) interfaces.EquipmentDatabase {
+	return &GormEquipmentDatabase{
+		db: db,
+		sf: sf,
+	}
+}
+
+// GetEquipmentEnhances 获取玩家所有装备实例的强化等级和词条
+func (g *GormEquipmentDatabase) GetEquipmentEnhances(ctx context.Context, userID int64) ([]*models.EquipmentEnhance, error) {
+	var enhances []*models.EquipmentEnhance
+
+	err := g.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Find(&enhances).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get equipment enhances: %w", err)
+	}
+
+	return enhances, nil
+}
+
+// UpgradeEquipment 强化或洗练装备
+// 锁定装备实例的强化记录并与读取时的强化等级比较，等级已变化说明有并发强化，整体回滚
+func (g *GormEquipmentDatabase) UpgradeEquipment(ctx context.Context, order *models.EquipmentUpgradeOrder) (*models.EquipmentEnhance, error) {
+	var enhance models.EquipmentEnhance
+
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := claimRequest(tx, order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		var item models.InventoryItem
+		err := tx.Where("id = ? AND user_id = ? AND template_id = ?", order.ItemID, order.UserID, order.TemplateID).
+			First(&item).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return interfaces.ErrEquipmentNotFound
+			}
+			return fmt.Errorf("failed to get equipment item: %w", err)
+		}
+
+		now := time.Now().Unix()
+		err = tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.EquipmentEnhance{ItemID: order.ItemID, UserID: order.UserID, UpdatedAt: now}).Error
+		if err != nil {
+			return fmt.Errorf("failed to init equipment enhance: %w", err)
+		}
+
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("item_id = ?", order.ItemID).
+			First(&enhance).Error
+		if err != nil {
+			return fmt.Errorf("failed to lock equipment enhance: %w", err)
+		}
+		if enhance.Level != order.FromLevel {
+			return interfaces.ErrEquipmentChanged
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			wallet := NewGormWalletDatabase(tx, g.sf)
+			if _, err := wallet.ChangeCurrencies(ctx, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := removeTemplateItems(ctx, tx, order.UserID, order.CostItems); err != nil {
+			return err
+		}
+
+		enhance.Level = order.ToLevel
+		if order.Affixes != nil {
+			enhance.Affixes = order.Affixes
+		}
+		enhance.UpdatedAt = now
+		if err := tx.Save(&enhance).Error; err != nil {
+			return fmt.Errorf("failed to update equipment enhance: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &enhance, nil
+}
@@ -0,0 +1,153 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormGachaDatabase GORM抽卡数据库实现
+type GormGachaDatabase struct {
+	db *gorm.DB
+	sf *snowflake.Snowflake
+}
+
+// NewGormGachaDatabase 创建GORM抽卡数据库实例
+func NewGormGachaDatabase(db *gorm.DB, sf *snowflake.Snowflake) interfaces.GachaDatabase {
+	return &GormGachaDatabase{
+		db: db,
+		sf: sf,
+	}
+}
+
+// GetGachaPity 获取用户在卡池中的保底计数
+func (g *GormGachaDatabase) GetGachaPity(ctx context.Context, userID int64, poolID int64) (*models.GachaPity, error) {
+	var pity models.GachaPity
+
+	err := g.db.WithContext(ctx).
+		Where("user_id = ? AND pool_id = ?", userID, poolID).
+		First(&pity).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // 没有抽过
+		}
+		return nil, fmt.Errorf("failed to get gacha pity: %w", err)
+	}
+
+	return &pity, nil
+}
+
+// Pull 抽卡
+// 锁定保底计数行并与抽取时读到的计数比较，计数已变化说明有并发抽取，整体回滚由调用方重新抽取
+func (g *GormGachaDatabase) Pull(ctx context.Context, order *models.GachaOrder) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := claimRequest(tx, order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		now := time.Now().Unix()
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.GachaPity{UserID: order.UserID, PoolID: order.PoolID, UpdatedAt: now}).Error
+		if err != nil {
+			return fmt.Errorf("failed to init gacha pity: %w", err)
+		}
+
+		var pity models.GachaPity
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND pool_id = ?", order.UserID, order.PoolID).
+			First(&pity).Error
+		if err != nil {
+			return fmt.Errorf("failed to lock gacha pity: %w", err)
+		}
+		if pity.PityCount != order.PityBefore || pity.TotalPulls != order.TotalBefore {
+			return interfaces.ErrGachaPityChanged
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			wallet := NewGormWalletDatabase(tx, g.sf)
+			if _, err := wallet.ChangeCurrencies(ctx, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := removeTemplateItems(ctx, tx, order.UserID, order.CostItems); err != nil {
+			return err
+		}
+
+		// 奖励通过各模块已有的数据库实现发放
+		if err := addTemplateItems(ctx, tx, g.sf, order.UserID, order.Items, order.Inventory, order.OverflowMail); err != nil {
+			return err
+		}
+		cardDB := NewGormCardDatabase(tx, g.sf)
+		for _, card := range order.Cards {
+			if err := cardDB.CreateCard(ctx, card); err != nil {
+				return err
+			}
+		}
+		petDB := NewGormPetDatabase(tx, g.sf)
+		for _, pet := range order.Pets {
+			if err := petDB.CreatePet(ctx, pet); err != nil {
+				return err
+			}
+		}
+
+		if len(order.Records) > 0 {
+			if err := tx.Create(&order.Records).Error; err != nil {
+				return fmt.Errorf("failed to create gacha records: %w", err)
+			}
+		}
+
+		err = tx.Model(&models.GachaPity{}).
+			Where("user_id = ? AND pool_id = ?", order.UserID, order.PoolID).
+			Updates(map[string]interface{}{
+				"pity_count":  order.PityAfter,
+				"total_pulls": pity.TotalPulls + int64(len(order.Records)),
+				"updated_at":  now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update gacha pity: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetGachaRecords 分页获取抽卡记录
+func (g *GormGachaDatabase) GetGachaRecords(ctx context.Context, userID int64, poolID int64, beforeID int64, limit int) ([]*models.GachaRecord, error) {
+	query := g.db.WithContext(ctx).Where("user_id = ?", userID)
+	if poolID != 0 {
+		query = query.Where("pool_id = ?", poolID)
+	}
+	if beforeID != 0 {
+		query = query.Where("id < ?", beforeID)
+	}
+
+	var records []*models.GachaRecord
+	err := query.Order("id DESC").Limit(limit).Find(&records).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gacha records: %w", err)
+	}
+
+	return records, nil
+}
+
+// GetGachaRecordsByRequest 获取同一抽卡请求写入的记录
+func (g *GormGachaDatabase) GetGachaRecordsByRequest(ctx context.Context, userID int64, requestKey string) ([]*models.GachaRecord, error) {
+	var records []*models.GachaRecord
+	err := g.db.WithContext(ctx).
+		Where("user_id = ? AND request_key = ?", userID, requestKey).
+		Order("pull_index ASC").
+		Find(&records).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gacha records: %w", err)
+	}
+
+	return records, nil
+}
@@ -98,16 +98,44 @@ func (c *GormDatabaseClient) autoMigrate() error {
 		&models.User{},
 		&models.MonthlySign{},
 		&models.MonthlySignReward{},
+		&models.MonthlySignArchive{},
 
 		// 卡牌相关表
 		&models.Card{},
 
 		// 背包相关表
 		&models.InventoryItem{},
+		&models.InventoryCapacity{},
 		&models.Equipment{},
+		&models.EquipmentEnhance{},
 
 		// 宠物相关表
 		&models.Pet{},
+		&models.PetSkill{},
+
+		// 邮件相关表
+		&models.Mail{},
+		&models.BroadcastMail{},
+
+		// 货币钱包相关表
+		&models.UserCurrency{},
+		&models.CurrencyLedger{},
+
+		// 请求幂等相关表
+		&models.ProcessedRequest{},
+
+		// 商店相关表
+		&models.ShopPurchase{},
+
+		// 抽卡相关表
+		&models.GachaPity{},
+		&models.GachaRecord{},
+
+		// 任务相关表
+		&models.QuestProgress{},
+
+		// 道具使用相关表
+		&models.UserBuff{},
 
 		// 好友相关表
 		&models.Friend{},
@@ -118,6 +146,7 @@ func (c *GormDatabaseClient) autoMigrate() error {
 		&models.GuildMember{},
 		&models.GuildApplication{},
 		&models.GuildInvitation{},
+		&models.GuildDonation{},
 
 		// 聊天相关表
 		&ChatMessage{},
@@ -46,12 +46,12 @@ func (g *GormGuildDatabase) GetGuildApplication(ctx context.Context, appID int64
 	return &application, nil
 }
 
-// GetGuildApplications 获取公会的所有申请
+// GetGuildApplications 获取公会未过期的申请
 func (g *GormGuildDatabase) GetGuildApplications(ctx context.Context, guildID int64) ([]*models.GuildApplication, error) {
 	var applications []*models.GuildApplication
 
 	err := g.db.WithContext(ctx).
-		Where("guild_id = ?", guildID).
+		Where("guild_id = ? AND expire_time > ?", guildID, time.Now()).
 		Find(&applications).Error
 
 	if err != nil {
@@ -70,3 +70,13 @@ func (g *GormGuildDatabase) DeleteGuildApplication(ctx context.Context, appID in
 
 	return nil
 }
+
+// DeleteExpiredGuildApplications 删除已过期的公会申请
+func (g *GormGuildDatabase) DeleteExpiredGuildApplications(ctx context.Context, before time.Time) (int64, error) {
+	result := g.db.WithContext(ctx).Where("expire_time <= ?", before).Delete(&models.GuildApplication{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired guild applications: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
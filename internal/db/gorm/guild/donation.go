@@ -0,0 +1,58 @@
+package guild
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+)
+
+// CreateGuildDonation 创建公会捐献记录
+func (g *GormGuildDatabase) CreateGuildDonation(ctx context.Context, donation *models.GuildDonation) error {
+	// 生成ID
+	donationID, err := g.sf.NextID()
+	if err != nil {
+		return fmt.Errorf("failed to generate donation ID: %w", err)
+	}
+	donation.ID = donationID
+
+	if err := g.db.WithContext(ctx).Create(donation).Error; err != nil {
+		return fmt.Errorf("failed to create guild donation: %w", err)
+	}
+
+	return nil
+}
+
+// CountUserGuildDonations 统计用户指定时间之后的捐献次数
+func (g *GormGuildDatabase) CountUserGuildDonations(ctx context.Context, userID, donateID int64, since time.Time) (int32, error) {
+	var count int64
+
+	err := g.db.WithContext(ctx).
+		Model(&models.GuildDonation{}).
+		Where("user_id = ? AND donate_id = ? AND time >= ?", userID, donateID, since).
+		Count(&count).Error
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count guild donations: %w", err)
+	}
+
+	return int32(count), nil
+}
+
+// GetGuildDonations 获取公会最近的捐献记录
+func (g *GormGuildDatabase) GetGuildDonations(ctx context.Context, guildID int64, limit int32) ([]*models.GuildDonation, error) {
+	var donations []*models.GuildDonation
+
+	err := g.db.WithContext(ctx).
+		Where("guild_id = ?", guildID).
+		Order("time DESC").
+		Limit(int(limit)).
+		Find(&donations).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild donations: %w", err)
+	}
+
+	return donations, nil
+}
@@ -135,8 +135,9 @@ func (g *GormGuildDatabase) GetGuild(ctx context.Context, guildID int64) (*model
 }
 
 // UpdateGuild 更新公会信息
+// 等级、经验和成员上限由捐献单独维护，这里不覆盖
 func (g *GormGuildDatabase) UpdateGuild(ctx context.Context, guild *models.Guild) error {
-	err := g.db.WithContext(ctx).Save(guild).Error
+	err := g.db.WithContext(ctx).Omit("level", "exp", "max_members").Save(guild).Error
 	if err != nil {
 		return fmt.Errorf("failed to update guild: %w", err)
 	}
@@ -144,6 +145,43 @@ func (g *GormGuildDatabase) UpdateGuild(ctx context.Context, guild *models.Guild
 	return nil
 }
 
+// AddGuildExp 累加公会经验
+// 在事务中调用时更新会锁定公会行，返回的累计经验包含本次累加
+func (g *GormGuildDatabase) AddGuildExp(ctx context.Context, guildID int64, exp int64) (int64, error) {
+	db := g.db.WithContext(ctx)
+	result := db.Model(&models.Guild{}).
+		Where("id = ?", guildID).
+		Update("exp", gorm.Expr("exp + ?", exp))
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to add guild exp: %w", result.Error)
+	}
+
+	var guild models.Guild
+	err := db.Select("exp").Where("id = ?", guildID).First(&guild).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get guild exp: %w", err)
+	}
+
+	return guild.Exp, nil
+}
+
+// UpgradeGuildLevel 提升公会等级，只在当前等级更低时更新，避免并发升级时等级回退
+func (g *GormGuildDatabase) UpgradeGuildLevel(ctx context.Context, guildID int64, level, maxMembers int32) error {
+	err := g.db.WithContext(ctx).
+		Model(&models.Guild{}).
+		Where("id = ? AND level < ?", guildID, level).
+		Updates(map[string]interface{}{
+			"level":       level,
+			"max_members": maxMembers,
+		}).Error
+
+	if err != nil {
+		return fmt.Errorf("failed to upgrade guild level: %w", err)
+	}
+
+	return nil
+}
+
 // DeleteGuild 删除公会
 func (g *GormGuildDatabase) DeleteGuild(ctx context.Context, guildID int64) error {
 	// 开始事务
@@ -175,6 +213,12 @@ func (g *GormGuildDatabase) DeleteGuild(ctx context.Context, guildID int64) erro
 		return fmt.Errorf("failed to delete guild invitations: %w", err)
 	}
 
+	// 删除公会捐献记录
+	if err := tx.Where("guild_id = ?", guildID).Delete(&models.GuildDonation{}).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete guild donations: %w", err)
+	}
+
 	// 删除公会
 	if err := tx.Where("id = ?", guildID).Delete(&models.Guild{}).Error; err != nil {
 		tx.Rollback()
@@ -2,10 +2,13 @@ package guild
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.xubinbest.com/go-game-server/internal/db/models"
+
+	"gorm.io/gorm"
 )
 
 // CreateGuildInvitation 创建公会邀请
@@ -54,3 +57,51 @@ func (g *GormGuildDatabase) GetUserPendingInvitations(ctx context.Context, userI
 
 	return invitations, nil
 }
+
+// GetGuildInvitation 获取公会邀请
+func (g *GormGuildDatabase) GetGuildInvitation(ctx context.Context, invitationID int64) (*models.GuildInvitation, error) {
+	var invitation models.GuildInvitation
+
+	err := g.db.WithContext(ctx).
+		Where("id = ?", invitationID).
+		First(&invitation).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // 邀请不存在
+		}
+		return nil, fmt.Errorf("failed to get guild invitation: %w", err)
+	}
+
+	return &invitation, nil
+}
+
+// DeleteGuildInvitation 删除公会邀请
+func (g *GormGuildDatabase) DeleteGuildInvitation(ctx context.Context, invitationID int64) error {
+	err := g.db.WithContext(ctx).Where("id = ?", invitationID).Delete(&models.GuildInvitation{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete guild invitation: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteUserGuildInvitations 删除用户收到的所有公会邀请
+func (g *GormGuildDatabase) DeleteUserGuildInvitations(ctx context.Context, userID int64) error {
+	err := g.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.GuildInvitation{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete user guild invitations: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredGuildInvitations 删除已过期的公会邀请
+func (g *GormGuildDatabase) DeleteExpiredGuildInvitations(ctx context.Context, before time.Time) (int64, error) {
+	result := g.db.WithContext(ctx).Where("expire_time <= ?", before).Delete(&models.GuildInvitation{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired guild invitations: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
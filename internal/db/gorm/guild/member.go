@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
 	"github.xubinbest.com/go-game-server/internal/db/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // AddGuildMember 添加公会成员
@@ -76,6 +78,32 @@ func (g *GormGuildDatabase) UpdateGuildMemberRole(ctx context.Context, guildID,
 	return nil
 }
 
+// AddGuildMemberContribution 累加公会成员贡献
+// 先锁定成员行，保证同一成员并发捐献时次数校验不会被绕过
+func (g *GormGuildDatabase) AddGuildMemberContribution(ctx context.Context, guildID, userID int64, contribution int64) error {
+	db := g.db.WithContext(ctx)
+
+	var member models.GuildMember
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("guild_id = ? AND user_id = ?", guildID, userID).
+		First(&member).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return interfaces.ErrGuildMemberNotFound
+		}
+		return fmt.Errorf("failed to lock guild member: %w", err)
+	}
+
+	err = db.Model(&models.GuildMember{}).
+		Where("id = ?", member.ID).
+		Update("contribution", gorm.Expr("contribution + ?", contribution)).Error
+	if err != nil {
+		return fmt.Errorf("failed to add guild member contribution: %w", err)
+	}
+
+	return nil
+}
+
 // RemoveGuildMember 移除公会成员
 func (g *GormGuildDatabase) RemoveGuildMember(ctx context.Context, guildID, userID int64) error {
 	err := g.db.WithContext(ctx).
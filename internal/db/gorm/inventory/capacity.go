@@ -0,0 +1,196 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/inventoryplan"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// lockInventory 锁定用户背包容量记录并读取背包物品，记录不存在时按默认容量创建
+// 同一用户的背包变更都先锁定这条记录，因此会串行执行，不会并发占用超出容量的格子
+func lockInventory(ctx context.Context, tx *gorm.DB, userID int64) (*models.InventoryCapacity, []*models.InventoryItem, error) {
+	capacity := &models.InventoryCapacity{
+		UserID:    userID,
+		Capacity:  models.DefaultInventoryCapacity,
+		UpdatedAt: time.Now().Unix(),
+	}
+	err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(capacity).Error
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to init inventory capacity: %w", err)
+	}
+
+	err = tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ?", userID).
+		First(capacity).Error
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to lock inventory capacity: %w", err)
+	}
+
+	var items []*models.InventoryItem
+	err = tx.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Find(&items).Error
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get inventory items: %w", err)
+	}
+
+	return capacity, items, nil
+}
+
+// applyPlan 执行背包变更计划
+func applyPlan(ctx context.Context, tx *gorm.DB, sf *snowflake.Snowflake, plan *inventoryplan.Plan) error {
+	for _, item := range plan.Updates {
+		err := tx.WithContext(ctx).
+			Model(&models.InventoryItem{}).
+			Where("id = ?", item.ID).
+			Updates(map[string]interface{}{
+				"count":      item.Count,
+				"position":   item.Position,
+				"updated_at": item.UpdatedAt,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update item %d: %w", item.ID, err)
+		}
+	}
+
+	if len(plan.Creates) > 0 {
+		for _, item := range plan.Creates {
+			itemID, err := sf.NextID()
+			if err != nil {
+				return fmt.Errorf("failed to generate item ID: %w", err)
+			}
+			item.ID = itemID
+		}
+		if err := tx.WithContext(ctx).Create(&plan.Creates).Error; err != nil {
+			return fmt.Errorf("failed to create items: %w", err)
+		}
+	}
+
+	if len(plan.Deletes) > 0 {
+		err := tx.WithContext(ctx).
+			Where("id IN ?", plan.Deletes).
+			Delete(&models.InventoryItem{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete items: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// AddTemplateItems 在事务中按模板ID发放物品，返回背包放不下的物品，由调用方决定拒绝还是转为邮件发放
+func AddTemplateItems(ctx context.Context, tx *gorm.DB, sf *snowflake.Snowflake, userID int64, items []designconfig.BaseItemCost, rules models.InventoryRules) ([]designconfig.BaseItemCost, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	capacity, current, err := lockInventory(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := inventoryplan.Add(rules, userID, current, capacity.Capacity, items, time.Now().Unix())
+	if err := applyPlan(ctx, tx, sf, plan); err != nil {
+		return nil, err
+	}
+	return plan.Overflow, nil
+}
+
+// RemoveTemplateItems 在事务中按模板ID扣除物品，同模板的多个格子合并计算，数量不足时返回 interfaces.ErrInsufficientItems
+func RemoveTemplateItems(ctx context.Context, tx *gorm.DB, userID int64, items []designconfig.BaseItemCost) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	_, current, err := lockInventory(ctx, tx, userID)
+	if err != nil {
+		return err
+	}
+
+	plan, ok := inventoryplan.Remove(current, items, time.Now().Unix())
+	if !ok {
+		return interfaces.ErrInsufficientItems
+	}
+	return applyPlan(ctx, tx, nil, plan)
+}
+
+// ConsumeItem 在事务中扣除指定实例的物品，实例不存在、模板不符、已装备或数量不足时返回 interfaces.ErrInsufficientItems
+func ConsumeItem(ctx context.Context, tx *gorm.DB, userID int64, itemID int64, templateID int64, count int32) error {
+	_, current, err := lockInventory(ctx, tx, userID)
+	if err != nil {
+		return err
+	}
+
+	plan, ok := inventoryplan.Consume(current, itemID, templateID, count, time.Now().Unix())
+	if !ok {
+		return interfaces.ErrInsufficientItems
+	}
+	return applyPlan(ctx, tx, nil, plan)
+}
+
+// ExpandCapacity 在事务中扩容背包，已扩容次数不是 expandTimes 时返回 interfaces.ErrInventoryExpandConflict
+func ExpandCapacity(ctx context.Context, tx *gorm.DB, userID int64, expandTimes int32, slots int32) (*models.InventoryCapacity, error) {
+	capacity, _, err := lockInventory(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if capacity.ExpandTimes != expandTimes {
+		return nil, interfaces.ErrInventoryExpandConflict
+	}
+
+	capacity.Capacity += slots
+	capacity.ExpandTimes++
+	capacity.UpdatedAt = time.Now().Unix()
+	err = tx.WithContext(ctx).Model(&models.InventoryCapacity{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{
+			"capacity":     capacity.Capacity,
+			"expand_times": capacity.ExpandTimes,
+			"updated_at":   capacity.UpdatedAt,
+		}).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand inventory: %w", err)
+	}
+	return capacity, nil
+}
+
+// ExpandInventory 扩容背包
+func (g *GormInventoryDatabase) ExpandInventory(ctx context.Context, userID int64, expandTimes int32, slots int32) (*models.InventoryCapacity, error) {
+	var capacity *models.InventoryCapacity
+
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		capacity, err = ExpandCapacity(ctx, tx, userID, expandTimes, slots)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return capacity, nil
+}
+
+// SortInventory 整理背包
+func (g *GormInventoryDatabase) SortInventory(ctx context.Context, userID int64, rules models.InventoryRules) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, current, err := lockInventory(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		plan := inventoryplan.Compact(rules, userID, current, time.Now().Unix())
+		return applyPlan(ctx, tx, g.sf, plan)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
 	"github.xubinbest.com/go-game-server/internal/db/models"
 
 	"gorm.io/gorm"
@@ -39,19 +40,31 @@ func (g *GormInventoryDatabase) EquipItem(ctx context.Context, userID int64, ite
 		}
 	}()
 
-	// 取消该槽位的其他装备
-	if err := tx.Model(&models.Equipment{}).
-		Where("user_id = ? AND slot = ?", userID, slot).
-		Delete(&models.Equipment{}).Error; err != nil {
+	// 获取物品信息，已穿戴在其他槽位的物品不能重复穿戴
+	var item models.InventoryItem
+	if err := tx.Where("id = ? AND user_id = ? AND equipped = ?", itemID, userID, false).First(&item).Error; err != nil {
 		tx.Rollback()
-		return fmt.Errorf("failed to unequip existing item: %w", err)
+		return fmt.Errorf("failed to get item: %w", err)
 	}
 
-	// 获取物品信息
-	var item models.InventoryItem
-	if err := tx.Where("id = ? AND user_id = ?", itemID, userID).First(&item).Error; err != nil {
+	// 取消该槽位的其他装备，被替换的物品回到背包
+	var current models.Equipment
+	err := tx.Where("user_id = ? AND slot = ?", userID, slot).First(&current).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		tx.Rollback()
-		return fmt.Errorf("failed to get item: %w", err)
+		return fmt.Errorf("failed to get current equipment: %w", err)
+	}
+	if err == nil {
+		if err := tx.Delete(&current).Error; err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to unequip existing item: %w", err)
+		}
+		if err := tx.Model(&models.InventoryItem{}).
+			Where("id = ?", current.ItemID).
+			Update("equipped", false).Error; err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to update item equipped status: %w", err)
+		}
 	}
 
 	// 生成装备ID
@@ -65,6 +78,7 @@ func (g *GormInventoryDatabase) EquipItem(ctx context.Context, userID int64, ite
 	equipment := &models.Equipment{
 		ID:         equipmentID,
 		UserID:     userID,
+		ItemID:     item.ID,
 		TemplateID: item.TemplateID,
 		Slot:       slot,
 		CreatedAt:  time.Now().Unix(),
@@ -105,6 +119,17 @@ func (g *GormInventoryDatabase) UnequipItem(ctx context.Context, userID int64, s
 		}
 	}()
 
+	// 卸下的装备回到背包占用一个格子，背包已满时拒绝卸下
+	capacity, items, err := lockInventory(ctx, tx, userID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if (&models.Inventory{Items: items}).UsedSlots() >= capacity.Capacity {
+		tx.Rollback()
+		return interfaces.ErrInventoryFull
+	}
+
 	// 获取装备信息
 	var equipment models.Equipment
 	if err := tx.Where("user_id = ? AND slot = ?", userID, slot).First(&equipment).Error; err != nil {
@@ -123,7 +148,7 @@ func (g *GormInventoryDatabase) UnequipItem(ctx context.Context, userID int64, s
 
 	// 更新物品装备状态
 	if err := tx.Model(&models.InventoryItem{}).
-		Where("user_id = ? AND template_id = ?", userID, equipment.TemplateID).
+		Where("id = ?", equipment.ItemID).
 		Update("equipped", false).Error; err != nil {
 		tx.Rollback()
 		return fmt.Errorf("failed to update item equipped status: %w", err)
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
 	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
 	"github.xubinbest.com/go-game-server/internal/snowflake"
 
 	"gorm.io/gorm"
@@ -32,6 +34,7 @@ func (g *GormInventoryDatabase) GetInventory(ctx context.Context, userID int64)
 
 	err := g.db.WithContext(ctx).
 		Where("user_id = ?", userID).
+		Order("position ASC, id ASC").
 		Find(&items).Error
 
 	if err != nil {
@@ -41,58 +44,41 @@ func (g *GormInventoryDatabase) GetInventory(ctx context.Context, userID int64)
 	inventory := &models.Inventory{
 		UserID:   userID,
 		Items:    items,
-		Capacity: 100, // 默认背包容量
+		Capacity: models.DefaultInventoryCapacity, // 未扩容过的用户使用默认容量
+	}
+
+	var capacity models.InventoryCapacity
+	err = g.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		First(&capacity).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get inventory capacity: %w", err)
+	}
+	if err == nil {
+		inventory.Capacity = capacity.Capacity
+		inventory.ExpandTimes = capacity.ExpandTimes
 	}
 
 	return inventory, nil
 }
 
 // AddItemByTemplate 根据模板ID添加物品
-func (g *GormInventoryDatabase) AddItemByTemplate(ctx context.Context, userID int64, templateID int64, count int32) error {
-	// 检查是否已存在该模板的物品
-	var existingItem models.InventoryItem
-	err := g.db.WithContext(ctx).
-		Where("user_id = ? AND template_id = ?", userID, templateID).
-		First(&existingItem).Error
-
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return fmt.Errorf("failed to check existing item: %w", err)
+func (g *GormInventoryDatabase) AddItemByTemplate(ctx context.Context, userID int64, templateID int64, count int32, rules models.InventoryRules) error {
+	if count <= 0 {
+		return errors.New("count must be positive")
 	}
 
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		// 生成ID
-		itemID, err := g.sf.NextID()
-		if err != nil {
-			return fmt.Errorf("failed to generate item ID: %w", err)
-		}
-
-		// 创建新物品
-		item := &models.InventoryItem{
-			ID:         itemID,
-			UserID:     userID,
-			TemplateID: templateID,
-			Count:      count,
-			Equipped:   false,
-			CreatedAt:  time.Now().Unix(),
-			UpdatedAt:  time.Now().Unix(),
-		}
-
-		err = g.db.WithContext(ctx).Create(item).Error
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		items := []designconfig.BaseItemCost{{ItemId: int(templateID), Count: int(count)}}
+		overflow, err := AddTemplateItems(ctx, tx, g.sf, userID, items, rules)
 		if err != nil {
-			return fmt.Errorf("failed to create item: %w", err)
+			return err
 		}
-	} else {
-		// 更新现有物品数量
-		existingItem.Count += count
-		existingItem.UpdatedAt = time.Now().Unix()
-
-		err = g.db.WithContext(ctx).Save(&existingItem).Error
-		if err != nil {
-			return fmt.Errorf("failed to update item count: %w", err)
+		if len(overflow) > 0 {
+			return interfaces.ErrInventoryFull
 		}
-	}
-
-	return nil
+		return nil
+	})
 }
 
 // AddItem 添加物品（根据物品ID）
@@ -0,0 +1,50 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/gorm/inventory"
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+)
+
+// addTemplateItems 在事务中按模板ID发放物品，按叠加上限拆分格子
+// 背包放不下的物品作为附件通过 overflowMail 发放，overflowMail 为 nil 时返回 interfaces.ErrInventoryFull
+func addTemplateItems(ctx context.Context, tx *gorm.DB, sf *snowflake.Snowflake, userID int64, items []designconfig.BaseItemCost, rules models.InventoryRules, overflowMail *models.Mail) error {
+	overflow, err := inventory.AddTemplateItems(ctx, tx, sf, userID, items, rules)
+	if err != nil {
+		return fmt.Errorf("failed to add items: %w", err)
+	}
+	if len(overflow) == 0 {
+		return nil
+	}
+	if overflowMail == nil {
+		return interfaces.ErrInventoryFull
+	}
+
+	mailID, err := sf.NextID()
+	if err != nil {
+		return fmt.Errorf("failed to generate mail ID: %w", err)
+	}
+	now := time.Now().Unix()
+	overflowMail.ID = mailID
+	overflowMail.UserID = userID
+	overflowMail.Attachments = overflow
+	overflowMail.CreatedAt = now
+	overflowMail.UpdatedAt = now
+	if err := tx.WithContext(ctx).Create(overflowMail).Error; err != nil {
+		return fmt.Errorf("failed to create overflow mail: %w", err)
+	}
+	return nil
+}
+
+// removeTemplateItems 在事务中按模板ID扣除物品，数量不足时返回 interfaces.ErrInsufficientItems
+func removeTemplateItems(ctx context.Context, tx *gorm.DB, userID int64, items []designconfig.BaseItemCost) error {
+	return inventory.RemoveTemplateItems(ctx, tx, userID, items)
+}
@@ -0,0 +1,124 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/gorm/inventory"
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormItemUseDatabase GORM道具使用数据库实现
+type GormItemUseDatabase struct {
+	db *gorm.DB
+	sf *snowflake.Snowflake
+}
+
+// NewGormItemUseDatabase 创建GORM道具使用数据库实例
+func NewGormItemUseDatabase(db *gorm.DB, sf *snowflake.Snowflake) interfaces.ItemUseDatabase {
+	return &GormItemUseDatabase{
+		db: db,
+		sf: sf,
+	}
+}
+
+// UseItem 使用道具
+// 先扣除道具，再通过各模块已有的数据库实现发放效果，任一步失败整体回滚
+func (g *GormItemUseDatabase) UseItem(ctx context.Context, order *models.ItemUseOrder) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := claimRequest(tx, order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		if err := inventory.ConsumeItem(ctx, tx, order.UserID, order.ItemID, order.TemplateID, order.Count); err != nil {
+			return err
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			wallet := NewGormWalletDatabase(tx, g.sf)
+			if _, err := wallet.ChangeCurrencies(ctx, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := addTemplateItems(ctx, tx, g.sf, order.UserID, order.Items, order.Inventory, order.OverflowMail); err != nil {
+			return err
+		}
+
+		if level := order.Level; level != nil {
+			userDB := NewGormUserDatabase(tx, g.sf)
+			updated, err := userDB.UpdateUserLevelExp(ctx, order.UserID, level.OldLevel, level.OldExp, level.NewLevel, level.NewExp)
+			if err != nil {
+				return err
+			}
+			if !updated {
+				return interfaces.ErrUserLevelChanged
+			}
+		}
+
+		petDB := NewGormPetDatabase(tx, g.sf)
+		for _, pet := range order.Pets {
+			if err := petDB.CreatePet(ctx, pet); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now().Unix()
+		for _, grant := range order.Buffs {
+			if err := g.extendBuff(ctx, tx, order.UserID, grant, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// extendBuff 锁定增益记录并延长持续时间，已过期的增益从当前时间开始计算
+func (g *GormItemUseDatabase) extendBuff(ctx context.Context, tx *gorm.DB, userID int64, grant *models.BuffGrant, now int64) error {
+	buff := &models.UserBuff{UserID: userID, BuffID: grant.BuffID, ExpireAt: now, UpdatedAt: now}
+	err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(buff).Error
+	if err != nil {
+		return fmt.Errorf("failed to init user buff: %w", err)
+	}
+
+	err = tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND buff_id = ?", userID, grant.BuffID).
+		First(buff).Error
+	if err != nil {
+		return fmt.Errorf("failed to lock user buff: %w", err)
+	}
+
+	grant.ExpireAt = max(buff.ExpireAt, now) + grant.Duration
+	err = tx.WithContext(ctx).Model(&models.UserBuff{}).
+		Where("user_id = ? AND buff_id = ?", userID, grant.BuffID).
+		Updates(map[string]interface{}{
+			"expire_at":  grant.ExpireAt,
+			"updated_at": now,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to extend user buff: %w", err)
+	}
+	return nil
+}
+
+// GetUserBuffs 获取玩家仍生效的增益
+func (g *GormItemUseDatabase) GetUserBuffs(ctx context.Context, userID int64, now int64) ([]*models.UserBuff, error) {
+	var buffs []*models.UserBuff
+	err := g.db.WithContext(ctx).
+		Where("user_id = ? AND expire_at > ?", userID, now).
+		Order("buff_id").
+		Find(&buffs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user buffs: %w", err)
+	}
+
+	return buffs, nil
+}
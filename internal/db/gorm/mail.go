@@ -0,0 +1,260 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormMailDatabase GORM邮件数据库实现
+type GormMailDatabase struct {
+	db *gorm.DB
+	sf *snowflake.Snowflake
+}
+
+// NewGormMailDatabase 创建GORM邮件数据库实例
+func NewGormMailDatabase(db *gorm.DB, sf *snowflake.Snowflake) interfaces.MailDatabase {
+	return &GormMailDatabase{
+		db: db,
+		sf: sf,
+	}
+}
+
+// CreateMails 批量创建邮件
+func (g *GormMailDatabase) CreateMails(ctx context.Context, mails []*models.Mail) error {
+	if len(mails) == 0 {
+		return nil
+	}
+
+	now := time.Now().Unix()
+	for _, mail := range mails {
+		mailID, err := g.sf.NextID()
+		if err != nil {
+			return fmt.Errorf("failed to generate mail ID: %w", err)
+		}
+		mail.ID = mailID
+		mail.CreatedAt = now
+		mail.UpdatedAt = now
+	}
+
+	if err := g.db.WithContext(ctx).Create(&mails).Error; err != nil {
+		return fmt.Errorf("failed to create mails: %w", err)
+	}
+	return nil
+}
+
+// CreateBroadcastMail 创建全服邮件
+func (g *GormMailDatabase) CreateBroadcastMail(ctx context.Context, mail *models.BroadcastMail) error {
+	mailID, err := g.sf.NextID()
+	if err != nil {
+		return fmt.Errorf("failed to generate broadcast mail ID: %w", err)
+	}
+	mail.ID = mailID
+	mail.CreatedAt = time.Now().Unix()
+
+	if err := g.db.WithContext(ctx).Create(mail).Error; err != nil {
+		return fmt.Errorf("failed to create broadcast mail: %w", err)
+	}
+	return nil
+}
+
+// SyncBroadcastMails 投递玩家尚未收到的全服邮件
+func (g *GormMailDatabase) SyncBroadcastMails(ctx context.Context, userID int64, since int64, now int64) error {
+	var broadcasts []*models.BroadcastMail
+	err := g.db.WithContext(ctx).
+		Where("created_at >= ? AND expire_at > ?", since, now).
+		Find(&broadcasts).Error
+	if err != nil {
+		return fmt.Errorf("failed to get broadcast mails: %w", err)
+	}
+	if len(broadcasts) == 0 {
+		return nil
+	}
+
+	broadcastIDs := make([]int64, 0, len(broadcasts))
+	for _, b := range broadcasts {
+		broadcastIDs = append(broadcastIDs, b.ID)
+	}
+
+	// 已投递过的全服邮件（包括已删除的）不再重复投递
+	var delivered []int64
+	err = g.db.WithContext(ctx).
+		Model(&models.Mail{}).
+		Where("user_id = ? AND broadcast_id IN ?", userID, broadcastIDs).
+		Pluck("broadcast_id", &delivered).Error
+	if err != nil {
+		return fmt.Errorf("failed to get delivered broadcast mails: %w", err)
+	}
+	deliveredSet := make(map[int64]bool, len(delivered))
+	for _, id := range delivered {
+		deliveredSet[id] = true
+	}
+
+	var mails []*models.Mail
+	for _, b := range broadcasts {
+		if deliveredSet[b.ID] {
+			continue
+		}
+		mailID, err := g.sf.NextID()
+		if err != nil {
+			return fmt.Errorf("failed to generate mail ID: %w", err)
+		}
+		broadcastID := b.ID
+		mails = append(mails, &models.Mail{
+			ID:          mailID,
+			UserID:      userID,
+			BroadcastID: &broadcastID,
+			Type:        models.MailTypeBroadcast,
+			Title:       b.Title,
+			Content:     b.Content,
+			Attachments: b.Attachments,
+			ExpireAt:    b.ExpireAt,
+			CreatedAt:   b.CreatedAt,
+			UpdatedAt:   now,
+		})
+	}
+	if len(mails) == 0 {
+		return nil
+	}
+
+	// 并发拉取邮箱时依靠 (user_id, broadcast_id) 唯一索引去重
+	err = g.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&mails).Error
+	if err != nil {
+		return fmt.Errorf("failed to deliver broadcast mails: %w", err)
+	}
+	return nil
+}
+
+// GetUserMails 获取用户未删除且未过期的邮件
+func (g *GormMailDatabase) GetUserMails(ctx context.Context, userID int64, now int64) ([]*models.Mail, error) {
+	var mails []*models.Mail
+
+	err := g.db.WithContext(ctx).
+		Where("user_id = ? AND is_deleted = ? AND expire_at > ?", userID, false, now).
+		Order("created_at DESC").
+		Find(&mails).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user mails: %w", err)
+	}
+
+	return mails, nil
+}
+
+// GetMail 获取用户的单封邮件
+func (g *GormMailDatabase) GetMail(ctx context.Context, userID int64, mailID int64) (*models.Mail, error) {
+	var mail models.Mail
+
+	err := g.db.WithContext(ctx).
+		Where("id = ? AND user_id = ? AND is_deleted = ?", mailID, userID, false).
+		First(&mail).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // 邮件不存在
+		}
+		return nil, fmt.Errorf("failed to get mail: %w", err)
+	}
+
+	return &mail, nil
+}
+
+// MarkMailRead 标记邮件已读
+func (g *GormMailDatabase) MarkMailRead(ctx context.Context, userID int64, mailID int64) error {
+	err := g.db.WithContext(ctx).
+		Model(&models.Mail{}).
+		Where("id = ? AND user_id = ? AND is_deleted = ?", mailID, userID, false).
+		Updates(map[string]interface{}{
+			"is_read":    true,
+			"updated_at": time.Now().Unix(),
+		}).Error
+
+	if err != nil {
+		return fmt.Errorf("failed to mark mail read: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimMailAttachments 领取邮件附件
+// 在事务中锁定邮件并以 is_claimed = false 为条件更新，更新成功后再发放物品，任一步失败整体回滚
+func (g *GormMailDatabase) ClaimMailAttachments(ctx context.Context, userID int64, mailID int64, rules models.InventoryRules, now int64) (*models.Mail, error) {
+	var mail models.Mail
+
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ? AND is_deleted = ?", mailID, userID, false).
+			First(&mail).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return interfaces.ErrMailNotFound
+			}
+			return fmt.Errorf("failed to get mail: %w", err)
+		}
+
+		if mail.ExpireAt <= now {
+			return interfaces.ErrMailExpired
+		}
+		if !mail.HasAttachments() {
+			return interfaces.ErrMailNoAttachments
+		}
+		if mail.IsClaimed {
+			return interfaces.ErrMailAlreadyClaimed
+		}
+
+		result := tx.Model(&models.Mail{}).
+			Where("id = ? AND is_claimed = ?", mailID, false).
+			Updates(map[string]interface{}{
+				"is_claimed": true,
+				"is_read":    true,
+				"updated_at": now,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to mark mail claimed: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return interfaces.ErrMailAlreadyClaimed
+		}
+
+		if err := addTemplateItems(ctx, tx, g.sf, userID, mail.Attachments, rules, nil); err != nil {
+			return fmt.Errorf("failed to add attachment items: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mail.IsClaimed = true
+	mail.IsRead = true
+	mail.UpdatedAt = now
+	return &mail, nil
+}
+
+// DeleteMail 删除邮件
+// 只做标记删除，避免全服邮件被重新投递
+func (g *GormMailDatabase) DeleteMail(ctx context.Context, userID int64, mailID int64) error {
+	err := g.db.WithContext(ctx).
+		Model(&models.Mail{}).
+		Where("id = ? AND user_id = ?", mailID, userID).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"updated_at": time.Now().Unix(),
+		}).Error
+
+	if err != nil {
+		return fmt.Errorf("failed to delete mail: %w", err)
+	}
+
+	return nil
+}
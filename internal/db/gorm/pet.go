@@ -60,22 +60,20 @@ func (g *GormPetDatabase) GetUserPets(ctx context.Context, userID int64) ([]*mod
 	return pets, nil
 }
 
-// GetUserBattlePet 获取用户的出战宠物
-func (g *GormPetDatabase) GetUserBattlePet(ctx context.Context, userID int64) (*models.Pet, error) {
-	var pet models.Pet
+// GetUserBattlePets 获取用户的出战宠物，按出战位排序
+func (g *GormPetDatabase) GetUserBattlePets(ctx context.Context, userID int64) ([]*models.Pet, error) {
+	var pets []*models.Pet
 
 	err := g.db.WithContext(ctx).
-		Where("user_id = ? AND is_battle = ?", userID, true).
-		First(&pet).Error
+		Where("user_id = ? AND battle_slot > ?", userID, 0).
+		Order("battle_slot ASC").
+		Find(&pets).Error
 
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil // 没有出战宠物
-		}
-		return nil, fmt.Errorf("failed to get user battle pet: %w", err)
+		return nil, fmt.Errorf("failed to get user battle pets: %w", err)
 	}
 
-	return &pet, nil
+	return pets, nil
 }
 
 // CreatePet 创建新宠物
@@ -100,11 +98,12 @@ func (g *GormPetDatabase) CreatePet(ctx context.Context, pet *models.Pet) error
 }
 
 // UpdatePet 更新宠物信息
+// 星级和出战位由升星、出战接口单独维护，这里不覆盖，避免并发请求的修改被旧数据还原
 func (g *GormPetDatabase) UpdatePet(ctx context.Context, pet *models.Pet) error {
 	// 设置更新时间
 	pet.UpdatedAt = time.Now()
 
-	err := g.db.WithContext(ctx).Save(pet).Error
+	err := g.db.WithContext(ctx).Omit("star", "battle_slot").Save(pet).Error
 	if err != nil {
 		return fmt.Errorf("failed to update pet: %w", err)
 	}
@@ -112,6 +111,22 @@ func (g *GormPetDatabase) UpdatePet(ctx context.Context, pet *models.Pet) error
 	return nil
 }
 
+// UpdatePetLevelExp 更新宠物等级和经验，以当前等级和经验为条件，避免并发加经验相互覆盖
+func (g *GormPetDatabase) UpdatePetLevelExp(ctx context.Context, petID int64, oldLevel, oldExp, newLevel, newExp int32) (bool, error) {
+	result := g.db.WithContext(ctx).Model(&models.Pet{}).
+		Where("id = ? AND level = ? AND exp = ?", petID, oldLevel, oldExp).
+		Updates(map[string]interface{}{
+			"level":      newLevel,
+			"exp":        newExp,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to update pet level: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
 // DeletePet 删除宠物
 func (g *GormPetDatabase) DeletePet(ctx context.Context, petID int64) error {
 	err := g.db.WithContext(ctx).Where("id = ?", petID).Delete(&models.Pet{}).Error
@@ -122,33 +137,37 @@ func (g *GormPetDatabase) DeletePet(ctx context.Context, petID int64) error {
 	return nil
 }
 
-// SetPetBattleStatus 设置宠物出战状态
-func (g *GormPetDatabase) SetPetBattleStatus(ctx context.Context, userID int64, petID int64, isBattle bool) error {
-	// 如果设置为出战，先取消其他宠物的出战状态
-	if isBattle {
-		err := g.db.WithContext(ctx).
-			Model(&models.Pet{}).
-			Where("user_id = ?", userID).
-			Update("is_battle", false).Error
-		if err != nil {
-			return fmt.Errorf("failed to cancel other pets battle status: %w", err)
+// SetPetBattleSlot 设置宠物出战位
+func (g *GormPetDatabase) SetPetBattleSlot(ctx context.Context, userID int64, petID int64, slot int32) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		// 出战时先将该出战位上的其他宠物替换下来
+		if slot > 0 {
+			err := tx.Model(&models.Pet{}).
+				Where("user_id = ? AND battle_slot = ? AND id <> ?", userID, slot, petID).
+				Updates(map[string]interface{}{
+					"battle_slot": 0,
+					"updated_at":  now,
+				}).Error
+			if err != nil {
+				return fmt.Errorf("failed to cancel battle slot: %w", err)
+			}
 		}
-	}
-
-	// 更新指定宠物的出战状态
-	err := g.db.WithContext(ctx).
-		Model(&models.Pet{}).
-		Where("id = ? AND user_id = ?", petID, userID).
-		Updates(map[string]interface{}{
-			"is_battle":  isBattle,
-			"updated_at": time.Now(),
-		}).Error
 
-	if err != nil {
-		return fmt.Errorf("failed to set pet battle status: %w", err)
-	}
+		// 更新指定宠物的出战位
+		err := tx.Model(&models.Pet{}).
+			Where("id = ? AND user_id = ?", petID, userID).
+			Updates(map[string]interface{}{
+				"battle_slot": slot,
+				"updated_at":  now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to set pet battle slot: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // CancelAllPetBattleStatus 取消所有宠物的出战状态
@@ -157,8 +176,8 @@ func (g *GormPetDatabase) CancelAllPetBattleStatus(ctx context.Context, userID i
 		Model(&models.Pet{}).
 		Where("user_id = ?", userID).
 		Updates(map[string]interface{}{
-			"is_battle":  false,
-			"updated_at": time.Now(),
+			"battle_slot": 0,
+			"updated_at":  time.Now(),
 		}).Error
 
 	if err != nil {
@@ -0,0 +1,217 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormPetGrowthDatabase GORM宠物养成数据库实现
+type GormPetGrowthDatabase struct {
+	db *gorm.DB
+	sf *snowflake.Snowflake
+}
+
+// NewGormPetGrowthDatabase 创建GORM宠物养成数据库实例
+func NewGormPetGrowthDatabase(db *gorm.DB, sf *snowflake.Snowflake) interfaces.PetGrowthDatabase {
+	return &GormPetGrowthDatabase{
+		db: db,
+		sf: sf,
+	}
+}
+
+// GetPetSkills 获取玩家所有宠物的技能
+func (g *GormPetGrowthDatabase) GetPetSkills(ctx context.Context, userID int64) ([]*models.PetSkill, error) {
+	var skills []*models.PetSkill
+
+	err := g.db.WithContext(ctx).
+		Where("user_id = ? AND skill_id > ?", userID, 0).
+		Order("pet_id ASC, slot ASC").
+		Find(&skills).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet skills: %w", err)
+	}
+
+	return skills, nil
+}
+
+// EvolvePet 宠物升星
+// 锁定宠物并与读取时的星级比较，星级已变化说明有并发升星，整体回滚
+func (g *GormPetGrowthDatabase) EvolvePet(ctx context.Context, order *models.PetEvolveOrder) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := claimRequest(tx, order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		pet, err := lockPet(ctx, tx, order.UserID, order.PetID)
+		if err != nil {
+			return err
+		}
+		if pet.Star != order.FromStar {
+			return interfaces.ErrPetChanged
+		}
+
+		if err := deletePets(ctx, tx, order.UserID, pet.TemplateID, order.MaterialPetIDs); err != nil {
+			return err
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			wallet := NewGormWalletDatabase(tx, g.sf)
+			if _, err := wallet.ChangeCurrencies(ctx, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := removeTemplateItems(ctx, tx, order.UserID, order.CostItems); err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.Pet{}).
+			Where("id = ?", order.PetID).
+			Updates(map[string]interface{}{
+				"star":       order.ToStar,
+				"updated_at": time.Now(),
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update pet star: %w", err)
+		}
+		return nil
+	})
+}
+
+// LearnPetSkill 学习宠物技能
+// 锁定技能槽记录并与读取时的技能比较，技能已变化说明有并发学习，整体回滚
+func (g *GormPetGrowthDatabase) LearnPetSkill(ctx context.Context, order *models.PetSkillOrder) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := claimRequest(tx, order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		if _, err := lockPet(ctx, tx, order.UserID, order.PetID); err != nil {
+			return err
+		}
+
+		var learned int64
+		err := tx.Model(&models.PetSkill{}).
+			Where("pet_id = ? AND skill_id = ? AND slot <> ?", order.PetID, order.SkillID, order.Slot).
+			Count(&learned).Error
+		if err != nil {
+			return fmt.Errorf("failed to check pet skill: %w", err)
+		}
+		if learned > 0 {
+			return interfaces.ErrPetSkillLearned
+		}
+
+		now := time.Now().Unix()
+		skill := &models.PetSkill{PetID: order.PetID, Slot: order.Slot, UserID: order.UserID, UpdatedAt: now}
+		err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(skill).Error
+		if err != nil {
+			return fmt.Errorf("failed to init pet skill: %w", err)
+		}
+
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("pet_id = ? AND slot = ?", order.PetID, order.Slot).
+			First(skill).Error
+		if err != nil {
+			return fmt.Errorf("failed to lock pet skill: %w", err)
+		}
+		if skill.SkillID != order.FromSkillID {
+			return interfaces.ErrPetChanged
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			wallet := NewGormWalletDatabase(tx, g.sf)
+			if _, err := wallet.ChangeCurrencies(ctx, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := removeTemplateItems(ctx, tx, order.UserID, order.CostItems); err != nil {
+			return err
+		}
+
+		skill.SkillID = order.SkillID
+		skill.UpdatedAt = now
+		if err := tx.Save(skill).Error; err != nil {
+			return fmt.Errorf("failed to update pet skill: %w", err)
+		}
+		return nil
+	})
+}
+
+// ReleasePet 放生宠物
+// 锁定宠物并与计算返还时的星级比较，删除宠物及其技能后发放返还资源
+func (g *GormPetGrowthDatabase) ReleasePet(ctx context.Context, order *models.PetReleaseOrder) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := claimRequest(tx, order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		pet, err := lockPet(ctx, tx, order.UserID, order.PetID)
+		if err != nil {
+			return err
+		}
+		if pet.Star != order.FromStar {
+			return interfaces.ErrPetChanged
+		}
+
+		if err := deletePets(ctx, tx, order.UserID, pet.TemplateID, []int64{pet.ID}); err != nil {
+			return err
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			wallet := NewGormWalletDatabase(tx, g.sf)
+			if _, err := wallet.ChangeCurrencies(ctx, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		return addTemplateItems(ctx, tx, g.sf, order.UserID, order.Items, order.Inventory, order.OverflowMail)
+	})
+}
+
+// lockPet 锁定玩家的宠物，不存在时返回 interfaces.ErrPetNotFound
+func lockPet(ctx context.Context, tx *gorm.DB, userID int64, petID int64) (*models.Pet, error) {
+	var pet models.Pet
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND user_id = ?", petID, userID).
+		First(&pet).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, interfaces.ErrPetNotFound
+		}
+		return nil, fmt.Errorf("failed to lock pet: %w", err)
+	}
+	return &pet, nil
+}
+
+// deletePets 删除玩家指定模板的未出战宠物及其技能，任一宠物不满足条件时返回 interfaces.ErrPetNotFound
+func deletePets(ctx context.Context, tx *gorm.DB, userID int64, templateID int64, petIDs []int64) error {
+	if len(petIDs) == 0 {
+		return nil
+	}
+
+	result := tx.WithContext(ctx).
+		Where("id IN ? AND user_id = ? AND template_id = ? AND battle_slot = ?", petIDs, userID, templateID, 0).
+		Delete(&models.Pet{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete pets: %w", result.Error)
+	}
+	if result.RowsAffected != int64(len(petIDs)) {
+		return interfaces.ErrPetNotFound
+	}
+
+	err := tx.WithContext(ctx).
+		Where("pet_id IN ?", petIDs).
+		Delete(&models.PetSkill{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete pet skills: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// claimRequest 在事务中记录请求键，请求键已记录过时返回 interfaces.ErrDuplicateRequest
+// 事务回滚时记录一并回滚，失败的请求可以使用同一请求键重试
+func claimRequest(tx *gorm.DB, userID int64, requestKey string) error {
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.ProcessedRequest{
+		UserID:     userID,
+		RequestKey: requestKey,
+		CreatedAt:  time.Now().Unix(),
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to record request: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return interfaces.ErrDuplicateRequest
+	}
+	return nil
+}
+
+// GormProcessedRequestDatabase GORM请求去重数据库实现
+type GormProcessedRequestDatabase struct {
+	db *gorm.DB
+	sf *snowflake.Snowflake
+}
+
+// NewGormProcessedRequestDatabase 创建GORM请求去重数据库实例
+func NewGormProcessedRequestDatabase(db *gorm.DB, sf *snowflake.Snowflake) interfaces.ProcessedRequestDatabase {
+	return &GormProcessedRequestDatabase{
+		db: db,
+		sf: sf,
+	}
+}
+
+// IsRequestProcessed 查询请求键是否已处理过
+func (g *GormProcessedRequestDatabase) IsRequestProcessed(ctx context.Context, userID int64, requestKey string) (bool, error) {
+	var count int64
+	err := g.db.WithContext(ctx).Model(&models.ProcessedRequest{}).
+		Where("user_id = ? AND request_key = ?", userID, requestKey).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to check request: %w", err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,137 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormQuestDatabase GORM任务数据库实现
+type GormQuestDatabase struct {
+	db *gorm.DB
+	sf *snowflake.Snowflake
+}
+
+// NewGormQuestDatabase 创建GORM任务数据库实例
+func NewGormQuestDatabase(db *gorm.DB, sf *snowflake.Snowflake) interfaces.QuestDatabase {
+	return &GormQuestDatabase{
+		db: db,
+		sf: sf,
+	}
+}
+
+// GetQuestProgresses 获取用户所有任务进度
+func (g *GormQuestDatabase) GetQuestProgresses(ctx context.Context, userID int64) ([]*models.QuestProgress, error) {
+	var progresses []*models.QuestProgress
+
+	err := g.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Find(&progresses).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quest progresses: %w", err)
+	}
+
+	return progresses, nil
+}
+
+// lockQuestProgress 在事务中锁定任务进度行，不存在时先创建
+func lockQuestProgress(tx *gorm.DB, userID, questID, periodStart, now int64) (*models.QuestProgress, error) {
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.QuestProgress{UserID: userID, QuestID: questID, PeriodStart: periodStart, UpdatedAt: now}).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to init quest progress: %w", err)
+	}
+
+	var progress models.QuestProgress
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND quest_id = ?", userID, questID).
+		First(&progress).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock quest progress: %w", err)
+	}
+	return &progress, nil
+}
+
+// AddQuestProgress 增加任务进度
+func (g *GormQuestDatabase) AddQuestProgress(ctx context.Context, userID int64, questID int64, periodStart int64, delta int64, target int64, eventKey string) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if eventKey != "" {
+			if err := claimRequest(tx, userID, eventKey); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now().Unix()
+		progress, err := lockQuestProgress(tx, userID, questID, periodStart, now)
+		if err != nil {
+			return err
+		}
+		// 进度已切换到更新的周期，旧周期的迟到事件直接丢弃，避免回退周期并重置领取状态
+		if progress.PeriodStart > periodStart {
+			return nil
+		}
+
+		value := progress.ProgressIn(periodStart) + delta
+		if value > target {
+			value = target
+		}
+		err = tx.Model(&models.QuestProgress{}).
+			Where("user_id = ? AND quest_id = ?", userID, questID).
+			Updates(map[string]interface{}{
+				"progress":     value,
+				"period_start": periodStart,
+				"claimed":      progress.ClaimedIn(periodStart),
+				"updated_at":   now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update quest progress: %w", err)
+		}
+		return nil
+	})
+}
+
+// ClaimQuestReward 领取任务奖励
+func (g *GormQuestDatabase) ClaimQuestReward(ctx context.Context, claim *models.QuestClaim) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().Unix()
+		progress, err := lockQuestProgress(tx, claim.UserID, claim.QuestID, claim.PeriodStart, now)
+		if err != nil {
+			return err
+		}
+		if progress.ClaimedIn(claim.PeriodStart) {
+			return interfaces.ErrQuestAlreadyClaimed
+		}
+		if progress.ProgressIn(claim.PeriodStart) < claim.Target {
+			return interfaces.ErrQuestNotCompleted
+		}
+
+		err = tx.Model(&models.QuestProgress{}).
+			Where("user_id = ? AND quest_id = ?", claim.UserID, claim.QuestID).
+			Updates(map[string]interface{}{
+				"claimed":    true,
+				"updated_at": now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to mark quest claimed: %w", err)
+		}
+
+		if len(claim.CurrencyChanges) > 0 {
+			wallet := NewGormWalletDatabase(tx, g.sf)
+			if _, err := wallet.ChangeCurrencies(ctx, claim.UserID, claim.CurrencyChanges, claim.Reason, claim.Source, claim.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := addTemplateItems(ctx, tx, g.sf, claim.UserID, claim.Items, claim.Inventory, claim.OverflowMail); err != nil {
+			return err
+		}
+		return nil
+	})
+}
@@ -0,0 +1,110 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormShopDatabase GORM商店数据库实现
+type GormShopDatabase struct {
+	db *gorm.DB
+	sf *snowflake.Snowflake
+}
+
+// NewGormShopDatabase 创建GORM商店数据库实例
+func NewGormShopDatabase(db *gorm.DB, sf *snowflake.Snowflake) interfaces.ShopDatabase {
+	return &GormShopDatabase{
+		db: db,
+		sf: sf,
+	}
+}
+
+// GetShopPurchases 获取用户指定商品的购买计数
+func (g *GormShopDatabase) GetShopPurchases(ctx context.Context, userID int64, goodsIDs []int64) ([]*models.ShopPurchase, error) {
+	var purchases []*models.ShopPurchase
+	if len(goodsIDs) == 0 {
+		return purchases, nil
+	}
+
+	err := g.db.WithContext(ctx).
+		Where("user_id = ? AND goods_id IN ?", userID, goodsIDs).
+		Find(&purchases).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shop purchases: %w", err)
+	}
+
+	return purchases, nil
+}
+
+// Purchase 购买商品
+// 先锁定购买计数行，保证同一玩家并发购买同一商品时限购校验不会被绕过
+func (g *GormShopDatabase) Purchase(ctx context.Context, order *models.ShopOrder) (*models.ShopPurchase, error) {
+	var purchase models.ShopPurchase
+
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := claimRequest(tx, order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		now := time.Now().Unix()
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.ShopPurchase{UserID: order.UserID, GoodsID: order.GoodsID, PeriodStart: order.PeriodStart, UpdatedAt: now}).Error
+		if err != nil {
+			return fmt.Errorf("failed to init shop purchase: %w", err)
+		}
+
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND goods_id = ?", order.UserID, order.GoodsID).
+			First(&purchase).Error
+		if err != nil {
+			return fmt.Errorf("failed to lock shop purchase: %w", err)
+		}
+
+		count := purchase.CountIn(order.PeriodStart) + order.Quantity
+		if order.LimitCount > 0 && count > order.LimitCount {
+			return interfaces.ErrPurchaseLimitReached
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			wallet := NewGormWalletDatabase(tx, g.sf)
+			if _, err := wallet.ChangeCurrencies(ctx, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := removeTemplateItems(ctx, tx, order.UserID, order.CostItems); err != nil {
+			return err
+		}
+		if err := addTemplateItems(ctx, tx, g.sf, order.UserID, order.GainItems, order.Inventory, nil); err != nil {
+			return err
+		}
+
+		purchase.Count = count
+		purchase.PeriodStart = order.PeriodStart
+		purchase.UpdatedAt = now
+		err = tx.Model(&models.ShopPurchase{}).
+			Where("user_id = ? AND goods_id = ?", order.UserID, order.GoodsID).
+			Updates(map[string]interface{}{
+				"count":        purchase.Count,
+				"period_start": purchase.PeriodStart,
+				"updated_at":   purchase.UpdatedAt,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update shop purchase: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &purchase, nil
+}
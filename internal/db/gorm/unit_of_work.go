@@ -0,0 +1,81 @@
+package gorm
+
+import (
+	"context"
+
+	"github.xubinbest.com/go-game-server/internal/db/gorm/inventory"
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+)
+
+// GormUnitOfWork GORM事务实现
+type GormUnitOfWork struct {
+	db *gorm.DB
+	sf *snowflake.Snowflake
+}
+
+// NewGormUnitOfWork 创建GORM事务实例
+func NewGormUnitOfWork(db *gorm.DB, sf *snowflake.Snowflake) interfaces.UnitOfWork {
+	return &GormUnitOfWork{
+		db: db,
+		sf: sf,
+	}
+}
+
+// Transaction 在同一个数据库事务中执行 fn
+// 各模块实现基于事务连接创建，其内部再开启的事务会作为保存点嵌套在该事务中
+func (g *GormUnitOfWork) Transaction(ctx context.Context, fn func(ctx context.Context, tx interfaces.Tx) error) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(ctx, &gormTx{db: tx, sf: g.sf})
+	})
+}
+
+// gormTx 绑定到同一个事务连接的数据库操作
+type gormTx struct {
+	db *gorm.DB
+	sf *snowflake.Snowflake
+}
+
+// Users 获取事务内的用户操作
+func (t *gormTx) Users() interfaces.UserDatabase {
+	return NewGormUserDatabase(t.db, t.sf)
+}
+
+// Cards 获取事务内的卡牌操作
+func (t *gormTx) Cards() interfaces.CardDatabase {
+	return NewGormCardDatabase(t.db, t.sf)
+}
+
+// Pets 获取事务内的宠物操作
+func (t *gormTx) Pets() interfaces.PetDatabase {
+	return NewGormPetDatabase(t.db, t.sf)
+}
+
+// Wallet 获取事务内的货币钱包操作
+func (t *gormTx) Wallet() interfaces.WalletDatabase {
+	return NewGormWalletDatabase(t.db, t.sf)
+}
+
+// Guilds 获取事务内的帮派操作
+func (t *gormTx) Guilds() interfaces.GuildDatabase {
+	return NewGormGuildDatabase(t.db, t.sf)
+}
+
+// RemoveItems 在事务中按模板ID扣除物品
+func (t *gormTx) RemoveItems(ctx context.Context, userID int64, items []designconfig.BaseItemCost) error {
+	return removeTemplateItems(ctx, t.db, userID, items)
+}
+
+// AddItems 在事务中按模板ID发放物品
+func (t *gormTx) AddItems(ctx context.Context, userID int64, items []designconfig.BaseItemCost, rules models.InventoryRules, overflowMail *models.Mail) error {
+	return addTemplateItems(ctx, t.db, t.sf, userID, items, rules, overflowMail)
+}
+
+// ExpandInventory 在事务中扩容背包
+func (t *gormTx) ExpandInventory(ctx context.Context, userID int64, expandTimes int32, slots int32) (*models.InventoryCapacity, error) {
+	return inventory.ExpandCapacity(ctx, t.db, userID, expandTimes, slots)
+}
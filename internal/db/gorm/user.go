@@ -11,6 +11,7 @@ import (
 	"github.xubinbest.com/go-game-server/internal/snowflake"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GormUserDatabase GORM用户数据库实现
@@ -107,6 +108,37 @@ func (g *GormUserDatabase) DeleteUser(ctx context.Context, userID int64) error {
 	return nil
 }
 
+// UpdateUserRating 更新用户匹配积分
+func (g *GormUserDatabase) UpdateUserRating(ctx context.Context, userID int64, rating int32) error {
+	err := g.db.WithContext(ctx).Model(&models.User{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"rating":     rating,
+			"updated_at": time.Now(),
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update user rating: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateUserLevelExp 更新用户等级和经验（乐观锁）
+func (g *GormUserDatabase) UpdateUserLevelExp(ctx context.Context, userID int64, oldLevel, oldExp, newLevel, newExp int32) (bool, error) {
+	result := g.db.WithContext(ctx).Model(&models.User{}).
+		Where("id = ? AND level = ? AND exp = ?", userID, oldLevel, oldExp).
+		Updates(map[string]interface{}{
+			"level":      newLevel,
+			"exp":        newExp,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to update user level: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
 // GetMonthlySign 获取用户月签到信息
 func (g *GormUserDatabase) GetMonthlySign(ctx context.Context, userID int64) (*models.MonthlySign, error) {
 	var sign models.MonthlySign
@@ -183,3 +215,38 @@ func (g *GormUserDatabase) CreateOrUpdateMonthlySignReward(ctx context.Context,
 
 	return nil
 }
+
+// RolloverMonthlySign 归档上月签到记录并写入新月份的签到记录
+func (g *GormUserDatabase) RolloverMonthlySign(ctx context.Context, archive *models.MonthlySignArchive, sign *models.MonthlySign) error {
+	sign.UpdatedAt = time.Now()
+
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 同一月份已归档时保留首次归档
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(archive).Error
+		if err != nil {
+			return fmt.Errorf("failed to archive monthly sign: %w", err)
+		}
+
+		if err := tx.Save(sign).Error; err != nil {
+			return fmt.Errorf("failed to reset monthly sign: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetMonthlySignArchives 获取用户历史月份签到归档
+func (g *GormUserDatabase) GetMonthlySignArchives(ctx context.Context, userID int64, limit int) ([]*models.MonthlySignArchive, error) {
+	var archives []*models.MonthlySignArchive
+
+	err := g.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("year DESC, month DESC").
+		Limit(limit).
+		Find(&archives).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monthly sign archives: %w", err)
+	}
+
+	return archives, nil
+}
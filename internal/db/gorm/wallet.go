@@ -0,0 +1,163 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormWalletDatabase GORM货币钱包数据库实现
+type GormWalletDatabase struct {
+	db *gorm.DB
+	sf *snowflake.Snowflake
+}
+
+// NewGormWalletDatabase 创建GORM货币钱包数据库实例
+func NewGormWalletDatabase(db *gorm.DB, sf *snowflake.Snowflake) interfaces.WalletDatabase {
+	return &GormWalletDatabase{
+		db: db,
+		sf: sf,
+	}
+}
+
+// GetWallet 获取用户所有货币余额
+func (g *GormWalletDatabase) GetWallet(ctx context.Context, userID int64) ([]*models.UserCurrency, error) {
+	var currencies []*models.UserCurrency
+
+	err := g.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Find(&currencies).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wallet: %w", err)
+	}
+
+	return currencies, nil
+}
+
+// GetCurrencyBalance 获取用户某种货币余额
+func (g *GormWalletDatabase) GetCurrencyBalance(ctx context.Context, userID int64, currencyID int64) (int64, error) {
+	var currency models.UserCurrency
+
+	err := g.db.WithContext(ctx).
+		Where("user_id = ? AND currency_id = ?", userID, currencyID).
+		First(&currency).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get currency balance: %w", err)
+	}
+
+	return currency.Balance, nil
+}
+
+// ChangeCurrencies 变更货币并记录流水
+// 先按货币ID顺序锁定余额行，再检查幂等键，保证同一幂等键的并发请求只有一个生效
+func (g *GormWalletDatabase) ChangeCurrencies(ctx context.Context, userID int64, changes []models.CurrencyChange, reason, source, idempotencyKey string) ([]*models.CurrencyLedger, error) {
+	if idempotencyKey == "" {
+		return nil, fmt.Errorf("idempotency key is required")
+	}
+
+	var ledgers []*models.CurrencyLedger
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().Unix()
+		balances := make([]models.UserCurrency, len(changes))
+		for i, change := range changes {
+			// 余额行不存在时先创建，再加锁读取
+			err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&models.UserCurrency{UserID: userID, CurrencyID: change.CurrencyID, UpdatedAt: now}).Error
+			if err != nil {
+				return fmt.Errorf("failed to init currency: %w", err)
+			}
+
+			err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("user_id = ? AND currency_id = ?", userID, change.CurrencyID).
+				First(&balances[i]).Error
+			if err != nil {
+				return fmt.Errorf("failed to lock currency: %w", err)
+			}
+		}
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND idempotency_key = ?", userID, idempotencyKey).
+			Find(&ledgers).Error
+		if err != nil {
+			return fmt.Errorf("failed to check idempotency key: %w", err)
+		}
+		if len(ledgers) > 0 {
+			return nil
+		}
+
+		for i, change := range changes {
+			balance := balances[i].Balance + change.Amount
+			if balance < 0 {
+				return interfaces.ErrInsufficientCurrency
+			}
+
+			err := tx.Model(&models.UserCurrency{}).
+				Where("user_id = ? AND currency_id = ?", userID, change.CurrencyID).
+				Updates(map[string]interface{}{
+					"balance":    balance,
+					"updated_at": now,
+				}).Error
+			if err != nil {
+				return fmt.Errorf("failed to update currency balance: %w", err)
+			}
+
+			ledgerID, err := g.sf.NextID()
+			if err != nil {
+				return fmt.Errorf("failed to generate ledger ID: %w", err)
+			}
+			ledger := &models.CurrencyLedger{
+				ID:             ledgerID,
+				UserID:         userID,
+				CurrencyID:     change.CurrencyID,
+				Amount:         change.Amount,
+				BalanceAfter:   balance,
+				Reason:         reason,
+				Source:         source,
+				IdempotencyKey: idempotencyKey,
+				CreatedAt:      now,
+			}
+			if err := tx.Create(ledger).Error; err != nil {
+				return fmt.Errorf("failed to create currency ledger: %w", err)
+			}
+			ledgers = append(ledgers, ledger)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ledgers, nil
+}
+
+// GetCurrencyLedgers 分页获取货币流水
+func (g *GormWalletDatabase) GetCurrencyLedgers(ctx context.Context, userID int64, currencyID int64, beforeID int64, limit int) ([]*models.CurrencyLedger, error) {
+	query := g.db.WithContext(ctx).Where("user_id = ?", userID)
+	if currencyID != 0 {
+		query = query.Where("currency_id = ?", currencyID)
+	}
+	if beforeID != 0 {
+		query = query.Where("id < ?", beforeID)
+	}
+
+	var ledgers []*models.CurrencyLedger
+	err := query.Order("id DESC").Limit(limit).Find(&ledgers).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get currency ledgers: %w", err)
+	}
+
+	return ledgers, nil
+}
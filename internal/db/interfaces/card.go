@@ -2,10 +2,14 @@ package interfaces
 
 import (
 	"context"
+	"errors"
 
 	"github.xubinbest.com/go-game-server/internal/db/models"
 )
 
+// ErrCardChanged 卡牌等级或星级已被并发请求修改
+var ErrCardChanged = errors.New("card changed")
+
 // CardDatabase 定义卡牌相关的数据库操作接口
 type CardDatabase interface {
 	// 获取用户所有卡牌
@@ -20,10 +24,10 @@ type CardDatabase interface {
 	// 更新卡牌信息
 	UpdateCard(ctx context.Context, card *models.Card) error
 
-	// 升级卡牌
+	// 升级卡牌，仅当当前等级为 newLevel-1 时更新，否则返回 ErrCardChanged
 	UpgradeCard(ctx context.Context, userID int64, cardID int64, newLevel int32) error
 
-	// 升星卡牌
+	// 升星卡牌，仅当当前星级为 newStar-1 时更新，否则返回 ErrCardChanged
 	UpgradeCardStar(ctx context.Context, userID int64, cardID int64, newStar int32) error
 
 	// 检查卡牌是否存在
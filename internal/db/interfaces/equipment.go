@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+)
+
+var (
+	// ErrEquipmentNotFound 装备实例不存在或不属于该玩家
+	ErrEquipmentNotFound = errors.New("equipment not found")
+	// ErrEquipmentChanged 强化时装备的强化等级已被并发请求修改
+	ErrEquipmentChanged = errors.New("equipment changed")
+)
+
+// EquipmentDatabase 定义装备强化和洗练相关的数据库操作接口
+type EquipmentDatabase interface {
+	// 获取玩家所有装备实例的强化等级和词条，未强化也未洗练的装备没有记录
+	GetEquipmentEnhances(ctx context.Context, userID int64) ([]*models.EquipmentEnhance, error)
+
+	// 强化或洗练装备：扣除消耗并更新强化等级和词条，任一步失败整体回滚
+	// 装备不存在返回 ErrEquipmentNotFound，强化等级已变化返回 ErrEquipmentChanged，货币不足返回 ErrInsufficientCurrency，物品不足返回 ErrInsufficientItems
+	// 同一幂等键的订单已处理过时返回 ErrDuplicateRequest
+	UpgradeEquipment(ctx context.Context, order *models.EquipmentUpgradeOrder) (*models.EquipmentEnhance, error)
+}
@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+)
+
+var ErrGachaPityChanged = errors.New("gacha pity changed")
+
+// GachaDatabase 定义抽卡相关的数据库操作接口
+type GachaDatabase interface {
+	// 获取用户在卡池中的保底计数，没有抽过时返回 nil
+	GetGachaPity(ctx context.Context, userID int64, poolID int64) (*models.GachaPity, error)
+
+	// 抽卡：扣除消耗、发放奖励、写审计记录并更新保底计数，任一步失败整体回滚
+	// 保底计数已被并发抽取修改时返回 ErrGachaPityChanged，货币不足返回 ErrInsufficientCurrency，物品不足返回 ErrInsufficientItems
+	// 同一幂等键的订单已处理过时返回 ErrDuplicateRequest
+	Pull(ctx context.Context, order *models.GachaOrder) error
+
+	// 分页获取抽卡记录，poolID 为0时返回所有卡池
+	GetGachaRecords(ctx context.Context, userID int64, poolID int64, beforeID int64, limit int) ([]*models.GachaRecord, error)
+
+	// 获取同一抽卡请求写入的记录，按抽取顺序返回
+	GetGachaRecordsByRequest(ctx context.Context, userID int64, requestKey string) ([]*models.GachaRecord, error)
+}
@@ -2,10 +2,14 @@ package interfaces
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.xubinbest.com/go-game-server/internal/db/models"
 )
 
+var ErrGuildMemberNotFound = errors.New("guild member not found")
+
 // GuildDatabase 定义帮派相关的数据库操作接口
 type GuildDatabase interface {
 	// 创建帮派
@@ -62,6 +66,21 @@ type GuildDatabase interface {
 	// 获取用户邀请列表
 	GetUserPendingInvitations(ctx context.Context, userID int64) ([]*models.GuildInvitation, error)
 
+	// 获取帮派邀请
+	GetGuildInvitation(ctx context.Context, invitationID int64) (*models.GuildInvitation, error)
+
+	// 删除帮派邀请
+	DeleteGuildInvitation(ctx context.Context, invitationID int64) error
+
+	// 删除用户收到的所有帮派邀请
+	DeleteUserGuildInvitations(ctx context.Context, userID int64) error
+
+	// 删除 before 之前过期的帮派申请，返回删除数量
+	DeleteExpiredGuildApplications(ctx context.Context, before time.Time) (int64, error)
+
+	// 删除 before 之前过期的帮派邀请，返回删除数量
+	DeleteExpiredGuildInvitations(ctx context.Context, before time.Time) (int64, error)
+
 	// 获取用户帮派
 	GetUserGuilds(ctx context.Context, userID int64) ([]*models.Guild, error)
 
@@ -71,5 +90,24 @@ type GuildDatabase interface {
 	// 分页查询帮派列表
 	GetGuildList(ctx context.Context, page, pageSize int32) ([]*models.Guild, int32, error)
 
+	// 累加帮派经验，返回累加后的累计经验
+	AddGuildExp(ctx context.Context, guildID int64, exp int64) (int64, error)
+
+	// 提升帮派等级并设置对应的成员上限，帮派当前等级不低于 level 时不做修改
+	UpgradeGuildLevel(ctx context.Context, guildID int64, level, maxMembers int32) error
+
+	// 累加成员贡献，成员不在帮派中时返回 ErrGuildMemberNotFound
+	// 在事务中调用时会锁定该成员，同一成员的并发捐献按顺序执行
+	AddGuildMemberContribution(ctx context.Context, guildID, userID int64, contribution int64) error
+
+	// 创建帮派捐献记录
+	CreateGuildDonation(ctx context.Context, donation *models.GuildDonation) error
+
+	// 统计用户 since 之后指定捐献项的捐献次数
+	CountUserGuildDonations(ctx context.Context, userID, donateID int64, since time.Time) (int32, error)
+
+	// 获取帮派最近的捐献记录，按时间倒序
+	GetGuildDonations(ctx context.Context, guildID int64, limit int32) ([]*models.GuildDonation, error)
+
 	// 其他帮派相关方法...
 }
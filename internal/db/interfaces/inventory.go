@@ -2,17 +2,33 @@ package interfaces
 
 import (
 	"context"
+	"errors"
 
 	"github.xubinbest.com/go-game-server/internal/db/models"
 )
 
+var ErrInsufficientItems = errors.New("insufficient items")
+
+var (
+	// ErrInventoryFull 背包空间不足，且调用方没有提供溢出邮件
+	ErrInventoryFull = errors.New("inventory full")
+	// ErrInventoryExpandConflict 扩容时背包的扩容次数已被并发请求修改
+	ErrInventoryExpandConflict = errors.New("inventory expand conflict")
+)
+
 // InventoryDatabase 定义背包相关的数据库操作接口
 type InventoryDatabase interface {
-	// 获取用户背包
+	// 获取用户背包，物品按格子序号排列
 	GetInventory(ctx context.Context, userID int64) (*models.Inventory, error)
 
-	// 添加物品到背包（通过模板ID）
-	AddItemByTemplate(ctx context.Context, userID int64, templateID int64, count int32) error
+	// 添加物品到背包（通过模板ID），按叠加上限拆分格子，背包放不下时返回 ErrInventoryFull 且不发放任何物品
+	AddItemByTemplate(ctx context.Context, userID int64, templateID int64, count int32, rules models.InventoryRules) error
+
+	// 扩容背包，仅当已扩容次数仍为 expandTimes 时生效，否则返回 ErrInventoryExpandConflict
+	ExpandInventory(ctx context.Context, userID int64, expandTimes int32, slots int32) (*models.InventoryCapacity, error)
+
+	// 整理背包，合并同模板物品并重新编排格子序号
+	SortInventory(ctx context.Context, userID int64, rules models.InventoryRules) error
 
 	// 添加物品到背包（通过实例ID）
 	AddItem(ctx context.Context, userID int64, itemID int64, count int32) error
@@ -29,6 +45,7 @@ type InventoryDatabase interface {
 	// 装备相关方法
 	GetEquipments(ctx context.Context, userID int64) ([]*models.Equipment, error)
 	EquipItem(ctx context.Context, userID int64, itemID int64, slot int32) error
+	// 卸下装备，装备回到背包，背包已满时返回 ErrInventoryFull
 	UnequipItem(ctx context.Context, userID int64, slot int32) error
 	GetEquipmentBySlot(ctx context.Context, userID int64, slot int32) (*models.Equipment, error)
 }
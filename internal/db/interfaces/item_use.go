@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+)
+
+var ErrUserLevelChanged = errors.New("user level changed")
+
+// ItemUseDatabase 定义道具使用相关的数据库操作接口
+type ItemUseDatabase interface {
+	// 使用道具：扣除道具并发放货币、物品、经验、宠物和增益，任一步失败整体回滚
+	// 道具不足返回 ErrInsufficientItems，等级经验已被并发修改返回 ErrUserLevelChanged
+	// 同一幂等键的订单已处理过时返回 ErrDuplicateRequest
+	UseItem(ctx context.Context, order *models.ItemUseOrder) error
+
+	// 获取玩家在 now 时仍生效的增益
+	GetUserBuffs(ctx context.Context, userID int64, now int64) ([]*models.UserBuff, error)
+}
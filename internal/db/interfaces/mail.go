@@ -0,0 +1,43 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+)
+
+var (
+	ErrMailNotFound       = errors.New("mail not found")
+	ErrMailExpired        = errors.New("mail expired")
+	ErrMailAlreadyClaimed = errors.New("mail attachments already claimed")
+	ErrMailNoAttachments  = errors.New("mail has no attachments")
+)
+
+// MailDatabase 定义邮件相关的数据库操作接口
+type MailDatabase interface {
+	// 创建邮件（系统邮件、玩家邮件），会为每封邮件生成ID
+	CreateMails(ctx context.Context, mails []*models.Mail) error
+
+	// 创建全服邮件
+	CreateBroadcastMail(ctx context.Context, mail *models.BroadcastMail) error
+
+	// 将玩家尚未收到的全服邮件投递到邮箱，只投递创建时间不早于since且未过期的全服邮件
+	SyncBroadcastMails(ctx context.Context, userID int64, since int64, now int64) error
+
+	// 获取用户未删除且未过期的邮件，按创建时间倒序
+	GetUserMails(ctx context.Context, userID int64, now int64) ([]*models.Mail, error)
+
+	// 获取用户的单封邮件，不存在或已删除时返回nil
+	GetMail(ctx context.Context, userID int64, mailID int64) (*models.Mail, error)
+
+	// 标记邮件已读
+	MarkMailRead(ctx context.Context, userID int64, mailID int64) error
+
+	// 领取邮件附件，标记领取与发放物品在同一事务中完成，保证附件只发放一次
+	// 背包放不下附件时返回 ErrInventoryFull，邮件保持未领取
+	ClaimMailAttachments(ctx context.Context, userID int64, mailID int64, rules models.InventoryRules, now int64) (*models.Mail, error)
+
+	// 删除邮件
+	DeleteMail(ctx context.Context, userID int64, mailID int64) error
+}
@@ -14,20 +14,23 @@ type PetDatabase interface {
 	// 获取用户的所有宠物
 	GetUserPets(ctx context.Context, userID int64) ([]*models.Pet, error)
 
-	// 获取用户出战的宠物
-	GetUserBattlePet(ctx context.Context, userID int64) (*models.Pet, error)
+	// 获取用户出战的宠物，按出战位排序
+	GetUserBattlePets(ctx context.Context, userID int64) ([]*models.Pet, error)
 
 	// 创建新宠物
 	CreatePet(ctx context.Context, pet *models.Pet) error
 
-	// 更新宠物信息
+	// 更新宠物信息，不修改星级和出战位
 	UpdatePet(ctx context.Context, pet *models.Pet) error
 
+	// 更新宠物等级和经验，仅当当前等级和经验与old一致时更新，返回是否更新成功
+	UpdatePetLevelExp(ctx context.Context, petID int64, oldLevel, oldExp, newLevel, newExp int32) (bool, error)
+
 	// 删除宠物
 	DeletePet(ctx context.Context, petID int64) error
 
-	// 设置宠物出战状态
-	SetPetBattleStatus(ctx context.Context, userID int64, petID int64, isBattle bool) error
+	// 设置宠物出战位，slot 为 0 时休战，出战位上原有的宠物会被替换下来
+	SetPetBattleSlot(ctx context.Context, userID int64, petID int64, slot int32) error
 
 	// 取消用户所有宠物的出战状态
 	CancelAllPetBattleStatus(ctx context.Context, userID int64) error
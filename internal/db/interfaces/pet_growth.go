@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+)
+
+var (
+	// ErrPetNotFound 宠物不存在、不属于该玩家，或作为材料、放生时处于出战状态
+	ErrPetNotFound = errors.New("pet not found")
+	// ErrPetChanged 宠物星级或技能槽已被并发请求修改
+	ErrPetChanged = errors.New("pet changed")
+	// ErrPetSkillLearned 宠物已在其他技能槽学会该技能
+	ErrPetSkillLearned = errors.New("pet skill already learned")
+)
+
+// PetGrowthDatabase 定义宠物升星、技能和放生相关的数据库操作接口
+type PetGrowthDatabase interface {
+	// 获取玩家所有宠物的技能
+	GetPetSkills(ctx context.Context, userID int64) ([]*models.PetSkill, error)
+
+	// 宠物升星：消耗材料宠物、货币和道具并提升星级，任一步失败整体回滚
+	// 宠物或材料宠物不存在返回 ErrPetNotFound，星级已变化返回 ErrPetChanged，货币不足返回 ErrInsufficientCurrency，物品不足返回 ErrInsufficientItems
+	// 同一幂等键的订单已处理过时返回 ErrDuplicateRequest
+	EvolvePet(ctx context.Context, order *models.PetEvolveOrder) error
+
+	// 学习宠物技能：扣除消耗并写入技能槽，技能槽已变化返回 ErrPetChanged，已在其他技能槽学会返回 ErrPetSkillLearned
+	// 同一幂等键的订单已处理过时返回 ErrDuplicateRequest
+	LearnPetSkill(ctx context.Context, order *models.PetSkillOrder) error
+
+	// 放生宠物：删除未出战的宠物及其技能并发放返还资源，宠物不存在或出战中返回 ErrPetNotFound，星级已变化返回 ErrPetChanged
+	// 同一幂等键的订单已处理过时返回 ErrDuplicateRequest
+	ReleasePet(ctx context.Context, order *models.PetReleaseOrder) error
+}
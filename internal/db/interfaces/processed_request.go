@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrDuplicateRequest 请求键已处理过，本次请求不做任何修改
+var ErrDuplicateRequest = errors.New("duplicate request")
+
+// ProcessedRequestDatabase 定义客户端请求去重相关的数据库操作接口
+type ProcessedRequestDatabase interface {
+	// 查询请求键是否已处理过，重试的请求可在前置校验之前直接返回已处理的结果
+	IsRequestProcessed(ctx context.Context, userID int64, requestKey string) (bool, error)
+}
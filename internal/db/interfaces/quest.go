@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+)
+
+var (
+	ErrQuestNotCompleted   = errors.New("quest not completed")
+	ErrQuestAlreadyClaimed = errors.New("quest already claimed")
+)
+
+// QuestDatabase 定义任务和成就相关的数据库操作接口
+type QuestDatabase interface {
+	// 获取用户所有任务进度
+	GetQuestProgresses(ctx context.Context, userID int64) ([]*models.QuestProgress, error)
+
+	// 增加任务进度，周期切换时先清零进度和领取状态，进度不超过 target
+	// 已记录的周期比 periodStart 更新时视为迟到事件，不做任何修改
+	// eventKey 与进度在同一事务中记录，同一事件键已处理过时返回 ErrDuplicateRequest，为空时不去重
+	AddQuestProgress(ctx context.Context, userID int64, questID int64, periodStart int64, delta int64, target int64, eventKey string) error
+
+	// 领取任务奖励：校验进度、标记已领取并发放奖励，任一步失败整体回滚
+	// 未完成返回 ErrQuestNotCompleted，重复领取返回 ErrQuestAlreadyClaimed
+	ClaimQuestReward(ctx context.Context, claim *models.QuestClaim) error
+}
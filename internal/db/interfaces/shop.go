@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+)
+
+var ErrPurchaseLimitReached = errors.New("purchase limit reached")
+
+// ShopDatabase 定义商店相关的数据库操作接口
+type ShopDatabase interface {
+	// 获取用户指定商品的购买计数，没有购买过的商品不返回记录
+	GetShopPurchases(ctx context.Context, userID int64, goodsIDs []int64) ([]*models.ShopPurchase, error)
+
+	// 购买商品：校验限购、扣除货币和物品、发放商品并累加购买计数，任一步失败整体回滚
+	// 超出限购返回 ErrPurchaseLimitReached，货币不足返回 ErrInsufficientCurrency，物品不足返回 ErrInsufficientItems
+	// 同一幂等键的订单已处理过时返回 ErrDuplicateRequest
+	Purchase(ctx context.Context, order *models.ShopOrder) (*models.ShopPurchase, error)
+}
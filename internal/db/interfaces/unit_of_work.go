@@ -0,0 +1,42 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// Tx 事务内的数据库操作入口，通过它取得的各模块实现共享同一个事务
+// 事务函数内的所有操作都必须使用事务函数传入的 ctx
+type Tx interface {
+	// 用户相关操作
+	Users() UserDatabase
+
+	// 卡牌相关操作
+	Cards() CardDatabase
+
+	// 宠物相关操作
+	Pets() PetDatabase
+
+	// 货币钱包相关操作
+	Wallet() WalletDatabase
+
+	// 帮派相关操作
+	Guilds() GuildDatabase
+
+	// 按模板ID扣除物品，数量不足时返回 ErrInsufficientItems
+	RemoveItems(ctx context.Context, userID int64, items []designconfig.BaseItemCost) error
+
+	// 按模板ID发放物品，背包放不下的物品通过 overflowMail 发放，overflowMail 为 nil 时返回 ErrInventoryFull
+	AddItems(ctx context.Context, userID int64, items []designconfig.BaseItemCost, rules models.InventoryRules, overflowMail *models.Mail) error
+
+	// 扩容背包，已扩容次数不是 expandTimes 时返回 ErrInventoryExpandConflict
+	ExpandInventory(ctx context.Context, userID int64, expandTimes int32, slots int32) (*models.InventoryCapacity, error)
+}
+
+// UnitOfWork 定义跨模块的事务操作接口
+type UnitOfWork interface {
+	// 在同一事务中执行 fn，fn 返回错误时回滚其中的全部操作，否则一并提交
+	Transaction(ctx context.Context, fn func(ctx context.Context, tx Tx) error) error
+}
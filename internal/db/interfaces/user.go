@@ -23,6 +23,11 @@ type UserDatabase interface {
 	// 删除用户
 	DeleteUser(ctx context.Context, userID int64) error
 
+	// 更新用户匹配积分
+	UpdateUserRating(ctx context.Context, userID int64, rating int32) error
+	// 更新用户等级和经验，仅当当前等级和经验与old一致时更新，返回是否更新成功
+	UpdateUserLevelExp(ctx context.Context, userID int64, oldLevel, oldExp, newLevel, newExp int32) (bool, error)
+
 	// 月签到相关方法
 	// 获取用户月签到信息
 	GetMonthlySign(ctx context.Context, userID int64) (*models.MonthlySign, error)
@@ -39,5 +44,11 @@ type UserDatabase interface {
 	// 创建或更新月签到累计奖励记录
 	CreateOrUpdateMonthlySignReward(ctx context.Context, reward *models.MonthlySignReward) error
 
+	// 跨月时归档上月签到记录并写入新月份的签到记录，两步在同一事务中完成，重复归档同一月份时保留首次归档
+	RolloverMonthlySign(ctx context.Context, archive *models.MonthlySignArchive, sign *models.MonthlySign) error
+
+	// 获取用户历史月份签到归档，按年月倒序
+	GetMonthlySignArchives(ctx context.Context, userID int64, limit int) ([]*models.MonthlySignArchive, error)
+
 	// 其他用户相关方法...
 }
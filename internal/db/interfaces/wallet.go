@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+)
+
+var ErrInsufficientCurrency = errors.New("insufficient currency")
+
+// WalletDatabase 定义货币钱包相关的数据库操作接口
+type WalletDatabase interface {
+	// 获取用户所有货币余额
+	GetWallet(ctx context.Context, userID int64) ([]*models.UserCurrency, error)
+
+	// 获取用户某种货币余额，没有记录时返回0
+	GetCurrencyBalance(ctx context.Context, userID int64, currencyID int64) (int64, error)
+
+	// 在一个事务中变更多种货币并记录流水，任一货币余额不足时整体失败并返回 ErrInsufficientCurrency
+	// idempotencyKey 已记账时不再重复变更，直接返回已有流水
+	ChangeCurrencies(ctx context.Context, userID int64, changes []models.CurrencyChange, reason, source, idempotencyKey string) ([]*models.CurrencyLedger, error)
+
+	// 分页获取货币流水，按时间倒序；currencyID为0时返回所有货币，beforeID为0时从最新开始
+	GetCurrencyLedgers(ctx context.Context, userID int64, currencyID int64, beforeID int64, limit int) ([]*models.CurrencyLedger, error)
+}
@@ -3,8 +3,8 @@ package models
 // Card 卡牌模型
 type Card struct {
 	ID         int64 `json:"id" bson:"id" gorm:"primaryKey;autoIncrement:false"`
-	UserID     int64 `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index"`
-	TemplateID int64 `json:"template_id" bson:"template_id" gorm:"type:bigint;not null"`
+	UserID     int64 `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index;uniqueIndex:uk_user_template"`
+	TemplateID int64 `json:"template_id" bson:"template_id" gorm:"type:bigint;not null;uniqueIndex:uk_user_template"`
 	Level      int32 `json:"level" bson:"level" gorm:"type:int;default:1;not null"`
 	Star       int32 `json:"star" bson:"star" gorm:"type:int;default:1;not null"`
 	CreatedAt  int64 `json:"created_at" bson:"created_at" gorm:"type:bigint;not null"`
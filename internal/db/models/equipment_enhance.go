@@ -0,0 +1,39 @@
+package models
+
+import (
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// EquipmentAffix 装备洗练出的随机词条，保存洗练时的属性，配置调整不影响已有词条
+type EquipmentAffix struct {
+	AffixID   int64                  `json:"affix_id" bson:"affix_id"`
+	Attribute designconfig.Attribute `json:"attribute" bson:"attribute"`
+}
+
+// EquipmentEnhance 装备实例的强化等级和洗练词条，以背包物品实例ID为主键，穿戴和卸下不影响
+type EquipmentEnhance struct {
+	ItemID    int64            `json:"item_id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
+	UserID    int64            `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index"`
+	Level     int32            `json:"level" bson:"level" gorm:"type:int;default:0;not null"`
+	Affixes   []EquipmentAffix `json:"affixes" bson:"affixes" gorm:"type:text;serializer:json"`
+	UpdatedAt int64            `json:"updated_at" bson:"updated_at" gorm:"type:bigint;not null"`
+}
+
+func (EquipmentEnhance) TableName() string {
+	return "equipment_enhances"
+}
+
+// EquipmentUpgradeOrder 一次装备强化或洗练，扣除消耗和更新装备实例在同一事务中完成
+type EquipmentUpgradeOrder struct {
+	UserID          int64
+	ItemID          int64
+	TemplateID      int64
+	FromLevel       int32            // 读取时的强化等级，用于检测并发强化
+	ToLevel         int32            // 强化失败时与 FromLevel 相同
+	Affixes         []EquipmentAffix // 为 nil 时不修改词条
+	CurrencyChanges []CurrencyChange
+	CostItems       []designconfig.BaseItemCost
+	Reason          string
+	Source          string
+	IdempotencyKey  string // 由客户端请求ID生成，同一键的订单只处理一次
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// GachaRewardType 定义抽卡奖励类型常量，与 gacha_drop 配置表一致
+const (
+	GachaRewardItem = 1 // 物品或货币
+	GachaRewardCard = 2 // 卡牌
+	GachaRewardPet  = 3 // 宠物
+)
+
+// GachaPity 玩家在卡池中的保底计数
+type GachaPity struct {
+	UserID     int64 `json:"user_id" bson:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	PoolID     int64 `json:"pool_id" bson:"pool_id" gorm:"primaryKey;autoIncrement:false"`
+	PityCount  int32 `json:"pity_count" bson:"pity_count" gorm:"type:int;default:0;not null"`      // 距上次抽中稀有的连续次数
+	TotalPulls int64 `json:"total_pulls" bson:"total_pulls" gorm:"type:bigint;default:0;not null"` // 累计抽取次数
+	UpdatedAt  int64 `json:"updated_at" bson:"updated_at" gorm:"type:bigint;not null"`
+}
+
+func (GachaPity) TableName() string {
+	return "gacha_pities"
+}
+
+// GachaRecord 抽卡审计记录，每抽一条
+// 记录随机种子和抽取前的保底计数，可按配置重放验证抽取结果
+type GachaRecord struct {
+	ID         int64  `json:"id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
+	UserID     int64  `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index:idx_user_pool,priority:1"`
+	PoolID     int64  `json:"pool_id" bson:"pool_id" gorm:"type:bigint;not null;index:idx_user_pool,priority:2"`
+	BatchID    int64  `json:"batch_id" bson:"batch_id" gorm:"type:bigint;not null;index"` // 同一次单抽或十连共用批次ID
+	Seed       int64  `json:"seed" bson:"seed" gorm:"type:bigint;not null"`
+	PullIndex  int32  `json:"pull_index" bson:"pull_index" gorm:"type:int;not null"`
+	PityBefore int32  `json:"pity_before" bson:"pity_before" gorm:"type:int;not null"`
+	DropID     int64  `json:"drop_id" bson:"drop_id" gorm:"type:bigint;not null"`
+	RewardType int32  `json:"reward_type" bson:"reward_type" gorm:"type:int;not null"`
+	RewardID   int64  `json:"reward_id" bson:"reward_id" gorm:"type:bigint;not null"`
+	Count      int32  `json:"count" bson:"count" gorm:"type:int;not null"`
+	IsRare     bool   `json:"is_rare" bson:"is_rare" gorm:"type:boolean;default:false;not null"`
+	Converted  bool   `json:"converted" bson:"converted" gorm:"type:boolean;default:false;not null"`             // 重复卡牌已转换为激活材料
+	RequestKey string `json:"request_key" bson:"request_key" gorm:"type:varchar(100);not null;default:'';index"` // 抽卡请求的幂等键，重试时据此返回已抽到的奖励
+	CreatedAt  int64  `json:"created_at" bson:"created_at" gorm:"type:bigint;not null"`
+}
+
+func (GachaRecord) TableName() string {
+	return "gacha_records"
+}
+
+// GachaOrder 一次抽卡，扣除消耗、发放奖励、写审计记录和更新保底计数在同一事务中完成
+type GachaOrder struct {
+	UserID          int64
+	PoolID          int64
+	PityBefore      int32 // 抽取时读到的保底计数，用于检测并发抽取
+	TotalBefore     int64
+	PityAfter       int32
+	CurrencyChanges []CurrencyChange
+	CostItems       []designconfig.BaseItemCost
+	Items           []designconfig.BaseItemCost
+	Inventory       InventoryRules // 发放物品时的叠加规则
+	OverflowMail    *Mail          // 背包放不下的物品通过该邮件发放
+	Cards           []*Card
+	Pets            []*Pet
+	Records         []*GachaRecord
+	Reason          string
+	Source          string
+	IdempotencyKey  string // 由客户端请求ID生成，同一键的订单只处理一次
+}
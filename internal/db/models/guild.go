@@ -36,7 +36,9 @@ type Guild struct {
 	Announcement string    `json:"announcement" bson:"announcement" gorm:"type:text"`
 	MasterID     int64     `json:"master_id" bson:"master_id" gorm:"type:bigint;not null;index"`
 	CreatedAt    time.Time `json:"created_at" bson:"created_at" gorm:"autoCreateTime"`
-	MaxMembers   int32     `json:"max_members" bson:"max_members" gorm:"type:int;default:50;not null"`
+	MaxMembers   int32     `json:"max_members" bson:"max_members" gorm:"type:int;default:50;not null"` // 由帮派等级决定
+	Level        int32     `json:"level" bson:"level" gorm:"type:int;default:1;not null"`
+	Exp          int64     `json:"exp" bson:"exp" gorm:"type:bigint;default:0;not null"`      // 累计经验
 	Version      int32     `json:"version" bson:"version" gorm:"type:int;default:1;not null"` // 用于乐观锁
 }
 
@@ -46,12 +48,13 @@ func (Guild) TableName() string {
 
 // GuildMember 公会成员模型
 type GuildMember struct {
-	ID        int64     `json:"id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
-	GuildID   int64     `json:"guild_id" bson:"guild_id" gorm:"type:bigint;not null;index"`
-	UserID    int64     `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index"`
-	Role      int32     `json:"role" bson:"role" gorm:"type:int;default:5;not null"` // 1: 帮主, 2: 副帮主, 3: 长老, 4: 精英, 5: 普通成员, 6: 学徒
-	JoinTime  time.Time `json:"join_time" bson:"join_time" gorm:"autoCreateTime"`
-	LastLogin time.Time `json:"last_login" bson:"last_login" gorm:"autoCreateTime"`
+	ID           int64     `json:"id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
+	GuildID      int64     `json:"guild_id" bson:"guild_id" gorm:"type:bigint;not null;index"`
+	UserID       int64     `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index"`
+	Role         int32     `json:"role" bson:"role" gorm:"type:int;default:5;not null"` // 1: 帮主, 2: 副帮主, 3: 长老, 4: 精英, 5: 普通成员, 6: 学徒
+	JoinTime     time.Time `json:"join_time" bson:"join_time" gorm:"autoCreateTime"`
+	LastLogin    time.Time `json:"last_login" bson:"last_login" gorm:"autoCreateTime"`
+	Contribution int64     `json:"contribution" bson:"contribution" gorm:"type:bigint;default:0;not null"` // 累计贡献
 }
 
 func (GuildMember) TableName() string {
@@ -64,7 +67,7 @@ type GuildApplication struct {
 	GuildID    int64     `json:"guild_id" bson:"guild_id" gorm:"type:bigint;not null;index"`
 	UserID     int64     `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index"`
 	Time       time.Time `json:"time" bson:"time" gorm:"autoCreateTime"`
-	ExpireTime time.Time `json:"expire_time" bson:"expire_time" gorm:"not null"`
+	ExpireTime time.Time `json:"expire_time" bson:"expire_time" gorm:"not null;index"`
 }
 
 func (GuildApplication) TableName() string {
@@ -78,9 +81,24 @@ type GuildInvitation struct {
 	UserID     int64     `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index"`
 	InviterID  int64     `json:"inviter_id" bson:"inviter_id" gorm:"type:bigint;not null;index"`
 	Time       time.Time `json:"time" bson:"time" gorm:"autoCreateTime"`
-	ExpireTime time.Time `json:"expire_time" bson:"expire_time" gorm:"not null"`
+	ExpireTime time.Time `json:"expire_time" bson:"expire_time" gorm:"not null;index"`
 }
 
 func (GuildInvitation) TableName() string {
 	return "guild_invitations"
 }
+
+// GuildDonation 帮派捐献记录
+type GuildDonation struct {
+	ID           int64     `json:"id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
+	GuildID      int64     `json:"guild_id" bson:"guild_id" gorm:"type:bigint;not null;index:idx_guild_time,priority:1"`
+	UserID       int64     `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index:idx_user_donate,priority:1"`
+	DonateID     int64     `json:"donate_id" bson:"donate_id" gorm:"type:bigint;not null;index:idx_user_donate,priority:2"` // guild_donate 配置表ID
+	Exp          int64     `json:"exp" bson:"exp" gorm:"type:bigint;not null"`
+	Contribution int64     `json:"contribution" bson:"contribution" gorm:"type:bigint;not null"`
+	Time         time.Time `json:"time" bson:"time" gorm:"not null;index:idx_guild_time,priority:2;index:idx_user_donate,priority:3"`
+}
+
+func (GuildDonation) TableName() string {
+	return "guild_donations"
+}
@@ -1,18 +1,34 @@
 package models
 
+// DefaultInventoryCapacity 背包默认格子数
+const DefaultInventoryCapacity = 100
+
 // Inventory 定义用户背包数据模型
 type Inventory struct {
-	UserID   int64            `json:"user_id" bson:"user_id,omitempty"`
-	Items    []*InventoryItem `json:"items" bson:"items,omitempty"`
-	Capacity int32            `json:"capacity" bson:"capacity"` // 背包容量
+	UserID      int64            `json:"user_id" bson:"user_id,omitempty"`
+	Items       []*InventoryItem `json:"items" bson:"items,omitempty"`
+	Capacity    int32            `json:"capacity" bson:"capacity"`         // 背包容量
+	ExpandTimes int32            `json:"expand_times" bson:"expand_times"` // 已扩容次数
+}
+
+// UsedSlots 背包已占用的格子数，已装备的物品不占用背包格子
+func (inv *Inventory) UsedSlots() int32 {
+	var used int32
+	for _, item := range inv.Items {
+		if !item.Equipped {
+			used++
+		}
+	}
+	return used
 }
 
-// InventoryItem 背包物品模型
+// InventoryItem 背包物品模型，每条记录占用一个背包格子
 type InventoryItem struct {
-	ID         int64 `json:"id" bson:"id" gorm:"primaryKey;autoIncrement:false"`
+	ID         int64 `json:"id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
 	UserID     int64 `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index"`
 	TemplateID int64 `json:"template_id" bson:"template_id" gorm:"type:bigint;not null"`
 	Count      int32 `json:"count" bson:"count" gorm:"type:int;default:1;not null"`
+	Position   int32 `json:"position" bson:"position" gorm:"type:int;default:0;not null"` // 格子序号，整理背包时重新编号
 	Equipped   bool  `json:"equipped" bson:"equipped" gorm:"type:boolean;default:false;not null"`
 	CreatedAt  int64 `json:"created_at" bson:"created_at" gorm:"type:bigint;not null"`
 	UpdatedAt  int64 `json:"updated_at" bson:"updated_at" gorm:"type:bigint;not null"`
@@ -22,10 +38,23 @@ func (InventoryItem) TableName() string {
 	return "inventory_items"
 }
 
+// InventoryCapacity 用户背包容量，未扩容过的用户在首次变更背包时按默认容量创建
+type InventoryCapacity struct {
+	UserID      int64 `json:"user_id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
+	Capacity    int32 `json:"capacity" bson:"capacity" gorm:"type:int;not null"`
+	ExpandTimes int32 `json:"expand_times" bson:"expand_times" gorm:"type:int;default:0;not null"`
+	UpdatedAt   int64 `json:"updated_at" bson:"updated_at" gorm:"type:bigint;not null"`
+}
+
+func (InventoryCapacity) TableName() string {
+	return "inventory_capacities"
+}
+
 // Equipment 装备模型
 type Equipment struct {
-	ID         int64 `json:"id" bson:"id" gorm:"primaryKey;autoIncrement:false"`
+	ID         int64 `json:"id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
 	UserID     int64 `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index"`
+	ItemID     int64 `json:"item_id" bson:"item_id" gorm:"type:bigint;default:0;not null"` // 穿戴的背包物品实例ID
 	TemplateID int64 `json:"template_id" bson:"template_id" gorm:"type:bigint;not null"`
 	Slot       int32 `json:"slot" bson:"slot" gorm:"type:int;not null"`
 	CreatedAt  int64 `json:"created_at" bson:"created_at" gorm:"type:bigint;not null"`
@@ -0,0 +1,7 @@
+package models
+
+// InventoryRules 背包物品规则，由业务层根据 item 配置表生成，随订单传给数据库层计算背包变更
+type InventoryRules struct {
+	Stacks map[int64]int32 // 模板ID到单格叠加上限，未配置或不大于0时不限叠加
+	Ranks  map[int64]int32 // 模板ID到整理排序序号，序号小的排在前面
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// UserBuff 玩家增益，同一增益重复获得时在剩余时间上延长持续时间
+type UserBuff struct {
+	UserID    int64 `json:"user_id" bson:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	BuffID    int64 `json:"buff_id" bson:"buff_id" gorm:"primaryKey;autoIncrement:false"`
+	ExpireAt  int64 `json:"expire_at" bson:"expire_at" gorm:"type:bigint;not null"`
+	UpdatedAt int64 `json:"updated_at" bson:"updated_at" gorm:"type:bigint;not null"`
+}
+
+func (UserBuff) TableName() string {
+	return "user_buffs"
+}
+
+// BuffGrant 使用道具获得的增益
+type BuffGrant struct {
+	BuffID   int64
+	Duration int64 // 持续秒数
+	ExpireAt int64 // 延长后的过期时间，由数据库层在事务中填入
+}
+
+// UserLevelChange 玩家等级经验变化，仅当等级经验仍为 OldLevel、OldExp 时生效
+type UserLevelChange struct {
+	OldLevel int32
+	OldExp   int32
+	NewLevel int32
+	NewExp   int32
+}
+
+// ItemUseOrder 一次道具使用，扣除道具和发放效果在同一事务中完成
+type ItemUseOrder struct {
+	UserID          int64
+	ItemID          int64 // 使用的道具实例ID
+	TemplateID      int64
+	Count           int32
+	CurrencyChanges []CurrencyChange
+	Items           []designconfig.BaseItemCost
+	Inventory       InventoryRules   // 发放物品时的叠加规则
+	OverflowMail    *Mail            // 背包放不下的物品通过该邮件发放
+	Level           *UserLevelChange // 为 nil 时不修改等级经验
+	Pets            []*Pet
+	Buffs           []*BuffGrant
+	Reason          string
+	Source          string
+	IdempotencyKey  string // 由客户端请求ID生成，同一键的订单只处理一次
+}
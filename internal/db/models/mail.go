@@ -0,0 +1,55 @@
+package models
+
+import (
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// MailType 定义邮件类型常量
+const (
+	MailTypeSystem    = 1 // 系统邮件
+	MailTypeBroadcast = 2 // 全服邮件
+	MailTypePlayer    = 3 // 玩家邮件
+)
+
+// Mail 玩家邮箱中的邮件
+// 全服邮件在玩家拉取邮箱时投递为一封普通邮件，BroadcastID 记录来源，保证每个玩家只投递一次
+type Mail struct {
+	ID          int64                       `json:"id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
+	UserID      int64                       `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index;uniqueIndex:uk_user_broadcast"`
+	BroadcastID *int64                      `json:"broadcast_id,omitempty" bson:"broadcast_id,omitempty" gorm:"type:bigint;uniqueIndex:uk_user_broadcast"`
+	Type        int32                       `json:"type" bson:"type" gorm:"type:int;not null"`
+	SenderID    int64                       `json:"sender_id" bson:"sender_id" gorm:"type:bigint;default:0;not null"` // 系统邮件为0
+	SenderName  string                      `json:"sender_name" bson:"sender_name" gorm:"type:varchar(50);not null"`
+	Title       string                      `json:"title" bson:"title" gorm:"type:varchar(100);not null"`
+	Content     string                      `json:"content" bson:"content" gorm:"type:text"`
+	Attachments []designconfig.BaseItemCost `json:"attachments" bson:"attachments" gorm:"type:text;serializer:json"`
+	IsRead      bool                        `json:"is_read" bson:"is_read" gorm:"type:boolean;default:false;not null"`
+	IsClaimed   bool                        `json:"is_claimed" bson:"is_claimed" gorm:"type:boolean;default:false;not null"`
+	IsDeleted   bool                        `json:"is_deleted" bson:"is_deleted" gorm:"type:boolean;default:false;not null"`
+	ExpireAt    int64                       `json:"expire_at" bson:"expire_at" gorm:"type:bigint;not null;index"`
+	CreatedAt   int64                       `json:"created_at" bson:"created_at" gorm:"type:bigint;not null"`
+	UpdatedAt   int64                       `json:"updated_at" bson:"updated_at" gorm:"type:bigint;not null"`
+}
+
+func (Mail) TableName() string {
+	return "mails"
+}
+
+// HasAttachments 邮件是否带有附件
+func (m *Mail) HasAttachments() bool {
+	return len(m.Attachments) > 0
+}
+
+// BroadcastMail 全服邮件
+type BroadcastMail struct {
+	ID          int64                       `json:"id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
+	Title       string                      `json:"title" bson:"title" gorm:"type:varchar(100);not null"`
+	Content     string                      `json:"content" bson:"content" gorm:"type:text"`
+	Attachments []designconfig.BaseItemCost `json:"attachments" bson:"attachments" gorm:"type:text;serializer:json"`
+	ExpireAt    int64                       `json:"expire_at" bson:"expire_at" gorm:"type:bigint;not null;index"`
+	CreatedAt   int64                       `json:"created_at" bson:"created_at" gorm:"type:bigint;not null;index"`
+}
+
+func (BroadcastMail) TableName() string {
+	return "broadcast_mails"
+}
@@ -12,7 +12,8 @@ type Pet struct {
 	Name       string    `json:"name" bson:"name" gorm:"type:varchar(50);not null"`
 	Level      int32     `json:"level" bson:"level" gorm:"type:int;default:1;not null"`
 	Exp        int32     `json:"exp" bson:"exp" gorm:"type:int;default:0;not null"`
-	IsBattle   bool      `json:"is_battle" bson:"is_battle" gorm:"type:boolean;default:false;not null"`
+	Star       int32     `json:"star" bson:"star" gorm:"type:int;default:0;not null"`
+	BattleSlot int32     `json:"battle_slot" bson:"battle_slot" gorm:"type:int;default:0;not null;index"` // 出战位，0 表示未出战
 	CreatedAt  time.Time `json:"created_at" bson:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt  time.Time `json:"updated_at" bson:"updated_at" gorm:"autoUpdateTime"`
 }
@@ -20,3 +21,8 @@ type Pet struct {
 func (Pet) TableName() string {
 	return "pets"
 }
+
+// InBattle 宠物是否在出战位上
+func (p *Pet) InBattle() bool {
+	return p.BattleSlot > 0
+}
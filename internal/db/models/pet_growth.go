@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// PetSkill 宠物技能槽上学会的技能，每个技能槽一条记录
+type PetSkill struct {
+	PetID     int64 `json:"pet_id" bson:"pet_id" gorm:"primaryKey;autoIncrement:false"`
+	Slot      int32 `json:"slot" bson:"slot" gorm:"primaryKey;autoIncrement:false"`
+	UserID    int64 `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index"`
+	SkillID   int64 `json:"skill_id" bson:"skill_id" gorm:"type:bigint;default:0;not null"`
+	UpdatedAt int64 `json:"updated_at" bson:"updated_at" gorm:"type:bigint;not null"`
+}
+
+func (PetSkill) TableName() string {
+	return "pet_skills"
+}
+
+// PetEvolveOrder 一次宠物升星，消耗材料宠物、货币和道具与提升星级在同一事务中完成
+type PetEvolveOrder struct {
+	UserID          int64
+	PetID           int64
+	FromStar        int32 // 读取时的星级，用于检测并发升星
+	ToStar          int32
+	MaterialPetIDs  []int64 // 作为材料消耗的同模板宠物
+	CurrencyChanges []CurrencyChange
+	CostItems       []designconfig.BaseItemCost
+	Reason          string
+	Source          string
+	IdempotencyKey  string // 由客户端请求ID生成，同一键的订单只处理一次
+}
+
+// PetSkillOrder 一次宠物技能学习，新技能替换技能槽上原有的技能
+type PetSkillOrder struct {
+	UserID          int64
+	PetID           int64
+	Slot            int32
+	FromSkillID     int64 // 读取时技能槽上的技能，0 表示空槽，用于检测并发学习
+	SkillID         int64
+	CurrencyChanges []CurrencyChange
+	CostItems       []designconfig.BaseItemCost
+	Reason          string
+	Source          string
+	IdempotencyKey  string // 由客户端请求ID生成，同一键的订单只处理一次
+}
+
+// PetReleaseOrder 一次宠物放生，删除宠物及其技能并发放返还资源
+type PetReleaseOrder struct {
+	UserID          int64
+	PetID           int64
+	FromStar        int32 // 计算返还时的星级，星级已变化时整体回滚
+	CurrencyChanges []CurrencyChange
+	Items           []designconfig.BaseItemCost
+	Inventory       InventoryRules // 发放物品时的叠加规则
+	OverflowMail    *Mail          // 背包放不下的物品通过该邮件发放
+	Reason          string
+	Source          string
+	IdempotencyKey  string // 由客户端请求ID生成，同一键的订单只处理一次
+}
@@ -0,0 +1,13 @@
+package models
+
+// ProcessedRequest 已处理的客户端请求，与业务写入在同一事务中记录
+// 同一玩家的同一请求键只会处理一次，客户端重试时不会重复扣除和发放
+type ProcessedRequest struct {
+	UserID     int64  `json:"user_id" bson:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	RequestKey string `json:"request_key" bson:"request_key" gorm:"primaryKey;type:varchar(100)"`
+	CreatedAt  int64  `json:"created_at" bson:"created_at" gorm:"type:bigint;not null"`
+}
+
+func (ProcessedRequest) TableName() string {
+	return "processed_requests"
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// QuestType 定义任务类型常量，与 quest 配置表一致
+const (
+	QuestTypeDaily       = 1 // 每日任务
+	QuestTypeWeekly      = 2 // 每周任务
+	QuestTypeAchievement = 3 // 成就，不重置
+)
+
+// QuestProgress 玩家任务进度
+type QuestProgress struct {
+	UserID      int64 `json:"user_id" bson:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	QuestID     int64 `json:"quest_id" bson:"quest_id" gorm:"primaryKey;autoIncrement:false"`
+	Progress    int64 `json:"progress" bson:"progress" gorm:"type:bigint;default:0;not null"`
+	PeriodStart int64 `json:"period_start" bson:"period_start" gorm:"type:bigint;default:0;not null"` // 进度所属重置周期的起始时间
+	Claimed     bool  `json:"claimed" bson:"claimed" gorm:"type:boolean;default:false;not null"`
+	UpdatedAt   int64 `json:"updated_at" bson:"updated_at" gorm:"type:bigint;not null"`
+}
+
+func (QuestProgress) TableName() string {
+	return "quest_progresses"
+}
+
+// ProgressIn 获取指定周期内的任务进度，周期已切换时进度视为0
+func (p *QuestProgress) ProgressIn(periodStart int64) int64 {
+	if p == nil || p.PeriodStart != periodStart {
+		return 0
+	}
+	return p.Progress
+}
+
+// ClaimedIn 判断指定周期内的任务奖励是否已领取
+func (p *QuestProgress) ClaimedIn(periodStart int64) bool {
+	if p == nil || p.PeriodStart != periodStart {
+		return false
+	}
+	return p.Claimed
+}
+
+// QuestClaim 一次任务奖励领取，校验进度、标记领取和发放奖励在同一事务中完成
+type QuestClaim struct {
+	UserID          int64
+	QuestID         int64
+	PeriodStart     int64
+	Target          int64
+	CurrencyChanges []CurrencyChange
+	Items           []designconfig.BaseItemCost
+	Inventory       InventoryRules // 发放物品时的叠加规则
+	OverflowMail    *Mail          // 背包放不下的物品通过该邮件发放
+	Reason          string
+	Source          string
+	IdempotencyKey  string
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// ShopLimitType 定义商品限购类型常量，与 shop_goods 配置表一致
+const (
+	ShopLimitNone     = 0 // 不限购
+	ShopLimitDaily    = 1 // 每日限购
+	ShopLimitWeekly   = 2 // 每周限购
+	ShopLimitLifetime = 3 // 终身限购
+)
+
+// ShopPurchase 玩家商品购买计数
+type ShopPurchase struct {
+	UserID      int64 `json:"user_id" bson:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	GoodsID     int64 `json:"goods_id" bson:"goods_id" gorm:"primaryKey;autoIncrement:false"`
+	Count       int32 `json:"count" bson:"count" gorm:"type:int;default:0;not null"`
+	PeriodStart int64 `json:"period_start" bson:"period_start" gorm:"type:bigint;default:0;not null"` // 计数所属限购周期的起始时间
+	UpdatedAt   int64 `json:"updated_at" bson:"updated_at" gorm:"type:bigint;not null"`
+}
+
+func (ShopPurchase) TableName() string {
+	return "shop_purchases"
+}
+
+// CountIn 获取指定限购周期内的购买次数，周期已切换时计数视为0
+func (p *ShopPurchase) CountIn(periodStart int64) int32 {
+	if p == nil || p.PeriodStart != periodStart {
+		return 0
+	}
+	return p.Count
+}
+
+// ShopOrder 一次商店购买，扣除价格、发放商品和累加购买计数在同一事务中完成
+type ShopOrder struct {
+	UserID          int64
+	GoodsID         int64
+	Quantity        int32
+	LimitCount      int32 // 限购次数，0为不限购
+	PeriodStart     int64 // 当前限购周期起始时间
+	CurrencyChanges []CurrencyChange
+	CostItems       []designconfig.BaseItemCost
+	GainItems       []designconfig.BaseItemCost
+	Inventory       InventoryRules // 发放物品时的叠加规则，背包放不下时购买失败
+	Reason          string
+	Source          string
+	IdempotencyKey  string // 由客户端请求ID生成，同一键的订单只处理一次
+}
@@ -4,6 +4,12 @@ import (
 	"time"
 )
 
+// DefaultUserRating 新用户的初始匹配积分
+const DefaultUserRating = 1000
+
+// InitialUserLevel 新用户的初始等级
+const InitialUserLevel = 1
+
 // User 用户模型 - 只包含基础字段，不包含关联关系
 type User struct {
 	ID           int64     `json:"id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
@@ -14,6 +20,7 @@ type User struct {
 	PasswordHash string    `json:"-" bson:"password_hash" gorm:"type:varchar(255);not null"`
 	Salt         string    `json:"-" bson:"salt" gorm:"type:varchar(50);not null"`
 	Role         string    `json:"role" bson:"role" gorm:"type:varchar(20);default:'user';not null"`
+	Rating       int32     `json:"rating" bson:"rating" gorm:"type:int;default:1000;not null"` // 匹配积分
 	CreatedAt    time.Time `json:"created_at" bson:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt    time.Time `json:"updated_at" bson:"updated_at" gorm:"autoUpdateTime"`
 }
@@ -23,12 +30,22 @@ func (User) TableName() string {
 	return "users"
 }
 
+// EffectiveUserLevel 未初始化的等级按初始等级处理，MongoDB 中早期创建的用户等级为0
+// 读取等级计算属性、校验等级或展示时使用，以等级和经验为条件的乐观锁更新仍使用数据库中的原值
+func EffectiveUserLevel(level int32) int32 {
+	if level < InitialUserLevel {
+		return InitialUserLevel
+	}
+	return level
+}
+
 // MonthlySign 月签到模型
 type MonthlySign struct {
 	UserID       int64     `json:"user_id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
 	Year         int32     `json:"year" bson:"year" gorm:"type:int;not null"`
 	Month        int32     `json:"month" bson:"month" gorm:"type:int;not null"`
 	SignDays     int32     `json:"sign_days" bson:"sign_days" gorm:"type:int;default:0;not null"`
+	MakeupCount  int32     `json:"makeup_count" bson:"makeup_count" gorm:"type:int;default:0;not null"` // 本月已补签次数
 	LastSignTime time.Time `json:"last_sign_time" bson:"last_sign_time" gorm:"not null"`
 	CreatedAt    time.Time `json:"created_at" bson:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt    time.Time `json:"updated_at" bson:"updated_at" gorm:"autoUpdateTime"`
@@ -51,3 +68,17 @@ type MonthlySignReward struct {
 func (MonthlySignReward) TableName() string {
 	return "monthly_sign_rewards"
 }
+
+// MonthlySignArchive 月签到归档，跨月时保存上月的签到位图
+type MonthlySignArchive struct {
+	UserID      int64     `json:"user_id" bson:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	Year        int32     `json:"year" bson:"year" gorm:"primaryKey;autoIncrement:false"`
+	Month       int32     `json:"month" bson:"month" gorm:"primaryKey;autoIncrement:false"`
+	SignDays    int32     `json:"sign_days" bson:"sign_days" gorm:"type:int;default:0;not null"`
+	MakeupCount int32     `json:"makeup_count" bson:"makeup_count" gorm:"type:int;default:0;not null"`
+	CreatedAt   time.Time `json:"created_at" bson:"created_at" gorm:"autoCreateTime"`
+}
+
+func (MonthlySignArchive) TableName() string {
+	return "monthly_sign_archives"
+}
@@ -0,0 +1,37 @@
+package models
+
+// UserCurrency 玩家货币余额，每个玩家每种货币一条记录
+type UserCurrency struct {
+	UserID     int64 `json:"user_id" bson:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	CurrencyID int64 `json:"currency_id" bson:"currency_id" gorm:"primaryKey;autoIncrement:false"`
+	Balance    int64 `json:"balance" bson:"balance" gorm:"type:bigint;default:0;not null"`
+	UpdatedAt  int64 `json:"updated_at" bson:"updated_at" gorm:"type:bigint;not null"`
+}
+
+func (UserCurrency) TableName() string {
+	return "user_currencies"
+}
+
+// CurrencyLedger 货币流水，只追加不修改
+// 同一玩家的同一幂等键只会记账一次，重试请求直接返回首次记账的流水
+type CurrencyLedger struct {
+	ID             int64  `json:"id" bson:"_id" gorm:"primaryKey;autoIncrement:false"`
+	UserID         int64  `json:"user_id" bson:"user_id" gorm:"type:bigint;not null;index;uniqueIndex:uk_user_currency_key"`
+	CurrencyID     int64  `json:"currency_id" bson:"currency_id" gorm:"type:bigint;not null;uniqueIndex:uk_user_currency_key"`
+	Amount         int64  `json:"amount" bson:"amount" gorm:"type:bigint;not null"`               // 变动数量，负数为扣除
+	BalanceAfter   int64  `json:"balance_after" bson:"balance_after" gorm:"type:bigint;not null"` // 变动后余额
+	Reason         string `json:"reason" bson:"reason" gorm:"type:varchar(50);not null"`          // 变动原因，例如 card_upgrade
+	Source         string `json:"source" bson:"source" gorm:"type:varchar(100);not null"`         // 关联来源，例如 card:1001
+	IdempotencyKey string `json:"idempotency_key" bson:"idempotency_key" gorm:"type:varchar(100);not null;uniqueIndex:uk_user_currency_key"`
+	CreatedAt      int64  `json:"created_at" bson:"created_at" gorm:"type:bigint;not null"`
+}
+
+func (CurrencyLedger) TableName() string {
+	return "currency_ledgers"
+}
+
+// CurrencyChange 一次货币变动
+type CurrencyChange struct {
+	CurrencyID int64
+	Amount     int64 // 负数为扣除
+}
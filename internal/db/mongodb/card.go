@@ -47,7 +47,7 @@ func (m *MongoDBCardDatabase) GetUserCards(ctx context.Context, userID int64) ([
 }
 
 func (m *MongoDBCardDatabase) GetUserCard(ctx context.Context, userID int64, cardID int64) (*models.Card, error) {
-	filter := bson.M{"_id": cardID, "user_id": userID}
+	filter := bson.M{"id": cardID, "user_id": userID}
 
 	var card models.Card
 	err := m.cardColl.FindOne(ctx, filter).Decode(&card)
@@ -82,7 +82,7 @@ func (m *MongoDBCardDatabase) CreateCard(ctx context.Context, card *models.Card)
 func (m *MongoDBCardDatabase) UpdateCard(ctx context.Context, card *models.Card) error {
 	card.UpdatedAt = time.Now().Unix()
 
-	filter := bson.M{"_id": card.ID, "user_id": card.UserID}
+	filter := bson.M{"id": card.ID, "user_id": card.UserID}
 	update := bson.M{
 		"$set": bson.M{
 			"template_id": card.TemplateID,
@@ -105,7 +105,7 @@ func (m *MongoDBCardDatabase) UpdateCard(ctx context.Context, card *models.Card)
 }
 
 func (m *MongoDBCardDatabase) UpgradeCard(ctx context.Context, userID int64, cardID int64, newLevel int32) error {
-	filter := bson.M{"_id": cardID, "user_id": userID}
+	filter := bson.M{"id": cardID, "user_id": userID, "level": newLevel - 1}
 	update := bson.M{
 		"$set": bson.M{
 			"level":      newLevel,
@@ -119,14 +119,14 @@ func (m *MongoDBCardDatabase) UpgradeCard(ctx context.Context, userID int64, car
 	}
 
 	if result.MatchedCount == 0 {
-		return fmt.Errorf("card not found")
+		return interfaces.ErrCardChanged
 	}
 
 	return nil
 }
 
 func (m *MongoDBCardDatabase) UpgradeCardStar(ctx context.Context, userID int64, cardID int64, newStar int32) error {
-	filter := bson.M{"_id": cardID, "user_id": userID}
+	filter := bson.M{"id": cardID, "user_id": userID, "star": newStar - 1}
 	update := bson.M{
 		"$set": bson.M{
 			"star":       newStar,
@@ -140,7 +140,7 @@ func (m *MongoDBCardDatabase) UpgradeCardStar(ctx context.Context, userID int64,
 	}
 
 	if result.MatchedCount == 0 {
-		return fmt.Errorf("card not found")
+		return interfaces.ErrCardChanged
 	}
 
 	return nil
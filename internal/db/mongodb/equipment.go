@@ -0,0 +1,131 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBEquipmentDatabase 实现 EquipmentDatabase 接口
+type MongoDBEquipmentDatabase struct {
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+	wallet   *MongoDBWalletDatabase
+}
+
+// NewMongoDBEquipmentDatabase 创建 MongoDBEquipmentDatabase 实例
+func NewMongoDBEquipmentDatabase(client *mongo.Client, database string, sf *snowflake.Snowflake) interfaces.EquipmentDatabase {
+	return &MongoDBEquipmentDatabase{
+		client:   client,
+		database: database,
+		sf:       sf,
+		wallet: &MongoDBWalletDatabase{
+			client:   client,
+			database: database,
+			sf:       sf,
+		},
+	}
+}
+
+// 获取装备强化集合
+func (m *MongoDBEquipmentDatabase) collection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("equipment_enhances")
+}
+
+// GetEquipmentEnhances 获取玩家所有装备实例的强化等级和词条
+func (m *MongoDBEquipmentDatabase) GetEquipmentEnhances(ctx context.Context, userID int64) ([]*models.EquipmentEnhance, error) {
+	cursor, err := m.collection().Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get equipment enhances: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var enhances []*models.EquipmentEnhance
+	if err := cursor.All(ctx, &enhances); err != nil {
+		return nil, fmt.Errorf("failed to decode equipment enhances: %w", err)
+	}
+
+	return enhances, nil
+}
+
+// UpgradeEquipment 强化或洗练装备
+// 以读取时的强化等级为条件更新，等级已变化说明有并发强化，整体回滚
+func (m *MongoDBEquipmentDatabase) UpgradeEquipment(ctx context.Context, order *models.EquipmentUpgradeOrder) (*models.EquipmentEnhance, error) {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	var enhance models.EquipmentEnhance
+	err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		db := m.client.Database(m.database)
+		if err := claimRequest(sc, db, order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		count, err := db.Collection("inventory_items").CountDocuments(sc, bson.M{
+			"_id":         order.ItemID,
+			"user_id":     order.UserID,
+			"template_id": order.TemplateID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get equipment item: %w", err)
+		}
+		if count == 0 {
+			return interfaces.ErrEquipmentNotFound
+		}
+
+		set := bson.M{
+			"user_id":    order.UserID,
+			"level":      order.ToLevel,
+			"updated_at": time.Now().Unix(),
+		}
+		if order.Affixes != nil {
+			set["affixes"] = order.Affixes
+		}
+		err = m.collection().FindOneAndUpdate(sc,
+			bson.M{"_id": order.ItemID, "level": order.FromLevel},
+			bson.M{"$set": set},
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(&enhance)
+		if err != nil {
+			// 文档已存在但等级不匹配时 upsert 会触发主键冲突
+			if mongo.IsDuplicateKeyError(err) || errors.Is(err, mongo.ErrNoDocuments) {
+				return interfaces.ErrEquipmentChanged
+			}
+			return fmt.Errorf("failed to update equipment enhance: %w", err)
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			if _, err := m.wallet.applyCurrencyChanges(sc, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := removeTemplateItems(sc, db, order.UserID, order.CostItems); err != nil {
+			return err
+		}
+
+		return session.CommitTransaction(sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &enhance, nil
+}
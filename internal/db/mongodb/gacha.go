@@ -0,0 +1,202 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBGachaDatabase 实现 GachaDatabase 接口
+type MongoDBGachaDatabase struct {
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+	wallet   *MongoDBWalletDatabase
+	cards    interfaces.CardDatabase
+	pets     interfaces.PetDatabase
+}
+
+// NewMongoDBGachaDatabase 创建 MongoDBGachaDatabase 实例
+func NewMongoDBGachaDatabase(client *mongo.Client, database string, sf *snowflake.Snowflake) interfaces.GachaDatabase {
+	return &MongoDBGachaDatabase{
+		client:   client,
+		database: database,
+		sf:       sf,
+		wallet: &MongoDBWalletDatabase{
+			client:   client,
+			database: database,
+			sf:       sf,
+		},
+		cards: NewMongoDBCardDatabase(client, database, sf),
+		pets:  NewMongoDBPetDatabase(client, database, sf),
+	}
+}
+
+// 获取保底计数集合
+func (m *MongoDBGachaDatabase) collection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("gacha_pities")
+}
+
+// 获取抽卡记录集合
+func (m *MongoDBGachaDatabase) recordCollection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("gacha_records")
+}
+
+// gachaPityDocID 保底计数文档ID，同一玩家同一卡池只有一条记录
+func gachaPityDocID(userID, poolID int64) string {
+	return fmt.Sprintf("%d:%d", userID, poolID)
+}
+
+// GetGachaPity 获取用户在卡池中的保底计数
+func (m *MongoDBGachaDatabase) GetGachaPity(ctx context.Context, userID int64, poolID int64) (*models.GachaPity, error) {
+	var pity models.GachaPity
+	err := m.collection().FindOne(ctx, bson.M{"_id": gachaPityDocID(userID, poolID)}).Decode(&pity)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get gacha pity: %w", err)
+	}
+
+	return &pity, nil
+}
+
+// Pull 抽卡
+// 以抽取时读到的保底计数为条件更新，计数已变化说明有并发抽取，整体回滚由调用方重新抽取
+func (m *MongoDBGachaDatabase) Pull(ctx context.Context, order *models.GachaOrder) error {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	return mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		if err := claimRequest(sc, m.client.Database(m.database), order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		now := time.Now().Unix()
+		result, err := m.collection().UpdateOne(sc,
+			bson.M{
+				"_id":         gachaPityDocID(order.UserID, order.PoolID),
+				"pity_count":  order.PityBefore,
+				"total_pulls": order.TotalBefore,
+			},
+			bson.M{
+				"$set": bson.M{
+					"user_id":    order.UserID,
+					"pool_id":    order.PoolID,
+					"pity_count": order.PityAfter,
+					"updated_at": now,
+				},
+				"$inc": bson.M{"total_pulls": int64(len(order.Records))},
+			},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			// 文档已存在但计数不匹配时 upsert 会触发主键冲突
+			if mongo.IsDuplicateKeyError(err) {
+				return interfaces.ErrGachaPityChanged
+			}
+			return fmt.Errorf("failed to update gacha pity: %w", err)
+		}
+		if result.MatchedCount == 0 && result.UpsertedCount == 0 {
+			return interfaces.ErrGachaPityChanged
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			if _, err := m.wallet.applyCurrencyChanges(sc, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := removeTemplateItems(sc, m.client.Database(m.database), order.UserID, order.CostItems); err != nil {
+			return err
+		}
+
+		if err := addTemplateItems(sc, m.client.Database(m.database), m.sf, order.UserID, order.Items, order.Inventory, order.OverflowMail); err != nil {
+			return err
+		}
+		for _, card := range order.Cards {
+			if err := m.cards.CreateCard(sc, card); err != nil {
+				return err
+			}
+		}
+		for _, pet := range order.Pets {
+			if err := m.pets.CreatePet(sc, pet); err != nil {
+				return err
+			}
+		}
+
+		if len(order.Records) > 0 {
+			docs := make([]interface{}, 0, len(order.Records))
+			for _, record := range order.Records {
+				docs = append(docs, record)
+			}
+			if _, err := m.recordCollection().InsertMany(sc, docs); err != nil {
+				return fmt.Errorf("failed to create gacha records: %w", err)
+			}
+		}
+
+		return session.CommitTransaction(sc)
+	})
+}
+
+// GetGachaRecords 分页获取抽卡记录
+func (m *MongoDBGachaDatabase) GetGachaRecords(ctx context.Context, userID int64, poolID int64, beforeID int64, limit int) ([]*models.GachaRecord, error) {
+	filter := bson.M{"user_id": userID}
+	if poolID != 0 {
+		filter["pool_id"] = poolID
+	}
+	if beforeID != 0 {
+		filter["_id"] = bson.M{"$lt": beforeID}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := m.recordCollection().Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gacha records: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var records []*models.GachaRecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode gacha records: %w", err)
+	}
+
+	return records, nil
+}
+
+// GetGachaRecordsByRequest 获取同一抽卡请求写入的记录
+func (m *MongoDBGachaDatabase) GetGachaRecordsByRequest(ctx context.Context, userID int64, requestKey string) ([]*models.GachaRecord, error) {
+	filter := bson.M{"user_id": userID, "request_key": requestKey}
+	opts := options.Find().SetSort(bson.D{{Key: "pull_index", Value: 1}})
+
+	cursor, err := m.recordCollection().Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gacha records: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var records []*models.GachaRecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode gacha records: %w", err)
+	}
+
+	return records, nil
+}
@@ -41,11 +41,11 @@ func (m *MongoDBGuildDatabase) CreateGuildApplication(ctx context.Context, appli
 		return errors.New("user is already a member of this guild")
 	}
 
-	// 检查是否已经有待处理的申请
+	// 检查是否已经有未过期的申请
 	count, err := m.applicationCollection().CountDocuments(ctx, bson.M{
-		"guild_id": application.GuildID,
-		"user_id":  application.UserID,
-		"status":   1, // 待处理状态
+		"guild_id":    application.GuildID,
+		"user_id":     application.UserID,
+		"expire_time": bson.M{"$gt": time.Now()},
 	})
 	if err != nil {
 		return err
@@ -106,8 +106,8 @@ func (m *MongoDBGuildDatabase) DeleteGuildApplication(ctx context.Context, appID
 func (m *MongoDBGuildDatabase) GetGuildApplications(ctx context.Context, guildID int64) ([]*models.GuildApplication, error) {
 	pipeline := mongo.Pipeline{
 		{{Key: "$match", Value: bson.M{
-			"guild_id": guildID,
-			"status":   1, // 待处理状态
+			"guild_id":    guildID,
+			"expire_time": bson.M{"$gt": time.Now()},
 		}}},
 		{{Key: "$lookup", Value: bson.M{
 			"from":         "users",
@@ -117,15 +117,14 @@ func (m *MongoDBGuildDatabase) GetGuildApplications(ctx context.Context, guildID
 		}}},
 		{{Key: "$unwind", Value: "$user_info"}},
 		{{Key: "$project", Value: bson.M{
-			"_id":        1,
-			"guild_id":   1,
-			"user_id":    1,
-			"message":    1,
-			"apply_time": 1,
-			"status":     1,
-			"username":   "$user_info.username",
+			"_id":         1,
+			"guild_id":    1,
+			"user_id":     1,
+			"time":        1,
+			"expire_time": 1,
+			"username":    "$user_info.username",
 		}}},
-		{{Key: "$sort", Value: bson.M{"apply_time": 1}}},
+		{{Key: "$sort", Value: bson.M{"time": 1}}},
 	}
 
 	cursor, err := m.applicationCollection().Aggregate(ctx, pipeline)
@@ -141,3 +140,13 @@ func (m *MongoDBGuildDatabase) GetGuildApplications(ctx context.Context, guildID
 
 	return applications, nil
 }
+
+// DeleteExpiredGuildApplications 删除已过期的帮派申请
+func (m *MongoDBGuildDatabase) DeleteExpiredGuildApplications(ctx context.Context, before time.Time) (int64, error) {
+	result, err := m.applicationCollection().DeleteMany(ctx, bson.M{"expire_time": bson.M{"$lte": before}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired guild applications: %w", err)
+	}
+
+	return result.DeletedCount, nil
+}
@@ -0,0 +1,67 @@
+package guild
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 获取帮派捐献记录集合
+func (m *MongoDBGuildDatabase) donationCollection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("guild_donations")
+}
+
+// CreateGuildDonation 创建帮派捐献记录
+func (m *MongoDBGuildDatabase) CreateGuildDonation(ctx context.Context, donation *models.GuildDonation) error {
+	id, err := m.sf.NextID()
+	if err != nil {
+		return fmt.Errorf("failed to generate ID: %w", err)
+	}
+	donation.ID = id
+
+	if _, err := m.donationCollection().InsertOne(ctx, donation); err != nil {
+		return fmt.Errorf("failed to create guild donation: %w", err)
+	}
+
+	return nil
+}
+
+// CountUserGuildDonations 统计用户指定时间之后的捐献次数
+func (m *MongoDBGuildDatabase) CountUserGuildDonations(ctx context.Context, userID, donateID int64, since time.Time) (int32, error) {
+	count, err := m.donationCollection().CountDocuments(ctx, bson.M{
+		"user_id":   userID,
+		"donate_id": donateID,
+		"time":      bson.M{"$gte": since},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count guild donations: %w", err)
+	}
+
+	return int32(count), nil
+}
+
+// GetGuildDonations 获取帮派最近的捐献记录
+func (m *MongoDBGuildDatabase) GetGuildDonations(ctx context.Context, guildID int64, limit int32) ([]*models.GuildDonation, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "time", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := m.donationCollection().Find(ctx, bson.M{"guild_id": guildID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild donations: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var donations []*models.GuildDonation
+	if err := cursor.All(ctx, &donations); err != nil {
+		return nil, fmt.Errorf("failed to decode guild donations: %w", err)
+	}
+
+	return donations, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // MongoDBGuildDatabase 实现 GuildDatabase 接口
@@ -67,6 +68,8 @@ func (m *MongoDBGuildDatabase) CreateGuild(ctx context.Context, guild *models.Gu
 		"master_id":    guild.MasterID,
 		"created_at":   guild.CreatedAt,
 		"max_members":  guild.MaxMembers,
+		"level":        guild.Level,
+		"exp":          guild.Exp,
 		"version":      1,
 	}
 
@@ -133,6 +136,8 @@ func (m *MongoDBGuildDatabase) CreateGuildWithMaster(ctx context.Context, guild
 			"master_id":    guild.MasterID,
 			"created_at":   guild.CreatedAt,
 			"max_members":  guild.MaxMembers,
+			"level":        guild.Level,
+			"exp":          guild.Exp,
 			"version":      1,
 		}
 
@@ -146,12 +151,13 @@ func (m *MongoDBGuildDatabase) CreateGuildWithMaster(ctx context.Context, guild
 		master.Role = models.GuildRoleMaster
 
 		memberDoc := bson.M{
-			"_id":        master.ID,
-			"guild_id":   master.GuildID,
-			"user_id":    master.UserID,
-			"role":       master.Role,
-			"join_time":  master.JoinTime,
-			"last_login": master.LastLogin,
+			"_id":          master.ID,
+			"guild_id":     master.GuildID,
+			"user_id":      master.UserID,
+			"role":         master.Role,
+			"join_time":    master.JoinTime,
+			"last_login":   master.LastLogin,
+			"contribution": master.Contribution,
 		}
 
 		memberCollection := m.client.Database(m.database).Collection("guild_members")
@@ -199,6 +205,7 @@ func (m *MongoDBGuildDatabase) GetGuild(ctx context.Context, guildID int64) (*mo
 }
 
 // UpdateGuild 更新帮派信息
+// 等级、经验和成员上限由捐献单独维护，这里不覆盖
 func (m *MongoDBGuildDatabase) UpdateGuild(ctx context.Context, guild *models.Guild) error {
 	filter := bson.M{
 		"_id":     guild.ID,
@@ -211,7 +218,6 @@ func (m *MongoDBGuildDatabase) UpdateGuild(ctx context.Context, guild *models.Gu
 			"description":  guild.Description,
 			"announcement": guild.Announcement,
 			"master_id":    guild.MasterID,
-			"max_members":  guild.MaxMembers,
 			"version":      guild.Version + 1,
 		},
 	}
@@ -231,6 +237,42 @@ func (m *MongoDBGuildDatabase) UpdateGuild(ctx context.Context, guild *models.Gu
 	return nil
 }
 
+// AddGuildExp 累加帮派经验，返回累加后的累计经验
+func (m *MongoDBGuildDatabase) AddGuildExp(ctx context.Context, guildID int64, exp int64) (int64, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var guild models.Guild
+	err := m.guildCollection().FindOneAndUpdate(ctx,
+		bson.M{"_id": guildID},
+		bson.M{"$inc": bson.M{"exp": exp}},
+		opts,
+	).Decode(&guild)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, errors.New("guild not found")
+		}
+		return 0, fmt.Errorf("failed to add guild exp: %w", err)
+	}
+
+	return guild.Exp, nil
+}
+
+// UpgradeGuildLevel 提升帮派等级，只在当前等级更低时更新，避免并发升级时等级回退
+func (m *MongoDBGuildDatabase) UpgradeGuildLevel(ctx context.Context, guildID int64, level, maxMembers int32) error {
+	_, err := m.guildCollection().UpdateOne(ctx,
+		bson.M{"_id": guildID, "level": bson.M{"$lt": level}},
+		bson.M{"$set": bson.M{
+			"level":       level,
+			"max_members": maxMembers,
+		}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upgrade guild level: %w", err)
+	}
+
+	return nil
+}
+
 // DeleteGuild 删除帮派
 func (m *MongoDBGuildDatabase) DeleteGuild(ctx context.Context, guildID int64) error {
 	// 使用事务确保原子性
@@ -249,6 +291,7 @@ func (m *MongoDBGuildDatabase) DeleteGuild(ctx context.Context, guildID int64) e
 		memberCollection := m.client.Database(m.database).Collection("guild_members")
 		applicationCollection := m.client.Database(m.database).Collection("guild_applications")
 		invitationCollection := m.client.Database(m.database).Collection("guild_invitations")
+		donationCollection := m.client.Database(m.database).Collection("guild_donations")
 
 		// 删除帮派成员
 		_, err := memberCollection.DeleteMany(sc, bson.M{"guild_id": guildID})
@@ -268,6 +311,12 @@ func (m *MongoDBGuildDatabase) DeleteGuild(ctx context.Context, guildID int64) e
 			return err
 		}
 
+		// 删除帮派捐献记录
+		_, err = donationCollection.DeleteMany(sc, bson.M{"guild_id": guildID})
+		if err != nil {
+			return err
+		}
+
 		// 删除帮派
 		result, err := m.guildCollection().DeleteOne(sc, bson.M{"_id": guildID})
 		if err != nil {
@@ -10,6 +10,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // 获取帮派邀请集合
@@ -41,11 +42,11 @@ func (m *MongoDBGuildDatabase) CreateGuildInvitation(ctx context.Context, invita
 		return errors.New("user is already a member of this guild")
 	}
 
-	// 检查是否已经有待处理的邀请
+	// 检查是否已经有未过期的邀请
 	count, err := m.invitationCollection().CountDocuments(ctx, bson.M{
-		"guild_id": invitation.GuildID,
-		"user_id":  invitation.UserID,
-		"status":   1, // 待处理状态
+		"guild_id":    invitation.GuildID,
+		"user_id":     invitation.UserID,
+		"expire_time": bson.M{"$gt": time.Now()},
 	})
 	if err != nil {
 		return err
@@ -87,10 +88,11 @@ func (m *MongoDBGuildDatabase) GetGuildInvitations(ctx context.Context, guildID
 			"guild_id":    1,
 			"user_id":     1,
 			"inviter_id":  1,
-			"invite_time": 1,
+			"time":        1,
+			"expire_time": 1,
 			"username":    "$user_info.username",
 		}}},
-		{{Key: "$sort", Value: bson.M{"invite_time": 1}}},
+		{{Key: "$sort", Value: bson.M{"time": 1}}},
 	}
 
 	cursor, err := m.invitationCollection().Aggregate(ctx, pipeline)
@@ -108,41 +110,15 @@ func (m *MongoDBGuildDatabase) GetGuildInvitations(ctx context.Context, guildID
 	return invitations, nil
 }
 
-// GetUserPendingInvitations 获取用户的待处理邀请
+// GetUserPendingInvitations 获取用户未过期的邀请
 func (m *MongoDBGuildDatabase) GetUserPendingInvitations(ctx context.Context, userID int64) ([]*models.GuildInvitation, error) {
-	pipeline := mongo.Pipeline{
-		{{Key: "$match", Value: bson.M{
-			"user_id": userID,
-			"status":  1, // 待处理状态
-		}}},
-		{{Key: "$lookup", Value: bson.M{
-			"from":         "guilds",
-			"localField":   "guild_id",
-			"foreignField": "_id",
-			"as":           "guild_info",
-		}}},
-		{{Key: "$unwind", Value: "$guild_info"}},
-		{{Key: "$lookup", Value: bson.M{
-			"from":         "users",
-			"localField":   "inviter_id",
-			"foreignField": "_id",
-			"as":           "inviter_info",
-		}}},
-		{{Key: "$unwind", Value: "$inviter_info"}},
-		{{Key: "$project", Value: bson.M{
-			"_id":          1,
-			"guild_id":     1,
-			"user_id":      1,
-			"inviter_id":   1,
-			"invite_time":  1,
-			"status":       1,
-			"guild_name":   "$guild_info.name",
-			"inviter_name": "$inviter_info.username",
-		}}},
-		{{Key: "$sort", Value: bson.M{"invite_time": 1}}},
+	filter := bson.M{
+		"user_id":     userID,
+		"expire_time": bson.M{"$gt": time.Now()},
 	}
+	opts := options.Find().SetSort(bson.D{{Key: "time", Value: 1}})
 
-	cursor, err := m.invitationCollection().Aggregate(ctx, pipeline)
+	cursor, err := m.invitationCollection().Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -155,3 +131,47 @@ func (m *MongoDBGuildDatabase) GetUserPendingInvitations(ctx context.Context, us
 
 	return invitations, nil
 }
+
+// GetGuildInvitation 获取帮派邀请
+func (m *MongoDBGuildDatabase) GetGuildInvitation(ctx context.Context, invitationID int64) (*models.GuildInvitation, error) {
+	var invitation models.GuildInvitation
+	err := m.invitationCollection().FindOne(ctx, bson.M{"_id": invitationID}).Decode(&invitation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil // 邀请不存在
+		}
+		return nil, err
+	}
+
+	return &invitation, nil
+}
+
+// DeleteGuildInvitation 删除帮派邀请
+func (m *MongoDBGuildDatabase) DeleteGuildInvitation(ctx context.Context, invitationID int64) error {
+	result, err := m.invitationCollection().DeleteOne(ctx, bson.M{"_id": invitationID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New("guild invitation not found")
+	}
+
+	return nil
+}
+
+// DeleteUserGuildInvitations 删除用户收到的所有帮派邀请
+func (m *MongoDBGuildDatabase) DeleteUserGuildInvitations(ctx context.Context, userID int64) error {
+	_, err := m.invitationCollection().DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
+
+// DeleteExpiredGuildInvitations 删除已过期的帮派邀请
+func (m *MongoDBGuildDatabase) DeleteExpiredGuildInvitations(ctx context.Context, before time.Time) (int64, error) {
+	result, err := m.invitationCollection().DeleteMany(ctx, bson.M{"expire_time": bson.M{"$lte": before}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired guild invitations: %w", err)
+	}
+
+	return result.DeletedCount, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
 	"github.xubinbest.com/go-game-server/internal/db/models"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -46,12 +47,13 @@ func (m *MongoDBGuildDatabase) AddGuildMember(ctx context.Context, member *model
 
 	// 添加成员
 	document := bson.M{
-		"_id":        member.ID,
-		"guild_id":   member.GuildID,
-		"user_id":    member.UserID,
-		"role":       member.Role,
-		"join_time":  member.JoinTime,
-		"last_login": member.LastLogin,
+		"_id":          member.ID,
+		"guild_id":     member.GuildID,
+		"user_id":      member.UserID,
+		"role":         member.Role,
+		"join_time":    member.JoinTime,
+		"last_login":   member.LastLogin,
+		"contribution": member.Contribution,
 	}
 
 	_, err = m.memberCollection().InsertOne(ctx, document)
@@ -89,13 +91,14 @@ func (m *MongoDBGuildDatabase) GetGuildMembers(ctx context.Context, guildID int6
 		}}},
 		{{Key: "$unwind", Value: "$user_info"}},
 		{{Key: "$project", Value: bson.M{
-			"_id":        1,
-			"guild_id":   1,
-			"user_id":    1,
-			"role":       1,
-			"join_time":  1,
-			"last_login": 1,
-			"username":   "$user_info.username",
+			"_id":          1,
+			"guild_id":     1,
+			"user_id":      1,
+			"role":         1,
+			"join_time":    1,
+			"last_login":   1,
+			"contribution": 1,
+			"username":     "$user_info.username",
 		}}},
 		{{Key: "$sort", Value: bson.M{
 			"role":      1,
@@ -142,6 +145,32 @@ func (m *MongoDBGuildDatabase) UpdateGuildMemberRole(ctx context.Context, guildI
 	return nil
 }
 
+// AddGuildMemberContribution 累加帮派成员贡献
+// 在事务中调用时，同一成员的并发捐献会因写冲突而中止，次数校验不会被绕过
+func (m *MongoDBGuildDatabase) AddGuildMemberContribution(ctx context.Context, guildID, userID int64, contribution int64) error {
+	filter := bson.M{
+		"guild_id": guildID,
+		"user_id":  userID,
+	}
+
+	update := bson.M{
+		"$inc": bson.M{
+			"contribution": contribution,
+		},
+	}
+
+	result, err := m.memberCollection().UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to add guild member contribution: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return interfaces.ErrGuildMemberNotFound
+	}
+
+	return nil
+}
+
 // RemoveGuildMember 移除帮派成员
 func (m *MongoDBGuildDatabase) RemoveGuildMember(ctx context.Context, guildID, userID int64) error {
 	// 检查是否是帮主
@@ -33,6 +33,8 @@ func (m *MongoDBGuildDatabase) GetUserGuilds(ctx context.Context, userID int64)
 			"master_id":    1,
 			"created_at":   1,
 			"max_members":  1,
+			"level":        1,
+			"exp":          1,
 			"version":      1,
 			"role":         "$members.role",
 		}}},
@@ -70,6 +72,8 @@ func (m *MongoDBGuildDatabase) GetGuildList(ctx context.Context, page, pageSize
 			"master_id":    1,
 			"created_at":   1,
 			"max_members":  1,
+			"level":        1,
+			"exp":          1,
 			"version":      1,
 		}}},
 	}
@@ -0,0 +1,232 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/inventoryplan"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 获取背包容量集合
+func (m *MongoDBInventoryDatabase) capacityCollection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("inventory_capacities")
+}
+
+// lockInventory 在事务中写入用户背包容量记录并读取背包物品，记录不存在时按默认容量创建
+// 同一用户的并发背包变更都会写这条记录，冲突的事务会被回滚，不会并发占用超出容量的格子
+func lockInventory(sc mongo.SessionContext, db *mongo.Database, userID int64) (*models.InventoryCapacity, []*models.InventoryItem, error) {
+	var capacity models.InventoryCapacity
+	err := db.Collection("inventory_capacities").FindOneAndUpdate(sc,
+		bson.M{"_id": userID},
+		bson.M{
+			"$set": bson.M{"updated_at": time.Now().Unix()},
+			"$setOnInsert": bson.M{
+				"capacity":     int32(models.DefaultInventoryCapacity),
+				"expand_times": int32(0),
+			},
+		},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&capacity)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to lock inventory capacity: %w", err)
+	}
+
+	cursor, err := db.Collection("inventory_items").Find(sc, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get inventory items: %w", err)
+	}
+	defer cursor.Close(sc)
+
+	var items []*models.InventoryItem
+	if err := cursor.All(sc, &items); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode inventory items: %w", err)
+	}
+
+	return &capacity, items, nil
+}
+
+// applyPlan 执行背包变更计划
+func applyPlan(sc mongo.SessionContext, db *mongo.Database, sf *snowflake.Snowflake, plan *inventoryplan.Plan) error {
+	coll := db.Collection("inventory_items")
+
+	for _, item := range plan.Updates {
+		_, err := coll.UpdateOne(sc,
+			bson.M{"_id": item.ID},
+			bson.M{"$set": bson.M{
+				"count":      item.Count,
+				"position":   item.Position,
+				"updated_at": item.UpdatedAt,
+			}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update item %d: %w", item.ID, err)
+		}
+	}
+
+	if len(plan.Creates) > 0 {
+		docs := make([]interface{}, 0, len(plan.Creates))
+		for _, item := range plan.Creates {
+			itemID, err := sf.NextID()
+			if err != nil {
+				return fmt.Errorf("failed to generate item ID: %w", err)
+			}
+			item.ID = itemID
+			docs = append(docs, item)
+		}
+		if _, err := coll.InsertMany(sc, docs); err != nil {
+			return fmt.Errorf("failed to create items: %w", err)
+		}
+	}
+
+	if len(plan.Deletes) > 0 {
+		_, err := coll.DeleteMany(sc, bson.M{"_id": bson.M{"$in": plan.Deletes}})
+		if err != nil {
+			return fmt.Errorf("failed to delete items: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// AddTemplateItems 在事务中按模板ID发放物品，返回背包放不下的物品，由调用方决定拒绝还是转为邮件发放
+func AddTemplateItems(sc mongo.SessionContext, db *mongo.Database, sf *snowflake.Snowflake, userID int64, items []designconfig.BaseItemCost, rules models.InventoryRules) ([]designconfig.BaseItemCost, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	capacity, current, err := lockInventory(sc, db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := inventoryplan.Add(rules, userID, current, capacity.Capacity, items, time.Now().Unix())
+	if err := applyPlan(sc, db, sf, plan); err != nil {
+		return nil, err
+	}
+	return plan.Overflow, nil
+}
+
+// RemoveTemplateItems 在事务中按模板ID扣除物品，同模板的多个格子合并计算，数量不足时返回 interfaces.ErrInsufficientItems
+func RemoveTemplateItems(sc mongo.SessionContext, db *mongo.Database, userID int64, items []designconfig.BaseItemCost) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	_, current, err := lockInventory(sc, db, userID)
+	if err != nil {
+		return err
+	}
+
+	plan, ok := inventoryplan.Remove(current, items, time.Now().Unix())
+	if !ok {
+		return interfaces.ErrInsufficientItems
+	}
+	return applyPlan(sc, db, nil, plan)
+}
+
+// ConsumeItem 在事务中扣除指定实例的物品，实例不存在、模板不符、已装备或数量不足时返回 interfaces.ErrInsufficientItems
+func ConsumeItem(sc mongo.SessionContext, db *mongo.Database, userID int64, itemID int64, templateID int64, count int32) error {
+	_, current, err := lockInventory(sc, db, userID)
+	if err != nil {
+		return err
+	}
+
+	plan, ok := inventoryplan.Consume(current, itemID, templateID, count, time.Now().Unix())
+	if !ok {
+		return interfaces.ErrInsufficientItems
+	}
+	return applyPlan(sc, db, nil, plan)
+}
+
+// ExpandCapacity 在事务中扩容背包，已扩容次数不是 expandTimes 时返回 interfaces.ErrInventoryExpandConflict
+func ExpandCapacity(sc mongo.SessionContext, db *mongo.Database, userID int64, expandTimes int32, slots int32) (*models.InventoryCapacity, error) {
+	if _, _, err := lockInventory(sc, db, userID); err != nil {
+		return nil, err
+	}
+
+	var capacity models.InventoryCapacity
+	err := db.Collection("inventory_capacities").FindOneAndUpdate(sc,
+		bson.M{"_id": userID, "expand_times": expandTimes},
+		bson.M{
+			"$inc": bson.M{"capacity": slots, "expand_times": int32(1)},
+			"$set": bson.M{"updated_at": time.Now().Unix()},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&capacity)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, interfaces.ErrInventoryExpandConflict
+		}
+		return nil, fmt.Errorf("failed to expand inventory: %w", err)
+	}
+	return &capacity, nil
+}
+
+// ExpandInventory 扩容背包
+func (m *MongoDBInventoryDatabase) ExpandInventory(ctx context.Context, userID int64, expandTimes int32, slots int32) (*models.InventoryCapacity, error) {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	var capacity *models.InventoryCapacity
+	err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		var err error
+		capacity, err = ExpandCapacity(sc, m.client.Database(m.database), userID, expandTimes, slots)
+		if err != nil {
+			return err
+		}
+
+		return session.CommitTransaction(sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return capacity, nil
+}
+
+// SortInventory 整理背包
+func (m *MongoDBInventoryDatabase) SortInventory(ctx context.Context, userID int64, rules models.InventoryRules) error {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	return mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		db := m.client.Database(m.database)
+		_, current, err := lockInventory(sc, db, userID)
+		if err != nil {
+			return err
+		}
+
+		plan := inventoryplan.Compact(rules, userID, current, time.Now().Unix())
+		if err := applyPlan(sc, db, m.sf, plan); err != nil {
+			return err
+		}
+
+		return session.CommitTransaction(sc)
+	})
+}
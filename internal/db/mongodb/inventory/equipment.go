@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
 	"github.xubinbest.com/go-game-server/internal/db/models"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -58,10 +59,11 @@ func (m *MongoDBInventoryDatabase) EquipItem(ctx context.Context, userID int64,
 			return fmt.Errorf("failed to start transaction: %w", err)
 		}
 
-		// 1. 检查物品是否存在且属于该用户
+		// 1. 检查物品是否存在且属于该用户，已穿戴在其他槽位的物品不能重复穿戴
 		itemFilter := bson.M{
-			"user_id": userID,
-			"_id":     itemID,
+			"user_id":  userID,
+			"_id":      itemID,
+			"equipped": false,
 		}
 
 		var item models.InventoryItem
@@ -73,16 +75,26 @@ func (m *MongoDBInventoryDatabase) EquipItem(ctx context.Context, userID int64,
 			return fmt.Errorf("failed to check item ownership: %w", err)
 		}
 
-		// 2. 卸下当前槽位的装备
+		// 2. 卸下当前槽位的装备，被替换的物品回到背包
 		equipmentFilter := bson.M{
 			"user_id": userID,
 			"slot":    slot,
 		}
 
-		_, err = m.equipmentCollection().DeleteOne(sc, equipmentFilter)
-		if err != nil {
+		var current models.Equipment
+		err = m.equipmentCollection().FindOneAndDelete(sc, equipmentFilter).Decode(&current)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
 			return fmt.Errorf("failed to unequip current item: %w", err)
 		}
+		if err == nil {
+			_, err = m.collection().UpdateOne(sc,
+				bson.M{"_id": current.ItemID},
+				bson.M{"$set": bson.M{"equipped": false, "updated_at": time.Now().Unix()}},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to update item equipped status: %w", err)
+			}
+		}
 
 		// 3. 装备新物品
 		now := time.Now().Unix()
@@ -94,6 +106,7 @@ func (m *MongoDBInventoryDatabase) EquipItem(ctx context.Context, userID int64,
 		newEquipment := bson.M{
 			"_id":         equipmentID,
 			"user_id":     userID,
+			"item_id":     item.ID,
 			"template_id": item.TemplateID,
 			"slot":        slot,
 			"created_at":  now,
@@ -139,6 +152,15 @@ func (m *MongoDBInventoryDatabase) UnequipItem(ctx context.Context, userID int64
 			return fmt.Errorf("failed to start transaction: %w", err)
 		}
 
+		// 卸下的装备回到背包占用一个格子，背包已满时拒绝卸下
+		capacity, items, err := lockInventory(sc, m.client.Database(m.database), userID)
+		if err != nil {
+			return err
+		}
+		if (&models.Inventory{Items: items}).UsedSlots() >= capacity.Capacity {
+			return interfaces.ErrInventoryFull
+		}
+
 		// 1. 获取当前槽位的装备
 		equipmentFilter := bson.M{
 			"user_id": userID,
@@ -146,7 +168,7 @@ func (m *MongoDBInventoryDatabase) UnequipItem(ctx context.Context, userID int64
 		}
 
 		var equipment models.Equipment
-		err := m.equipmentCollection().FindOne(sc, equipmentFilter).Decode(&equipment)
+		err = m.equipmentCollection().FindOne(sc, equipmentFilter).Decode(&equipment)
 		if err != nil {
 			if errors.Is(err, mongo.ErrNoDocuments) {
 				return fmt.Errorf("equipment not found in slot: %d", slot)
@@ -169,12 +191,7 @@ func (m *MongoDBInventoryDatabase) UnequipItem(ctx context.Context, userID int64
 			},
 		}
 
-		itemFilter := bson.M{
-			"user_id":     userID,
-			"template_id": equipment.TemplateID,
-		}
-
-		_, err = m.collection().UpdateMany(sc, itemFilter, update)
+		_, err = m.collection().UpdateOne(sc, bson.M{"_id": equipment.ItemID}, update)
 		if err != nil {
 			return fmt.Errorf("failed to update item equipped status: %w", err)
 		}
@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
 	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
 	"github.xubinbest.com/go-game-server/internal/snowflake"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // MongoDBInventoryDatabase 实现 InventoryDatabase 接口
@@ -37,16 +40,18 @@ func (m *MongoDBInventoryDatabase) collection() *mongo.Collection {
 // GetInventory 获取用户背包
 func (m *MongoDBInventoryDatabase) GetInventory(ctx context.Context, userID int64) (*models.Inventory, error) {
 	filter := bson.M{"user_id": userID}
+	opts := options.Find().SetSort(bson.D{{Key: "position", Value: 1}, {Key: "_id", Value: 1}})
 
-	cursor, err := m.collection().Find(ctx, filter)
+	cursor, err := m.collection().Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
 	defer cursor.Close(ctx)
 
 	inventory := &models.Inventory{
-		UserID: userID,
-		Items:  make([]*models.InventoryItem, 0),
+		UserID:   userID,
+		Items:    make([]*models.InventoryItem, 0),
+		Capacity: models.DefaultInventoryCapacity, // 未扩容过的用户使用默认容量
 	}
 
 	for cursor.Next(ctx) {
@@ -61,11 +66,21 @@ func (m *MongoDBInventoryDatabase) GetInventory(ctx context.Context, userID int6
 		return nil, err
 	}
 
+	var capacity models.InventoryCapacity
+	err = m.capacityCollection().FindOne(ctx, bson.M{"_id": userID}).Decode(&capacity)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to get inventory capacity: %w", err)
+	}
+	if err == nil {
+		inventory.Capacity = capacity.Capacity
+		inventory.ExpandTimes = capacity.ExpandTimes
+	}
+
 	return inventory, nil
 }
 
 // AddItemByTemplate 通过模板ID添加物品到背包
-func (m *MongoDBInventoryDatabase) AddItemByTemplate(ctx context.Context, userID int64, templateID int64, count int32) error {
+func (m *MongoDBInventoryDatabase) AddItemByTemplate(ctx context.Context, userID int64, templateID int64, count int32, rules models.InventoryRules) error {
 	if count <= 0 {
 		return errors.New("count must be positive")
 	}
@@ -83,51 +98,13 @@ func (m *MongoDBInventoryDatabase) AddItemByTemplate(ctx context.Context, userID
 			return fmt.Errorf("failed to start transaction: %w", err)
 		}
 
-		// 检查是否已存在相同模板的物品
-		filter := bson.M{
-			"user_id":     userID,
-			"template_id": templateID,
-		}
-
-		var existingItem models.InventoryItem
-		err := m.collection().FindOne(sc, filter).Decode(&existingItem)
+		items := []designconfig.BaseItemCost{{ItemId: int(templateID), Count: int(count)}}
+		overflow, err := AddTemplateItems(sc, m.client.Database(m.database), m.sf, userID, items, rules)
 		if err != nil {
-			if !errors.Is(err, mongo.ErrNoDocuments) {
-				return err
-			}
-
-			// 物品不存在，创建新实例
-			now := time.Now().Unix()
-			itemID, err := m.sf.NextID()
-			if err != nil {
-				return fmt.Errorf("failed to generate item ID: %w", err)
-			}
-
-			newItem := bson.M{
-				"_id":         itemID,
-				"user_id":     userID,
-				"template_id": templateID,
-				"count":       count,
-				"equipped":    false,
-				"created_at":  now,
-				"updated_at":  now,
-			}
-
-			_, err = m.collection().InsertOne(sc, newItem)
-			if err != nil {
-				return fmt.Errorf("failed to insert item: %w", err)
-			}
-		} else {
-			// 更新现有物品数量
-			update := bson.M{
-				"$inc": bson.M{"count": count},
-				"$set": bson.M{"updated_at": time.Now().Unix()},
-			}
-
-			_, err = m.collection().UpdateOne(sc, filter, update)
-			if err != nil {
-				return fmt.Errorf("failed to update item count: %w", err)
-			}
+			return err
+		}
+		if len(overflow) > 0 {
+			return interfaces.ErrInventoryFull
 		}
 
 		return session.CommitTransaction(sc)
@@ -0,0 +1,170 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/db/mongodb/inventory"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBItemUseDatabase 实现 ItemUseDatabase 接口
+type MongoDBItemUseDatabase struct {
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+	wallet   *MongoDBWalletDatabase
+	users    interfaces.UserDatabase
+}
+
+// NewMongoDBItemUseDatabase 创建 MongoDBItemUseDatabase 实例
+func NewMongoDBItemUseDatabase(client *mongo.Client, database string, sf *snowflake.Snowflake) interfaces.ItemUseDatabase {
+	return &MongoDBItemUseDatabase{
+		client:   client,
+		database: database,
+		sf:       sf,
+		wallet: &MongoDBWalletDatabase{
+			client:   client,
+			database: database,
+			sf:       sf,
+		},
+		users: NewMongoDBUserDatabase(client, database, sf),
+	}
+}
+
+// 获取增益集合
+func (m *MongoDBItemUseDatabase) collection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("user_buffs")
+}
+
+// 获取宠物集合
+func (m *MongoDBItemUseDatabase) petCollection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("pets")
+}
+
+// userBuffDocID 增益文档ID，同一玩家同一增益只有一条记录
+func userBuffDocID(userID, buffID int64) string {
+	return fmt.Sprintf("%d:%d", userID, buffID)
+}
+
+// UseItem 使用道具
+// 先扣除道具，再发放各项效果，任一步失败整体回滚
+func (m *MongoDBItemUseDatabase) UseItem(ctx context.Context, order *models.ItemUseOrder) error {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	return mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		db := m.client.Database(m.database)
+		if err := claimRequest(sc, db, order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		if err := inventory.ConsumeItem(sc, db, order.UserID, order.ItemID, order.TemplateID, order.Count); err != nil {
+			return err
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			if _, err := m.wallet.applyCurrencyChanges(sc, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := addTemplateItems(sc, db, m.sf, order.UserID, order.Items, order.Inventory, order.OverflowMail); err != nil {
+			return err
+		}
+
+		if level := order.Level; level != nil {
+			updated, err := m.users.UpdateUserLevelExp(sc, order.UserID, level.OldLevel, level.OldExp, level.NewLevel, level.NewExp)
+			if err != nil {
+				return fmt.Errorf("failed to update user level: %w", err)
+			}
+			if !updated {
+				return interfaces.ErrUserLevelChanged
+			}
+		}
+
+		// MongoDB 没有独立的宠物实现，直接写入宠物集合
+		for _, pet := range order.Pets {
+			petID, err := m.sf.NextID()
+			if err != nil {
+				return fmt.Errorf("failed to generate pet ID: %w", err)
+			}
+			pet.ID = petID
+			pet.CreatedAt = time.Now()
+			pet.UpdatedAt = pet.CreatedAt
+			if _, err := m.petCollection().InsertOne(sc, pet); err != nil {
+				return fmt.Errorf("failed to create pet: %w", err)
+			}
+		}
+
+		now := time.Now().Unix()
+		for _, grant := range order.Buffs {
+			if err := m.extendBuff(sc, order.UserID, grant, now); err != nil {
+				return err
+			}
+		}
+
+		return session.CommitTransaction(sc)
+	})
+}
+
+// extendBuff 延长增益持续时间，已过期或不存在的增益从当前时间开始计算
+func (m *MongoDBItemUseDatabase) extendBuff(sc mongo.SessionContext, userID int64, grant *models.BuffGrant, now int64) error {
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"user_id": userID,
+			"buff_id": grant.BuffID,
+			"expire_at": bson.M{"$add": bson.A{
+				bson.M{"$max": bson.A{bson.M{"$ifNull": bson.A{"$expire_at", now}}, now}},
+				grant.Duration,
+			}},
+			"updated_at": now,
+		}}},
+	}
+
+	var buff models.UserBuff
+	err := m.collection().FindOneAndUpdate(sc,
+		bson.M{"_id": userBuffDocID(userID, grant.BuffID)},
+		update,
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&buff)
+	if err != nil {
+		return fmt.Errorf("failed to extend user buff: %w", err)
+	}
+
+	grant.ExpireAt = buff.ExpireAt
+	return nil
+}
+
+// GetUserBuffs 获取玩家仍生效的增益
+func (m *MongoDBItemUseDatabase) GetUserBuffs(ctx context.Context, userID int64, now int64) ([]*models.UserBuff, error) {
+	filter := bson.M{"user_id": userID, "expire_at": bson.M{"$gt": now}}
+	opts := options.Find().SetSort(bson.D{{Key: "buff_id", Value: 1}})
+
+	cursor, err := m.collection().Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user buffs: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var buffs []*models.UserBuff
+	if err := cursor.All(ctx, &buffs); err != nil {
+		return nil, fmt.Errorf("failed to decode user buffs: %w", err)
+	}
+
+	return buffs, nil
+}
@@ -0,0 +1,298 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBMailDatabase 实现 MailDatabase 接口
+type MongoDBMailDatabase struct {
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+}
+
+// NewMongoDBMailDatabase 创建 MongoDBMailDatabase 实例
+func NewMongoDBMailDatabase(client *mongo.Client, database string, sf *snowflake.Snowflake) interfaces.MailDatabase {
+	return &MongoDBMailDatabase{
+		client:   client,
+		database: database,
+		sf:       sf,
+	}
+}
+
+// 获取邮件集合
+func (m *MongoDBMailDatabase) collection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("mails")
+}
+
+// 获取全服邮件集合
+func (m *MongoDBMailDatabase) broadcastCollection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("broadcast_mails")
+}
+
+// CreateMails 批量创建邮件
+func (m *MongoDBMailDatabase) CreateMails(ctx context.Context, mails []*models.Mail) error {
+	if len(mails) == 0 {
+		return nil
+	}
+
+	now := time.Now().Unix()
+	docs := make([]interface{}, 0, len(mails))
+	for _, mail := range mails {
+		mailID, err := m.sf.NextID()
+		if err != nil {
+			return fmt.Errorf("failed to generate mail ID: %w", err)
+		}
+		mail.ID = mailID
+		mail.CreatedAt = now
+		mail.UpdatedAt = now
+		docs = append(docs, mail)
+	}
+
+	if _, err := m.collection().InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("failed to create mails: %w", err)
+	}
+	return nil
+}
+
+// CreateBroadcastMail 创建全服邮件
+func (m *MongoDBMailDatabase) CreateBroadcastMail(ctx context.Context, mail *models.BroadcastMail) error {
+	mailID, err := m.sf.NextID()
+	if err != nil {
+		return fmt.Errorf("failed to generate broadcast mail ID: %w", err)
+	}
+	mail.ID = mailID
+	mail.CreatedAt = time.Now().Unix()
+
+	if _, err := m.broadcastCollection().InsertOne(ctx, mail); err != nil {
+		return fmt.Errorf("failed to create broadcast mail: %w", err)
+	}
+	return nil
+}
+
+// SyncBroadcastMails 投递玩家尚未收到的全服邮件
+func (m *MongoDBMailDatabase) SyncBroadcastMails(ctx context.Context, userID int64, since int64, now int64) error {
+	filter := bson.M{
+		"created_at": bson.M{"$gte": since},
+		"expire_at":  bson.M{"$gt": now},
+	}
+
+	cursor, err := m.broadcastCollection().Find(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to get broadcast mails: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var broadcasts []*models.BroadcastMail
+	if err := cursor.All(ctx, &broadcasts); err != nil {
+		return fmt.Errorf("failed to decode broadcast mails: %w", err)
+	}
+	if len(broadcasts) == 0 {
+		return nil
+	}
+
+	broadcastIDs := make([]int64, 0, len(broadcasts))
+	for _, b := range broadcasts {
+		broadcastIDs = append(broadcastIDs, b.ID)
+	}
+
+	// 已投递过的全服邮件（包括已删除的）不再重复投递
+	delivered, err := m.collection().Distinct(ctx, "broadcast_id", bson.M{
+		"user_id":      userID,
+		"broadcast_id": bson.M{"$in": broadcastIDs},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get delivered broadcast mails: %w", err)
+	}
+	deliveredSet := make(map[int64]bool, len(delivered))
+	for _, id := range delivered {
+		if v, ok := id.(int64); ok {
+			deliveredSet[v] = true
+		}
+	}
+
+	for _, b := range broadcasts {
+		if deliveredSet[b.ID] {
+			continue
+		}
+		mailID, err := m.sf.NextID()
+		if err != nil {
+			return fmt.Errorf("failed to generate mail ID: %w", err)
+		}
+		broadcastID := b.ID
+		mail := &models.Mail{
+			ID:          mailID,
+			UserID:      userID,
+			BroadcastID: &broadcastID,
+			Type:        models.MailTypeBroadcast,
+			Title:       b.Title,
+			Content:     b.Content,
+			Attachments: b.Attachments,
+			ExpireAt:    b.ExpireAt,
+			CreatedAt:   b.CreatedAt,
+			UpdatedAt:   now,
+		}
+
+		// 以 (user_id, broadcast_id) 为条件插入，并发拉取邮箱时不会重复投递
+		_, err = m.collection().UpdateOne(ctx,
+			bson.M{"user_id": userID, "broadcast_id": broadcastID},
+			bson.M{"$setOnInsert": mail},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to deliver broadcast mail: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetUserMails 获取用户未删除且未过期的邮件
+func (m *MongoDBMailDatabase) GetUserMails(ctx context.Context, userID int64, now int64) ([]*models.Mail, error) {
+	filter := bson.M{
+		"user_id":    userID,
+		"is_deleted": false,
+		"expire_at":  bson.M{"$gt": now},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := m.collection().Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user mails: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var mails []*models.Mail
+	if err := cursor.All(ctx, &mails); err != nil {
+		return nil, fmt.Errorf("failed to decode mails: %w", err)
+	}
+
+	return mails, nil
+}
+
+// GetMail 获取用户的单封邮件
+func (m *MongoDBMailDatabase) GetMail(ctx context.Context, userID int64, mailID int64) (*models.Mail, error) {
+	filter := bson.M{"_id": mailID, "user_id": userID, "is_deleted": false}
+
+	var mail models.Mail
+	err := m.collection().FindOne(ctx, filter).Decode(&mail)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get mail: %w", err)
+	}
+
+	return &mail, nil
+}
+
+// MarkMailRead 标记邮件已读
+func (m *MongoDBMailDatabase) MarkMailRead(ctx context.Context, userID int64, mailID int64) error {
+	filter := bson.M{"_id": mailID, "user_id": userID, "is_deleted": false}
+	update := bson.M{
+		"$set": bson.M{
+			"is_read":    true,
+			"updated_at": time.Now().Unix(),
+		},
+	}
+
+	if _, err := m.collection().UpdateOne(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to mark mail read: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimMailAttachments 领取邮件附件
+// 在事务中以 is_claimed = false 为条件更新邮件，更新成功后再发放物品，任一步失败整体回滚
+func (m *MongoDBMailDatabase) ClaimMailAttachments(ctx context.Context, userID int64, mailID int64, rules models.InventoryRules, now int64) (*models.Mail, error) {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	var mail models.Mail
+	err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		filter := bson.M{"_id": mailID, "user_id": userID, "is_deleted": false}
+		if err := m.collection().FindOne(sc, filter).Decode(&mail); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return interfaces.ErrMailNotFound
+			}
+			return fmt.Errorf("failed to get mail: %w", err)
+		}
+
+		if mail.ExpireAt <= now {
+			return interfaces.ErrMailExpired
+		}
+		if !mail.HasAttachments() {
+			return interfaces.ErrMailNoAttachments
+		}
+		if mail.IsClaimed {
+			return interfaces.ErrMailAlreadyClaimed
+		}
+
+		result, err := m.collection().UpdateOne(sc,
+			bson.M{"_id": mailID, "is_claimed": false},
+			bson.M{"$set": bson.M{
+				"is_claimed": true,
+				"is_read":    true,
+				"updated_at": now,
+			}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to mark mail claimed: %w", err)
+		}
+		if result.ModifiedCount == 0 {
+			return interfaces.ErrMailAlreadyClaimed
+		}
+
+		if err := addTemplateItems(sc, m.client.Database(m.database), m.sf, userID, mail.Attachments, rules, nil); err != nil {
+			return fmt.Errorf("failed to add attachment items: %w", err)
+		}
+
+		return session.CommitTransaction(sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mail.IsClaimed = true
+	mail.IsRead = true
+	mail.UpdatedAt = now
+	return &mail, nil
+}
+
+// DeleteMail 删除邮件
+// 只做标记删除，避免全服邮件被重新投递
+func (m *MongoDBMailDatabase) DeleteMail(ctx context.Context, userID int64, mailID int64) error {
+	filter := bson.M{"_id": mailID, "user_id": userID}
+	update := bson.M{
+		"$set": bson.M{
+			"is_deleted": true,
+			"updated_at": time.Now().Unix(),
+		},
+	}
+
+	if _, err := m.collection().UpdateOne(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to delete mail: %w", err)
+	}
+
+	return nil
+}
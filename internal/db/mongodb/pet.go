@@ -0,0 +1,194 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBPetDatabase 实现 PetDatabase 接口
+type MongoDBPetDatabase struct {
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+}
+
+// NewMongoDBPetDatabase 创建 MongoDBPetDatabase 实例
+func NewMongoDBPetDatabase(client *mongo.Client, database string, sf *snowflake.Snowflake) interfaces.PetDatabase {
+	return &MongoDBPetDatabase{
+		client:   client,
+		database: database,
+		sf:       sf,
+	}
+}
+
+// 获取宠物集合
+func (m *MongoDBPetDatabase) collection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("pets")
+}
+
+// GetPet 根据ID获取宠物
+func (m *MongoDBPetDatabase) GetPet(ctx context.Context, petID int64) (*models.Pet, error) {
+	var pet models.Pet
+	err := m.collection().FindOne(ctx, bson.M{"id": petID}).Decode(&pet)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil // 宠物不存在
+		}
+		return nil, fmt.Errorf("failed to get pet: %w", err)
+	}
+
+	return &pet, nil
+}
+
+// GetUserPets 获取用户的所有宠物
+func (m *MongoDBPetDatabase) GetUserPets(ctx context.Context, userID int64) ([]*models.Pet, error) {
+	cursor, err := m.collection().Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user pets: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var pets []*models.Pet
+	if err := cursor.All(ctx, &pets); err != nil {
+		return nil, fmt.Errorf("failed to decode pets: %w", err)
+	}
+
+	return pets, nil
+}
+
+// GetUserBattlePets 获取用户的出战宠物，按出战位排序
+func (m *MongoDBPetDatabase) GetUserBattlePets(ctx context.Context, userID int64) ([]*models.Pet, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "battle_slot", Value: 1}})
+	cursor, err := m.collection().Find(ctx, bson.M{"user_id": userID, "battle_slot": bson.M{"$gt": 0}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user battle pets: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var pets []*models.Pet
+	if err := cursor.All(ctx, &pets); err != nil {
+		return nil, fmt.Errorf("failed to decode pets: %w", err)
+	}
+
+	return pets, nil
+}
+
+// CreatePet 创建新宠物
+func (m *MongoDBPetDatabase) CreatePet(ctx context.Context, pet *models.Pet) error {
+	id, err := m.sf.NextID()
+	if err != nil {
+		return fmt.Errorf("failed to generate pet ID: %w", err)
+	}
+	pet.ID = id
+
+	now := time.Now()
+	pet.CreatedAt = now
+	pet.UpdatedAt = now
+
+	if _, err := m.collection().InsertOne(ctx, pet); err != nil {
+		return fmt.Errorf("failed to create pet: %w", err)
+	}
+
+	return nil
+}
+
+// UpdatePet 更新宠物信息
+// 星级和出战位由升星、出战接口单独维护，这里不覆盖，避免并发请求的修改被旧数据还原
+func (m *MongoDBPetDatabase) UpdatePet(ctx context.Context, pet *models.Pet) error {
+	pet.UpdatedAt = time.Now()
+
+	_, err := m.collection().UpdateOne(ctx,
+		bson.M{"id": pet.ID},
+		bson.M{"$set": bson.M{
+			"user_id":     pet.UserID,
+			"template_id": pet.TemplateID,
+			"name":        pet.Name,
+			"level":       pet.Level,
+			"exp":         pet.Exp,
+			"updated_at":  pet.UpdatedAt,
+		}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update pet: %w", err)
+	}
+
+	return nil
+}
+
+// UpdatePetLevelExp 更新宠物等级和经验，以当前等级和经验为条件，避免并发加经验相互覆盖
+func (m *MongoDBPetDatabase) UpdatePetLevelExp(ctx context.Context, petID int64, oldLevel, oldExp, newLevel, newExp int32) (bool, error) {
+	result, err := m.collection().UpdateOne(ctx,
+		bson.M{"id": petID, "level": oldLevel, "exp": oldExp},
+		bson.M{"$set": bson.M{
+			"level":      newLevel,
+			"exp":        newExp,
+			"updated_at": time.Now(),
+		}},
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to update pet level: %w", err)
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+// DeletePet 删除宠物
+func (m *MongoDBPetDatabase) DeletePet(ctx context.Context, petID int64) error {
+	if _, err := m.collection().DeleteOne(ctx, bson.M{"id": petID}); err != nil {
+		return fmt.Errorf("failed to delete pet: %w", err)
+	}
+
+	return nil
+}
+
+// SetPetBattleSlot 设置宠物出战位
+func (m *MongoDBPetDatabase) SetPetBattleSlot(ctx context.Context, userID int64, petID int64, slot int32) error {
+	return withTransaction(ctx, m.client, func(sc mongo.SessionContext) error {
+		now := time.Now()
+
+		// 出战时先将该出战位上的其他宠物替换下来
+		if slot > 0 {
+			_, err := m.collection().UpdateMany(sc,
+				bson.M{"user_id": userID, "battle_slot": slot, "id": bson.M{"$ne": petID}},
+				bson.M{"$set": bson.M{"battle_slot": 0, "updated_at": now}},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to cancel battle slot: %w", err)
+			}
+		}
+
+		// 更新指定宠物的出战位
+		_, err := m.collection().UpdateOne(sc,
+			bson.M{"id": petID, "user_id": userID},
+			bson.M{"$set": bson.M{"battle_slot": slot, "updated_at": now}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to set pet battle slot: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// CancelAllPetBattleStatus 取消所有宠物的出战状态
+func (m *MongoDBPetDatabase) CancelAllPetBattleStatus(ctx context.Context, userID int64) error {
+	_, err := m.collection().UpdateMany(ctx,
+		bson.M{"user_id": userID},
+		bson.M{"$set": bson.M{"battle_slot": 0, "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to cancel all pets battle status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBPetGrowthDatabase 实现 PetGrowthDatabase 接口
+type MongoDBPetGrowthDatabase struct {
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+	wallet   *MongoDBWalletDatabase
+}
+
+// NewMongoDBPetGrowthDatabase 创建 MongoDBPetGrowthDatabase 实例
+func NewMongoDBPetGrowthDatabase(client *mongo.Client, database string, sf *snowflake.Snowflake) interfaces.PetGrowthDatabase {
+	return &MongoDBPetGrowthDatabase{
+		client:   client,
+		database: database,
+		sf:       sf,
+		wallet: &MongoDBWalletDatabase{
+			client:   client,
+			database: database,
+			sf:       sf,
+		},
+	}
+}
+
+// 获取宠物技能集合
+func (m *MongoDBPetGrowthDatabase) collection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("pet_skills")
+}
+
+// 获取宠物集合
+func (m *MongoDBPetGrowthDatabase) petCollection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("pets")
+}
+
+// petSkillDocID 宠物技能文档ID，同一宠物的每个技能槽只有一条记录
+func petSkillDocID(petID int64, slot int32) string {
+	return fmt.Sprintf("%d:%d", petID, slot)
+}
+
+// GetPetSkills 获取玩家所有宠物的技能
+func (m *MongoDBPetGrowthDatabase) GetPetSkills(ctx context.Context, userID int64) ([]*models.PetSkill, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "pet_id", Value: 1}, {Key: "slot", Value: 1}})
+	cursor, err := m.collection().Find(ctx, bson.M{"user_id": userID, "skill_id": bson.M{"$gt": 0}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet skills: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var skills []*models.PetSkill
+	if err := cursor.All(ctx, &skills); err != nil {
+		return nil, fmt.Errorf("failed to decode pet skills: %w", err)
+	}
+
+	return skills, nil
+}
+
+// EvolvePet 宠物升星
+// 以读取时的星级为条件更新，星级已变化说明有并发升星，整体回滚
+func (m *MongoDBPetGrowthDatabase) EvolvePet(ctx context.Context, order *models.PetEvolveOrder) error {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	return mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		if err := claimRequest(sc, m.client.Database(m.database), order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		pet, err := m.getPet(sc, order.UserID, order.PetID)
+		if err != nil {
+			return err
+		}
+
+		if pet.Star != order.FromStar {
+			return interfaces.ErrPetChanged
+		}
+		result, err := m.petCollection().UpdateOne(sc,
+			bson.M{"id": order.PetID, "star": order.FromStar},
+			bson.M{"$set": bson.M{"star": order.ToStar, "updated_at": time.Now()}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update pet star: %w", err)
+		}
+		if result.MatchedCount == 0 {
+			return interfaces.ErrPetChanged
+		}
+
+		if err := m.deletePets(sc, order.UserID, pet.TemplateID, order.MaterialPetIDs); err != nil {
+			return err
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			if _, err := m.wallet.applyCurrencyChanges(sc, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := removeTemplateItems(sc, m.client.Database(m.database), order.UserID, order.CostItems); err != nil {
+			return err
+		}
+
+		return session.CommitTransaction(sc)
+	})
+}
+
+// LearnPetSkill 学习宠物技能
+// 以读取时技能槽上的技能为条件更新，技能已变化说明有并发学习，整体回滚
+func (m *MongoDBPetGrowthDatabase) LearnPetSkill(ctx context.Context, order *models.PetSkillOrder) error {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	return mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		if err := claimRequest(sc, m.client.Database(m.database), order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		if _, err := m.getPet(sc, order.UserID, order.PetID); err != nil {
+			return err
+		}
+
+		learned, err := m.collection().CountDocuments(sc, bson.M{
+			"pet_id":   order.PetID,
+			"skill_id": order.SkillID,
+			"slot":     bson.M{"$ne": order.Slot},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check pet skill: %w", err)
+		}
+		if learned > 0 {
+			return interfaces.ErrPetSkillLearned
+		}
+
+		var skill models.PetSkill
+		err = m.collection().FindOneAndUpdate(sc,
+			bson.M{"_id": petSkillDocID(order.PetID, order.Slot), "skill_id": order.FromSkillID},
+			bson.M{"$set": bson.M{
+				"pet_id":     order.PetID,
+				"slot":       order.Slot,
+				"user_id":    order.UserID,
+				"skill_id":   order.SkillID,
+				"updated_at": time.Now().Unix(),
+			}},
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(&skill)
+		if err != nil {
+			// 文档已存在但技能不匹配时 upsert 会触发主键冲突
+			if mongo.IsDuplicateKeyError(err) || errors.Is(err, mongo.ErrNoDocuments) {
+				return interfaces.ErrPetChanged
+			}
+			return fmt.Errorf("failed to update pet skill: %w", err)
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			if _, err := m.wallet.applyCurrencyChanges(sc, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := removeTemplateItems(sc, m.client.Database(m.database), order.UserID, order.CostItems); err != nil {
+			return err
+		}
+
+		return session.CommitTransaction(sc)
+	})
+}
+
+// ReleasePet 放生宠物
+// 删除星级与计算返还时一致的宠物及其技能，再发放返还资源
+func (m *MongoDBPetGrowthDatabase) ReleasePet(ctx context.Context, order *models.PetReleaseOrder) error {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	return mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		if err := claimRequest(sc, m.client.Database(m.database), order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		pet, err := m.getPet(sc, order.UserID, order.PetID)
+		if err != nil {
+			return err
+		}
+		if pet.Star != order.FromStar {
+			return interfaces.ErrPetChanged
+		}
+
+		if err := m.deletePets(sc, order.UserID, pet.TemplateID, []int64{pet.ID}); err != nil {
+			return err
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			if _, err := m.wallet.applyCurrencyChanges(sc, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := addTemplateItems(sc, m.client.Database(m.database), m.sf, order.UserID, order.Items, order.Inventory, order.OverflowMail); err != nil {
+			return err
+		}
+
+		return session.CommitTransaction(sc)
+	})
+}
+
+// getPet 在事务中读取玩家的宠物，不存在时返回 interfaces.ErrPetNotFound
+func (m *MongoDBPetGrowthDatabase) getPet(sc mongo.SessionContext, userID int64, petID int64) (*models.Pet, error) {
+	var pet models.Pet
+	err := m.petCollection().FindOne(sc, bson.M{"id": petID, "user_id": userID}).Decode(&pet)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, interfaces.ErrPetNotFound
+		}
+		return nil, fmt.Errorf("failed to get pet: %w", err)
+	}
+	return &pet, nil
+}
+
+// deletePets 删除玩家指定模板的未出战宠物及其技能，任一宠物不满足条件时返回 interfaces.ErrPetNotFound
+func (m *MongoDBPetGrowthDatabase) deletePets(sc mongo.SessionContext, userID int64, templateID int64, petIDs []int64) error {
+	if len(petIDs) == 0 {
+		return nil
+	}
+
+	result, err := m.petCollection().DeleteMany(sc, bson.M{
+		"id":          bson.M{"$in": petIDs},
+		"user_id":     userID,
+		"template_id": templateID,
+		"battle_slot": bson.M{"$not": bson.M{"$gt": 0}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete pets: %w", err)
+	}
+	if result.DeletedCount != int64(len(petIDs)) {
+		return interfaces.ErrPetNotFound
+	}
+
+	if _, err := m.collection().DeleteMany(sc, bson.M{"pet_id": bson.M{"$in": petIDs}}); err != nil {
+		return fmt.Errorf("failed to delete pet skills: %w", err)
+	}
+	return nil
+}
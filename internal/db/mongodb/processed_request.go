@@ -0,0 +1,66 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// processedRequestDocID 请求记录文档ID，同一玩家的同一请求键只有一条记录
+func processedRequestDocID(userID int64, requestKey string) string {
+	return fmt.Sprintf("%d:%s", userID, requestKey)
+}
+
+// claimRequest 在事务中记录请求键，请求键已记录过时返回 interfaces.ErrDuplicateRequest
+// 事务中止时记录一并回滚，失败的请求可以使用同一请求键重试
+func claimRequest(sc mongo.SessionContext, db *mongo.Database, userID int64, requestKey string) error {
+	_, err := db.Collection("processed_requests").InsertOne(sc, bson.M{
+		"_id":         processedRequestDocID(userID, requestKey),
+		"user_id":     userID,
+		"request_key": requestKey,
+		"created_at":  time.Now().Unix(),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return interfaces.ErrDuplicateRequest
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record request: %w", err)
+	}
+	return nil
+}
+
+// MongoDBProcessedRequestDatabase 实现 ProcessedRequestDatabase 接口
+type MongoDBProcessedRequestDatabase struct {
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+}
+
+// NewMongoDBProcessedRequestDatabase 创建 MongoDBProcessedRequestDatabase 实例
+func NewMongoDBProcessedRequestDatabase(client *mongo.Client, database string, sf *snowflake.Snowflake) interfaces.ProcessedRequestDatabase {
+	return &MongoDBProcessedRequestDatabase{
+		client:   client,
+		database: database,
+		sf:       sf,
+	}
+}
+
+// IsRequestProcessed 查询请求键是否已处理过
+func (m *MongoDBProcessedRequestDatabase) IsRequestProcessed(ctx context.Context, userID int64, requestKey string) (bool, error) {
+	filter := bson.M{"_id": processedRequestDocID(userID, requestKey)}
+	err := m.client.Database(m.database).Collection("processed_requests").FindOne(ctx, filter).Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check request: %w", err)
+	}
+	return true, nil
+}
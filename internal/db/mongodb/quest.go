@@ -0,0 +1,178 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBQuestDatabase 实现 QuestDatabase 接口
+type MongoDBQuestDatabase struct {
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+	wallet   *MongoDBWalletDatabase
+}
+
+// NewMongoDBQuestDatabase 创建 MongoDBQuestDatabase 实例
+func NewMongoDBQuestDatabase(client *mongo.Client, database string, sf *snowflake.Snowflake) interfaces.QuestDatabase {
+	return &MongoDBQuestDatabase{
+		client:   client,
+		database: database,
+		sf:       sf,
+		wallet: &MongoDBWalletDatabase{
+			client:   client,
+			database: database,
+			sf:       sf,
+		},
+	}
+}
+
+// 获取任务进度集合
+func (m *MongoDBQuestDatabase) collection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("quest_progresses")
+}
+
+// questProgressDocID 任务进度文档ID，同一玩家同一任务只有一条记录
+func questProgressDocID(userID, questID int64) string {
+	return fmt.Sprintf("%d:%d", userID, questID)
+}
+
+// GetQuestProgresses 获取用户所有任务进度
+func (m *MongoDBQuestDatabase) GetQuestProgresses(ctx context.Context, userID int64) ([]*models.QuestProgress, error) {
+	cursor, err := m.collection().Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quest progresses: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var progresses []*models.QuestProgress
+	if err := cursor.All(ctx, &progresses); err != nil {
+		return nil, fmt.Errorf("failed to decode quest progresses: %w", err)
+	}
+
+	return progresses, nil
+}
+
+// findQuestProgress 在事务中读取任务进度，不存在时返回空进度
+func (m *MongoDBQuestDatabase) findQuestProgress(sc mongo.SessionContext, userID, questID int64) (*models.QuestProgress, error) {
+	var progress models.QuestProgress
+	err := m.collection().FindOne(sc, bson.M{"_id": questProgressDocID(userID, questID)}).Decode(&progress)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to get quest progress: %w", err)
+	}
+	return &progress, nil
+}
+
+// AddQuestProgress 增加任务进度
+// 进度文档使用固定ID，并发更新同一任务时由事务写冲突保证进度不会丢失
+func (m *MongoDBQuestDatabase) AddQuestProgress(ctx context.Context, userID int64, questID int64, periodStart int64, delta int64, target int64, eventKey string) error {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	return mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		if eventKey != "" {
+			if err := claimRequest(sc, m.client.Database(m.database), userID, eventKey); err != nil {
+				return err
+			}
+		}
+
+		progress, err := m.findQuestProgress(sc, userID, questID)
+		if err != nil {
+			return err
+		}
+		// 进度已切换到更新的周期，旧周期的迟到事件直接丢弃，避免回退周期并重置领取状态
+		// 未提交的事务由 EndSession 中止
+		if progress.PeriodStart > periodStart {
+			return nil
+		}
+
+		value := progress.ProgressIn(periodStart) + delta
+		if value > target {
+			value = target
+		}
+		_, err = m.collection().UpdateOne(sc,
+			bson.M{"_id": questProgressDocID(userID, questID)},
+			bson.M{"$set": bson.M{
+				"user_id":      userID,
+				"quest_id":     questID,
+				"progress":     value,
+				"period_start": periodStart,
+				"claimed":      progress.ClaimedIn(periodStart),
+				"updated_at":   time.Now().Unix(),
+			}},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update quest progress: %w", err)
+		}
+
+		return session.CommitTransaction(sc)
+	})
+}
+
+// ClaimQuestReward 领取任务奖励
+func (m *MongoDBQuestDatabase) ClaimQuestReward(ctx context.Context, claim *models.QuestClaim) error {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	return mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		progress, err := m.findQuestProgress(sc, claim.UserID, claim.QuestID)
+		if err != nil {
+			return err
+		}
+		if progress.ClaimedIn(claim.PeriodStart) {
+			return interfaces.ErrQuestAlreadyClaimed
+		}
+		if progress.ProgressIn(claim.PeriodStart) < claim.Target {
+			return interfaces.ErrQuestNotCompleted
+		}
+
+		_, err = m.collection().UpdateOne(sc,
+			bson.M{"_id": questProgressDocID(claim.UserID, claim.QuestID), "claimed": false},
+			bson.M{"$set": bson.M{
+				"claimed":    true,
+				"updated_at": time.Now().Unix(),
+			}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to mark quest claimed: %w", err)
+		}
+
+		if len(claim.CurrencyChanges) > 0 {
+			if _, err := m.wallet.applyCurrencyChanges(sc, claim.UserID, claim.CurrencyChanges, claim.Reason, claim.Source, claim.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := addTemplateItems(sc, m.client.Database(m.database), m.sf, claim.UserID, claim.Items, claim.Inventory, claim.OverflowMail); err != nil {
+			return err
+		}
+
+		return session.CommitTransaction(sc)
+	})
+}
@@ -0,0 +1,140 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBShopDatabase 实现 ShopDatabase 接口
+type MongoDBShopDatabase struct {
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+	wallet   *MongoDBWalletDatabase
+}
+
+// NewMongoDBShopDatabase 创建 MongoDBShopDatabase 实例
+func NewMongoDBShopDatabase(client *mongo.Client, database string, sf *snowflake.Snowflake) interfaces.ShopDatabase {
+	return &MongoDBShopDatabase{
+		client:   client,
+		database: database,
+		sf:       sf,
+		wallet: &MongoDBWalletDatabase{
+			client:   client,
+			database: database,
+			sf:       sf,
+		},
+	}
+}
+
+// 获取购买计数集合
+func (m *MongoDBShopDatabase) collection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("shop_purchases")
+}
+
+// purchaseDocID 购买计数文档ID，同一玩家同一商品只有一条记录
+func purchaseDocID(userID, goodsID int64) string {
+	return fmt.Sprintf("%d:%d", userID, goodsID)
+}
+
+// GetShopPurchases 获取用户指定商品的购买计数
+func (m *MongoDBShopDatabase) GetShopPurchases(ctx context.Context, userID int64, goodsIDs []int64) ([]*models.ShopPurchase, error) {
+	var purchases []*models.ShopPurchase
+	if len(goodsIDs) == 0 {
+		return purchases, nil
+	}
+
+	filter := bson.M{"user_id": userID, "goods_id": bson.M{"$in": goodsIDs}}
+	cursor, err := m.collection().Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shop purchases: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &purchases); err != nil {
+		return nil, fmt.Errorf("failed to decode shop purchases: %w", err)
+	}
+
+	return purchases, nil
+}
+
+// Purchase 购买商品
+// 购买计数文档使用固定ID，并发购买同一商品时由事务写冲突保证限购校验不会被绕过
+func (m *MongoDBShopDatabase) Purchase(ctx context.Context, order *models.ShopOrder) (*models.ShopPurchase, error) {
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	purchase := &models.ShopPurchase{UserID: order.UserID, GoodsID: order.GoodsID}
+	err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		if err := claimRequest(sc, m.client.Database(m.database), order.UserID, order.IdempotencyKey); err != nil {
+			return err
+		}
+
+		docID := purchaseDocID(order.UserID, order.GoodsID)
+		var existing models.ShopPurchase
+		err := m.collection().FindOne(sc, bson.M{"_id": docID}).Decode(&existing)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return fmt.Errorf("failed to get shop purchase: %w", err)
+		}
+
+		count := existing.CountIn(order.PeriodStart) + order.Quantity
+		if order.LimitCount > 0 && count > order.LimitCount {
+			return interfaces.ErrPurchaseLimitReached
+		}
+
+		if len(order.CurrencyChanges) > 0 {
+			if _, err := m.wallet.applyCurrencyChanges(sc, order.UserID, order.CurrencyChanges, order.Reason, order.Source, order.IdempotencyKey); err != nil {
+				return err
+			}
+		}
+		if err := removeTemplateItems(sc, m.client.Database(m.database), order.UserID, order.CostItems); err != nil {
+			return err
+		}
+		if err := addTemplateItems(sc, m.client.Database(m.database), m.sf, order.UserID, order.GainItems, order.Inventory, nil); err != nil {
+			return err
+		}
+
+		purchase.Count = count
+		purchase.PeriodStart = order.PeriodStart
+		purchase.UpdatedAt = time.Now().Unix()
+		_, err = m.collection().UpdateOne(sc,
+			bson.M{"_id": docID},
+			bson.M{"$set": bson.M{
+				"user_id":      purchase.UserID,
+				"goods_id":     purchase.GoodsID,
+				"count":        purchase.Count,
+				"period_start": purchase.PeriodStart,
+				"updated_at":   purchase.UpdatedAt,
+			}},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update shop purchase: %w", err)
+		}
+
+		return session.CommitTransaction(sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return purchase, nil
+}
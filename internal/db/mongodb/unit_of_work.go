@@ -0,0 +1,117 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/db/mongodb/inventory"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MongoDBUnitOfWork 实现 UnitOfWork 接口
+type MongoDBUnitOfWork struct {
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+}
+
+// NewMongoDBUnitOfWork 创建 MongoDBUnitOfWork 实例
+func NewMongoDBUnitOfWork(client *mongo.Client, database string, sf *snowflake.Snowflake) interfaces.UnitOfWork {
+	return &MongoDBUnitOfWork{
+		client:   client,
+		database: database,
+		sf:       sf,
+	}
+}
+
+// Transaction 在同一个会话事务中执行 fn
+// 传给 fn 的 ctx 绑定了该会话，各模块实现使用它读写时都会加入该事务
+func (m *MongoDBUnitOfWork) Transaction(ctx context.Context, fn func(ctx context.Context, tx interfaces.Tx) error) error {
+	return withTransaction(ctx, m.client, func(sc mongo.SessionContext) error {
+		return fn(sc, &mongoTx{
+			session:  mongo.SessionFromContext(sc),
+			client:   m.client,
+			database: m.database,
+			sf:       m.sf,
+		})
+	})
+}
+
+// withTransaction 开启事务执行 fn 并提交
+// ctx 已绑定 UnitOfWork 开启的会话时直接在该会话的事务中执行，由 UnitOfWork 统一提交或回滚
+func withTransaction(ctx context.Context, client *mongo.Client, fn func(sc mongo.SessionContext) error) error {
+	if session := mongo.SessionFromContext(ctx); session != nil {
+		return fn(mongo.NewSessionContext(ctx, session))
+	}
+
+	session, err := client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	return mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		if err := fn(sc); err != nil {
+			return err
+		}
+
+		return session.CommitTransaction(sc)
+	})
+}
+
+// mongoTx 绑定到同一个会话事务的数据库操作
+type mongoTx struct {
+	session  mongo.Session
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+}
+
+// Users 获取事务内的用户操作
+func (t *mongoTx) Users() interfaces.UserDatabase {
+	return NewMongoDBUserDatabase(t.client, t.database, t.sf)
+}
+
+// Cards 获取事务内的卡牌操作
+func (t *mongoTx) Cards() interfaces.CardDatabase {
+	return NewMongoDBCardDatabase(t.client, t.database, t.sf)
+}
+
+// Pets 获取事务内的宠物操作
+func (t *mongoTx) Pets() interfaces.PetDatabase {
+	return NewMongoDBPetDatabase(t.client, t.database, t.sf)
+}
+
+// Wallet 获取事务内的货币钱包操作
+func (t *mongoTx) Wallet() interfaces.WalletDatabase {
+	return NewMongoDBWalletDatabase(t.client, t.database, t.sf)
+}
+
+// Guilds 获取事务内的帮派操作
+func (t *mongoTx) Guilds() interfaces.GuildDatabase {
+	return NewMongoDBGuildDatabase(t.client, t.database, t.sf)
+}
+
+// RemoveItems 在事务中按模板ID扣除物品
+func (t *mongoTx) RemoveItems(ctx context.Context, userID int64, items []designconfig.BaseItemCost) error {
+	return removeTemplateItems(mongo.NewSessionContext(ctx, t.session), t.client.Database(t.database), userID, items)
+}
+
+// AddItems 在事务中按模板ID发放物品
+func (t *mongoTx) AddItems(ctx context.Context, userID int64, items []designconfig.BaseItemCost, rules models.InventoryRules, overflowMail *models.Mail) error {
+	return addTemplateItems(mongo.NewSessionContext(ctx, t.session), t.client.Database(t.database), t.sf, userID, items, rules, overflowMail)
+}
+
+// ExpandInventory 在事务中扩容背包
+func (t *mongoTx) ExpandInventory(ctx context.Context, userID int64, expandTimes int32, slots int32) (*models.InventoryCapacity, error) {
+	return inventory.ExpandCapacity(mongo.NewSessionContext(ctx, t.session), t.client.Database(t.database), userID, expandTimes, slots)
+}
@@ -92,6 +92,7 @@ func (m *MongoDBUserDatabase) CreateUser(ctx context.Context, user *models.User)
 		"password_hash": user.PasswordHash,
 		"salt":          user.Salt,
 		"role":          user.Role,
+		"rating":        user.Rating,
 		"created_at":    user.CreatedAt,
 		"update_at":     user.UpdatedAt,
 	}
@@ -120,6 +121,7 @@ func (m *MongoDBUserDatabase) UpdateUser(ctx context.Context, user *models.User)
 			"password_hash": user.PasswordHash,
 			"salt":          user.Salt,
 			"role":          user.Role,
+			"rating":        user.Rating,
 			"update_at":     user.UpdatedAt,
 		},
 	}
@@ -152,6 +154,47 @@ func (m *MongoDBUserDatabase) DeleteUser(ctx context.Context, userID int64) erro
 	return nil
 }
 
+// UpdateUserRating 更新用户匹配积分
+func (m *MongoDBUserDatabase) UpdateUserRating(ctx context.Context, userID int64, rating int32) error {
+	filter := bson.M{"_id": userID}
+	update := bson.M{
+		"$set": bson.M{
+			"rating":    rating,
+			"update_at": time.Now(),
+		},
+	}
+
+	result, err := m.collection().UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("user not found")
+	}
+
+	return nil
+}
+
+// UpdateUserLevelExp 更新用户等级和经验（乐观锁）
+func (m *MongoDBUserDatabase) UpdateUserLevelExp(ctx context.Context, userID int64, oldLevel, oldExp, newLevel, newExp int32) (bool, error) {
+	filter := bson.M{"_id": userID, "level": oldLevel, "exp": oldExp}
+	update := bson.M{
+		"$set": bson.M{
+			"level":     newLevel,
+			"exp":       newExp,
+			"update_at": time.Now(),
+		},
+	}
+
+	result, err := m.collection().UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
 // 获取月签到集合
 func (m *MongoDBUserDatabase) monthlySignCollection() *mongo.Collection {
 	return m.client.Database(m.database).Collection("monthly_signs")
@@ -162,6 +205,16 @@ func (m *MongoDBUserDatabase) monthlySignRewardCollection() *mongo.Collection {
 	return m.client.Database(m.database).Collection("monthly_sign_rewards")
 }
 
+// 获取月签到归档集合
+func (m *MongoDBUserDatabase) monthlySignArchiveCollection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("monthly_sign_archives")
+}
+
+// monthlySignArchiveDocID 月签到归档文档ID，同一玩家同一月份只有一条记录
+func monthlySignArchiveDocID(userID int64, year, month int32) string {
+	return fmt.Sprintf("%d:%d:%d", userID, year, month)
+}
+
 // GetMonthlySign 获取用户月签到信息
 func (m *MongoDBUserDatabase) GetMonthlySign(ctx context.Context, userID int64) (*models.MonthlySign, error) {
 	filter := bson.M{
@@ -196,6 +249,7 @@ func (m *MongoDBUserDatabase) CreateOrUpdateMonthlySign(ctx context.Context, sig
 			"year":           sign.Year,
 			"month":          sign.Month,
 			"sign_days":      sign.SignDays,
+			"makeup_count":   sign.MakeupCount,
 			"last_sign_time": sign.LastSignTime,
 			"updated_at":     sign.UpdatedAt,
 		},
@@ -268,4 +322,72 @@ func (m *MongoDBUserDatabase) CreateMonthlySign(ctx context.Context, sign *model
 	return err
 }
 
+// RolloverMonthlySign 归档上月签到记录并写入新月份的签到记录
+func (m *MongoDBUserDatabase) RolloverMonthlySign(ctx context.Context, archive *models.MonthlySignArchive, sign *models.MonthlySign) error {
+	if archive.CreatedAt.IsZero() {
+		archive.CreatedAt = time.Now()
+	}
+	sign.UpdatedAt = time.Now()
+
+	// 使用事务确保原子性
+	session, err := m.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	return mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		// 同一月份已归档时保留首次归档
+		_, err := m.monthlySignArchiveCollection().UpdateOne(sc,
+			bson.M{"_id": monthlySignArchiveDocID(archive.UserID, archive.Year, archive.Month)},
+			bson.M{"$setOnInsert": archive},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to archive monthly sign: %w", err)
+		}
+
+		_, err = m.monthlySignCollection().UpdateOne(sc,
+			bson.M{"_id": sign.UserID},
+			bson.M{"$set": bson.M{
+				"year":           sign.Year,
+				"month":          sign.Month,
+				"sign_days":      sign.SignDays,
+				"makeup_count":   sign.MakeupCount,
+				"last_sign_time": sign.LastSignTime,
+				"updated_at":     sign.UpdatedAt,
+			}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to reset monthly sign: %w", err)
+		}
+
+		return session.CommitTransaction(sc)
+	})
+}
+
+// GetMonthlySignArchives 获取用户历史月份签到归档
+func (m *MongoDBUserDatabase) GetMonthlySignArchives(ctx context.Context, userID int64, limit int) ([]*models.MonthlySignArchive, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "year", Value: -1}, {Key: "month", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := m.monthlySignArchiveCollection().Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monthly sign archives: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var archives []*models.MonthlySignArchive
+	if err := cursor.All(ctx, &archives); err != nil {
+		return nil, fmt.Errorf("failed to decode monthly sign archives: %w", err)
+	}
+
+	return archives, nil
+}
+
 // 其他用户相关方法实现...
@@ -0,0 +1,183 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBWalletDatabase 实现 WalletDatabase 接口
+type MongoDBWalletDatabase struct {
+	client   *mongo.Client
+	database string
+	sf       *snowflake.Snowflake
+}
+
+// NewMongoDBWalletDatabase 创建 MongoDBWalletDatabase 实例
+func NewMongoDBWalletDatabase(client *mongo.Client, database string, sf *snowflake.Snowflake) interfaces.WalletDatabase {
+	return &MongoDBWalletDatabase{
+		client:   client,
+		database: database,
+		sf:       sf,
+	}
+}
+
+// 获取货币余额集合
+func (m *MongoDBWalletDatabase) collection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("user_currencies")
+}
+
+// 获取货币流水集合
+func (m *MongoDBWalletDatabase) ledgerCollection() *mongo.Collection {
+	return m.client.Database(m.database).Collection("currency_ledgers")
+}
+
+// GetWallet 获取用户所有货币余额
+func (m *MongoDBWalletDatabase) GetWallet(ctx context.Context, userID int64) ([]*models.UserCurrency, error) {
+	cursor, err := m.collection().Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wallet: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var currencies []*models.UserCurrency
+	if err := cursor.All(ctx, &currencies); err != nil {
+		return nil, fmt.Errorf("failed to decode wallet: %w", err)
+	}
+
+	return currencies, nil
+}
+
+// GetCurrencyBalance 获取用户某种货币余额
+func (m *MongoDBWalletDatabase) GetCurrencyBalance(ctx context.Context, userID int64, currencyID int64) (int64, error) {
+	filter := bson.M{"user_id": userID, "currency_id": currencyID}
+
+	var currency models.UserCurrency
+	err := m.collection().FindOne(ctx, filter).Decode(&currency)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get currency balance: %w", err)
+	}
+
+	return currency.Balance, nil
+}
+
+// ChangeCurrencies 变更货币并记录流水
+// 并发写入冲突由事务回滚
+func (m *MongoDBWalletDatabase) ChangeCurrencies(ctx context.Context, userID int64, changes []models.CurrencyChange, reason, source, idempotencyKey string) ([]*models.CurrencyLedger, error) {
+	if idempotencyKey == "" {
+		return nil, fmt.Errorf("idempotency key is required")
+	}
+
+	// 使用事务确保原子性，处于 UnitOfWork 事务中时加入外层事务
+	var ledgers []*models.CurrencyLedger
+	err := withTransaction(ctx, m.client, func(sc mongo.SessionContext) error {
+		cursor, err := m.ledgerCollection().Find(sc, bson.M{"user_id": userID, "idempotency_key": idempotencyKey})
+		if err != nil {
+			return fmt.Errorf("failed to check idempotency key: %w", err)
+		}
+		if err := cursor.All(sc, &ledgers); err != nil {
+			return fmt.Errorf("failed to decode currency ledgers: %w", err)
+		}
+		if len(ledgers) > 0 {
+			return nil
+		}
+
+		ledgers, err = m.applyCurrencyChanges(sc, userID, changes, reason, source, idempotencyKey)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ledgers, nil
+}
+
+// applyCurrencyChanges 在已开启的事务中变更货币并记录流水，不检查幂等键
+// 扣除时以余额充足为条件更新，余额不足时返回 interfaces.ErrInsufficientCurrency
+func (m *MongoDBWalletDatabase) applyCurrencyChanges(sc mongo.SessionContext, userID int64, changes []models.CurrencyChange, reason, source, idempotencyKey string) ([]*models.CurrencyLedger, error) {
+	var ledgers []*models.CurrencyLedger
+	now := time.Now().Unix()
+	for _, change := range changes {
+		filter := bson.M{"user_id": userID, "currency_id": change.CurrencyID}
+		if change.Amount < 0 {
+			filter["balance"] = bson.M{"$gte": -change.Amount}
+		}
+		update := bson.M{
+			"$inc": bson.M{"balance": change.Amount},
+			"$set": bson.M{"updated_at": now},
+		}
+		opts := options.FindOneAndUpdate().
+			SetUpsert(change.Amount >= 0).
+			SetReturnDocument(options.After)
+
+		var currency models.UserCurrency
+		err := m.collection().FindOneAndUpdate(sc, filter, update, opts).Decode(&currency)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, interfaces.ErrInsufficientCurrency
+			}
+			return nil, fmt.Errorf("failed to update currency balance: %w", err)
+		}
+
+		ledgerID, err := m.sf.NextID()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate ledger ID: %w", err)
+		}
+		ledger := &models.CurrencyLedger{
+			ID:             ledgerID,
+			UserID:         userID,
+			CurrencyID:     change.CurrencyID,
+			Amount:         change.Amount,
+			BalanceAfter:   currency.Balance,
+			Reason:         reason,
+			Source:         source,
+			IdempotencyKey: idempotencyKey,
+			CreatedAt:      now,
+		}
+		if _, err := m.ledgerCollection().InsertOne(sc, ledger); err != nil {
+			return nil, fmt.Errorf("failed to create currency ledger: %w", err)
+		}
+		ledgers = append(ledgers, ledger)
+	}
+
+	return ledgers, nil
+}
+
+// GetCurrencyLedgers 分页获取货币流水
+func (m *MongoDBWalletDatabase) GetCurrencyLedgers(ctx context.Context, userID int64, currencyID int64, beforeID int64, limit int) ([]*models.CurrencyLedger, error) {
+	filter := bson.M{"user_id": userID}
+	if currencyID != 0 {
+		filter["currency_id"] = currencyID
+	}
+	if beforeID != 0 {
+		filter["_id"] = bson.M{"$lt": beforeID}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := m.ledgerCollection().Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get currency ledgers: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var ledgers []*models.CurrencyLedger
+	if err := cursor.All(ctx, &ledgers); err != nil {
+		return nil, fmt.Errorf("failed to decode currency ledgers: %w", err)
+	}
+
+	return ledgers, nil
+}
@@ -68,3 +68,14 @@ func (dcm *DesignConfigManager) GetConfig(tableName string) any {
 	defer dcm.mutex.RUnlock()
 	return dcm.cache[tableName]
 }
+
+// FindConfig 在配置表中查找第一条满足条件的配置，配置表缺失或没有匹配的配置时返回nil
+func FindConfig[T any](dcm *DesignConfigManager, tableName string, match func(*T) bool) *T {
+	rows, _ := dcm.GetConfig(tableName).([]T)
+	for i := range rows {
+		if match(&rows[i]) {
+			return &rows[i]
+		}
+	}
+	return nil
+}
@@ -39,6 +39,13 @@ type ItemData struct {
 	Stack   int    `csv:"stack"`
 }
 
+// 背包扩容配置表
+type InventoryExpandData struct {
+	ID    int            `csv:"id"`    // 第几次扩容
+	Slots int            `csv:"slots"` // 增加的格子数
+	Cost  []BaseItemCost `csv:"cost"`  // 扩容消耗
+}
+
 // 等级配置表
 type LevelData struct {
 	Level     int       `csv:"level"`
@@ -53,6 +60,29 @@ type EquipmentData struct {
 	Attribute Attribute `csv:"attribute"`
 }
 
+// 装备强化配置表，每行为强化到该等级的消耗、成功率和属性加成，最大行的等级即强化上限
+type EquipEnhanceData struct {
+	Level int            `csv:"level"`
+	Cost  []BaseItemCost `csv:"cost"`
+	Rate  int            `csv:"rate"`  // 成功率，万分比
+	Bonus int            `csv:"bonus"` // 装备基础属性加成，万分比
+}
+
+// 装备洗练配置表，ID 为装备品质
+type EquipRefineData struct {
+	ID         int            `csv:"id"`
+	AffixCount int            `csv:"affix_count"` // 洗练出的词条数量
+	Cost       []BaseItemCost `csv:"cost"`
+}
+
+// 装备词条配置表，洗练时按权重抽取不重复的词条
+type EquipAffixData struct {
+	ID        int       `csv:"id"`
+	Name      string    `csv:"name"`
+	Attribute Attribute `csv:"attribute"`
+	Weight    int       `csv:"weight"`
+}
+
 // 宠物配置表
 type PetData struct {
 	ID    int    `csv:"id"`
@@ -69,6 +99,44 @@ type PetLevelData struct {
 	Attribute Attribute `csv:"attribute"`
 }
 
+// 宠物升星表，每行为升到该星级的消耗和属性加成，最大行的星级即升星上限
+type PetStarData struct {
+	ID        int            `csv:"id"`
+	PetId     int            `csv:"pet_id"`
+	Star      int            `csv:"star"`
+	DupCount  int            `csv:"dup_count"` // 消耗的同模板宠物数量
+	Cost      []BaseItemCost `csv:"cost"`      // 消耗的货币和道具
+	Attribute Attribute      `csv:"attribute"`
+}
+
+// 宠物技能表
+type PetSkillData struct {
+	ID        int            `csv:"id"`
+	Name      string         `csv:"name"`
+	Attribute Attribute      `csv:"attribute"`
+	Cost      []BaseItemCost `csv:"cost"` // 学习消耗
+}
+
+// 宠物技能槽表，ID 为技能槽序号
+type PetSkillSlotData struct {
+	ID   int `csv:"id"`
+	Star int `csv:"star"` // 解锁需要的宠物星级
+}
+
+// 宠物出战位表，ID 为出战位序号
+type PetBattleSlotData struct {
+	ID    int `csv:"id"`
+	Level int `csv:"level"` // 解锁需要的玩家等级
+}
+
+// 宠物放生返还表，按宠物品质和星级返还
+type PetReleaseData struct {
+	ID      int            `csv:"id"`
+	Color   int            `csv:"color"`
+	Star    int            `csv:"star"`
+	Rewards []BaseItemCost `csv:"rewards"`
+}
+
 // 卡牌配置表
 type CardData struct {
 	ID        int            `csv:"id"`
@@ -96,6 +164,18 @@ type CardLevelData struct {
 	Cost      []BaseItemCost `csv:"cost"` // 升级消耗
 }
 
+// 卡牌羁绊配置表，每行为一档羁绊加成
+// 拥有 Cards 中的全部卡牌且每张都达到 Level 级、Star 星时生效，同一羁绊的多档加成可同时生效
+type CardSetData struct {
+	ID        int       `csv:"id"`
+	SetId     int       `csv:"set_id"` // 羁绊ID，同一羁绊的多档加成使用相同的羁绊ID，每档属性为累计值，只生效已满足的最高一档
+	Name      string    `csv:"name"`
+	Cards     []int     `csv:"cards"` // 需要的卡牌模板ID
+	Level     int       `csv:"level"` // 每张卡牌需要达到的等级，0 表示不要求
+	Star      int       `csv:"star"`  // 每张卡牌需要达到的星级，0 表示不要求
+	Attribute Attribute `csv:"attribute"`
+}
+
 // 月签到配置表
 type MonthlySignData struct {
 	ID     int            `csv:"id"`
@@ -107,3 +187,142 @@ type MonthlySignCumulativeData struct {
 	ID     int            `csv:"id"`
 	Reward []BaseItemCost `csv:"reward"` // 奖励
 }
+
+// 月签到补签配置表，ID 为本月第几次补签，行数即每月补签次数上限
+type MonthlySignMakeupData struct {
+	ID   int            `csv:"id"`
+	Cost []BaseItemCost `csv:"cost"` // 补签消耗
+}
+
+// 怪物配置表
+type MonsterData struct {
+	ID        int       `csv:"id"`
+	Name      string    `csv:"name"`
+	Attribute Attribute `csv:"attribute"`
+}
+
+// 战斗关卡配置表
+type BattleStageData struct {
+	ID        int    `csv:"id"`
+	Name      string `csv:"name"`
+	Monsters  []int  `csv:"monsters"`   // 怪物ID列表，按出场顺序
+	MaxRounds int    `csv:"max_rounds"` // 最大回合数，0使用默认值
+}
+
+// 货币配置表
+// 货币ID与物品ID共用消耗/奖励配置中的 itemId 字段，取值范围不与物品重叠
+type CurrencyData struct {
+	ID   int    `csv:"id"`
+	Name string `csv:"name"`
+}
+
+// 商店配置表
+type ShopData struct {
+	ID   int    `csv:"id"`
+	Name string `csv:"name"`
+}
+
+// 商品配置表
+type ShopGoodsData struct {
+	ID         int            `csv:"id"`
+	ShopID     int            `csv:"shop_id"`
+	Goods      []BaseItemCost `csv:"goods"`       // 购买获得的物品或货币
+	Price      []BaseItemCost `csv:"price"`       // 价格，可以是货币或物品
+	LimitType  int            `csv:"limit_type"`  // 限购类型：0不限购 1每日 2每周 3终身
+	LimitCount int            `csv:"limit_count"` // 每个限购周期的购买次数上限
+}
+
+// 抽卡卡池配置表
+type GachaPoolData struct {
+	ID            int            `csv:"id"`
+	Name          string         `csv:"name"`
+	Cost          []BaseItemCost `csv:"cost"`            // 单抽消耗
+	TenCost       []BaseItemCost `csv:"ten_cost"`        // 十连消耗
+	SoftPityStart int            `csv:"soft_pity_start"` // 连续未出稀有达到该抽数后开始提升稀有权重，0为不启用
+	SoftPityStep  int            `csv:"soft_pity_step"`  // 软保底每抽增加的稀有权重
+	HardPity      int            `csv:"hard_pity"`       // 连续未出稀有达到该抽数必出稀有，0为不启用
+}
+
+// 抽卡掉落配置表
+type GachaDropData struct {
+	ID         int `csv:"id"`
+	PoolID     int `csv:"pool_id"`
+	RewardType int `csv:"reward_type"` // 奖励类型：1物品或货币 2卡牌 3宠物
+	RewardID   int `csv:"reward_id"`
+	Count      int `csv:"count"`
+	Weight     int `csv:"weight"`
+	Rare       int `csv:"rare"` // 是否稀有，抽中稀有后保底计数清零
+}
+
+// 任务和成就配置表
+type QuestData struct {
+	ID      int            `csv:"id"`
+	Name    string         `csv:"name"`
+	Type    int            `csv:"type"`   // 任务类型：1每日 2每周 3成就
+	Event   string         `csv:"event"`  // 推进任务进度的游戏事件类型
+	Target  int            `csv:"target"` // 完成所需的事件计数
+	Rewards []BaseItemCost `csv:"rewards"`
+}
+
+// 道具使用配置表，ID 为道具ID
+// 使用效果由道具在 item 配置表中的 type 和 subtype 决定，各效果只读取自己需要的字段
+type ItemUseData struct {
+	ID       int            `csv:"id"`
+	DropID   int            `csv:"drop_id"`  // 宝箱掉落组，对应 item_drop 配置表的 drop_id
+	Exp      int            `csv:"exp"`      // 增加的玩家经验
+	Rewards  []BaseItemCost `csv:"rewards"`  // 直接发放的货币或物品
+	PetID    int            `csv:"pet_id"`   // 孵化的宠物ID
+	BuffID   int            `csv:"buff_id"`  // 获得的增益ID
+	Duration int            `csv:"duration"` // 增益持续秒数
+}
+
+// 道具掉落配置表，同一掉落组内按权重抽取一项
+type ItemDropData struct {
+	ID     int `csv:"id"`
+	DropID int `csv:"drop_id"`
+	ItemID int `csv:"item_id"` // 物品或货币ID
+	Count  int `csv:"count"`
+	Weight int `csv:"weight"`
+}
+
+// 增益配置表，生效期间获得经验和属性按配置加成，同时生效的多个增益加成相加
+type BuffData struct {
+	ID        int       `csv:"id"`
+	Name      string    `csv:"name"`
+	ExpRate   int       `csv:"exp_rate"`  // 获得经验的额外加成，万分比
+	Attribute Attribute `csv:"attribute"` // 属性加成
+}
+
+// 帮派等级配置表，每行为升到该等级所需的累计经验和该等级的成员上限
+type GuildLevelData struct {
+	Level      int `csv:"level"`
+	Exp        int `csv:"exp"`         // 升到该等级所需的累计经验
+	MaxMembers int `csv:"max_members"` // 成员上限
+}
+
+// 帮派福利配置表，帮派等级达到后解锁
+type GuildPerkData struct {
+	ID          int    `csv:"id"`
+	Name        string `csv:"name"`
+	UnlockLevel int    `csv:"unlock_level"` // 解锁所需的帮派等级
+	Description string `csv:"description"`
+}
+
+// 帮派捐献配置表
+type GuildDonateData struct {
+	ID           int            `csv:"id"`
+	Name         string         `csv:"name"`
+	Cost         []BaseItemCost `csv:"cost"`         // 捐献消耗，可以是货币或物品
+	Exp          int            `csv:"exp"`          // 增加的帮派经验
+	Contribution int            `csv:"contribution"` // 增加的个人贡献
+	DailyLimit   int            `csv:"daily_limit"`  // 每日捐献次数上限
+}
+
+// 帮派职位权限配置表，role 与帮派成员职位一致，1为帮主，数值越小职位越高
+type GuildRoleData struct {
+	Role        int      `csv:"role"`
+	Name        string   `csv:"name"`
+	Permissions []string `csv:"permissions"`   // 允许的操作：invite 邀请 approve 审批申请 kick 踢人 edit_announcement 修改公告
+	PromoteUpTo int      `csv:"promote_up_to"` // 可任命的最高职位，0表示不能任命
+	MaxCount    int      `csv:"max_count"`     // 该职位的人数上限，0为不限
+}
@@ -0,0 +1,170 @@
+package game_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/game_service/battle"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrStageNotFound  = errors.New("battle stage not found")
+	ErrBattleNotFound = errors.New("battle record not found")
+)
+
+// StartBattle 挑战PVE关卡，由服务端生成随机种子并模拟战斗，战斗输入保存后供复核
+func (s *GameGRPCService) StartBattle(ctx context.Context, req *pb.StartBattleRequest) (*pb.StartBattleResponse, error) {
+	userID, err := strconv.ParseInt(req.PlayerId, 10, 64)
+	if err != nil || userID == 0 {
+		return nil, fmt.Errorf("invalid player id")
+	}
+
+	monsters, maxRounds, err := s.buildStageMonsters(req.StageId)
+	if err != nil {
+		utils.Warn("Failed to build stage monsters", zap.Int32("stageId", req.StageId), zap.Error(err))
+		return &pb.StartBattleResponse{Success: false, Message: roomErrorMessage(err)}, nil
+	}
+
+	player, err := s.buildPlayerUnit(ctx, userID)
+	if err != nil {
+		utils.Error("Failed to build player unit", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to build player unit: %w", err)
+	}
+
+	battleID, err := s.nextID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate battle id: %w", err)
+	}
+
+	record := &battle.Record{
+		BattleID:  battleID,
+		PlayerID:  req.PlayerId,
+		StageID:   req.StageId,
+		Seed:      rand.Int63(),
+		Player:    player,
+		Monsters:  monsters,
+		MaxRounds: maxRounds,
+	}
+	if err := s.battleRecords.Save(ctx, record); err != nil {
+		utils.Error("Failed to save battle record", zap.String("battleId", battleID), zap.Error(err))
+		return nil, err
+	}
+
+	result := battle.Simulate(record.Seed, record.Player, record.Monsters, record.MaxRounds)
+	utils.Info("Battle finished", zap.String("playerId", req.PlayerId), zap.Int32("stageId", req.StageId),
+		zap.String("battleId", battleID), zap.Bool("win", result.Win), zap.Int32("rounds", result.Rounds))
+
+	resp := &pb.StartBattleResponse{
+		Success:   true,
+		Message:   "战斗结束",
+		BattleId:  battleID,
+		Seed:      record.Seed,
+		Player:    toPBBattleUnit(record.Player),
+		MaxRounds: record.MaxRounds,
+		Win:       result.Win,
+		Rounds:    result.Rounds,
+		Digest:    result.Digest,
+	}
+	for _, m := range record.Monsters {
+		resp.Monsters = append(resp.Monsters, toPBBattleUnit(m))
+	}
+	for _, e := range result.Events {
+		resp.Events = append(resp.Events, &pb.BattleEvent{
+			Round:    e.Round,
+			Actor:    e.Actor,
+			Target:   e.Target,
+			Damage:   e.Damage,
+			Crit:     e.Crit,
+			TargetHp: e.TargetHp,
+		})
+	}
+	return resp, nil
+}
+
+// VerifyBattle 使用保存的战斗输入重新模拟，复核上报的战斗结果
+func (s *GameGRPCService) VerifyBattle(ctx context.Context, req *pb.VerifyBattleRequest) (*pb.VerifyBattleResponse, error) {
+	if req.PlayerId == "" {
+		return nil, fmt.Errorf("invalid player id")
+	}
+
+	record, err := s.battleRecords.Get(ctx, req.BattleId)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil || record.PlayerID != req.PlayerId {
+		return &pb.VerifyBattleResponse{Valid: false, Message: roomErrorMessage(ErrBattleNotFound)}, nil
+	}
+
+	if !battle.Verify(record.Seed, record.Player, record.Monsters, record.MaxRounds, req.Win, req.Rounds, req.Digest) {
+		utils.Warn("Battle result mismatch", zap.String("playerId", req.PlayerId), zap.String("battleId", req.BattleId))
+		return &pb.VerifyBattleResponse{Valid: false, Message: "战斗结果不一致"}, nil
+	}
+	return &pb.VerifyBattleResponse{Valid: true, Message: "战斗结果有效"}, nil
+}
+
+func toPBBattleUnit(u battle.Unit) *pb.BattleUnit {
+	return &pb.BattleUnit{
+		Name:  u.Name,
+		Atk:   u.Atk,
+		Def:   u.Def,
+		HpMax: u.HpMax,
+	}
+}
+
+// buildPlayerUnit 使用与战力相同的属性汇总规则构建玩家战斗单位
+func (s *GameGRPCService) buildPlayerUnit(ctx context.Context, userID int64) (battle.Unit, error) {
+	user, err := s.dbClient.GetUser(ctx, userID)
+	if err != nil {
+		return battle.Unit{}, err
+	}
+	if user == nil {
+		return battle.Unit{}, fmt.Errorf("user not found: %d", userID)
+	}
+
+	attrs, err := s.attributes.Compute(ctx, user)
+	if err != nil {
+		return battle.Unit{}, err
+	}
+	return battle.Unit{
+		Name:  user.Username,
+		Atk:   attrs.Atk,
+		Def:   attrs.Def,
+		HpMax: attrs.HpMax,
+	}, nil
+}
+
+// buildStageMonsters 根据关卡配置构建怪物列表，返回怪物和最大回合数
+func (s *GameGRPCService) buildStageMonsters(stageID int32) ([]battle.Unit, int32, error) {
+	stage := designconfig.FindConfig(s.configManager, "battle_stage", func(d *designconfig.BattleStageData) bool {
+		return int32(d.ID) == stageID
+	})
+	if stage == nil {
+		return nil, 0, ErrStageNotFound
+	}
+
+	monsters := make([]battle.Unit, 0, len(stage.Monsters))
+	for _, monsterID := range stage.Monsters {
+		monster := designconfig.FindConfig(s.configManager, "monster", func(d *designconfig.MonsterData) bool {
+			return d.ID == monsterID
+		})
+		if monster == nil {
+			return nil, 0, fmt.Errorf("monster not found: %d", monsterID)
+		}
+
+		monsters = append(monsters, battle.Unit{
+			Name:  monster.Name,
+			Atk:   int64(monster.Attribute.Atk),
+			Def:   int64(monster.Attribute.Def),
+			HpMax: int64(monster.Attribute.HpMax),
+		})
+	}
+	return monsters, int32(stage.MaxRounds), nil
+}
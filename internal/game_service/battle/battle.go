@@ -0,0 +1,180 @@
+// 确定性PVE战斗模拟
+// 相同的随机种子、玩家单位和怪物列表总是产生相同的战斗过程，
+// 客户端可据此回放战斗，服务端可据此复核客户端上报的结果
+package battle
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+)
+
+const (
+	// DefaultMaxRounds 默认最大回合数，超过回合数视为挑战失败
+	DefaultMaxRounds = 30
+	// critRate 暴击概率（百分比）
+	critRate = 10
+	// critMultiplier 暴击伤害倍率（百分比）
+	critMultiplier = 150
+	// damageVariance 伤害浮动范围（百分比）
+	damageVariance = 10
+	// minDamageRate 最低伤害为攻击力的百分比，防御再高也能造成伤害
+	minDamageRate = 10
+)
+
+// PlayerIndex 战斗日志中玩家单位的下标，怪物下标从1开始
+const PlayerIndex = 0
+
+// Unit 战斗单位
+type Unit struct {
+	Name  string `json:"name"`
+	Atk   int64  `json:"atk"`
+	Def   int64  `json:"def"`
+	HpMax int64  `json:"hpMax"`
+}
+
+// Event 一次攻击
+type Event struct {
+	Round    int32 `json:"round"`
+	Actor    int32 `json:"actor"`  // 攻击方下标
+	Target   int32 `json:"target"` // 受击方下标
+	Damage   int64 `json:"damage"`
+	Crit     bool  `json:"crit"`
+	TargetHp int64 `json:"targetHp"` // 受击后剩余血量
+}
+
+// Result 战斗结果
+type Result struct {
+	Win    bool    `json:"win"`
+	Rounds int32   `json:"rounds"`
+	Events []Event `json:"events"`
+	Digest string  `json:"digest"` // 战斗过程摘要，用于快速比对
+}
+
+// Simulate 模拟一场玩家对多个怪物的战斗
+// 每回合玩家先攻击第一个存活的怪物，随后存活的怪物依次攻击玩家
+func Simulate(seed int64, player Unit, monsters []Unit, maxRounds int32) *Result {
+	if maxRounds <= 0 {
+		maxRounds = DefaultMaxRounds
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	playerHp := player.HpMax
+	monsterHp := make([]int64, len(monsters))
+	for i, m := range monsters {
+		monsterHp[i] = m.HpMax
+	}
+
+	result := &Result{}
+	for round := int32(1); round <= maxRounds; round++ {
+		result.Rounds = round
+
+		target := firstAlive(monsterHp)
+		if target < 0 {
+			break
+		}
+		damage, crit := rollDamage(rng, player, monsters[target])
+		monsterHp[target] = max(monsterHp[target]-damage, 0)
+		result.Events = append(result.Events, Event{
+			Round:    round,
+			Actor:    PlayerIndex,
+			Target:   int32(target + 1),
+			Damage:   damage,
+			Crit:     crit,
+			TargetHp: monsterHp[target],
+		})
+
+		if firstAlive(monsterHp) < 0 {
+			result.Win = true
+			break
+		}
+
+		for i, m := range monsters {
+			if monsterHp[i] == 0 {
+				continue
+			}
+			damage, crit := rollDamage(rng, m, player)
+			playerHp = max(playerHp-damage, 0)
+			result.Events = append(result.Events, Event{
+				Round:    round,
+				Actor:    int32(i + 1),
+				Target:   PlayerIndex,
+				Damage:   damage,
+				Crit:     crit,
+				TargetHp: playerHp,
+			})
+			if playerHp == 0 {
+				break
+			}
+		}
+		if playerHp == 0 {
+			break
+		}
+	}
+
+	result.Digest = digest(result)
+	return result
+}
+
+// Verify 使用相同输入重新模拟，校验上报的结果是否一致
+func Verify(seed int64, player Unit, monsters []Unit, maxRounds int32, win bool, rounds int32, digestStr string) bool {
+	result := Simulate(seed, player, monsters, maxRounds)
+	return result.Win == win && result.Rounds == rounds && result.Digest == digestStr
+}
+
+// rollDamage 计算一次攻击的伤害，只使用整数运算保证各平台结果一致
+func rollDamage(rng *rand.Rand, attacker, defender Unit) (int64, bool) {
+	damage := attacker.Atk - defender.Def
+	if minDamage := attacker.Atk * minDamageRate / 100; damage < minDamage {
+		damage = minDamage
+	}
+
+	variance := int64(rng.Intn(2*damageVariance+1) - damageVariance)
+	damage = damage * (100 + variance) / 100
+
+	crit := rng.Intn(100) < critRate
+	if crit {
+		damage = damage * critMultiplier / 100
+	}
+	return max(damage, 1), crit
+}
+
+func firstAlive(hp []int64) int {
+	for i, v := range hp {
+		if v > 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// digest 计算战斗过程摘要
+func digest(result *Result) string {
+	h := fnv.New64a()
+	buf := make([]byte, 8)
+	write := func(v int64) {
+		binary.BigEndian.PutUint64(buf, uint64(v))
+		h.Write(buf)
+	}
+
+	for _, e := range result.Events {
+		write(int64(e.Round))
+		write(int64(e.Actor))
+		write(int64(e.Target))
+		write(e.Damage)
+		if e.Crit {
+			write(1)
+		} else {
+			write(0)
+		}
+		write(e.TargetHp)
+	}
+	write(int64(result.Rounds))
+	if result.Win {
+		write(1)
+	} else {
+		write(0)
+	}
+	return fmt.Sprintf("%016x", h.Sum64())
+}
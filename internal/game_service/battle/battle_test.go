@@ -0,0 +1,82 @@
+package battle
+
+import (
+	"reflect"
+	"testing"
+)
+
+var (
+	testPlayer   = Unit{Name: "player", Atk: 120, Def: 30, HpMax: 1000}
+	testMonsters = []Unit{
+		{Name: "slime", Atk: 60, Def: 10, HpMax: 300},
+		{Name: "wolf", Atk: 90, Def: 20, HpMax: 400},
+	}
+)
+
+func TestSimulateDeterministic(t *testing.T) {
+	for _, seed := range []int64{1, 42, 20260310} {
+		first := Simulate(seed, testPlayer, testMonsters, DefaultMaxRounds)
+		second := Simulate(seed, testPlayer, testMonsters, DefaultMaxRounds)
+		if !reflect.DeepEqual(first, second) {
+			t.Errorf("seed %d: Simulate results differ:\n%+v\n%+v", seed, first, second)
+		}
+		if len(first.Events) == 0 {
+			t.Errorf("seed %d: Simulate produced no events", seed)
+		}
+	}
+}
+
+func TestSimulateOutcome(t *testing.T) {
+	tests := []struct {
+		name      string
+		player    Unit
+		maxRounds int32
+		win       bool
+		rounds    int32
+	}{
+		{"strong player wins", Unit{Atk: 10000, Def: 0, HpMax: 100}, 10, true, 2},
+		{"weak player loses", Unit{Atk: 1, Def: 0, HpMax: 10}, 10, false, 1},
+		{"round limit reached", Unit{Atk: 1, Def: 1000, HpMax: 1000000}, 3, false, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Simulate(7, tt.player, testMonsters, tt.maxRounds)
+			if result.Win != tt.win || result.Rounds != tt.rounds {
+				t.Errorf("Simulate() win=%v rounds=%d, want win=%v rounds=%d", result.Win, result.Rounds, tt.win, tt.rounds)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	const seed = 42
+	result := Simulate(seed, testPlayer, testMonsters, DefaultMaxRounds)
+
+	tamperedEvents := append([]Event(nil), result.Events...)
+	tamperedEvents[0].Damage *= 2
+	tamperedLog := digest(&Result{Win: result.Win, Rounds: result.Rounds, Events: tamperedEvents})
+
+	tests := []struct {
+		name     string
+		seed     int64
+		player   Unit
+		win      bool
+		rounds   int32
+		digest   string
+		expected bool
+	}{
+		{"server log accepted", seed, testPlayer, result.Win, result.Rounds, result.Digest, true},
+		{"tampered log rejected", seed, testPlayer, result.Win, result.Rounds, tamperedLog, false},
+		{"flipped result rejected", seed, testPlayer, !result.Win, result.Rounds, result.Digest, false},
+		{"tampered rounds rejected", seed, testPlayer, result.Win, result.Rounds + 1, result.Digest, false},
+		{"other seed rejected", seed + 1, testPlayer, result.Win, result.Rounds, result.Digest, false},
+		{"boosted player rejected", seed, Unit{Name: "player", Atk: 240, Def: 30, HpMax: 1000}, result.Win, result.Rounds, result.Digest, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.seed, tt.player, testMonsters, DefaultMaxRounds, tt.win, tt.rounds, tt.digest); got != tt.expected {
+				t.Errorf("Verify() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package battle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/cache"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RecordTTL 战斗记录保留时长，超时后无法再复核
+const RecordTTL = 30 * time.Minute
+
+// Record 战斗输入，保存后用于复核客户端上报的结果
+type Record struct {
+	BattleID  string `json:"battleId"`
+	PlayerID  string `json:"playerId"`
+	StageID   int32  `json:"stageId"`
+	Seed      int64  `json:"seed"`
+	Player    Unit   `json:"player"`
+	Monsters  []Unit `json:"monsters"`
+	MaxRounds int32  `json:"maxRounds"`
+}
+
+// RecordStore 基于Redis的战斗记录存储
+type RecordStore struct {
+	cacheClient cache.Cache
+}
+
+// NewRecordStore 创建战斗记录存储
+func NewRecordStore(cacheClient cache.Cache) *RecordStore {
+	return &RecordStore{cacheClient: cacheClient}
+}
+
+func recordKey(battleID string) string {
+	return fmt.Sprintf("game:battle:%s", battleID)
+}
+
+// Save 保存战斗记录
+func (s *RecordStore) Save(ctx context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal battle record: %w", err)
+	}
+	if err := s.cacheClient.Set(ctx, recordKey(record.BattleID), string(data), RecordTTL); err != nil {
+		return fmt.Errorf("failed to save battle record %s: %w", record.BattleID, err)
+	}
+	return nil
+}
+
+// Get 获取战斗记录，记录不存在或已过期时返回nil
+func (s *RecordStore) Get(ctx context.Context, battleID string) (*Record, error) {
+	data, err := s.cacheClient.Get(ctx, recordKey(battleID)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get battle record %s: %w", battleID, err)
+	}
+
+	var record Record
+	if err := json.Unmarshal([]byte(data), &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal battle record: %w", err)
+	}
+	return &record, nil
+}
@@ -0,0 +1,143 @@
+package game_service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/cache"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// matchTicketTTL 匹配票据的过期时间，实例异常退出时残留的票据会自动清理
+	matchTicketTTL = matchTimeout + 30*time.Second
+	// matchLockTTL 单轮匹配持有模式锁的最长时间
+	matchLockTTL = 10 * time.Second
+)
+
+// matchQueue 基于Redis的匹配队列，所有游戏服实例共享
+// 每个模式一个以积分为分值的有序集合，票据详情单独保存，
+// 从有序集合中移除成员视为占有该票据，取消匹配和成局之间由此互斥
+type matchQueue struct {
+	cacheClient cache.Cache
+}
+
+func newMatchQueue(cacheClient cache.Cache) *matchQueue {
+	return &matchQueue{cacheClient: cacheClient}
+}
+
+func matchQueueKey(mode string) string {
+	return fmt.Sprintf("game:match:%s:queue", mode)
+}
+
+func matchTicketKey(playerID string) string {
+	return fmt.Sprintf("game:match:ticket:%s", playerID)
+}
+
+func matchLockKey(mode string) string {
+	return fmt.Sprintf("game:match:%s", mode)
+}
+
+// add 写入票据并加入模式队列，玩家已在匹配中时返回 ErrAlreadyMatching
+func (q *matchQueue) add(ctx context.Context, ticket *MatchTicket) error {
+	data, err := json.Marshal(ticket)
+	if err != nil {
+		return fmt.Errorf("failed to marshal match ticket: %w", err)
+	}
+	ok, err := q.cacheClient.SetNX(ctx, matchTicketKey(ticket.PlayerID), data, matchTicketTTL).Result()
+	if err != nil {
+		return fmt.Errorf("failed to save match ticket: %w", err)
+	}
+	if !ok {
+		return ErrAlreadyMatching
+	}
+
+	if err := q.putBack(ctx, ticket); err != nil {
+		_ = q.cacheClient.Delete(ctx, matchTicketKey(ticket.PlayerID))
+		return err
+	}
+	return nil
+}
+
+// get 读取玩家的匹配票据，不在匹配中时返回 ErrNotMatching
+func (q *matchQueue) get(ctx context.Context, playerID string) (*MatchTicket, error) {
+	data, err := q.cacheClient.Get(ctx, matchTicketKey(playerID)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrNotMatching
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match ticket: %w", err)
+	}
+
+	var ticket MatchTicket
+	if err := json.Unmarshal(data, &ticket); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal match ticket: %w", err)
+	}
+	return &ticket, nil
+}
+
+// list 按入队时间返回模式队列中的全部票据，顺带清理票据已过期的成员
+func (q *matchQueue) list(ctx context.Context, mode string) ([]*MatchTicket, error) {
+	members, err := q.cacheClient.ZRangeWithScores(ctx, matchQueueKey(mode), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list match queue: %w", err)
+	}
+
+	tickets := make([]*MatchTicket, 0, len(members))
+	for _, member := range members {
+		playerID, _ := member.Member.(string)
+		ticket, err := q.get(ctx, playerID)
+		if err == ErrNotMatching {
+			q.cacheClient.ZRem(ctx, matchQueueKey(mode), playerID)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	sort.SliceStable(tickets, func(i, j int) bool {
+		return tickets[i].EnqueuedAt.Before(tickets[j].EnqueuedAt)
+	})
+	return tickets, nil
+}
+
+// claim 从队列中移除票据，返回false表示票据已被取消或被其他流程占有
+func (q *matchQueue) claim(ctx context.Context, ticket *MatchTicket) (bool, error) {
+	n, err := q.cacheClient.ZRem(ctx, matchQueueKey(ticket.Mode), ticket.PlayerID).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to claim match ticket: %w", err)
+	}
+	return n == 1, nil
+}
+
+// putBack 将票据放回模式队列，票据详情保持不变
+func (q *matchQueue) putBack(ctx context.Context, ticket *MatchTicket) error {
+	err := q.cacheClient.ZAdd(ctx, matchQueueKey(ticket.Mode), redis.Z{
+		Score:  float64(ticket.Rating),
+		Member: ticket.PlayerID,
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("failed to enqueue match ticket: %w", err)
+	}
+	return nil
+}
+
+// done 删除已被占有的票据，玩家可以重新加入匹配
+func (q *matchQueue) done(ctx context.Context, ticket *MatchTicket) error {
+	return q.cacheClient.Delete(ctx, matchTicketKey(ticket.PlayerID))
+}
+
+// lockMode 获取模式的匹配锁，同一时刻只有一个实例对该模式组队
+func (q *matchQueue) lockMode(ctx context.Context, mode string) (bool, error) {
+	return q.cacheClient.TryLock(ctx, matchLockKey(mode), matchLockTTL)
+}
+
+func (q *matchQueue) unlockMode(ctx context.Context, mode string) error {
+	return q.cacheClient.Unlock(ctx, matchLockKey(mode))
+}
@@ -0,0 +1,301 @@
+package game_service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/cache"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// matchInterval 匹配轮询间隔
+	matchInterval = time.Second
+	// matchBaseWindow 初始积分匹配范围
+	matchBaseWindow = 100
+	// matchWindowGrowth 每等待一秒扩大的积分范围
+	matchWindowGrowth = 20
+	// matchMaxWindow 积分匹配范围上限
+	matchMaxWindow = 1000
+	// matchTimeout 匹配超时时间
+	matchTimeout = 60 * time.Second
+)
+
+// matchModes 匹配模式及每局人数
+var matchModes = map[string]int{
+	"1v1":  2,
+	"ffa4": 4,
+}
+
+var (
+	ErrUnknownMatchMode = errors.New("unknown match mode")
+	ErrAlreadyMatching  = errors.New("player already in match queue")
+	ErrNotMatching      = errors.New("player not in match queue")
+)
+
+// MatchTicket 匹配队列中的玩家
+type MatchTicket struct {
+	PlayerID   string    `json:"player_id"`
+	Mode       string    `json:"mode"`
+	Rating     int32     `json:"rating"`
+	EnqueuedAt time.Time `json:"enqueued_at"`
+}
+
+// MatchNotifier 匹配结果通知
+type MatchNotifier interface {
+	NotifyMatched(ctx context.Context, room RoomSnapshot) error
+	NotifyTimeout(ctx context.Context, ticket *MatchTicket) error
+}
+
+// Matchmaker 基于积分的匹配器
+// 匹配队列保存在Redis中，请求落在任意实例上都能进入同一队列，
+// 各实例轮流持有模式锁组队，成局的房间创建在执行本轮匹配的实例上
+type Matchmaker struct {
+	queue    *matchQueue
+	rooms    *RoomManager
+	notifier MatchNotifier
+	now      func() time.Time
+}
+
+// NewMatchmaker 创建匹配器，notifier为nil时不发送通知
+func NewMatchmaker(cacheClient cache.Cache, rooms *RoomManager, notifier MatchNotifier) *Matchmaker {
+	return &Matchmaker{
+		queue:    newMatchQueue(cacheClient),
+		rooms:    rooms,
+		notifier: notifier,
+		now:      time.Now,
+	}
+}
+
+// Enqueue 玩家加入匹配队列
+func (m *Matchmaker) Enqueue(ctx context.Context, playerID, mode string, rating int32) error {
+	if _, ok := matchModes[mode]; !ok {
+		return ErrUnknownMatchMode
+	}
+	if _, err := m.rooms.GetPlayerRoom(playerID); err == nil {
+		return ErrAlreadyInRoom
+	}
+
+	return m.queue.add(ctx, &MatchTicket{
+		PlayerID:   playerID,
+		Mode:       mode,
+		Rating:     rating,
+		EnqueuedAt: m.now(),
+	})
+}
+
+// Cancel 玩家取消匹配，票据已被本轮匹配占有时同样返回 ErrNotMatching
+func (m *Matchmaker) Cancel(ctx context.Context, playerID string) error {
+	ticket, err := m.queue.get(ctx, playerID)
+	if err != nil {
+		return err
+	}
+
+	claimed, err := m.queue.claim(ctx, ticket)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return ErrNotMatching
+	}
+	return m.queue.done(ctx, ticket)
+}
+
+// Start 启动匹配轮询
+func (m *Matchmaker) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(matchInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.Match(ctx)
+			}
+		}
+	}()
+}
+
+// Match 执行一轮匹配：移除超时玩家，按积分范围组队并创建房间，返回本轮创建的房间
+// 其他实例正在匹配的模式本轮跳过
+func (m *Matchmaker) Match(ctx context.Context) []RoomSnapshot {
+	var rooms []RoomSnapshot
+	for mode := range matchModes {
+		locked, err := m.queue.lockMode(ctx, mode)
+		if err != nil {
+			utils.Warn("Failed to lock match mode", zap.String("mode", mode), zap.Error(err))
+			continue
+		}
+		if !locked {
+			continue
+		}
+
+		rooms = append(rooms, m.matchMode(ctx, mode)...)
+
+		if err := m.queue.unlockMode(ctx, mode); err != nil {
+			utils.Warn("Failed to unlock match mode", zap.String("mode", mode), zap.Error(err))
+		}
+	}
+	return rooms
+}
+
+// matchMode 对单个模式执行一轮匹配，调用方需持有该模式的匹配锁
+func (m *Matchmaker) matchMode(ctx context.Context, mode string) []RoomSnapshot {
+	tickets, err := m.queue.list(ctx, mode)
+	if err != nil {
+		utils.Warn("Failed to load match queue", zap.String("mode", mode), zap.Error(err))
+		return nil
+	}
+
+	groups, expired := groupTickets(tickets, matchModes[mode], m.now())
+
+	for _, ticket := range expired {
+		claimed, err := m.queue.claim(ctx, ticket)
+		if err != nil || !claimed {
+			continue
+		}
+		_ = m.queue.done(ctx, ticket)
+		if m.notifier != nil {
+			if err := m.notifier.NotifyTimeout(ctx, ticket); err != nil {
+				utils.Warn("Failed to notify match timeout", zap.String("playerId", ticket.PlayerID), zap.Error(err))
+			}
+		}
+	}
+
+	var rooms []RoomSnapshot
+	for _, group := range groups {
+		group = m.claimGroup(ctx, group)
+		if group == nil {
+			continue
+		}
+
+		playerIDs := make([]string, len(group))
+		for i, ticket := range group {
+			playerIDs[i] = ticket.PlayerID
+		}
+
+		room, err := m.rooms.CreateRoomWithPlayers(ctx, mode, playerIDs)
+		if err != nil {
+			// 创建失败（例如有玩家已进入其他房间）时，将仍可匹配的玩家放回队列
+			utils.Warn("Failed to create match room", zap.Strings("players", playerIDs), zap.Error(err))
+			m.requeue(ctx, group)
+			continue
+		}
+		for _, ticket := range group {
+			_ = m.queue.done(ctx, ticket)
+		}
+		if err := m.rooms.StartRoom(ctx, room.ID(), ""); err != nil {
+			utils.Error("Failed to start match room", zap.String("roomId", room.ID()), zap.Error(err))
+			m.rooms.DestroyRoom(ctx, room.ID())
+			continue
+		}
+
+		snapshot := room.Snapshot()
+		rooms = append(rooms, snapshot)
+
+		if m.notifier != nil {
+			if err := m.notifier.NotifyMatched(ctx, snapshot); err != nil {
+				utils.Warn("Failed to notify match found", zap.String("roomId", snapshot.ID), zap.Error(err))
+			}
+		}
+	}
+
+	return rooms
+}
+
+// claimGroup 占有组内全部票据，有玩家已取消匹配时放回其余玩家并返回nil
+func (m *Matchmaker) claimGroup(ctx context.Context, group []*MatchTicket) []*MatchTicket {
+	claimed := make([]*MatchTicket, 0, len(group))
+	for _, ticket := range group {
+		ok, err := m.queue.claim(ctx, ticket)
+		if err != nil {
+			utils.Warn("Failed to claim match ticket", zap.String("playerId", ticket.PlayerID), zap.Error(err))
+		}
+		if err != nil || !ok {
+			m.requeue(ctx, claimed)
+			return nil
+		}
+		claimed = append(claimed, ticket)
+	}
+	return claimed
+}
+
+// groupTickets 从按入队时间排序的票据中挑出可以成局的玩家组和已超时的玩家
+func groupTickets(queue []*MatchTicket, size int, now time.Time) ([][]*MatchTicket, []*MatchTicket) {
+	var groups [][]*MatchTicket
+	var expired []*MatchTicket
+	matched := make(map[string]bool)
+
+	// 等待最久的玩家优先，以其当前积分范围挑选积分最接近的玩家
+	for _, anchor := range queue {
+		if matched[anchor.PlayerID] {
+			continue
+		}
+		if now.Sub(anchor.EnqueuedAt) > matchTimeout {
+			expired = append(expired, anchor)
+			matched[anchor.PlayerID] = true
+			continue
+		}
+
+		window := matchWindow(now.Sub(anchor.EnqueuedAt))
+		candidates := make([]*MatchTicket, 0)
+		for _, t := range queue {
+			if t == anchor || matched[t.PlayerID] || now.Sub(t.EnqueuedAt) > matchTimeout {
+				continue
+			}
+			if absInt32(t.Rating-anchor.Rating) <= window {
+				candidates = append(candidates, t)
+			}
+		}
+		if len(candidates) < size-1 {
+			continue
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return absInt32(candidates[i].Rating-anchor.Rating) < absInt32(candidates[j].Rating-anchor.Rating)
+		})
+
+		group := append([]*MatchTicket{anchor}, candidates[:size-1]...)
+		for _, t := range group {
+			matched[t.PlayerID] = true
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, expired
+}
+
+// requeue 将已占有但未成局的玩家放回队列，已进入房间的玩家不再匹配
+func (m *Matchmaker) requeue(ctx context.Context, group []*MatchTicket) {
+	for _, ticket := range group {
+		if _, err := m.rooms.GetPlayerRoom(ticket.PlayerID); err == nil {
+			_ = m.queue.done(ctx, ticket)
+			continue
+		}
+		if err := m.queue.putBack(ctx, ticket); err != nil {
+			utils.Warn("Failed to requeue match ticket", zap.String("playerId", ticket.PlayerID), zap.Error(err))
+		}
+	}
+}
+
+// matchWindow 根据等待时长计算积分匹配范围
+func matchWindow(waited time.Duration) int32 {
+	window := int32(matchBaseWindow + matchWindowGrowth*int64(waited/time.Second))
+	if window > matchMaxWindow {
+		window = matchMaxWindow
+	}
+	return window
+}
+
+func absInt32(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,363 @@
+package game_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/cache"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// memCache 进程内的匹配队列存储，只实现匹配队列用到的方法
+type memCache struct {
+	cache.Cache
+
+	mu     sync.Mutex
+	values map[string]string
+	zsets  map[string]map[string]float64
+	locks  map[string]bool
+}
+
+func newMemCache() *memCache {
+	return &memCache{
+		values: make(map[string]string),
+		zsets:  make(map[string]map[string]float64),
+		locks:  make(map[string]bool),
+	}
+}
+
+func (c *memCache) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.BoolCmd {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.values[key]; ok {
+		return redis.NewBoolResult(false, nil)
+	}
+	switch v := value.(type) {
+	case []byte:
+		c.values[key] = string(v)
+	default:
+		c.values[key] = fmt.Sprint(v)
+	}
+	return redis.NewBoolResult(true, nil)
+}
+
+func (c *memCache) Get(ctx context.Context, key string) *redis.StringCmd {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.values[key]
+	if !ok {
+		return redis.NewStringResult("", redis.Nil)
+	}
+	return redis.NewStringResult(v, nil)
+}
+
+func (c *memCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	return nil
+}
+
+func (c *memCache) ZAdd(ctx context.Context, key string, members ...redis.Z) *redis.IntCmd {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	set, ok := c.zsets[key]
+	if !ok {
+		set = make(map[string]float64)
+		c.zsets[key] = set
+	}
+	var added int64
+	for _, m := range members {
+		member := fmt.Sprint(m.Member)
+		if _, ok := set[member]; !ok {
+			added++
+		}
+		set[member] = m.Score
+	}
+	return redis.NewIntResult(added, nil)
+}
+
+func (c *memCache) ZRangeWithScores(ctx context.Context, key string, start, stop int64) *redis.ZSliceCmd {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	members := make([]redis.Z, 0, len(c.zsets[key]))
+	for member, score := range c.zsets[key] {
+		members = append(members, redis.Z{Score: score, Member: member})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Score != members[j].Score {
+			return members[i].Score < members[j].Score
+		}
+		return members[i].Member.(string) < members[j].Member.(string)
+	})
+	return redis.NewZSliceCmdResult(members, nil)
+}
+
+func (c *memCache) ZRem(ctx context.Context, key string, members ...interface{}) *redis.IntCmd {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var removed int64
+	for _, m := range members {
+		member := fmt.Sprint(m)
+		if _, ok := c.zsets[key][member]; ok {
+			delete(c.zsets[key], member)
+			removed++
+		}
+	}
+	return redis.NewIntResult(removed, nil)
+}
+
+func (c *memCache) TryLock(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.locks[key] {
+		return false, nil
+	}
+	c.locks[key] = true
+	return true, nil
+}
+
+func (c *memCache) Unlock(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.locks, key)
+	return nil
+}
+
+// recordNotifier 记录匹配通知
+type recordNotifier struct {
+	mu       sync.Mutex
+	matched  []RoomSnapshot
+	timeouts []string
+}
+
+func (n *recordNotifier) NotifyMatched(ctx context.Context, room RoomSnapshot) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.matched = append(n.matched, room)
+	return nil
+}
+
+func (n *recordNotifier) NotifyTimeout(ctx context.Context, ticket *MatchTicket) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.timeouts = append(n.timeouts, ticket.PlayerID)
+	return nil
+}
+
+// newTestMatchmaker 创建不依赖Redis和房间目录的匹配器，返回的时钟指针用于推进时间
+func newTestMatchmaker() (*Matchmaker, *RoomManager, *recordNotifier, *time.Time) {
+	var seq int
+	rooms := NewRoomManager(nil, "", func() (string, error) {
+		seq++
+		return fmt.Sprintf("room-%d", seq), nil
+	})
+	notifier := &recordNotifier{}
+	m := NewMatchmaker(newMemCache(), rooms, notifier)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	m.now = func() time.Time { return now }
+	return m, rooms, notifier, &now
+}
+
+func TestMatchWindow(t *testing.T) {
+	tests := []struct {
+		waited time.Duration
+		want   int32
+	}{
+		{0, matchBaseWindow},
+		{999 * time.Millisecond, matchBaseWindow},
+		{time.Second, matchBaseWindow + matchWindowGrowth},
+		{10 * time.Second, matchBaseWindow + 10*matchWindowGrowth},
+		{45 * time.Second, matchMaxWindow},
+		{matchTimeout, matchMaxWindow},
+	}
+	for _, tt := range tests {
+		if got := matchWindow(tt.waited); got != tt.want {
+			t.Errorf("matchWindow(%v) = %d, want %d", tt.waited, got, tt.want)
+		}
+	}
+}
+
+func TestGroupTickets(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	ticket := func(id string, rating int32, waited time.Duration) *MatchTicket {
+		return &MatchTicket{PlayerID: id, Mode: "1v1", Rating: rating, EnqueuedAt: now.Add(-waited)}
+	}
+
+	tests := []struct {
+		name    string
+		queue   []*MatchTicket
+		size    int
+		groups  [][]string
+		expired []string
+	}{
+		{
+			name:   "within base window",
+			queue:  []*MatchTicket{ticket("a", 1000, 0), ticket("b", 1100, 0)},
+			size:   2,
+			groups: [][]string{{"a", "b"}},
+		},
+		{
+			name:  "outside base window",
+			queue: []*MatchTicket{ticket("a", 1000, 0), ticket("b", 1250, 0)},
+			size:  2,
+		},
+		{
+			name:   "window widens with wait time",
+			queue:  []*MatchTicket{ticket("a", 1000, 10*time.Second), ticket("b", 1250, 0)},
+			size:   2,
+			groups: [][]string{{"a", "b"}},
+		},
+		{
+			name:   "closest rating picked first",
+			queue:  []*MatchTicket{ticket("a", 1000, 3*time.Second), ticket("b", 1090, 2*time.Second), ticket("c", 1020, time.Second)},
+			size:   2,
+			groups: [][]string{{"a", "c"}},
+		},
+		{
+			name:   "at timeout still matched",
+			queue:  []*MatchTicket{ticket("a", 1000, matchTimeout), ticket("b", 1900, 0)},
+			size:   2,
+			groups: [][]string{{"a", "b"}},
+		},
+		{
+			name:    "expired removed and not used as candidate",
+			queue:   []*MatchTicket{ticket("a", 1000, matchTimeout+time.Second), ticket("b", 1000, time.Second), ticket("c", 1010, 0)},
+			size:    2,
+			groups:  [][]string{{"b", "c"}},
+			expired: []string{"a"},
+		},
+		{
+			name:  "not enough players for mode",
+			queue: []*MatchTicket{ticket("a", 1000, 0), ticket("b", 1000, 0), ticket("c", 1000, 0)},
+			size:  4,
+		},
+		{
+			name:   "four player group",
+			queue:  []*MatchTicket{ticket("a", 1000, 3*time.Second), ticket("b", 1050, 2*time.Second), ticket("c", 950, time.Second), ticket("d", 1000, 0)},
+			size:   4,
+			groups: [][]string{{"a", "d", "b", "c"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, expired := groupTickets(tt.queue, tt.size, now)
+
+			gotGroups := make([][]string, 0, len(groups))
+			for _, group := range groups {
+				ids := make([]string, len(group))
+				for i, ticket := range group {
+					ids[i] = ticket.PlayerID
+				}
+				gotGroups = append(gotGroups, ids)
+			}
+			gotExpired := make([]string, 0, len(expired))
+			for _, ticket := range expired {
+				gotExpired = append(gotExpired, ticket.PlayerID)
+			}
+
+			if fmt.Sprint(gotGroups) != fmt.Sprint(append([][]string{}, tt.groups...)) {
+				t.Errorf("groups = %v, want %v", gotGroups, tt.groups)
+			}
+			if fmt.Sprint(gotExpired) != fmt.Sprint(append([]string{}, tt.expired...)) {
+				t.Errorf("expired = %v, want %v", gotExpired, tt.expired)
+			}
+		})
+	}
+}
+
+func TestMatchmakerCreatesRoom(t *testing.T) {
+	ctx := context.Background()
+	m, rooms, notifier, _ := newTestMatchmaker()
+
+	if err := m.Enqueue(ctx, "1", "1v1", 1000); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	if err := m.Enqueue(ctx, "1", "1v1", 1000); !errors.Is(err, ErrAlreadyMatching) {
+		t.Fatalf("enqueue twice = %v, want %v", err, ErrAlreadyMatching)
+	}
+	if err := m.Enqueue(ctx, "2", "ffa4", 1000); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	if err := m.Enqueue(ctx, "3", "1v1", 1050); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	if err := m.Enqueue(ctx, "4", "unknown", 1000); !errors.Is(err, ErrUnknownMatchMode) {
+		t.Fatalf("enqueue unknown mode = %v, want %v", err, ErrUnknownMatchMode)
+	}
+
+	matched := m.Match(ctx)
+	if len(matched) != 1 {
+		t.Fatalf("Match created %d rooms, want 1", len(matched))
+	}
+	snapshot := matched[0]
+	if snapshot.Mode != "1v1" || snapshot.State != RoomStateRunning || fmt.Sprint(snapshot.Players) != "[1 3]" {
+		t.Fatalf("room = %+v, want running 1v1 room with players [1 3]", snapshot)
+	}
+	if len(notifier.matched) != 1 {
+		t.Errorf("NotifyMatched called %d times, want 1", len(notifier.matched))
+	}
+
+	// 不同模式的玩家互不匹配，ffa4 的玩家仍在队列中
+	if err := m.Cancel(ctx, "2"); err != nil {
+		t.Errorf("cancel ffa4 player: %v", err)
+	}
+	if err := m.Cancel(ctx, "1"); !errors.Is(err, ErrNotMatching) {
+		t.Errorf("cancel matched player = %v, want %v", err, ErrNotMatching)
+	}
+
+	// 对局中不能重新匹配，结束后立即可以
+	if err := m.Enqueue(ctx, "1", "1v1", 1000); !errors.Is(err, ErrAlreadyInRoom) {
+		t.Fatalf("enqueue while in room = %v, want %v", err, ErrAlreadyInRoom)
+	}
+	if err := rooms.FinishRoom(ctx, snapshot.ID, []string{"1"}); err != nil {
+		t.Fatalf("finish room: %v", err)
+	}
+	if err := m.Enqueue(ctx, "1", "1v1", 1000); err != nil {
+		t.Fatalf("enqueue after finish: %v", err)
+	}
+	if _, err := rooms.GetRoom(snapshot.ID); err != nil {
+		t.Errorf("finished room should be kept for result queries: %v", err)
+	}
+}
+
+func TestMatchmakerTimeout(t *testing.T) {
+	ctx := context.Background()
+	m, _, notifier, now := newTestMatchmaker()
+
+	if err := m.Enqueue(ctx, "1", "1v1", 1000); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	*now = now.Add(matchTimeout)
+	if err := m.Enqueue(ctx, "2", "1v1", 2100); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	if matched := m.Match(ctx); len(matched) != 0 {
+		t.Fatalf("Match created %d rooms, want 0", len(matched))
+	}
+	if len(notifier.timeouts) != 0 {
+		t.Fatalf("timeouts = %v, want none at exactly matchTimeout", notifier.timeouts)
+	}
+
+	*now = now.Add(time.Second)
+	m.Match(ctx)
+	if fmt.Sprint(notifier.timeouts) != "[1]" {
+		t.Fatalf("timeouts = %v, want [1]", notifier.timeouts)
+	}
+	if err := m.Cancel(ctx, "1"); !errors.Is(err, ErrNotMatching) {
+		t.Errorf("cancel expired player = %v, want %v", err, ErrNotMatching)
+	}
+	if err := m.Enqueue(ctx, "1", "1v1", 1000); err != nil {
+		t.Errorf("enqueue after timeout: %v", err)
+	}
+	if err := m.Cancel(ctx, "2"); err != nil {
+		t.Errorf("cancel waiting player: %v", err)
+	}
+}
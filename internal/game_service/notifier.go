@@ -0,0 +1,44 @@
+package game_service
+
+import (
+	"context"
+	"strconv"
+
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/push"
+)
+
+// pushNotifier 通过网关推送通知玩家匹配结果
+type pushNotifier struct {
+	pusher *push.Publisher
+}
+
+// NewPushNotifier 创建基于服务端推送的匹配通知
+func NewPushNotifier(pusher *push.Publisher) MatchNotifier {
+	return &pushNotifier{pusher: pusher}
+}
+
+func (n *pushNotifier) NotifyMatched(ctx context.Context, room RoomSnapshot) error {
+	return n.pusher.PushToUsers(ctx, playerUserIDs(room.Players), push.EventMatchFound, &pb.MatchFoundNotify{
+		GameId:  room.ID,
+		Mode:    room.Mode,
+		Players: room.Players,
+	})
+}
+
+func (n *pushNotifier) NotifyTimeout(ctx context.Context, ticket *MatchTicket) error {
+	return n.pusher.PushToUsers(ctx, playerUserIDs([]string{ticket.PlayerID}), push.EventMatchTimeout, &pb.MatchTimeoutNotify{
+		Mode: ticket.Mode,
+	})
+}
+
+// playerUserIDs 将玩家ID转换为用户ID，忽略无法解析的ID
+func playerUserIDs(playerIDs []string) []int64 {
+	userIDs := make([]int64, 0, len(playerIDs))
+	for _, id := range playerIDs {
+		if userID, err := strconv.ParseInt(id, 10, 64); err == nil {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs
+}
@@ -0,0 +1,121 @@
+package game_service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.xubinbest.com/go-game-server/internal/db"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+)
+
+// eloK Elo积分的K系数
+const eloK = 32.0
+
+// RatingStore 玩家匹配积分存储
+type RatingStore interface {
+	GetRating(ctx context.Context, playerID string) (int32, error)
+	SetRating(ctx context.Context, playerID string, rating int32) error
+}
+
+// dbRatingStore 基于用户表的积分存储
+type dbRatingStore struct {
+	dbClient db.Database
+}
+
+// NewDBRatingStore 创建基于用户表的积分存储
+func NewDBRatingStore(dbClient db.Database) RatingStore {
+	return &dbRatingStore{dbClient: dbClient}
+}
+
+func (s *dbRatingStore) GetRating(ctx context.Context, playerID string) (int32, error) {
+	userID, err := strconv.ParseInt(playerID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid player id: %s", playerID)
+	}
+
+	user, err := s.dbClient.GetUser(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if user == nil {
+		return 0, fmt.Errorf("user not found: %d", userID)
+	}
+	if user.Rating <= 0 {
+		return models.DefaultUserRating, nil
+	}
+	return user.Rating, nil
+}
+
+func (s *dbRatingStore) SetRating(ctx context.Context, playerID string, rating int32) error {
+	userID, err := strconv.ParseInt(playerID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid player id: %s", playerID)
+	}
+	return s.dbClient.UpdateUserRating(ctx, userID, rating)
+}
+
+// calculateElo 计算对局后的积分
+// 多人对局按两两对战计算：胜者对负者记1分，同为胜者或同为负者记0.5分，结果按对手数取平均
+func calculateElo(ratings map[string]int32, winners map[string]bool) map[string]int32 {
+	result := make(map[string]int32, len(ratings))
+	if len(ratings) < 2 {
+		for id, r := range ratings {
+			result[id] = r
+		}
+		return result
+	}
+
+	k := eloK / float64(len(ratings)-1)
+	for id, r := range ratings {
+		delta := 0.0
+		for otherID, otherRating := range ratings {
+			if otherID == id {
+				continue
+			}
+
+			actual := 0.5
+			if winners[id] && !winners[otherID] {
+				actual = 1
+			} else if !winners[id] && winners[otherID] {
+				actual = 0
+			}
+			expected := 1 / (1 + math.Pow(10, float64(otherRating-r)/400))
+			delta += k * (actual - expected)
+		}
+
+		newRating := int32(math.Round(float64(r) + delta))
+		if newRating < 0 {
+			newRating = 0
+		}
+		result[id] = newRating
+	}
+
+	return result
+}
+
+// settleRatings 根据对局结果更新参与玩家的积分
+func settleRatings(ctx context.Context, store RatingStore, players []string, winners []string) (map[string]int32, error) {
+	ratings := make(map[string]int32, len(players))
+	for _, playerID := range players {
+		rating, err := store.GetRating(ctx, playerID)
+		if err != nil {
+			return nil, err
+		}
+		ratings[playerID] = rating
+	}
+
+	winnerSet := make(map[string]bool, len(winners))
+	for _, w := range winners {
+		winnerSet[w] = true
+	}
+
+	updated := calculateElo(ratings, winnerSet)
+	for playerID, rating := range updated {
+		if err := store.SetRating(ctx, playerID, rating); err != nil {
+			return nil, err
+		}
+	}
+	return updated, nil
+}
@@ -0,0 +1,66 @@
+package game_service
+
+import "testing"
+
+func TestCalculateElo(t *testing.T) {
+	tests := []struct {
+		name    string
+		ratings map[string]int32
+		winners map[string]bool
+		want    map[string]int32
+	}{
+		{
+			name:    "win between equals",
+			ratings: map[string]int32{"a": 1500, "b": 1500},
+			winners: map[string]bool{"a": true},
+			want:    map[string]int32{"a": 1516, "b": 1484},
+		},
+		{
+			name:    "favourite loses",
+			ratings: map[string]int32{"a": 1600, "b": 1400},
+			winners: map[string]bool{"b": true},
+			want:    map[string]int32{"a": 1576, "b": 1424},
+		},
+		{
+			name:    "favourite wins",
+			ratings: map[string]int32{"a": 1600, "b": 1400},
+			winners: map[string]bool{"a": true},
+			want:    map[string]int32{"a": 1608, "b": 1392},
+		},
+		{
+			name:    "draw between equals",
+			ratings: map[string]int32{"a": 1500, "b": 1500},
+			want:    map[string]int32{"a": 1500, "b": 1500},
+		},
+		{
+			name:    "draw moves ratings together",
+			ratings: map[string]int32{"a": 1600, "b": 1400},
+			want:    map[string]int32{"a": 1592, "b": 1408},
+		},
+		{
+			name:    "free for all single winner",
+			ratings: map[string]int32{"a": 1500, "b": 1500, "c": 1500, "d": 1500},
+			winners: map[string]bool{"a": true},
+			want:    map[string]int32{"a": 1516, "b": 1495, "c": 1495, "d": 1495},
+		},
+		{
+			name:    "single player unchanged",
+			ratings: map[string]int32{"a": 1500},
+			winners: map[string]bool{"a": true},
+			want:    map[string]int32{"a": 1500},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := calculateElo(tt.ratings, tt.winners)
+			if len(got) != len(tt.want) {
+				t.Fatalf("calculateElo() = %v, want %v", got, tt.want)
+			}
+			for id, want := range tt.want {
+				if got[id] != want {
+					t.Errorf("rating[%s] = %d, want %d", id, got[id], want)
+				}
+			}
+		})
+	}
+}
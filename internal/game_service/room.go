@@ -0,0 +1,206 @@
+package game_service
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// RoomState 房间状态
+type RoomState string
+
+const (
+	RoomStateWaiting  RoomState = "waiting"  // 等待中，可加入
+	RoomStateRunning  RoomState = "running"  // 游戏进行中
+	RoomStateFinished RoomState = "finished" // 已结束
+)
+
+const (
+	defaultRoomCapacity = 4
+	maxRoomCapacity     = 16
+)
+
+var (
+	ErrRoomNotFound     = errors.New("room not found")
+	ErrRoomFull         = errors.New("room is full")
+	ErrRoomNotWaiting   = errors.New("room is not waiting")
+	ErrRoomNotRunning   = errors.New("room is not running")
+	ErrAlreadyInRoom    = errors.New("player already in a room")
+	ErrNotInRoom        = errors.New("player not in room")
+	ErrNotRoomOwner     = errors.New("player is not room owner")
+	ErrInvalidCapacity  = errors.New("invalid room capacity")
+	ErrNotEnoughPlayers = errors.New("not enough players")
+)
+
+// Room 游戏房间，仅存在于创建它的实例内存中
+type Room struct {
+	mu           sync.RWMutex
+	id           string
+	ownerID      string
+	mode         string // 匹配模式，自建房间为空
+	capacity     int
+	state        RoomState
+	players      []string
+	participants []string // 开局时的玩家，用于结算
+	winners      []string
+	sim          *Simulation // 游戏开始后创建
+	createdAt    time.Time
+	startedAt    time.Time
+	finishedAt   time.Time
+}
+
+// RoomSnapshot 房间状态快照
+type RoomSnapshot struct {
+	ID           string
+	OwnerID      string
+	Mode         string
+	Capacity     int
+	State        RoomState
+	Players      []string
+	Participants []string
+	Winners      []string
+	CreatedAt    time.Time
+	StartedAt    time.Time
+	FinishedAt   time.Time
+}
+
+func newRoom(id, ownerID, mode string, capacity int) *Room {
+	return &Room{
+		id:        id,
+		ownerID:   ownerID,
+		mode:      mode,
+		capacity:  capacity,
+		state:     RoomStateWaiting,
+		players:   []string{ownerID},
+		createdAt: time.Now(),
+	}
+}
+
+// ID 房间ID
+func (r *Room) ID() string {
+	return r.id
+}
+
+// State 房间当前状态
+func (r *Room) State() RoomState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.state
+}
+
+// Snapshot 获取房间状态快照
+func (r *Room) Snapshot() RoomSnapshot {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return RoomSnapshot{
+		ID:           r.id,
+		OwnerID:      r.ownerID,
+		Mode:         r.mode,
+		Capacity:     r.capacity,
+		State:        r.state,
+		Players:      append([]string(nil), r.players...),
+		Participants: append([]string(nil), r.participants...),
+		Winners:      append([]string(nil), r.winners...),
+		CreatedAt:    r.createdAt,
+		StartedAt:    r.startedAt,
+		FinishedAt:   r.finishedAt,
+	}
+}
+
+// Simulation 获取对局模拟，游戏未开始时返回nil
+func (r *Room) Simulation() *Simulation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.sim
+}
+
+// HasPlayer 玩家是否在房间内
+func (r *Room) HasPlayer(playerID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.indexOf(playerID) >= 0
+}
+
+func (r *Room) join(playerID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.state != RoomStateWaiting {
+		return ErrRoomNotWaiting
+	}
+	if r.indexOf(playerID) >= 0 {
+		return ErrAlreadyInRoom
+	}
+	if len(r.players) >= r.capacity {
+		return ErrRoomFull
+	}
+
+	r.players = append(r.players, playerID)
+	return nil
+}
+
+// leave 玩家离开房间，返回房间剩余人数
+func (r *Room) leave(playerID string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	idx := r.indexOf(playerID)
+	if idx < 0 {
+		return len(r.players), ErrNotInRoom
+	}
+	r.players = append(r.players[:idx], r.players[idx+1:]...)
+	if r.sim != nil {
+		r.sim.RemovePlayer(playerID)
+	}
+
+	// 房主离开时由下一位玩家接任
+	if r.ownerID == playerID && len(r.players) > 0 {
+		r.ownerID = r.players[0]
+	}
+	return len(r.players), nil
+}
+
+func (r *Room) start(playerID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.state != RoomStateWaiting {
+		return ErrRoomNotWaiting
+	}
+	if playerID != "" && r.ownerID != playerID {
+		return ErrNotRoomOwner
+	}
+	if len(r.players) == 0 {
+		return ErrNotEnoughPlayers
+	}
+
+	r.state = RoomStateRunning
+	r.participants = append([]string(nil), r.players...)
+	r.sim = newSimulation(r.id, r.participants)
+	r.startedAt = time.Now()
+	return nil
+}
+
+func (r *Room) finish(winners []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.state != RoomStateRunning {
+		return ErrRoomNotRunning
+	}
+
+	r.state = RoomStateFinished
+	r.winners = append([]string(nil), winners...)
+	r.finishedAt = time.Now()
+	return nil
+}
+
+func (r *Room) indexOf(playerID string) int {
+	for i, p := range r.players {
+		if p == playerID {
+			return i
+		}
+	}
+	return -1
+}
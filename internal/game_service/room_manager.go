@@ -0,0 +1,305 @@
+package game_service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// roomRefreshInterval 房间目录续期及清理的间隔
+	roomRefreshInterval = 30 * time.Second
+	// finishedRoomRetention 已结束房间保留时长，便于玩家查询结果
+	finishedRoomRetention = 5 * time.Minute
+)
+
+// RoomDirectory 房间目录，记录房间所在实例，为nil时仅在进程内管理房间
+type RoomDirectory interface {
+	Register(ctx context.Context, roomID, addr string) error
+	Remove(ctx context.Context, roomID string) error
+}
+
+// RoomManager 管理本实例内存中的游戏房间
+type RoomManager struct {
+	mu          sync.RWMutex
+	rooms       map[string]*Room
+	playerRooms map[string]string // 玩家ID -> 房间ID
+	directory   RoomDirectory
+	addr        string
+	nextID      func() (string, error)
+	onTick      TickHandler
+}
+
+// TickHandler 处理对局每帧的模拟结果，在房间的模拟协程中调用
+type TickHandler func(ctx context.Context, room *Room, result TickResult)
+
+// NewRoomManager 创建房间管理器，addr为本实例对外地址，nextID用于生成房间ID
+func NewRoomManager(directory RoomDirectory, addr string, nextID func() (string, error)) *RoomManager {
+	return &RoomManager{
+		rooms:       make(map[string]*Room),
+		playerRooms: make(map[string]string),
+		directory:   directory,
+		addr:        addr,
+		nextID:      nextID,
+	}
+}
+
+// SetTickHandler 设置对局帧结果处理函数，需在启动任何对局前调用
+func (m *RoomManager) SetTickHandler(handler TickHandler) {
+	m.onTick = handler
+}
+
+// CreateRoom 创建房间，创建者自动成为房主并加入房间
+func (m *RoomManager) CreateRoom(ctx context.Context, ownerID string, capacity int) (*Room, error) {
+	return m.createRoom(ctx, ownerID, "", capacity)
+}
+
+func (m *RoomManager) createRoom(ctx context.Context, ownerID, mode string, capacity int) (*Room, error) {
+	if capacity == 0 {
+		capacity = defaultRoomCapacity
+	}
+	if capacity < 1 || capacity > maxRoomCapacity {
+		return nil, ErrInvalidCapacity
+	}
+
+	roomID, err := m.nextID()
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	if _, ok := m.playerRooms[ownerID]; ok {
+		m.mu.Unlock()
+		return nil, ErrAlreadyInRoom
+	}
+	room := newRoom(roomID, ownerID, mode, capacity)
+	m.rooms[roomID] = room
+	m.playerRooms[ownerID] = roomID
+	m.mu.Unlock()
+
+	if m.directory != nil {
+		if err := m.directory.Register(ctx, roomID, m.addr); err != nil {
+			utils.Error("Failed to register room", zap.String("roomId", roomID), zap.Error(err))
+		}
+	}
+
+	return room, nil
+}
+
+// CreateRoomWithPlayers 创建房间并直接加入一组玩家（匹配成功时使用），第一个玩家为房主
+func (m *RoomManager) CreateRoomWithPlayers(ctx context.Context, mode string, playerIDs []string) (*Room, error) {
+	if len(playerIDs) == 0 || len(playerIDs) > maxRoomCapacity {
+		return nil, ErrInvalidCapacity
+	}
+
+	room, err := m.createRoom(ctx, playerIDs[0], mode, len(playerIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, playerID := range playerIDs[1:] {
+		if err := m.JoinRoom(ctx, room.ID(), playerID); err != nil {
+			m.DestroyRoom(ctx, room.ID())
+			return nil, err
+		}
+	}
+
+	return room, nil
+}
+
+// GetRoom 获取房间
+func (m *RoomManager) GetRoom(roomID string) (*Room, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	room, ok := m.rooms[roomID]
+	if !ok {
+		return nil, ErrRoomNotFound
+	}
+	return room, nil
+}
+
+// GetPlayerRoom 获取玩家所在房间
+func (m *RoomManager) GetPlayerRoom(playerID string) (*Room, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	roomID, ok := m.playerRooms[playerID]
+	if !ok {
+		return nil, ErrNotInRoom
+	}
+	room, ok := m.rooms[roomID]
+	if !ok {
+		return nil, ErrRoomNotFound
+	}
+	return room, nil
+}
+
+// JoinRoom 玩家加入房间
+func (m *RoomManager) JoinRoom(ctx context.Context, roomID, playerID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	room, ok := m.rooms[roomID]
+	if !ok {
+		return ErrRoomNotFound
+	}
+	if _, ok := m.playerRooms[playerID]; ok {
+		return ErrAlreadyInRoom
+	}
+	if err := room.join(playerID); err != nil {
+		return err
+	}
+
+	m.playerRooms[playerID] = roomID
+	return nil
+}
+
+// LeaveRoom 玩家离开房间，房间为空时销毁
+func (m *RoomManager) LeaveRoom(ctx context.Context, roomID, playerID string) error {
+	m.mu.Lock()
+	room, ok := m.rooms[roomID]
+	if !ok {
+		m.mu.Unlock()
+		return ErrRoomNotFound
+	}
+
+	remaining, err := room.leave(playerID)
+	if err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	delete(m.playerRooms, playerID)
+	m.mu.Unlock()
+
+	if remaining == 0 {
+		m.DestroyRoom(ctx, roomID)
+	}
+	return nil
+}
+
+// StartRoom 开始游戏，operatorID为空时跳过房主校验（系统开局）
+func (m *RoomManager) StartRoom(ctx context.Context, roomID, operatorID string) error {
+	room, err := m.GetRoom(roomID)
+	if err != nil {
+		return err
+	}
+	if err := room.start(operatorID); err != nil {
+		return err
+	}
+
+	// 模拟协程的生命周期跟随房间而非请求
+	go m.runSimulation(context.Background(), room)
+	return nil
+}
+
+// runSimulation 以固定帧率推进对局模拟，直到房间不再处于进行中
+func (m *RoomManager) runSimulation(ctx context.Context, room *Room) {
+	sim := room.Simulation()
+	ticker := time.NewTicker(time.Second / tickRate)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if room.State() != RoomStateRunning {
+			return
+		}
+		if _, err := m.GetRoom(room.ID()); err != nil {
+			return
+		}
+
+		result := sim.Step()
+		if len(result.Deltas) > 0 && m.onTick != nil {
+			m.onTick(ctx, room, result)
+		}
+	}
+}
+
+// FinishRoom 结束游戏并记录胜者
+// 房间保留到清理时供查询结果，玩家则立即释放，可以马上创建、加入房间或重新匹配
+func (m *RoomManager) FinishRoom(ctx context.Context, roomID string, winners []string) error {
+	room, err := m.GetRoom(roomID)
+	if err != nil {
+		return err
+	}
+	if err := room.finish(winners); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	for _, playerID := range room.Snapshot().Players {
+		if m.playerRooms[playerID] == roomID {
+			delete(m.playerRooms, playerID)
+		}
+	}
+	m.mu.Unlock()
+	return nil
+}
+
+// DestroyRoom 销毁房间并移除房间目录条目
+func (m *RoomManager) DestroyRoom(ctx context.Context, roomID string) {
+	m.mu.Lock()
+	room, ok := m.rooms[roomID]
+	if !ok {
+		m.mu.Unlock()
+		return
+	}
+	for _, playerID := range room.Snapshot().Players {
+		if m.playerRooms[playerID] == roomID {
+			delete(m.playerRooms, playerID)
+		}
+	}
+	delete(m.rooms, roomID)
+	m.mu.Unlock()
+
+	if m.directory != nil {
+		if err := m.directory.Remove(ctx, roomID); err != nil {
+			utils.Error("Failed to remove room from directory", zap.String("roomId", roomID), zap.Error(err))
+		}
+	}
+}
+
+// Start 启动后台任务：续期房间目录并清理已结束的房间
+func (m *RoomManager) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(roomRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.maintain(ctx)
+			}
+		}
+	}()
+}
+
+func (m *RoomManager) maintain(ctx context.Context) {
+	m.mu.RLock()
+	rooms := make([]*Room, 0, len(m.rooms))
+	for _, room := range m.rooms {
+		rooms = append(rooms, room)
+	}
+	m.mu.RUnlock()
+
+	now := time.Now()
+	for _, room := range rooms {
+		snapshot := room.Snapshot()
+		if snapshot.State == RoomStateFinished && now.Sub(snapshot.FinishedAt) > finishedRoomRetention {
+			m.DestroyRoom(ctx, snapshot.ID)
+			continue
+		}
+
+		// 重新登记以续期，目录条目丢失时也能恢复
+		if m.directory != nil {
+			if err := m.directory.Register(ctx, snapshot.ID, m.addr); err != nil {
+				utils.Warn("Failed to refresh room directory", zap.String("roomId", snapshot.ID), zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package roomdir
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/cache"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// EntryTTL 房间目录条目的过期时间，房间所属实例需在过期前续期
+const EntryTTL = 2 * time.Minute
+
+// Directory 基于Redis的房间目录，记录房间所在的游戏服实例地址，供网关路由
+type Directory struct {
+	cacheClient cache.Cache
+}
+
+// NewDirectory 创建房间目录
+func NewDirectory(cacheClient cache.Cache) *Directory {
+	return &Directory{cacheClient: cacheClient}
+}
+
+func roomKey(roomID string) string {
+	return fmt.Sprintf("game:room:%s:addr", roomID)
+}
+
+// Register 登记房间所在实例，重复登记会刷新过期时间
+func (d *Directory) Register(ctx context.Context, roomID, addr string) error {
+	if err := d.cacheClient.Set(ctx, roomKey(roomID), addr, EntryTTL); err != nil {
+		return fmt.Errorf("failed to register room %s: %w", roomID, err)
+	}
+	return nil
+}
+
+// Remove 移除房间目录条目
+func (d *Directory) Remove(ctx context.Context, roomID string) error {
+	return d.cacheClient.Delete(ctx, roomKey(roomID))
+}
+
+// Lookup 查询房间所在实例地址，房间不存在时返回空字符串
+func (d *Directory) Lookup(ctx context.Context, roomID string) (string, error) {
+	addr, err := d.cacheClient.Get(ctx, roomKey(roomID)).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to lookup room %s: %w", roomID, err)
+	}
+	return addr, nil
+}
@@ -3,11 +3,23 @@ package game_service
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
 
+	"github.xubinbest.com/go-game-server/internal/attribute"
 	"github.xubinbest.com/go-game-server/internal/cache"
 	"github.xubinbest.com/go-game-server/internal/config"
+	"github.xubinbest.com/go-game-server/internal/db"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/game_service/battle"
+	"github.xubinbest.com/go-game-server/internal/game_service/roomdir"
+	"github.xubinbest.com/go-game-server/internal/gameevent"
 	"github.xubinbest.com/go-game-server/internal/mq"
 	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/push"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
 	"github.xubinbest.com/go-game-server/internal/utils"
 
 	"go.uber.org/zap"
@@ -17,20 +29,111 @@ type GameGRPCService struct {
 	pb.UnimplementedGameServiceServer
 	handler      *Handler
 	kafkaFactory *mq.KafkaFactory
+	events       *gameevent.Emitter
+	roomManager  *RoomManager
+	matchmaker   *Matchmaker
+	ratingStore  RatingStore
+	pusher       *push.Publisher
+
+	dbClient      db.Database
+	configManager *designconfig.DesignConfigManager
+	attributes    *attribute.Aggregator
+	battleRecords *battle.RecordStore
+	nextID        func() (string, error)
 }
 
-func NewGameGRPCService(ctx context.Context, cache cache.Cache, cfg *config.Config) *GameGRPCService {
+// NewGameGRPCService 创建游戏服务，addr为本实例对外的gRPC地址，登记到房间目录供网关路由
+func NewGameGRPCService(ctx context.Context, cache cache.Cache, dbClient db.Database, sf *snowflake.Snowflake, cfg *config.Config, configManager *designconfig.DesignConfigManager, addr string) *GameGRPCService {
 	kafkaFactory := mq.NewKafkaFactory(&cfg.KafkaConfigs)
 
-	return &GameGRPCService{
+	nextID := func() (string, error) {
+		id, err := sf.NextID()
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(id, 10), nil
+	}
+
+	roomManager := NewRoomManager(roomdir.NewDirectory(cache), addr, nextID)
+	roomManager.Start(context.Background())
+
+	pusher := push.NewPublisher(cache, "game")
+	matchmaker := NewMatchmaker(cache, roomManager, NewPushNotifier(pusher))
+	matchmaker.Start(context.Background())
+
+	s := &GameGRPCService{
 		UnimplementedGameServiceServer: pb.UnimplementedGameServiceServer{},
 		handler:                        NewHandler(cache, cfg),
 		kafkaFactory:                   kafkaFactory,
+		events:                         gameevent.NewEmitter(kafkaFactory, sf),
+		roomManager:                    roomManager,
+		matchmaker:                     matchmaker,
+		ratingStore:                    NewDBRatingStore(dbClient),
+		pusher:                         pusher,
+		dbClient:                       dbClient,
+		configManager:                  configManager,
+		attributes:                     attribute.NewAggregator(dbClient, configManager),
+		battleRecords:                  battle.NewRecordStore(cache),
+		nextID:                         nextID,
+	}
+	roomManager.SetTickHandler(s.handleTick)
+	return s
+}
+
+func (s *GameGRPCService) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest) (*pb.CreateRoomResponse, error) {
+	if req.PlayerId == "" {
+		return nil, fmt.Errorf("invalid player id")
+	}
+
+	room, err := s.roomManager.CreateRoom(ctx, req.PlayerId, int(req.Capacity))
+	if err != nil {
+		utils.Warn("Failed to create room", zap.String("playerId", req.PlayerId), zap.Error(err))
+		return &pb.CreateRoomResponse{
+			Success: false,
+			Message: roomErrorMessage(err),
+		}, nil
+	}
+
+	utils.Info("Room created", zap.String("playerId", req.PlayerId), zap.String("gameId", room.ID()))
+	return &pb.CreateRoomResponse{
+		Success: true,
+		Message: "创建房间成功",
+		GameId:  room.ID(),
+	}, nil
+}
+
+func (s *GameGRPCService) StartGame(ctx context.Context, req *pb.StartGameRequest) (*pb.StartGameResponse, error) {
+	if req.PlayerId == "" {
+		return nil, fmt.Errorf("invalid player id")
 	}
+
+	if err := s.roomManager.StartRoom(ctx, req.GameId, req.PlayerId); err != nil {
+		return &pb.StartGameResponse{
+			Success: false,
+			Message: roomErrorMessage(err),
+		}, nil
+	}
+
+	utils.Info("Game started", zap.String("playerId", req.PlayerId), zap.String("gameId", req.GameId))
+	return &pb.StartGameResponse{
+		Success: true,
+		Message: "游戏开始",
+	}, nil
 }
 
 func (s *GameGRPCService) JoinGame(ctx context.Context, req *pb.JoinGameRequest) (*pb.JoinGameResponse, error) {
 	utils.Info("Player joining game", zap.String("playerId", req.PlayerId), zap.String("gameId", req.GameId))
+	if req.PlayerId == "" {
+		return nil, fmt.Errorf("invalid player id")
+	}
+
+	if err := s.roomManager.JoinRoom(ctx, req.GameId, req.PlayerId); err != nil {
+		return &pb.JoinGameResponse{
+			Success: false,
+			Message: roomErrorMessage(err),
+		}, nil
+	}
+
 	return &pb.JoinGameResponse{
 		Success: true,
 		Message: "Welcome to the game!",
@@ -39,48 +142,304 @@ func (s *GameGRPCService) JoinGame(ctx context.Context, req *pb.JoinGameRequest)
 
 func (s *GameGRPCService) LeaveGame(ctx context.Context, req *pb.LeaveGameRequest) (*pb.LeaveGameResponse, error) {
 	utils.Info("Player leaving game", zap.String("playerId", req.PlayerId), zap.String("gameId", req.GameId))
+	if req.PlayerId == "" {
+		return nil, fmt.Errorf("invalid player id")
+	}
+
+	if err := s.roomManager.LeaveRoom(ctx, req.GameId, req.PlayerId); err != nil {
+		utils.Warn("Failed to leave room", zap.String("playerId", req.PlayerId), zap.Error(err))
+		return &pb.LeaveGameResponse{Success: false}, nil
+	}
+
+	s.checkForfeit(ctx, req.GameId)
 	return &pb.LeaveGameResponse{Success: true}, nil
 }
 
-func (s *GameGRPCService) GetGameState(ctx context.Context, req *pb.GameStateRequest) (*pb.GameStateResponse, error) {
-	return &pb.GameStateResponse{
-		State:   "running",
-		Players: []string{"player1", "player2"},
-	}, nil
+// checkForfeit 匹配对局进行中玩家离开视为认输，仅剩一名玩家时结束对局
+func (s *GameGRPCService) checkForfeit(ctx context.Context, roomID string) {
+	room, err := s.roomManager.GetRoom(roomID)
+	if err != nil {
+		return
+	}
+
+	snapshot := room.Snapshot()
+	if snapshot.Mode == "" || snapshot.State != RoomStateRunning || len(snapshot.Players) != 1 {
+		return
+	}
+	s.finishMatch(ctx, roomID, snapshot.Players)
 }
 
-func (s *GameGRPCService) PlayerAction(ctx context.Context, req *pb.PlayerActionRequest) (*pb.PlayerActionResponse, error) {
-	utils.Info("Player action", zap.String("playerId", req.PlayerId), zap.String("gameId", req.GameId), zap.String("action", req.Action))
+// finishMatch 结束对局并上报最终得分，匹配对局同时结算积分
+func (s *GameGRPCService) finishMatch(ctx context.Context, roomID string, winners []string) {
+	if err := s.roomManager.FinishRoom(ctx, roomID, winners); err != nil {
+		utils.Warn("Failed to finish room", zap.String("roomId", roomID), zap.Error(err))
+		return
+	}
 
-	// 示例：当玩家完成某个动作时，更新他们的分数
-	if req.Action == "complete_level" {
-		producer, err := s.kafkaFactory.GetProducer(mq.GameScore)
+	room, err := s.roomManager.GetRoom(roomID)
+	if err != nil {
+		return
+	}
+	snapshot := room.Snapshot()
+	s.reportFinalScores(ctx, room, snapshot.Participants)
+	if snapshot.Mode == "" {
+		return
+	}
+
+	ratings, err := settleRatings(ctx, s.ratingStore, snapshot.Participants, snapshot.Winners)
+	if err != nil {
+		utils.Error("Failed to settle ratings", zap.String("roomId", roomID), zap.Error(err))
+		return
+	}
+	utils.Info("Match settled", zap.String("roomId", roomID), zap.Strings("winners", snapshot.Winners), zap.Any("ratings", ratings))
+	s.emitMatchFinished(ctx, snapshot.Participants, snapshot.Winners)
+}
+
+// emitMatchFinished 为参与匹配对局的玩家发送对局结束事件，获胜方额外发送获胜事件
+func (s *GameGRPCService) emitMatchFinished(ctx context.Context, participants, winners []string) {
+	for _, playerID := range participants {
+		userID, err := strconv.ParseInt(playerID, 10, 64)
 		if err != nil {
-			utils.Error("Failed to get score producer", zap.Error(err))
-			// 继续处理，不让Kafka错误影响游戏流程
-		} else {
-			scoreData := struct {
-				UserID string  `json:"user_id"`
-				Score  float64 `json:"score"`
-			}{
-				UserID: req.PlayerId,
-				Score:  100.0,
-			}
-
-			value, err := json.Marshal(scoreData)
-			if err != nil {
-				utils.Error("Failed to marshal score data", zap.Error(err))
-			} else {
-				key := []byte(req.PlayerId)
-				if err := producer.SendMessage(ctx, key, value); err != nil {
-					utils.Error("Failed to send score to kafka", zap.Error(err))
-				}
-			}
+			continue
+		}
+		s.events.Emit(ctx, userID, gameevent.MatchFinished, 1)
+	}
+	for _, playerID := range winners {
+		userID, err := strconv.ParseInt(playerID, 10, 64)
+		if err != nil {
+			continue
+		}
+		s.events.Emit(ctx, userID, gameevent.MatchWon, 1)
+	}
+}
+
+func (s *GameGRPCService) JoinMatch(ctx context.Context, req *pb.JoinMatchRequest) (*pb.JoinMatchResponse, error) {
+	if req.PlayerId == "" {
+		return nil, fmt.Errorf("invalid player id")
+	}
+
+	rating, err := s.ratingStore.GetRating(ctx, req.PlayerId)
+	if err != nil {
+		utils.Error("Failed to get player rating", zap.String("playerId", req.PlayerId), zap.Error(err))
+		return nil, fmt.Errorf("failed to get player rating: %w", err)
+	}
+
+	if err := s.matchmaker.Enqueue(ctx, req.PlayerId, req.Mode, rating); err != nil {
+		return &pb.JoinMatchResponse{
+			Success: false,
+			Message: roomErrorMessage(err),
+			Rating:  rating,
+		}, nil
+	}
+
+	utils.Info("Player joined match queue", zap.String("playerId", req.PlayerId), zap.String("mode", req.Mode), zap.Int32("rating", rating))
+	return &pb.JoinMatchResponse{
+		Success: true,
+		Message: "开始匹配",
+		Rating:  rating,
+	}, nil
+}
+
+func (s *GameGRPCService) CancelMatch(ctx context.Context, req *pb.CancelMatchRequest) (*pb.CancelMatchResponse, error) {
+	if req.PlayerId == "" {
+		return nil, fmt.Errorf("invalid player id")
+	}
+
+	if err := s.matchmaker.Cancel(ctx, req.PlayerId); err != nil {
+		return &pb.CancelMatchResponse{
+			Success: false,
+			Message: roomErrorMessage(err),
+		}, nil
+	}
+
+	return &pb.CancelMatchResponse{
+		Success: true,
+		Message: "已取消匹配",
+	}, nil
+}
+
+func (s *GameGRPCService) GetGameState(ctx context.Context, req *pb.GameStateRequest) (*pb.GameStateResponse, error) {
+	room, err := s.roomManager.GetRoom(req.GameId)
+	if err != nil {
+		return &pb.GameStateResponse{
+			Success: false,
+			Message: roomErrorMessage(err),
+		}, nil
+	}
+
+	snapshot := room.Snapshot()
+	resp := &pb.GameStateResponse{
+		Success:  true,
+		State:    string(snapshot.State),
+		Players:  snapshot.Players,
+		GameId:   snapshot.ID,
+		OwnerId:  snapshot.OwnerID,
+		Capacity: int32(snapshot.Capacity),
+	}
+
+	if sim := room.Simulation(); sim != nil {
+		simSnapshot := sim.Snapshot()
+		resp.Tick = simSnapshot.Tick
+		for _, playerID := range snapshot.Participants {
+			pos := simSnapshot.Positions[playerID]
+			resp.PlayerStates = append(resp.PlayerStates, &pb.GamePlayerState{
+				PlayerId: playerID,
+				X:        pos.X,
+				Y:        pos.Y,
+				Score:    simSnapshot.Scores[playerID],
+			})
+		}
+		for itemID, pos := range simSnapshot.Items {
+			resp.Items = append(resp.Items, &pb.GameItem{
+				ItemId: itemID,
+				X:      pos.X,
+				Y:      pos.Y,
+			})
 		}
+		sort.Slice(resp.Items, func(i, j int) bool {
+			return resp.Items[i].ItemId < resp.Items[j].ItemId
+		})
+	}
+	return resp, nil
+}
+
+// roomErrorMessage 将房间错误转换为客户端提示
+func roomErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, ErrRoomNotFound):
+		return "房间不存在"
+	case errors.Is(err, ErrRoomFull):
+		return "房间已满"
+	case errors.Is(err, ErrRoomNotWaiting):
+		return "房间不在等待状态"
+	case errors.Is(err, ErrRoomNotRunning):
+		return "游戏未在进行中"
+	case errors.Is(err, ErrAlreadyInRoom):
+		return "已在房间中"
+	case errors.Is(err, ErrNotInRoom):
+		return "不在房间中"
+	case errors.Is(err, ErrNotRoomOwner):
+		return "只有房主可以操作"
+	case errors.Is(err, ErrInvalidCapacity):
+		return "房间人数不合法"
+	case errors.Is(err, ErrNotEnoughPlayers):
+		return "玩家人数不足"
+	case errors.Is(err, ErrUnknownMatchMode):
+		return "匹配模式不存在"
+	case errors.Is(err, ErrAlreadyMatching):
+		return "已在匹配中"
+	case errors.Is(err, ErrNotMatching):
+		return "不在匹配中"
+	case errors.Is(err, ErrUnknownAction):
+		return "未知操作"
+	case errors.Is(err, ErrActionQueueFull):
+		return "操作过于频繁"
+	case errors.Is(err, ErrStageNotFound):
+		return "关卡不存在"
+	case errors.Is(err, ErrBattleNotFound):
+		return "战斗记录不存在或已过期"
+	default:
+		return "操作失败"
+	}
+}
+
+// PlayerAction 提交玩家操作，操作在下一帧统一校验和处理，结果通过帧推送下发
+func (s *GameGRPCService) PlayerAction(ctx context.Context, req *pb.PlayerActionRequest) (*pb.PlayerActionResponse, error) {
+	if req.PlayerId == "" {
+		return nil, fmt.Errorf("invalid player id")
+	}
+
+	room, err := s.roomManager.GetRoom(req.GameId)
+	if err != nil {
+		return &pb.PlayerActionResponse{Success: false, Message: roomErrorMessage(err)}, nil
+	}
+	if !room.HasPlayer(req.PlayerId) {
+		return &pb.PlayerActionResponse{Success: false, Message: roomErrorMessage(ErrNotInRoom)}, nil
+	}
+	sim := room.Simulation()
+	if sim == nil || room.State() != RoomStateRunning {
+		return &pb.PlayerActionResponse{Success: false, Message: roomErrorMessage(ErrRoomNotRunning)}, nil
+	}
+
+	tick, err := sim.Submit(req.PlayerId, req.Action, req.Payload)
+	if err != nil {
+		return &pb.PlayerActionResponse{Success: false, Message: roomErrorMessage(err)}, nil
 	}
 
 	return &pb.PlayerActionResponse{
 		Success: true,
-		Message: "Action processed",
+		Message: "Action queued",
+		Tick:    tick,
 	}, nil
 }
+
+// handleTick 处理对局帧结果：推送状态变化，有玩家通关时结束对局
+func (s *GameGRPCService) handleTick(ctx context.Context, room *Room, result TickResult) {
+	snapshot := room.Snapshot()
+
+	notify := &pb.GameTickNotify{
+		GameId: snapshot.ID,
+		Tick:   result.Tick,
+	}
+	for _, d := range result.Deltas {
+		notify.Deltas = append(notify.Deltas, &pb.GameDelta{
+			PlayerId:    d.PlayerID,
+			Action:      d.Action,
+			Accepted:    d.Accepted,
+			Reason:      d.Reason,
+			X:           d.Position.X,
+			Y:           d.Position.Y,
+			ItemId:      d.ItemID,
+			ScoreGained: d.ScoreGained,
+			Score:       d.Score,
+		})
+
+	}
+	if err := s.pusher.PushToUsers(ctx, playerUserIDs(snapshot.Players), push.EventGameTick, notify); err != nil {
+		utils.Warn("Failed to push game tick", zap.String("gameId", snapshot.ID), zap.Error(err))
+	}
+
+	if len(result.Completed) > 0 {
+		s.finishMatch(ctx, snapshot.ID, result.Completed)
+	}
+}
+
+// reportFinalScores 对局结束时上报每名参与者的本局得分，每局只上报一次
+func (s *GameGRPCService) reportFinalScores(ctx context.Context, room *Room, participants []string) {
+	sim := room.Simulation()
+	if sim == nil {
+		return
+	}
+	scores := sim.Snapshot().Scores
+	for _, playerID := range participants {
+		if score := scores[playerID]; score > 0 {
+			s.reportScore(ctx, playerID, score)
+		}
+	}
+}
+
+// reportScore 上报玩家本局得分到排行榜，Kafka错误不影响游戏流程
+func (s *GameGRPCService) reportScore(ctx context.Context, playerID string, score int64) {
+	producer, err := s.kafkaFactory.GetProducer(mq.GameScore)
+	if err != nil {
+		utils.Error("Failed to get score producer", zap.Error(err))
+		return
+	}
+
+	scoreData := struct {
+		UserID string  `json:"user_id"`
+		Score  float64 `json:"score"`
+	}{
+		UserID: playerID,
+		Score:  float64(score),
+	}
+
+	value, err := json.Marshal(scoreData)
+	if err != nil {
+		utils.Error("Failed to marshal score data", zap.Error(err))
+		return
+	}
+	if err := producer.SendMessage(ctx, []byte(playerID), value); err != nil {
+		utils.Error("Failed to send score to kafka", zap.Error(err))
+	}
+}
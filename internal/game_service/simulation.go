@@ -0,0 +1,294 @@
+package game_service
+
+import (
+	"encoding/json"
+	"errors"
+	"hash/fnv"
+	"math/rand"
+	"sync"
+)
+
+const (
+	// tickRate 每秒模拟帧数
+	tickRate = 10
+	// boardSize 地图边长，坐标范围 [0, boardSize)
+	boardSize = 20
+	// maxMoveStep 单次移动在每个坐标轴上的最大步长
+	maxMoveStep = 1
+	// levelItemCount 每局生成的道具数量
+	levelItemCount = 5
+	// itemScore 拾取道具获得的分数
+	itemScore = 10
+	// levelCompleteScore 通关获得的分数
+	levelCompleteScore = 100
+	// maxPendingActions 单局待处理操作上限，防止刷包
+	maxPendingActions = 256
+)
+
+// 玩家操作类型
+const (
+	ActionMove          = "move"
+	ActionCollect       = "collect"
+	ActionCompleteLevel = "complete_level"
+)
+
+var (
+	ErrUnknownAction   = errors.New("unknown action")
+	ErrActionQueueFull = errors.New("action queue is full")
+)
+
+// 操作校验失败原因
+const (
+	rejectInvalidPayload = "invalid payload"
+	rejectNotPlaying     = "player not playing"
+	rejectMoveTooFar     = "move too far"
+	rejectOutOfBounds    = "out of bounds"
+	rejectItemNotFound   = "item not found"
+	rejectItemTooFar     = "item too far"
+	rejectItemsRemaining = "items remaining"
+	rejectNotAtExit      = "not at exit"
+)
+
+// Position 地图坐标
+type Position struct {
+	X int32 `json:"x"`
+	Y int32 `json:"y"`
+}
+
+// MovePayload 移动操作参数
+type MovePayload struct {
+	DX int32 `json:"dx"`
+	DY int32 `json:"dy"`
+}
+
+// CollectPayload 拾取操作参数
+type CollectPayload struct {
+	ItemID int32 `json:"item_id"`
+}
+
+// Action 玩家提交的操作，在下一帧统一处理
+type Action struct {
+	PlayerID string
+	Type     string
+	Payload  []byte
+	seq      uint64
+}
+
+// Delta 单个操作的处理结果
+type Delta struct {
+	PlayerID    string
+	Action      string
+	Accepted    bool
+	Reason      string // 被拒绝的原因
+	Position    Position
+	ItemID      int32
+	ScoreGained int64
+	Score       int64 // 玩家本局总分
+}
+
+// TickResult 一帧的模拟结果
+type TickResult struct {
+	Tick      uint64
+	Deltas    []Delta
+	Completed []string // 本帧通关的玩家
+}
+
+// Simulation 服务端权威的对局模拟，同样的初始玩家和操作序列总是产生同样的结果
+type Simulation struct {
+	mu        sync.Mutex
+	tick      uint64
+	seq       uint64
+	pending   []Action
+	players   map[string]bool
+	positions map[string]Position
+	scores    map[string]int64
+	items     map[int32]Position
+	exit      Position
+}
+
+// newSimulation 创建对局模拟，地图由房间ID确定性生成
+func newSimulation(roomID string, players []string) *Simulation {
+	h := fnv.New64a()
+	h.Write([]byte(roomID))
+	rng := rand.New(rand.NewSource(int64(h.Sum64())))
+
+	s := &Simulation{
+		players:   make(map[string]bool, len(players)),
+		positions: make(map[string]Position, len(players)),
+		scores:    make(map[string]int64, len(players)),
+		items:     make(map[int32]Position, levelItemCount),
+	}
+	for _, p := range players {
+		s.players[p] = true
+		s.positions[p] = Position{}
+	}
+	for i := int32(1); i <= levelItemCount; i++ {
+		s.items[i] = Position{X: rng.Int31n(boardSize), Y: rng.Int31n(boardSize)}
+	}
+	s.exit = Position{X: boardSize - 1, Y: boardSize - 1}
+	return s
+}
+
+// Submit 提交操作，返回处理该操作的帧号
+func (s *Simulation) Submit(playerID, actionType string, payload []byte) (uint64, error) {
+	switch actionType {
+	case ActionMove, ActionCollect, ActionCompleteLevel:
+	default:
+		return 0, ErrUnknownAction
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.pending) >= maxPendingActions {
+		return 0, ErrActionQueueFull
+	}
+	s.seq++
+	s.pending = append(s.pending, Action{
+		PlayerID: playerID,
+		Type:     actionType,
+		Payload:  payload,
+		seq:      s.seq,
+	})
+	return s.tick + 1, nil
+}
+
+// RemovePlayer 玩家离开对局，之后的操作不再生效
+func (s *Simulation) RemovePlayer(playerID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.players, playerID)
+}
+
+// Step 推进一帧：按提交顺序校验并应用所有待处理操作
+func (s *Simulation) Step() TickResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tick++
+	actions := s.pending
+	s.pending = nil
+
+	result := TickResult{Tick: s.tick}
+	for _, action := range actions {
+		delta := s.apply(action)
+		result.Deltas = append(result.Deltas, delta)
+		if delta.Accepted && delta.Action == ActionCompleteLevel {
+			result.Completed = append(result.Completed, delta.PlayerID)
+		}
+	}
+	return result
+}
+
+// Score 获取玩家本局分数
+func (s *Simulation) Score(playerID string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.scores[playerID]
+}
+
+func (s *Simulation) apply(action Action) Delta {
+	delta := Delta{
+		PlayerID: action.PlayerID,
+		Action:   action.Type,
+		Position: s.positions[action.PlayerID],
+		Score:    s.scores[action.PlayerID],
+	}
+	if !s.players[action.PlayerID] {
+		delta.Reason = rejectNotPlaying
+		return delta
+	}
+
+	pos := s.positions[action.PlayerID]
+	switch action.Type {
+	case ActionMove:
+		var p MovePayload
+		if err := json.Unmarshal(action.Payload, &p); err != nil {
+			delta.Reason = rejectInvalidPayload
+			return delta
+		}
+		if absInt32(p.DX) > maxMoveStep || absInt32(p.DY) > maxMoveStep {
+			delta.Reason = rejectMoveTooFar
+			return delta
+		}
+		next := Position{X: pos.X + p.DX, Y: pos.Y + p.DY}
+		if !inBoard(next) {
+			delta.Reason = rejectOutOfBounds
+			return delta
+		}
+		s.positions[action.PlayerID] = next
+		delta.Position = next
+
+	case ActionCollect:
+		var p CollectPayload
+		if err := json.Unmarshal(action.Payload, &p); err != nil {
+			delta.Reason = rejectInvalidPayload
+			return delta
+		}
+		itemPos, ok := s.items[p.ItemID]
+		if !ok {
+			delta.Reason = rejectItemNotFound
+			return delta
+		}
+		if absInt32(itemPos.X-pos.X) > 1 || absInt32(itemPos.Y-pos.Y) > 1 {
+			delta.Reason = rejectItemTooFar
+			return delta
+		}
+		delete(s.items, p.ItemID)
+		delta.ItemID = p.ItemID
+		delta.ScoreGained = itemScore
+
+	case ActionCompleteLevel:
+		if len(s.items) > 0 {
+			delta.Reason = rejectItemsRemaining
+			return delta
+		}
+		if pos != s.exit {
+			delta.Reason = rejectNotAtExit
+			return delta
+		}
+		delta.ScoreGained = levelCompleteScore
+	}
+
+	s.scores[action.PlayerID] += delta.ScoreGained
+	delta.Score = s.scores[action.PlayerID]
+	delta.Accepted = true
+	return delta
+}
+
+func inBoard(p Position) bool {
+	return p.X >= 0 && p.X < boardSize && p.Y >= 0 && p.Y < boardSize
+}
+
+// SimulationSnapshot 对局模拟状态快照
+type SimulationSnapshot struct {
+	Tick      uint64
+	Positions map[string]Position
+	Scores    map[string]int64
+	Items     map[int32]Position
+	Exit      Position
+}
+
+// Snapshot 获取对局模拟状态快照
+func (s *Simulation) Snapshot() SimulationSnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := SimulationSnapshot{
+		Tick:      s.tick,
+		Positions: make(map[string]Position, len(s.positions)),
+		Scores:    make(map[string]int64, len(s.scores)),
+		Items:     make(map[int32]Position, len(s.items)),
+		Exit:      s.exit,
+	}
+	for id, p := range s.positions {
+		snapshot.Positions[id] = p
+	}
+	for id, score := range s.scores {
+		snapshot.Scores[id] = score
+	}
+	for id, p := range s.items {
+		snapshot.Items[id] = p
+	}
+	return snapshot
+}
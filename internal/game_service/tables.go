@@ -0,0 +1,89 @@
+package game_service
+
+import (
+	"reflect"
+
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// 所有用到的配置表
+var Tables = []designconfig.Tables{
+	{
+		DataId:    "level.csv",
+		TableName: "level",
+		DataType:  reflect.TypeOf(designconfig.LevelData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "equipment.csv",
+		TableName: "equipment",
+		DataType:  reflect.TypeOf(designconfig.EquipmentData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "equip_enhance.csv",
+		TableName: "equip_enhance",
+		DataType:  reflect.TypeOf(designconfig.EquipEnhanceData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "pet_level.csv",
+		TableName: "pet_level",
+		DataType:  reflect.TypeOf(designconfig.PetLevelData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "pet_star.csv",
+		TableName: "pet_star",
+		DataType:  reflect.TypeOf(designconfig.PetStarData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "pet_skill.csv",
+		TableName: "pet_skill",
+		DataType:  reflect.TypeOf(designconfig.PetSkillData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "card.csv",
+		TableName: "card",
+		DataType:  reflect.TypeOf(designconfig.CardData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "card_star.csv",
+		TableName: "card_star",
+		DataType:  reflect.TypeOf(designconfig.CardStarData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "card_level.csv",
+		TableName: "card_level",
+		DataType:  reflect.TypeOf(designconfig.CardLevelData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "card_set.csv",
+		TableName: "card_set",
+		DataType:  reflect.TypeOf(designconfig.CardSetData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "buff.csv",
+		TableName: "buff",
+		DataType:  reflect.TypeOf(designconfig.BuffData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "monster.csv",
+		TableName: "monster",
+		DataType:  reflect.TypeOf(designconfig.MonsterData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "battle_stage.csv",
+		TableName: "battle_stage",
+		DataType:  reflect.TypeOf(designconfig.BattleStageData{}),
+		Group:     designconfig.BaseGroup,
+	},
+}
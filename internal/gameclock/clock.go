@@ -0,0 +1,175 @@
+package gameclock
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	// 内嵌时区数据库，alpine 等精简镜像中没有 zoneinfo 时也能加载配置的时区
+	_ "time/tzdata"
+
+	"github.xubinbest.com/go-game-server/internal/config"
+)
+
+// Clock 时间来源，测试中可替换为 ManualClock 模拟跨天、跨周、跨月
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock 系统时钟
+var SystemClock Clock = systemClock{}
+
+// ManualClock 手动设置的时钟，供测试使用
+type ManualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewManualClock 创建停在 now 的手动时钟
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+// Now 获取当前时间
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set 将时钟设置到指定时间
+func (c *ManualClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+// Advance 将时钟向后推进 d
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// GameClock 游戏时钟，按配置的时区和每日重置时刻划分游戏日
+// 重置时刻之前的时间属于前一个游戏日，例如 05:00 重置时 04:59 仍算作前一天
+// 每周从周一的重置时刻开始，每月从1号的重置时刻开始
+type GameClock struct {
+	clock     Clock
+	location  *time.Location
+	resetHour int
+}
+
+// New 创建使用系统时钟的游戏时钟
+func New(cfg config.GameClockConfig) (*GameClock, error) {
+	return NewWithClock(cfg, SystemClock)
+}
+
+// NewWithClock 创建使用指定时间来源的游戏时钟
+func NewWithClock(cfg config.GameClockConfig, clock Clock) (*GameClock, error) {
+	if cfg.ResetHour < 0 || cfg.ResetHour > 23 {
+		return nil, fmt.Errorf("invalid reset hour: %d", cfg.ResetHour)
+	}
+
+	location := time.Local
+	if cfg.Timezone != "" {
+		loc, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", cfg.Timezone, err)
+		}
+		location = loc
+	}
+
+	return &GameClock{
+		clock:     clock,
+		location:  location,
+		resetHour: cfg.ResetHour,
+	}, nil
+}
+
+// Now 获取游戏时区的当前时间
+func (c *GameClock) Now() time.Time {
+	return c.clock.Now().In(c.location)
+}
+
+// Location 获取游戏时区
+func (c *GameClock) Location() *time.Location {
+	return c.location
+}
+
+// Date 获取 t 所属游戏日的日期
+func (c *GameClock) Date(t time.Time) (year int, month time.Month, day int) {
+	return t.In(c.location).Add(-time.Duration(c.resetHour) * time.Hour).Date()
+}
+
+// DayStart 获取 t 所属游戏日的开始时间
+func (c *GameClock) DayStart(t time.Time) time.Time {
+	year, month, day := c.Date(t)
+	return c.at(year, month, day)
+}
+
+// NextDayStart 获取 t 之后下一个游戏日的开始时间
+func (c *GameClock) NextDayStart(t time.Time) time.Time {
+	year, month, day := c.Date(t)
+	return c.at(year, month, day+1)
+}
+
+// WeekStart 获取 t 所属游戏周的开始时间，周一为一周的第一天
+func (c *GameClock) WeekStart(t time.Time) time.Time {
+	year, month, day := c.Date(t)
+	return c.at(year, month, day-c.weekdayOffset(year, month, day))
+}
+
+// NextWeekStart 获取 t 之后下一个游戏周的开始时间
+func (c *GameClock) NextWeekStart(t time.Time) time.Time {
+	year, month, day := c.Date(t)
+	return c.at(year, month, day-c.weekdayOffset(year, month, day)+7)
+}
+
+// MonthStart 获取 t 所属游戏月的开始时间
+func (c *GameClock) MonthStart(t time.Time) time.Time {
+	year, month, _ := c.Date(t)
+	return c.at(year, month, 1)
+}
+
+// NextMonthStart 获取 t 之后下一个游戏月的开始时间
+func (c *GameClock) NextMonthStart(t time.Time) time.Time {
+	year, month, _ := c.Date(t)
+	return c.at(year, month+1, 1)
+}
+
+// DayKey 获取 t 所属游戏日的标识，格式为 20060102
+func (c *GameClock) DayKey(t time.Time) string {
+	year, month, day := c.Date(t)
+	return fmt.Sprintf("%04d%02d%02d", year, month, day)
+}
+
+// WeekKey 获取 t 所属游戏周的标识，使用 ISO 周数，格式为 2006W01
+func (c *GameClock) WeekKey(t time.Time) string {
+	year, month, day := c.Date(t)
+	isoYear, week := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return fmt.Sprintf("%04dW%02d", isoYear, week)
+}
+
+// MonthKey 获取 t 所属游戏月的标识，格式为 200601
+func (c *GameClock) MonthKey(t time.Time) string {
+	year, month, _ := c.Date(t)
+	return fmt.Sprintf("%04d%02d", year, month)
+}
+
+// at 获取指定日期的重置时刻，日期超出范围时自动进位
+func (c *GameClock) at(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, c.resetHour, 0, 0, 0, c.location)
+}
+
+// weekdayOffset 获取指定日期距本周一的天数
+func (c *GameClock) weekdayOffset(year int, month time.Month, day int) int {
+	weekday := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday()
+	return (int(weekday) + 6) % 7
+}
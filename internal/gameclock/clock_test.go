@@ -0,0 +1,104 @@
+package gameclock
+
+import (
+	"testing"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/config"
+)
+
+// newTestClock 创建 Asia/Shanghai 时区、05:00 重置的游戏时钟
+func newTestClock(t *testing.T) (*GameClock, *ManualClock, *time.Location) {
+	t.Helper()
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	manual := NewManualClock(time.Time{})
+	clock, err := NewWithClock(config.GameClockConfig{Timezone: "Asia/Shanghai", ResetHour: 5}, manual)
+	if err != nil {
+		t.Fatalf("new game clock: %v", err)
+	}
+	return clock, manual, loc
+}
+
+func TestDate(t *testing.T) {
+	clock, manual, loc := newTestClock(t)
+
+	tests := []struct {
+		name  string
+		now   time.Time
+		year  int
+		month time.Month
+		day   int
+	}{
+		{"before reset", time.Date(2026, 3, 10, 4, 59, 59, 0, loc), 2026, 3, 9},
+		{"at reset", time.Date(2026, 3, 10, 5, 0, 0, 0, loc), 2026, 3, 10},
+		{"after reset", time.Date(2026, 3, 10, 23, 59, 0, 0, loc), 2026, 3, 10},
+		{"month end before reset", time.Date(2026, 3, 1, 4, 59, 0, 0, loc), 2026, 2, 28},
+		{"year end before reset", time.Date(2027, 1, 1, 4, 59, 0, 0, loc), 2026, 12, 31},
+		{"other timezone", time.Date(2026, 3, 9, 21, 0, 0, 0, time.UTC), 2026, 3, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manual.Set(tt.now)
+			year, month, day := clock.Date(clock.Now())
+			if year != tt.year || month != tt.month || day != tt.day {
+				t.Errorf("Date(%v) = %d-%02d-%02d, want %d-%02d-%02d", tt.now, year, month, day, tt.year, tt.month, tt.day)
+			}
+		})
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	clock, manual, loc := newTestClock(t)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{"sunday", time.Date(2026, 3, 8, 12, 0, 0, 0, loc), time.Date(2026, 3, 2, 5, 0, 0, 0, loc)},
+		{"monday before reset", time.Date(2026, 3, 9, 4, 59, 0, 0, loc), time.Date(2026, 3, 2, 5, 0, 0, 0, loc)},
+		{"monday at reset", time.Date(2026, 3, 9, 5, 0, 0, 0, loc), time.Date(2026, 3, 9, 5, 0, 0, 0, loc)},
+		{"week across month", time.Date(2026, 3, 1, 12, 0, 0, 0, loc), time.Date(2026, 2, 23, 5, 0, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manual.Set(tt.now)
+			if got := clock.WeekStart(clock.Now()); !got.Equal(tt.want) {
+				t.Errorf("WeekStart(%v) = %v, want %v", tt.now, got, tt.want)
+			}
+			if got, want := clock.NextWeekStart(clock.Now()), tt.want.AddDate(0, 0, 7); !got.Equal(want) {
+				t.Errorf("NextWeekStart(%v) = %v, want %v", tt.now, got, want)
+			}
+		})
+	}
+}
+
+func TestMonthStart(t *testing.T) {
+	clock, manual, loc := newTestClock(t)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+		next time.Time
+	}{
+		{"month end before reset", time.Date(2026, 3, 1, 4, 59, 0, 0, loc), time.Date(2026, 2, 1, 5, 0, 0, 0, loc), time.Date(2026, 3, 1, 5, 0, 0, 0, loc)},
+		{"first day at reset", time.Date(2026, 3, 1, 5, 0, 0, 0, loc), time.Date(2026, 3, 1, 5, 0, 0, 0, loc), time.Date(2026, 4, 1, 5, 0, 0, 0, loc)},
+		{"last day of month", time.Date(2026, 3, 31, 23, 0, 0, 0, loc), time.Date(2026, 3, 1, 5, 0, 0, 0, loc), time.Date(2026, 4, 1, 5, 0, 0, 0, loc)},
+		{"year end before reset", time.Date(2027, 1, 1, 4, 59, 0, 0, loc), time.Date(2026, 12, 1, 5, 0, 0, 0, loc), time.Date(2027, 1, 1, 5, 0, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manual.Set(tt.now)
+			if got := clock.MonthStart(clock.Now()); !got.Equal(tt.want) {
+				t.Errorf("MonthStart(%v) = %v, want %v", tt.now, got, tt.want)
+			}
+			if got := clock.NextMonthStart(clock.Now()); !got.Equal(tt.next) {
+				t.Errorf("NextMonthStart(%v) = %v, want %v", tt.now, got, tt.next)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package gameevent
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/mq"
+	"github.xubinbest.com/go-game-server/internal/snowflake"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// Type 游戏事件类型，与 quest 配置表的 event 列一致
+type Type string
+
+const (
+	// Login 登录
+	Login Type = "login"
+	// Sign 签到
+	Sign Type = "sign"
+	// CardUpgrade 卡牌升级
+	CardUpgrade Type = "card_upgrade"
+	// PetLevelUp 宠物升级，每升一级计一次
+	PetLevelUp Type = "pet_level_up"
+	// MatchFinished 完成一局匹配对局
+	MatchFinished Type = "match_finished"
+	// MatchWon 赢得一局匹配对局
+	MatchWon Type = "match_won"
+	// FriendAdded 新增好友
+	FriendAdded Type = "friend_added"
+)
+
+// Event 游戏事件，由各服务发送到 Kafka，由任务系统等订阅方消费
+type Event struct {
+	EventID   int64 `json:"event_id"` // 事件ID，Kafka重复投递时订阅方据此去重
+	Type      Type  `json:"type"`
+	UserID    int64 `json:"user_id"`
+	Count     int64 `json:"count"` // 事件计数，如一次升多级时为升级数
+	Timestamp int64 `json:"timestamp"`
+}
+
+// Emitter 游戏事件发送器
+type Emitter struct {
+	kafkaFactory *mq.KafkaFactory
+	sf           *snowflake.Snowflake
+}
+
+// NewEmitter 创建游戏事件发送器
+func NewEmitter(kafkaFactory *mq.KafkaFactory, sf *snowflake.Snowflake) *Emitter {
+	return &Emitter{kafkaFactory: kafkaFactory, sf: sf}
+}
+
+// Emit 发送游戏事件，以用户ID为消息key保证同一玩家的事件有序
+// Kafka错误只记录日志，不影响发送方的业务流程
+func (e *Emitter) Emit(ctx context.Context, userID int64, eventType Type, count int64) {
+	if userID == 0 || count <= 0 {
+		return
+	}
+
+	producer, err := e.kafkaFactory.GetProducer(mq.GameplayEvent)
+	if err != nil {
+		utils.Error("Failed to get gameplay event producer", zap.Error(err))
+		return
+	}
+
+	eventID, err := e.sf.NextID()
+	if err != nil {
+		utils.Error("Failed to generate gameplay event id", zap.Error(err))
+		return
+	}
+
+	value, err := json.Marshal(&Event{
+		EventID:   eventID,
+		Type:      eventType,
+		UserID:    userID,
+		Count:     count,
+		Timestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		utils.Error("Failed to marshal gameplay event", zap.Error(err))
+		return
+	}
+
+	key := []byte(strconv.FormatInt(userID, 10))
+	if err := producer.SendMessage(ctx, key, value); err != nil {
+		utils.Error("Failed to send gameplay event to kafka", zap.String("type", string(eventType)), zap.Int64("userId", userID), zap.Error(err))
+	}
+}
+
+// Decode 解析 Kafka 消息中的游戏事件
+func Decode(msg mq.Message) (*Event, error) {
+	var event Event
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
@@ -48,6 +48,54 @@ func DispatchGRPCRequest(ctx context.Context, conn interface{}, req proto.Messag
 			return nil, err
 		}
 		return resp, nil
+	case "game.CreateRoomRequest":
+		client := pb.NewGameServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.CreateRoom(ctx, req.(*pb.CreateRoomRequest))
+		if err != nil {
+			utils.Error("Error calling CreateRoom", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "game.StartGameRequest":
+		client := pb.NewGameServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.StartGame(ctx, req.(*pb.StartGameRequest))
+		if err != nil {
+			utils.Error("Error calling StartGame", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "game.JoinMatchRequest":
+		client := pb.NewGameServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.JoinMatch(ctx, req.(*pb.JoinMatchRequest))
+		if err != nil {
+			utils.Error("Error calling JoinMatch", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "game.CancelMatchRequest":
+		client := pb.NewGameServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.CancelMatch(ctx, req.(*pb.CancelMatchRequest))
+		if err != nil {
+			utils.Error("Error calling CancelMatch", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "game.StartBattleRequest":
+		client := pb.NewGameServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.StartBattle(ctx, req.(*pb.StartBattleRequest))
+		if err != nil {
+			utils.Error("Error calling StartBattle", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "game.VerifyBattleRequest":
+		client := pb.NewGameServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.VerifyBattle(ctx, req.(*pb.VerifyBattleRequest))
+		if err != nil {
+			utils.Error("Error calling VerifyBattle", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
 	case "user.RegisterRequest":
 		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
 		resp, err := client.Register(ctx, req.(*pb.RegisterRequest))
@@ -216,6 +264,198 @@ func DispatchGRPCRequest(ctx context.Context, conn interface{}, req proto.Messag
 			return nil, err
 		}
 		return resp, nil
+	case "user.GetMailsRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GetMails(ctx, req.(*pb.GetMailsRequest))
+		if err != nil {
+			utils.Error("Error calling GetMails", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.ReadMailRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.ReadMail(ctx, req.(*pb.ReadMailRequest))
+		if err != nil {
+			utils.Error("Error calling ReadMail", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.ClaimMailRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.ClaimMail(ctx, req.(*pb.ClaimMailRequest))
+		if err != nil {
+			utils.Error("Error calling ClaimMail", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.DeleteMailRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.DeleteMail(ctx, req.(*pb.DeleteMailRequest))
+		if err != nil {
+			utils.Error("Error calling DeleteMail", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.SendMailRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.SendMail(ctx, req.(*pb.SendMailRequest))
+		if err != nil {
+			utils.Error("Error calling SendMail", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.GetWalletRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GetWallet(ctx, req.(*pb.GetWalletRequest))
+		if err != nil {
+			utils.Error("Error calling GetWallet", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.GetCurrencyHistoryRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GetCurrencyHistory(ctx, req.(*pb.GetCurrencyHistoryRequest))
+		if err != nil {
+			utils.Error("Error calling GetCurrencyHistory", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.GetShopRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GetShop(ctx, req.(*pb.GetShopRequest))
+		if err != nil {
+			utils.Error("Error calling GetShop", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.BuyGoodsRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.BuyGoods(ctx, req.(*pb.BuyGoodsRequest))
+		if err != nil {
+			utils.Error("Error calling BuyGoods", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.GetGachaPoolRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GetGachaPool(ctx, req.(*pb.GetGachaPoolRequest))
+		if err != nil {
+			utils.Error("Error calling GetGachaPool", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.GachaPullRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GachaPull(ctx, req.(*pb.GachaPullRequest))
+		if err != nil {
+			utils.Error("Error calling GachaPull", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.GetGachaHistoryRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GetGachaHistory(ctx, req.(*pb.GetGachaHistoryRequest))
+		if err != nil {
+			utils.Error("Error calling GetGachaHistory", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.GetQuestsRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GetQuests(ctx, req.(*pb.GetQuestsRequest))
+		if err != nil {
+			utils.Error("Error calling GetQuests", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.ClaimQuestRewardRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.ClaimQuestReward(ctx, req.(*pb.ClaimQuestRewardRequest))
+		if err != nil {
+			utils.Error("Error calling ClaimQuestReward", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.MonthlySignMakeupRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.MonthlySignMakeup(ctx, req.(*pb.MonthlySignMakeupRequest))
+		if err != nil {
+			utils.Error("Error calling MonthlySignMakeup", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.GetMonthlySignHistoryRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GetMonthlySignHistory(ctx, req.(*pb.GetMonthlySignHistoryRequest))
+		if err != nil {
+			utils.Error("Error calling GetMonthlySignHistory", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.ExpandInventoryRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.ExpandInventory(ctx, req.(*pb.ExpandInventoryRequest))
+		if err != nil {
+			utils.Error("Error calling ExpandInventory", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.SortInventoryRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.SortInventory(ctx, req.(*pb.SortInventoryRequest))
+		if err != nil {
+			utils.Error("Error calling SortInventory", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.GetBuffsRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GetBuffs(ctx, req.(*pb.GetBuffsRequest))
+		if err != nil {
+			utils.Error("Error calling GetBuffs", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.EnhanceEquipmentRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.EnhanceEquipment(ctx, req.(*pb.EnhanceEquipmentRequest))
+		if err != nil {
+			utils.Error("Error calling EnhanceEquipment", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.RefineEquipmentRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.RefineEquipment(ctx, req.(*pb.RefineEquipmentRequest))
+		if err != nil {
+			utils.Error("Error calling RefineEquipment", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.EvolvePetRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.EvolvePet(ctx, req.(*pb.EvolvePetRequest))
+		if err != nil {
+			utils.Error("Error calling EvolvePet", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.LearnPetSkillRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.LearnPetSkill(ctx, req.(*pb.LearnPetSkillRequest))
+		if err != nil {
+			utils.Error("Error calling LearnPetSkill", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "user.ReleasePetRequest":
+		client := pb.NewUserServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.ReleasePet(ctx, req.(*pb.ReleasePetRequest))
+		if err != nil {
+			utils.Error("Error calling ReleasePet", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
 	case "leaderboard.ReportScoreRequest":
 		client := pb.NewLeaderboardServiceClient(conn.(*grpc.ClientConn))
 		resp, err := client.ReportScore(ctx, req.(*pb.ReportScoreRequest))
@@ -408,6 +648,46 @@ func DispatchGRPCRequest(ctx context.Context, conn interface{}, req proto.Messag
 			return nil, err
 		}
 		return resp, nil
+	case "social.DonateToGuildRequest":
+		client := pb.NewSocialServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.DonateToGuild(ctx, req.(*pb.DonateToGuildRequest))
+		if err != nil {
+			utils.Error("Error calling DonateToGuild", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "social.GetGuildDonationsRequest":
+		client := pb.NewSocialServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GetGuildDonations(ctx, req.(*pb.GetGuildDonationsRequest))
+		if err != nil {
+			utils.Error("Error calling GetGuildDonations", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "social.GetUserGuildInvitationsRequest":
+		client := pb.NewSocialServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.GetUserGuildInvitations(ctx, req.(*pb.GetUserGuildInvitationsRequest))
+		if err != nil {
+			utils.Error("Error calling GetUserGuildInvitations", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "social.HandleGuildInvitationRequest":
+		client := pb.NewSocialServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.HandleGuildInvitation(ctx, req.(*pb.HandleGuildInvitationRequest))
+		if err != nil {
+			utils.Error("Error calling HandleGuildInvitation", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
+	case "social.UpdateGuildAnnouncementRequest":
+		client := pb.NewSocialServiceClient(conn.(*grpc.ClientConn))
+		resp, err := client.UpdateGuildAnnouncement(ctx, req.(*pb.UpdateGuildAnnouncementRequest))
+		if err != nil {
+			utils.Error("Error calling UpdateGuildAnnouncement", zap.Error(err))
+			return nil, err
+		}
+		return resp, nil
 	default:
 		utils.Error("Unknown request type", zap.String("name", string(name)))
 		return nil, fmt.Errorf("unsupported message type: %s", name)
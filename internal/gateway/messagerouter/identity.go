@@ -0,0 +1,42 @@
+package messagerouter
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// identityFields 请求中表示调用者身份的字段，转发前统一使用会话身份覆盖
+var identityFields = []protoreflect.Name{
+	"user_id",
+	"sender_id",
+	"from_user_id",
+	"creator_id",
+	"inviter_id",
+	"operator_id",
+	"role_id",
+	"player_id",
+}
+
+// BindIdentity 将请求中的身份字段覆盖为会话绑定的用户ID，防止客户端冒用他人身份
+func BindIdentity(req proto.Message, userID int64) {
+	msg := req.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+
+	for _, name := range identityFields {
+		fd := fields.ByName(name)
+		if fd == nil || fd.IsList() || fd.IsMap() {
+			continue
+		}
+
+		switch fd.Kind() {
+		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			msg.Set(fd, protoreflect.ValueOfInt64(userID))
+		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			msg.Set(fd, protoreflect.ValueOfUint64(uint64(userID)))
+		case protoreflect.StringKind:
+			msg.Set(fd, protoreflect.ValueOfString(strconv.FormatInt(userID, 10)))
+		}
+	}
+}
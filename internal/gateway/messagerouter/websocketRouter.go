@@ -3,6 +3,7 @@ package messagerouter
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 
 	"github.xubinbest.com/go-game-server/internal/gateway/msgfactory"
@@ -15,23 +16,27 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// ErrBadRequest 客户端请求格式错误
+var ErrBadRequest = errors.New("bad request")
+
 // HandleWSMessage 处理WebSocket消息（支持熔断器）
-func HandleWSMessage(ctx context.Context, msg []byte, conn *grpc.ClientConn, cbManager interface{}) ([]byte, error) {
+// userID 为连接绑定的用户ID，非0时会覆盖请求中的身份字段
+func HandleWSMessage(ctx context.Context, msg []byte, conn *grpc.ClientConn, cbManager interface{}, userID int64) ([]byte, error) {
 	// 检查消息长度
 	if len(msg) < 4 {
-		return nil, fmt.Errorf("message too short")
+		return nil, fmt.Errorf("%w: message too short", ErrBadRequest)
 	}
 
 	// 解析消息长度
 	msgLength := binary.BigEndian.Uint32(msg[:4])
 	if uint32(len(msg)-4) != msgLength {
-		return nil, fmt.Errorf("message length mismatch: expected %d, got %d", msgLength, len(msg)-4)
+		return nil, fmt.Errorf("%w: message length mismatch: expected %d, got %d", ErrBadRequest, msgLength, len(msg)-4)
 	}
 
 	// 解析WebSocket消息
 	var wsMsg pb.WSMessage
 	if err := proto.Unmarshal(msg[4:], &wsMsg); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal websocket message: %v", err)
+		return nil, fmt.Errorf("%w: failed to unmarshal websocket message: %v", ErrBadRequest, err)
 	}
 
 	// 构造消息类型
@@ -41,12 +46,17 @@ func HandleWSMessage(ctx context.Context, msg []byte, conn *grpc.ClientConn, cbM
 	// 使用msgfactory包下的GetRequestMessageStruct函数获取消息结构体
 	req, err := msgfactory.GetRequestMessageStruct(msgType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get request message struct: %v", err)
+		return nil, fmt.Errorf("%w: failed to get request message struct: %v", ErrBadRequest, err)
 	}
 
 	// 将protobuf二进制数据反序列化为消息
 	if err := proto.Unmarshal(wsMsg.Payload, req); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal protobuf message: %v", err)
+		return nil, fmt.Errorf("%w: failed to unmarshal protobuf message: %v", ErrBadRequest, err)
+	}
+
+	// 使用会话身份覆盖请求中的用户ID
+	if userID != 0 {
+		BindIdentity(req, userID)
 	}
 
 	// 通过gRPC调用相应的服务（使用熔断器保护）
@@ -84,6 +94,8 @@ func HandleWSMessage(ctx context.Context, msg []byte, conn *grpc.ClientConn, cbM
 		Service: wsMsg.Service,
 		Method:  wsMsg.Method,
 		Payload: respBytes,
+		Seq:     wsMsg.Seq,
+		Code:    pb.ErrorCode_ERROR_CODE_OK,
 	}
 
 	// 序列化响应消息
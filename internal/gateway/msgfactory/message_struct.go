@@ -18,6 +18,18 @@ func GetRequestMessageStruct(msgType string) (proto.Message, error) {
 		return &pb.GameStateRequest{}, nil
 	case "game.playerAction":
 		return &pb.PlayerActionRequest{}, nil
+	case "game.createRoom":
+		return &pb.CreateRoomRequest{}, nil
+	case "game.startGame":
+		return &pb.StartGameRequest{}, nil
+	case "game.joinMatch":
+		return &pb.JoinMatchRequest{}, nil
+	case "game.cancelMatch":
+		return &pb.CancelMatchRequest{}, nil
+	case "game.startBattle":
+		return &pb.StartBattleRequest{}, nil
+	case "game.verifyBattle":
+		return &pb.VerifyBattleRequest{}, nil
 	case "user.register":
 		return &pb.RegisterRequest{}, nil
 	case "user.login":
@@ -60,6 +72,54 @@ func GetRequestMessageStruct(msgType string) (proto.Message, error) {
 		return &pb.MonthlySignRequest{}, nil
 	case "user.claimMonthlySignReward":
 		return &pb.ClaimMonthlySignRewardRequest{}, nil
+	case "user.getMails":
+		return &pb.GetMailsRequest{}, nil
+	case "user.readMail":
+		return &pb.ReadMailRequest{}, nil
+	case "user.claimMail":
+		return &pb.ClaimMailRequest{}, nil
+	case "user.deleteMail":
+		return &pb.DeleteMailRequest{}, nil
+	case "user.sendMail":
+		return &pb.SendMailRequest{}, nil
+	case "user.getWallet":
+		return &pb.GetWalletRequest{}, nil
+	case "user.getCurrencyHistory":
+		return &pb.GetCurrencyHistoryRequest{}, nil
+	case "user.getShop":
+		return &pb.GetShopRequest{}, nil
+	case "user.buyGoods":
+		return &pb.BuyGoodsRequest{}, nil
+	case "user.getGachaPool":
+		return &pb.GetGachaPoolRequest{}, nil
+	case "user.gachaPull":
+		return &pb.GachaPullRequest{}, nil
+	case "user.getGachaHistory":
+		return &pb.GetGachaHistoryRequest{}, nil
+	case "user.getQuests":
+		return &pb.GetQuestsRequest{}, nil
+	case "user.claimQuestReward":
+		return &pb.ClaimQuestRewardRequest{}, nil
+	case "user.monthlySignMakeup":
+		return &pb.MonthlySignMakeupRequest{}, nil
+	case "user.getMonthlySignHistory":
+		return &pb.GetMonthlySignHistoryRequest{}, nil
+	case "user.expandInventory":
+		return &pb.ExpandInventoryRequest{}, nil
+	case "user.sortInventory":
+		return &pb.SortInventoryRequest{}, nil
+	case "user.getBuffs":
+		return &pb.GetBuffsRequest{}, nil
+	case "user.enhanceEquipment":
+		return &pb.EnhanceEquipmentRequest{}, nil
+	case "user.refineEquipment":
+		return &pb.RefineEquipmentRequest{}, nil
+	case "user.evolvePet":
+		return &pb.EvolvePetRequest{}, nil
+	case "user.learnPetSkill":
+		return &pb.LearnPetSkillRequest{}, nil
+	case "user.releasePet":
+		return &pb.ReleasePetRequest{}, nil
 	case "leaderboard.reportScore":
 		return &pb.ReportScoreRequest{}, nil
 	case "leaderboard.getLeaderboard":
@@ -108,6 +168,18 @@ func GetRequestMessageStruct(msgType string) (proto.Message, error) {
 		return &pb.LeaveGuildRequest{}, nil
 	case "social.getGuildList":
 		return &pb.GetGuildListRequest{}, nil
+	case "social.donateToGuild":
+		return &pb.DonateToGuildRequest{}, nil
+	case "social.getGuildDonations":
+		return &pb.GetGuildDonationsRequest{}, nil
+	case "social.getUserGuildInvitations":
+		return &pb.GetUserGuildInvitationsRequest{}, nil
+	case "social.handleGuildInvitation":
+		return &pb.HandleGuildInvitationRequest{}, nil
+	case "social.updateGuildAnnouncement":
+		return &pb.UpdateGuildAnnouncementRequest{}, nil
+	case "ws.auth":
+		return &pb.AuthRequest{}, nil
 	default:
 		return nil, fmt.Errorf("unknown request message type: %s", msgType)
 	}
@@ -123,6 +195,18 @@ func GetResponseMessageStruct(msgType string) (proto.Message, error) {
 		return &pb.GameStateResponse{}, nil
 	case "game.playerAction":
 		return &pb.PlayerActionResponse{}, nil
+	case "game.createRoom":
+		return &pb.CreateRoomResponse{}, nil
+	case "game.startGame":
+		return &pb.StartGameResponse{}, nil
+	case "game.joinMatch":
+		return &pb.JoinMatchResponse{}, nil
+	case "game.cancelMatch":
+		return &pb.CancelMatchResponse{}, nil
+	case "game.startBattle":
+		return &pb.StartBattleResponse{}, nil
+	case "game.verifyBattle":
+		return &pb.VerifyBattleResponse{}, nil
 	case "user.register":
 		return &pb.RegisterResponse{}, nil
 	case "user.login":
@@ -165,6 +249,54 @@ func GetResponseMessageStruct(msgType string) (proto.Message, error) {
 		return &pb.MonthlySignResponse{}, nil
 	case "user.claimMonthlySignReward":
 		return &pb.ClaimMonthlySignRewardResponse{}, nil
+	case "user.getMails":
+		return &pb.GetMailsResponse{}, nil
+	case "user.readMail":
+		return &pb.ReadMailResponse{}, nil
+	case "user.claimMail":
+		return &pb.ClaimMailResponse{}, nil
+	case "user.deleteMail":
+		return &pb.DeleteMailResponse{}, nil
+	case "user.sendMail":
+		return &pb.SendMailResponse{}, nil
+	case "user.getWallet":
+		return &pb.GetWalletResponse{}, nil
+	case "user.getCurrencyHistory":
+		return &pb.GetCurrencyHistoryResponse{}, nil
+	case "user.getShop":
+		return &pb.GetShopResponse{}, nil
+	case "user.buyGoods":
+		return &pb.BuyGoodsResponse{}, nil
+	case "user.getGachaPool":
+		return &pb.GetGachaPoolResponse{}, nil
+	case "user.gachaPull":
+		return &pb.GachaPullResponse{}, nil
+	case "user.getGachaHistory":
+		return &pb.GetGachaHistoryResponse{}, nil
+	case "user.getQuests":
+		return &pb.GetQuestsResponse{}, nil
+	case "user.claimQuestReward":
+		return &pb.ClaimQuestRewardResponse{}, nil
+	case "user.monthlySignMakeup":
+		return &pb.MonthlySignMakeupResponse{}, nil
+	case "user.getMonthlySignHistory":
+		return &pb.GetMonthlySignHistoryResponse{}, nil
+	case "user.expandInventory":
+		return &pb.ExpandInventoryResponse{}, nil
+	case "user.sortInventory":
+		return &pb.SortInventoryResponse{}, nil
+	case "user.getBuffs":
+		return &pb.GetBuffsResponse{}, nil
+	case "user.enhanceEquipment":
+		return &pb.EnhanceEquipmentResponse{}, nil
+	case "user.refineEquipment":
+		return &pb.RefineEquipmentResponse{}, nil
+	case "user.evolvePet":
+		return &pb.EvolvePetResponse{}, nil
+	case "user.learnPetSkill":
+		return &pb.LearnPetSkillResponse{}, nil
+	case "user.releasePet":
+		return &pb.ReleasePetResponse{}, nil
 	case "leaderboard.reportScore":
 		return &pb.ReportScoreResponse{}, nil
 	case "leaderboard.getLeaderboard":
@@ -213,6 +345,18 @@ func GetResponseMessageStruct(msgType string) (proto.Message, error) {
 		return &pb.LeaveGuildResponse{}, nil
 	case "social.getGuildList":
 		return &pb.GetGuildListResponse{}, nil
+	case "social.donateToGuild":
+		return &pb.DonateToGuildResponse{}, nil
+	case "social.getGuildDonations":
+		return &pb.GetGuildDonationsResponse{}, nil
+	case "social.getUserGuildInvitations":
+		return &pb.GetUserGuildInvitationsResponse{}, nil
+	case "social.handleGuildInvitation":
+		return &pb.HandleGuildInvitationResponse{}, nil
+	case "social.updateGuildAnnouncement":
+		return &pb.UpdateGuildAnnouncementResponse{}, nil
+	case "ws.auth":
+		return &pb.AuthResponse{}, nil
 	default:
 		return nil, fmt.Errorf("unknown response message type: %s", msgType)
 	}
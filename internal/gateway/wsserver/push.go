@@ -0,0 +1,86 @@
+package wsserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/push"
+	"github.xubinbest.com/go-game-server/internal/telemetry"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// StartPush 订阅后端推送频道，将推送事件投递给本实例上的对应连接
+func (s *WSServer) StartPush(ctx context.Context) error {
+	ch, err := s.cacheClient.Subscribe(ctx, push.Channel)
+	if err != nil {
+		return fmt.Errorf("subscribe push channel fail. err: %v", err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				payload, ok := msg.(string)
+				if !ok {
+					s.logger.Warn("Unexpected push message type", zap.Any("message", msg))
+					continue
+				}
+				s.dispatchPush([]byte(payload))
+			}
+		}
+	}()
+
+	return nil
+}
+
+// dispatchPush 解析推送事件并按目标投递
+func (s *WSServer) dispatchPush(data []byte) {
+	var ev pb.PushEvent
+	if err := proto.Unmarshal(data, &ev); err != nil {
+		s.logger.Error("Failed to unmarshal push event", zap.Error(err))
+		return
+	}
+
+	frame := s.packMessage(&pb.WSMessage{
+		Service: ev.Service,
+		Method:  push.MethodName(ev.Event),
+		Payload: ev.Payload,
+	})
+	if frame == nil {
+		return
+	}
+
+	switch ev.Target {
+	case pb.PushTarget_PUSH_TARGET_ALL:
+		s.clients.Range(func(_, value interface{}) bool {
+			if sess, ok := value.(*Session); ok && sess.IsAuthenticated() {
+				s.sendPush(sess, frame)
+			}
+			return true
+		})
+	default:
+		for _, userID := range ev.UserIds {
+			if value, ok := s.sessions.Load(userID); ok {
+				s.sendPush(value.(*Session), frame)
+			}
+		}
+	}
+}
+
+func (s *WSServer) sendPush(sess *Session, frame []byte) {
+	if err := sess.WriteMessage(frame); err != nil {
+		s.logger.Warn("Failed to send push message",
+			zap.Int64("user_id", sess.UserID()),
+			zap.Error(err))
+		return
+	}
+	telemetry.WebSocketMessagesTotal.WithLabelValues("pushed").Inc()
+}
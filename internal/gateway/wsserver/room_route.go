@@ -0,0 +1,56 @@
+package wsserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.xubinbest.com/go-game-server/internal/gateway/msgfactory"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/registry"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// roomService 房间所在的服务，带game_id的请求需路由到房间所属实例
+const roomService = "game"
+
+// selectRoomInstance 根据房间目录选择房间所在实例，无法确定时返回空字符串
+func (s *WSServer) selectRoomInstance(ctx context.Context, wsMsg *pb.WSMessage, instances []*registry.ServiceInstance) string {
+	if wsMsg.Service != roomService || s.roomDirectory == nil {
+		return ""
+	}
+
+	req, err := msgfactory.GetRequestMessageStruct(wsMsg.Service + "." + wsMsg.Method)
+	if err != nil {
+		return ""
+	}
+	if err := proto.Unmarshal(wsMsg.Payload, req); err != nil {
+		return ""
+	}
+
+	msg := req.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName("game_id")
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return ""
+	}
+	roomID := msg.Get(fd).String()
+	if roomID == "" {
+		return ""
+	}
+
+	addr, err := s.roomDirectory.Lookup(ctx, roomID)
+	if err != nil {
+		s.logger.Warn("Room directory lookup failed", zap.String("roomId", roomID), zap.Error(err))
+		return ""
+	}
+
+	// 只路由到仍在注册中心的实例
+	for _, ins := range instances {
+		if ins.Ip+":"+fmt.Sprint(ins.Port) == addr {
+			return addr
+		}
+	}
+	return ""
+}
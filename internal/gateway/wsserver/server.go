@@ -3,6 +3,7 @@ package wsserver
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -13,6 +14,7 @@ import (
 	"github.xubinbest.com/go-game-server/internal/cache"
 	"github.xubinbest.com/go-game-server/internal/circuitbreaker"
 	"github.xubinbest.com/go-game-server/internal/config"
+	"github.xubinbest.com/go-game-server/internal/game_service/roomdir"
 	"github.xubinbest.com/go-game-server/internal/gateway/grpcpool"
 	"github.xubinbest.com/go-game-server/internal/gateway/messagerouter"
 	"github.xubinbest.com/go-game-server/internal/pb"
@@ -27,19 +29,27 @@ import (
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
+// defaultMaxConcurrentRequests 未配置时单个连接的默认并发请求数
+const defaultMaxConcurrentRequests = 16
+
 type WSServer struct {
 	reg         registry.Registry
 	logger      *zap.Logger
 	cfg         *config.Config
 	upgrader    websocket.Upgrader
-	clients     sync.Map // map[*websocket.Conn]struct{}
+	clients     sync.Map // map[*websocket.Conn]*Session
+	sessions    sync.Map // map[int64]*Session 已鉴权用户ID到会话的索引
 	Addr        string
 	grpcPools   sync.Map // map[string]*grpcpool.GRPCPool
 	cacheClient cache.Cache
 	cbManager   *circuitbreaker.Manager
+
+	roomDirectory *roomdir.Directory
 }
 
 func New(port int, reg registry.Registry, logger *zap.Logger, cfg *config.Config, cacheClient cache.Cache) *WSServer {
@@ -63,6 +73,9 @@ func New(port int, reg registry.Registry, logger *zap.Logger, cfg *config.Config
 		}
 	}
 
+	// 房间目录，用于将房间请求路由到房间所在的游戏服实例
+	ws.roomDirectory = roomdir.NewDirectory(cacheClient)
+
 	// 初始化熔断器管理器
 	if cfg.CircuitBreaker.Enabled {
 		ws.cbManager = circuitbreaker.NewManager(cfg.CircuitBreaker, logger)
@@ -188,8 +201,10 @@ func (s *WSServer) HandleWS(w http.ResponseWriter, r *http.Request) {
 	}
 	defer conn.Close()
 
-	s.clients.Store(conn, struct{}{})
+	sess := newSession(conn)
+	s.clients.Store(conn, sess)
 	defer s.clients.Delete(conn)
+	defer s.removeSession(sess)
 
 	// 更新连接数metrics
 	telemetry.WebSocketConnections.WithLabelValues("active").Inc()
@@ -205,6 +220,11 @@ func (s *WSServer) HandleWS(w http.ResponseWriter, r *http.Request) {
 	errChan := make(chan error, 1)
 	done := make(chan struct{})
 
+	// 限制单个连接同时处理的请求数
+	sem := make(chan struct{}, s.maxConcurrentRequests())
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
 	// Reader goroutine
 	go func() {
 		defer close(errChan)
@@ -243,18 +263,6 @@ func (s *WSServer) HandleWS(w http.ResponseWriter, r *http.Request) {
 		for {
 			select {
 			case msg := <-msgChan:
-				// 创建消息处理span
-				msgCtx := ctx
-				var msgSpan trace.Span
-				if s.cfg.Telemetry.Enabled {
-					tracer := otel.Tracer("gateway")
-					msgCtx, msgSpan = tracer.Start(ctx, "websocket.message",
-						trace.WithAttributes(
-							attribute.Int("websocket.message_size", len(msg)),
-						),
-					)
-				}
-
 				telemetry.WebSocketMessagesTotal.WithLabelValues("received").Inc()
 
 				s.logger.Debug("WebSocket message received",
@@ -265,112 +273,40 @@ func (s *WSServer) HandleWS(w http.ResponseWriter, r *http.Request) {
 				var wsMsg pb.WSMessage
 				if err := proto.Unmarshal(msg[4:], &wsMsg); err != nil {
 					s.logger.Error("Failed to unmarshal message", zap.Error(err))
-					if msgSpan != nil {
-						msgSpan.SetStatus(codes.Error, "Unmarshal failed")
-						msgSpan.RecordError(err)
-						msgSpan.End()
-					}
-					continue
-				}
-
-				if msgSpan != nil {
-					msgSpan.SetAttributes(
-						attribute.String("websocket.service", wsMsg.Service),
-						attribute.String("websocket.method", wsMsg.Method),
-					)
-				}
-
-				// 服务发现
-				instances, err := s.reg.Discover(msgCtx, wsMsg.Service)
-				if err != nil {
-					s.logger.Error("Service discovery failed",
-						zap.String("service", wsMsg.Service),
-						zap.Error(err))
-					if msgSpan != nil {
-						msgSpan.SetStatus(codes.Error, "Service discovery failed")
-						msgSpan.RecordError(err)
-						msgSpan.End()
-					}
 					continue
 				}
 
-				if len(instances) == 0 {
-					s.logger.Error("No available instances",
-						zap.String("service", wsMsg.Service))
-					if msgSpan != nil {
-						msgSpan.SetStatus(codes.Error, "No available instances")
-						msgSpan.End()
+				// 鉴权消息按顺序同步处理，保证后续请求使用绑定后的身份
+				if wsMsg.Service == authService && wsMsg.Method == authMethod {
+					if err := sess.WriteMessage(s.handleAuth(ctx, sess, &wsMsg)); err != nil {
+						s.logger.Error("Failed to send auth response", zap.Error(err))
 					}
 					continue
 				}
 
-				// 选择服务实例
-				target := s.selectInstance(instances)
-
-				// 获取或创建gRPC连接池
-				pool, _ := s.grpcPools.LoadOrStore(wsMsg.Service, grpcpool.New(100, 30*time.Minute))
-				grpcPool := pool.(*grpcpool.GRPCPool)
-
-				// 获取gRPC连接
-				pc, err := grpcPool.GetConn(target, s.cfg)
-				if err != nil {
-					s.logger.Error("Failed to get gRPC connection",
+				// 请求并发处理，响应通过seq与请求对应
+				// 并发数已满时直接拒绝，不能阻塞当前循环，否则心跳无法按时发送
+				select {
+				case sem <- struct{}{}:
+				default:
+					s.logger.Warn("Too many concurrent requests",
 						zap.String("service", wsMsg.Service),
-						zap.Error(err))
-					if msgSpan != nil {
-						msgSpan.SetStatus(codes.Error, "gRPC connection failed")
-						msgSpan.RecordError(err)
-						msgSpan.End()
-					}
+						zap.String("method", wsMsg.Method),
+						zap.String("remote", conn.RemoteAddr().String()))
+					s.sendError(sess, &wsMsg, pb.ErrorCode_ERROR_CODE_TOO_MANY_REQUESTS, "too many concurrent requests")
 					continue
 				}
-				defer pc.Close()
-
-				// 处理消息（使用熔断器保护）
-				var resp []byte
-				if s.cbManager != nil {
-					resp, err = messagerouter.HandleWSMessage(msgCtx, msg, pc.ClientConn, s.cbManager)
-				} else {
-					resp, err = messagerouter.HandleWSMessage(msgCtx, msg, pc.ClientConn, nil)
-				}
-				if err != nil {
-					s.logger.Error("Failed to handle message", zap.Error(err))
-					if msgSpan != nil {
-						msgSpan.SetStatus(codes.Error, "Message handling failed")
-						msgSpan.RecordError(err)
-					}
-					// 发送错误响应给客户端
-					errorResp := s.buildErrorMessage(wsMsg.Service, wsMsg.Method, err.Error())
-					if writeErr := conn.WriteMessage(websocket.BinaryMessage, errorResp); writeErr != nil {
-						s.logger.Error("Failed to send error message", zap.Error(writeErr))
-					}
-					if msgSpan != nil {
-						msgSpan.End()
-					}
-					continue
-				}
-
-				// 发送响应
-				if err := conn.WriteMessage(websocket.BinaryMessage, resp); err != nil {
-					s.logger.Error("Failed to send WebSocket message", zap.Error(err))
-					if msgSpan != nil {
-						msgSpan.SetStatus(codes.Error, "Send message failed")
-						msgSpan.RecordError(err)
-						msgSpan.End()
-					}
-					close(done)
-					return
-				}
-
-				telemetry.WebSocketMessagesTotal.WithLabelValues("sent").Inc()
-				if msgSpan != nil {
-					msgSpan.SetAttributes(attribute.Int("websocket.response_size", len(resp)))
-					msgSpan.SetStatus(codes.Ok, "")
-					msgSpan.End()
-				}
+				wg.Add(1)
+				go func(msg []byte, wsMsg *pb.WSMessage) {
+					defer func() {
+						<-sem
+						wg.Done()
+					}()
+					s.processMessage(ctx, sess, msg, wsMsg)
+				}(msg, &wsMsg)
 
 			case <-ticker.C:
-				if err := conn.WriteControl(websocket.PingMessage,
+				if err := sess.WriteControl(websocket.PingMessage,
 					[]byte("heartbeat"), time.Now().Add(time.Second*5)); err != nil {
 					s.logger.Error("Failed to send ping", zap.Error(err))
 					close(done)
@@ -395,6 +331,121 @@ func (s *WSServer) HandleWS(w http.ResponseWriter, r *http.Request) {
 	<-done
 }
 
+// processMessage 处理单条请求消息并将响应写回连接
+func (s *WSServer) processMessage(ctx context.Context, sess *Session, msg []byte, wsMsg *pb.WSMessage) {
+	// 创建消息处理span
+	msgCtx := ctx
+	var msgSpan trace.Span
+	if s.cfg.Telemetry.Enabled {
+		tracer := otel.Tracer("gateway")
+		msgCtx, msgSpan = tracer.Start(ctx, "websocket.message",
+			trace.WithAttributes(
+				attribute.Int("websocket.message_size", len(msg)),
+				attribute.String("websocket.service", wsMsg.Service),
+				attribute.String("websocket.method", wsMsg.Method),
+				attribute.Int64("websocket.seq", int64(wsMsg.Seq)),
+			),
+		)
+		defer msgSpan.End()
+	}
+
+	// 未鉴权的连接只允许调用公开方法
+	if !sess.IsAuthenticated() && !isPublicMethod(wsMsg.Service, wsMsg.Method) {
+		s.logger.Warn("Unauthenticated WebSocket request",
+			zap.String("service", wsMsg.Service),
+			zap.String("method", wsMsg.Method),
+			zap.String("remote", sess.conn.RemoteAddr().String()))
+		if msgSpan != nil {
+			msgSpan.SetStatus(codes.Error, "Unauthenticated")
+		}
+		s.sendError(sess, wsMsg, pb.ErrorCode_ERROR_CODE_UNAUTHENTICATED, "unauthenticated")
+		return
+	}
+
+	// 服务发现
+	instances, err := s.reg.Discover(msgCtx, wsMsg.Service)
+	if err != nil {
+		s.logger.Error("Service discovery failed",
+			zap.String("service", wsMsg.Service),
+			zap.Error(err))
+		if msgSpan != nil {
+			msgSpan.SetStatus(codes.Error, "Service discovery failed")
+			msgSpan.RecordError(err)
+		}
+		s.sendError(sess, wsMsg, pb.ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE, "service discovery failed")
+		return
+	}
+
+	if len(instances) == 0 {
+		s.logger.Error("No available instances",
+			zap.String("service", wsMsg.Service))
+		if msgSpan != nil {
+			msgSpan.SetStatus(codes.Error, "No available instances")
+		}
+		s.sendError(sess, wsMsg, pb.ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE, "no available instances")
+		return
+	}
+
+	// 选择服务实例，房间请求优先路由到房间所在实例
+	target := s.selectRoomInstance(msgCtx, wsMsg, instances)
+	if target == "" {
+		target = s.selectInstance(instances)
+	}
+
+	// 获取或创建gRPC连接池
+	pool, _ := s.grpcPools.LoadOrStore(wsMsg.Service, grpcpool.New(100, 30*time.Minute))
+	grpcPool := pool.(*grpcpool.GRPCPool)
+
+	// 获取gRPC连接
+	pc, err := grpcPool.GetConn(target, s.cfg)
+	if err != nil {
+		s.logger.Error("Failed to get gRPC connection",
+			zap.String("service", wsMsg.Service),
+			zap.Error(err))
+		if msgSpan != nil {
+			msgSpan.SetStatus(codes.Error, "gRPC connection failed")
+			msgSpan.RecordError(err)
+		}
+		s.sendError(sess, wsMsg, pb.ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE, "grpc connection failed")
+		return
+	}
+	defer pc.Close()
+
+	// 处理消息（使用熔断器保护）
+	var resp []byte
+	if s.cbManager != nil {
+		resp, err = messagerouter.HandleWSMessage(msgCtx, msg, pc.ClientConn, s.cbManager, sess.UserID())
+	} else {
+		resp, err = messagerouter.HandleWSMessage(msgCtx, msg, pc.ClientConn, nil, sess.UserID())
+	}
+	if err != nil {
+		s.logger.Error("Failed to handle message", zap.Error(err))
+		if msgSpan != nil {
+			msgSpan.SetStatus(codes.Error, "Message handling failed")
+			msgSpan.RecordError(err)
+		}
+		// 发送错误响应给客户端
+		s.sendError(sess, wsMsg, errorCode(err), err.Error())
+		return
+	}
+
+	// 发送响应
+	if err := sess.WriteMessage(resp); err != nil {
+		s.logger.Error("Failed to send WebSocket message", zap.Error(err))
+		if msgSpan != nil {
+			msgSpan.SetStatus(codes.Error, "Send message failed")
+			msgSpan.RecordError(err)
+		}
+		return
+	}
+
+	telemetry.WebSocketMessagesTotal.WithLabelValues("sent").Inc()
+	if msgSpan != nil {
+		msgSpan.SetAttributes(attribute.Int("websocket.response_size", len(resp)))
+		msgSpan.SetStatus(codes.Ok, "")
+	}
+}
+
 func (s *WSServer) Start() error {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/ws", s.HandleWS)
@@ -433,9 +484,9 @@ func (s *WSServer) Shutdown(ctx context.Context) error {
 }
 
 func (s *WSServer) Broadcast(msg []byte) {
-	s.clients.Range(func(conn, _ interface{}) bool {
-		if wsConn, ok := conn.(*websocket.Conn); ok {
-			wsConn.WriteMessage(websocket.BinaryMessage, msg)
+	s.clients.Range(func(_, value interface{}) bool {
+		if sess, ok := value.(*Session); ok {
+			sess.WriteMessage(msg)
 		}
 		return true
 	})
@@ -485,17 +536,50 @@ func (s *WSServer) checkConnectionRateLimit(ctx context.Context, r *http.Request
 	return true, remaining, nil
 }
 
-// buildErrorMessage 构建错误消息
-func (s *WSServer) buildErrorMessage(service, method, errorMsg string) []byte {
+// buildErrorMessage 构建错误消息，带回请求的seq
+func (s *WSServer) buildErrorMessage(req *pb.WSMessage, code pb.ErrorCode, errorMsg string) []byte {
 	errorResp := &pb.WSMessage{
-		Service: service,
-		Method:  method,
-		Payload: []byte(fmt.Sprintf(`{"error":"%s"}`, errorMsg)),
+		Service:      req.Service,
+		Method:       req.Method,
+		Seq:          req.Seq,
+		Code:         code,
+		ErrorMessage: errorMsg,
 	}
 
-	respMsgBytes, err := proto.Marshal(errorResp)
+	return s.packMessage(errorResp)
+}
+
+// sendError 向连接发送错误响应
+func (s *WSServer) sendError(sess *Session, req *pb.WSMessage, code pb.ErrorCode, errorMsg string) {
+	if err := sess.WriteMessage(s.buildErrorMessage(req, code, errorMsg)); err != nil {
+		s.logger.Error("Failed to send error message", zap.Error(err))
+	}
+}
+
+// errorCode 将请求处理错误转换为消息状态码
+func errorCode(err error) pb.ErrorCode {
+	if errors.Is(err, messagerouter.ErrBadRequest) {
+		return pb.ErrorCode_ERROR_CODE_BAD_REQUEST
+	}
+	if status.Code(err) == grpccodes.Unavailable {
+		return pb.ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE
+	}
+	return pb.ErrorCode_ERROR_CODE_INTERNAL
+}
+
+// maxConcurrentRequests 单个连接允许并发处理的请求数
+func (s *WSServer) maxConcurrentRequests() int {
+	if s.cfg.WebSocket.MaxConcurrentRequests > 0 {
+		return s.cfg.WebSocket.MaxConcurrentRequests
+	}
+	return defaultMaxConcurrentRequests
+}
+
+// packMessage 序列化消息并添加4字节长度头
+func (s *WSServer) packMessage(wsMsg *pb.WSMessage) []byte {
+	respMsgBytes, err := proto.Marshal(wsMsg)
 	if err != nil {
-		s.logger.Error("Failed to marshal error message", zap.Error(err))
+		s.logger.Error("Failed to marshal message", zap.Error(err))
 		return nil
 	}
 
@@ -0,0 +1,176 @@
+package wsserver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/auth"
+	"github.xubinbest.com/go-game-server/internal/pb"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	authService = "ws"
+	authMethod  = "auth"
+)
+
+// publicMethods 未鉴权连接允许调用的方法
+var publicMethods = map[string]struct{}{
+	"user.register": {},
+	"user.login":    {},
+}
+
+// Session WebSocket连接会话，鉴权成功后绑定用户身份
+type Session struct {
+	conn      *websocket.Conn
+	writeMu   sync.Mutex // websocket连接不支持并发写
+	mu        sync.RWMutex
+	userID    int64
+	expiresAt time.Time
+}
+
+func newSession(conn *websocket.Conn) *Session {
+	return &Session{conn: conn}
+}
+
+// UserID 获取会话绑定的用户ID，未鉴权或令牌已过期时返回0
+func (s *Session) UserID() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.userID == 0 || time.Now().After(s.expiresAt) {
+		return 0
+	}
+	return s.userID
+}
+
+// WriteMessage 向连接写入二进制消息，可在多个goroutine中并发调用
+func (s *Session) WriteMessage(data []byte) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.conn.WriteMessage(websocket.BinaryMessage, data)
+}
+
+// WriteControl 向连接写入控制消息
+func (s *Session) WriteControl(messageType int, data []byte, deadline time.Time) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.conn.WriteControl(messageType, data, deadline)
+}
+
+// IsAuthenticated 会话是否已鉴权
+func (s *Session) IsAuthenticated() bool {
+	return s.UserID() != 0
+}
+
+func (s *Session) bind(userID int64, expiresAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.userID = userID
+	s.expiresAt = expiresAt
+}
+
+// isPublicMethod 检查方法是否允许未鉴权调用
+func isPublicMethod(service, method string) bool {
+	_, ok := publicMethods[service+"."+method]
+	return ok
+}
+
+// authenticate 校验JWT并与缓存中的令牌比对，返回令牌对应的用户ID和过期时间
+func (s *WSServer) authenticate(ctx context.Context, token string) (int64, time.Time, error) {
+	if token == "" {
+		return 0, time.Time{}, fmt.Errorf("token is empty")
+	}
+
+	claims, err := auth.ParseToken(token, s.cfg.Auth.SecretKey)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("invalid token: %w", err)
+	}
+
+	userID := int64(claims.UserID)
+	if userID == 0 || claims.ExpiresAt == nil {
+		return 0, time.Time{}, fmt.Errorf("invalid token claims")
+	}
+
+	// 令牌必须与登录时写入缓存的一致，重新登录或登出后旧令牌失效
+	stored, err := s.cacheClient.GetToken(ctx, userID)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("token not found: %w", err)
+	}
+	if stored != token {
+		return 0, time.Time{}, fmt.Errorf("token revoked")
+	}
+
+	return userID, claims.ExpiresAt.Time, nil
+}
+
+// handleAuth 处理鉴权消息，成功后将用户身份绑定到会话
+func (s *WSServer) handleAuth(ctx context.Context, sess *Session, wsMsg *pb.WSMessage) []byte {
+	req := &pb.AuthRequest{}
+	resp := &pb.AuthResponse{}
+
+	if err := proto.Unmarshal(wsMsg.Payload, req); err != nil {
+		resp.Message = "鉴权请求格式错误"
+		return s.buildResponseMessage(wsMsg, pb.ErrorCode_ERROR_CODE_BAD_REQUEST, resp)
+	}
+
+	userID, expiresAt, err := s.authenticate(ctx, req.Token)
+	if err != nil {
+		s.logger.Warn("WebSocket auth failed",
+			zap.String("remote", sess.conn.RemoteAddr().String()),
+			zap.Error(err))
+		resp.Message = "鉴权失败"
+		return s.buildResponseMessage(wsMsg, pb.ErrorCode_ERROR_CODE_UNAUTHENTICATED, resp)
+	}
+
+	// 同一连接切换账号时移除旧的身份索引
+	if prev := sess.UserID(); prev != 0 && prev != userID {
+		s.sessions.CompareAndDelete(prev, sess)
+	}
+	sess.bind(userID, expiresAt)
+	s.sessions.Store(userID, sess)
+	s.logger.Info("WebSocket session authenticated",
+		zap.Int64("user_id", userID),
+		zap.String("remote", sess.conn.RemoteAddr().String()))
+
+	resp.Success = true
+	resp.Message = "鉴权成功"
+	resp.UserId = userID
+	return s.buildResponseMessage(wsMsg, pb.ErrorCode_ERROR_CODE_OK, resp)
+}
+
+// buildResponseMessage 构建网关直接返回的响应消息，带回请求的seq
+func (s *WSServer) buildResponseMessage(req *pb.WSMessage, code pb.ErrorCode, resp proto.Message) []byte {
+	payload, err := proto.Marshal(resp)
+	if err != nil {
+		s.logger.Error("Failed to marshal response payload", zap.Error(err))
+		return nil
+	}
+
+	return s.packMessage(&pb.WSMessage{
+		Service: req.Service,
+		Method:  req.Method,
+		Payload: payload,
+		Seq:     req.Seq,
+		Code:    code,
+	})
+}
+
+// removeSession 连接断开时移除会话的身份索引
+func (s *WSServer) removeSession(sess *Session) {
+	sess.mu.RLock()
+	userID := sess.userID
+	sess.mu.RUnlock()
+
+	if userID != 0 {
+		s.sessions.CompareAndDelete(userID, sess)
+	}
+}
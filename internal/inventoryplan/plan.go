@@ -0,0 +1,300 @@
+package inventoryplan
+
+import (
+	"math"
+	"sort"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// StackLimit 获取物品模板的单格叠加上限
+func StackLimit(r models.InventoryRules, templateID int64) int32 {
+	if limit := r.Stacks[templateID]; limit > 0 {
+		return limit
+	}
+	return math.MaxInt32
+}
+
+// Plan 一次背包变更需要执行的写操作
+type Plan struct {
+	Updates  []*models.InventoryItem     // 数量或格子序号发生变化的已有物品
+	Creates  []*models.InventoryItem     // 新占用的格子，ID 由执行方生成
+	Deletes  []int64                     // 需要删除的物品实例ID
+	Overflow []designconfig.BaseItemCost // 背包放不下的物品
+}
+
+// Empty 计划中是否没有任何写操作
+func (p *Plan) Empty() bool {
+	return len(p.Updates) == 0 && len(p.Creates) == 0 && len(p.Deletes) == 0
+}
+
+// Add 计算按模板发放物品的变更
+// 先补满同模板未满的格子，再占用空闲格子，仍放不下的物品计入 Overflow
+func Add(r models.InventoryRules, userID int64, items []*models.InventoryItem, capacity int32, adds []designconfig.BaseItemCost, now int64) *Plan {
+	plan := &Plan{}
+	bag := bagItems(items)
+	used := int32(len(bag))
+	nextPosition := maxPosition(items) + 1
+	changed := make(map[int64]bool)
+
+	for _, add := range adds {
+		templateID := int64(add.ItemId)
+		remaining := int64(add.Count)
+		if remaining <= 0 {
+			continue
+		}
+		limit := int64(StackLimit(r, templateID))
+
+		for _, item := range bag {
+			if remaining == 0 {
+				break
+			}
+			if item.TemplateID != templateID || int64(item.Count) >= limit {
+				continue
+			}
+			n := min(limit-int64(item.Count), remaining)
+			item.Count += int32(n)
+			item.UpdatedAt = now
+			remaining -= n
+			if item.ID != 0 {
+				changed[item.ID] = true
+			}
+		}
+
+		for remaining > 0 && used < capacity {
+			n := min(limit, remaining)
+			item := &models.InventoryItem{
+				UserID:     userID,
+				TemplateID: templateID,
+				Count:      int32(n),
+				Position:   nextPosition,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			}
+			bag = append(bag, item)
+			plan.Creates = append(plan.Creates, item)
+			nextPosition++
+			used++
+			remaining -= n
+		}
+
+		if remaining > 0 {
+			plan.Overflow = appendItemCost(plan.Overflow, templateID, remaining)
+		}
+	}
+
+	for _, item := range bag {
+		if changed[item.ID] {
+			plan.Updates = append(plan.Updates, item)
+		}
+	}
+	return plan
+}
+
+// Remove 计算按模板扣除物品的变更，同模板优先扣除数量少的格子以腾出空间
+// 背包中未装备的物品数量不足时返回 false
+func Remove(items []*models.InventoryItem, removes []designconfig.BaseItemCost, now int64) (*Plan, bool) {
+	bag := bagItems(items)
+	sort.SliceStable(bag, func(i, j int) bool {
+		if bag[i].Count != bag[j].Count {
+			return bag[i].Count < bag[j].Count
+		}
+		return bag[i].Position > bag[j].Position
+	})
+
+	changed := make(map[int64]bool)
+	for _, remove := range removes {
+		templateID := int64(remove.ItemId)
+		remaining := int64(remove.Count)
+		for _, item := range bag {
+			if remaining <= 0 {
+				break
+			}
+			if item.TemplateID != templateID || item.Count == 0 {
+				continue
+			}
+			n := min(int64(item.Count), remaining)
+			item.Count -= int32(n)
+			item.UpdatedAt = now
+			remaining -= n
+			changed[item.ID] = true
+		}
+		if remaining > 0 {
+			return nil, false
+		}
+	}
+
+	plan := &Plan{}
+	for _, item := range sortByPosition(bag) {
+		if !changed[item.ID] {
+			continue
+		}
+		if item.Count == 0 {
+			plan.Deletes = append(plan.Deletes, item.ID)
+		} else {
+			plan.Updates = append(plan.Updates, item)
+		}
+	}
+	return plan, true
+}
+
+// Consume 计算扣除指定实例物品的变更，实例不存在、模板不符、已装备或数量不足时返回 false
+func Consume(items []*models.InventoryItem, itemID int64, templateID int64, count int32, now int64) (*Plan, bool) {
+	if count <= 0 {
+		return nil, false
+	}
+	for _, item := range items {
+		if item.ID != itemID {
+			continue
+		}
+		if item.TemplateID != templateID || item.Equipped || item.Count < count {
+			return nil, false
+		}
+
+		plan := &Plan{}
+		if item.Count == count {
+			plan.Deletes = append(plan.Deletes, item.ID)
+		} else {
+			updated := *item
+			updated.Count -= count
+			updated.UpdatedAt = now
+			plan.Updates = append(plan.Updates, &updated)
+		}
+		return plan, true
+	}
+	return nil, false
+}
+
+// Compact 计算整理背包的变更
+// 同模板物品合并到尽量少的格子，超过叠加上限的格子拆分，再按排序序号、模板ID、数量重新编排格子序号
+func Compact(r models.InventoryRules, userID int64, items []*models.InventoryItem, now int64) *Plan {
+	plan := &Plan{}
+	bag := bagItems(items)
+	original := make(map[int64]models.InventoryItem, len(bag))
+	for _, item := range bag {
+		original[item.ID] = *item
+	}
+
+	// 按模板分组，组内保持原有格子顺序
+	var templateIDs []int64
+	groups := make(map[int64][]*models.InventoryItem)
+	for _, item := range bag {
+		if _, ok := groups[item.TemplateID]; !ok {
+			templateIDs = append(templateIDs, item.TemplateID)
+		}
+		groups[item.TemplateID] = append(groups[item.TemplateID], item)
+	}
+
+	var result []*models.InventoryItem
+	for _, templateID := range templateIDs {
+		stacks := groups[templateID]
+		limit := int64(StackLimit(r, templateID))
+		var total int64
+		for _, item := range stacks {
+			total += int64(item.Count)
+		}
+
+		for _, item := range stacks {
+			if total == 0 {
+				plan.Deletes = append(plan.Deletes, item.ID)
+				continue
+			}
+			n := min(limit, total)
+			item.Count = int32(n)
+			total -= n
+			result = append(result, item)
+		}
+		for total > 0 {
+			n := min(limit, total)
+			item := &models.InventoryItem{
+				UserID:     userID,
+				TemplateID: templateID,
+				Count:      int32(n),
+				CreatedAt:  now,
+			}
+			result = append(result, item)
+			plan.Creates = append(plan.Creates, item)
+			total -= n
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if rank(r, a.TemplateID) != rank(r, b.TemplateID) {
+			return rank(r, a.TemplateID) < rank(r, b.TemplateID)
+		}
+		if a.TemplateID != b.TemplateID {
+			return a.TemplateID < b.TemplateID
+		}
+		return a.Count > b.Count
+	})
+
+	for i, item := range result {
+		item.Position = int32(i + 1)
+		item.UpdatedAt = now
+		if item.ID == 0 {
+			continue
+		}
+		before := original[item.ID]
+		if before.Count != item.Count || before.Position != item.Position {
+			plan.Updates = append(plan.Updates, item)
+		}
+	}
+	return plan
+}
+
+// rank 获取物品模板的整理排序序号，未配置的模板排在最后
+func rank(r models.InventoryRules, templateID int64) int32 {
+	if rank, ok := r.Ranks[templateID]; ok {
+		return rank
+	}
+	return math.MaxInt32
+}
+
+// bagItems 复制背包中未装备的物品并按格子序号排序，计划在副本上计算，不修改调用方的数据
+func bagItems(items []*models.InventoryItem) []*models.InventoryItem {
+	bag := make([]*models.InventoryItem, 0, len(items))
+	for _, item := range items {
+		if item.Equipped {
+			continue
+		}
+		copied := *item
+		bag = append(bag, &copied)
+	}
+	return sortByPosition(bag)
+}
+
+// sortByPosition 按格子序号排序，序号相同时按实例ID排序
+func sortByPosition(items []*models.InventoryItem) []*models.InventoryItem {
+	sorted := append([]*models.InventoryItem(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Position != sorted[j].Position {
+			return sorted[i].Position < sorted[j].Position
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+// maxPosition 获取当前最大的格子序号
+func maxPosition(items []*models.InventoryItem) int32 {
+	var position int32
+	for _, item := range items {
+		if item.Position > position {
+			position = item.Position
+		}
+	}
+	return position
+}
+
+// appendItemCost 将物品累加到列表中，同模板合并为一项
+func appendItemCost(costs []designconfig.BaseItemCost, templateID int64, count int64) []designconfig.BaseItemCost {
+	for i := range costs {
+		if int64(costs[i].ItemId) == templateID {
+			costs[i].Count += int(count)
+			return costs
+		}
+	}
+	return append(costs, designconfig.BaseItemCost{ItemId: int(templateID), Count: int(count)})
+}
@@ -0,0 +1,217 @@
+package inventoryplan
+
+import (
+	"fmt"
+	"testing"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+const testUserID = 1001
+
+var testRules = models.InventoryRules{
+	Stacks: map[int64]int32{1: 10, 2: 5},
+	Ranks:  map[int64]int32{2: 1, 1: 2},
+}
+
+func bagItem(id, templateID int64, count, position int32) *models.InventoryItem {
+	return &models.InventoryItem{ID: id, UserID: testUserID, TemplateID: templateID, Count: count, Position: position}
+}
+
+func equippedItem(id, templateID int64, count, position int32) *models.InventoryItem {
+	item := bagItem(id, templateID, count, position)
+	item.Equipped = true
+	return item
+}
+
+// planResult 以字符串描述计划，已有物品为 ID:模板xN@格子，新格子为 模板xN@格子
+type planResult struct {
+	updates  string
+	creates  string
+	deletes  string
+	overflow string
+}
+
+func describe(plan *Plan) planResult {
+	var updates, creates []string
+	for _, item := range plan.Updates {
+		updates = append(updates, fmt.Sprintf("%d:%dx%d@%d", item.ID, item.TemplateID, item.Count, item.Position))
+	}
+	for _, item := range plan.Creates {
+		creates = append(creates, fmt.Sprintf("%dx%d@%d", item.TemplateID, item.Count, item.Position))
+	}
+	var overflow []string
+	for _, cost := range plan.Overflow {
+		overflow = append(overflow, fmt.Sprintf("%dx%d", cost.ItemId, cost.Count))
+	}
+	return planResult{
+		updates:  fmt.Sprint(updates),
+		creates:  fmt.Sprint(creates),
+		deletes:  fmt.Sprint(plan.Deletes),
+		overflow: fmt.Sprint(overflow),
+	}
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []*models.InventoryItem
+		capacity int32
+		adds     []designconfig.BaseItemCost
+		want     planResult
+	}{
+		{
+			name:     "fills partial stacks before opening new slots",
+			items:    []*models.InventoryItem{bagItem(1, 1, 7, 1), bagItem(2, 1, 10, 2)},
+			capacity: 4,
+			adds:     []designconfig.BaseItemCost{{ItemId: 1, Count: 5}},
+			want:     planResult{updates: "[1:1x10@1]", creates: "[1x2@3]", deletes: "[]", overflow: "[]"},
+		},
+		{
+			name:     "new slots respect stack limit",
+			items:    nil,
+			capacity: 4,
+			adds:     []designconfig.BaseItemCost{{ItemId: 2, Count: 12}},
+			want:     planResult{updates: "[]", creates: "[2x5@1 2x5@2 2x2@3]", deletes: "[]", overflow: "[]"},
+		},
+		{
+			name:     "unconfigured template stacks without limit",
+			items:    []*models.InventoryItem{bagItem(1, 9, 100, 1)},
+			capacity: 1,
+			adds:     []designconfig.BaseItemCost{{ItemId: 9, Count: 1000}},
+			want:     planResult{updates: "[1:9x1100@1]", creates: "[]", deletes: "[]", overflow: "[]"},
+		},
+		{
+			name:     "bag full overflows everything",
+			items:    []*models.InventoryItem{bagItem(1, 1, 10, 1), bagItem(2, 2, 5, 2)},
+			capacity: 2,
+			adds:     []designconfig.BaseItemCost{{ItemId: 1, Count: 3}, {ItemId: 2, Count: 1}},
+			want:     planResult{updates: "[]", creates: "[]", deletes: "[]", overflow: "[1x3 2x1]"},
+		},
+		{
+			name:     "partial overflow",
+			items:    []*models.InventoryItem{bagItem(1, 1, 9, 1), bagItem(2, 2, 5, 2)},
+			capacity: 3,
+			adds:     []designconfig.BaseItemCost{{ItemId: 1, Count: 15}},
+			want:     planResult{updates: "[1:1x10@1]", creates: "[1x10@3]", deletes: "[]", overflow: "[1x4]"},
+		},
+		{
+			name:     "equipped items are not topped up and do not use capacity",
+			items:    []*models.InventoryItem{equippedItem(1, 1, 3, 1), equippedItem(2, 3, 1, 2), bagItem(3, 2, 5, 3)},
+			capacity: 2,
+			adds:     []designconfig.BaseItemCost{{ItemId: 1, Count: 4}},
+			want:     planResult{updates: "[]", creates: "[1x4@4]", deletes: "[]", overflow: "[]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := describe(&Plan{Updates: tt.items})
+			plan := Add(testRules, testUserID, tt.items, tt.capacity, tt.adds, 100)
+			if got := describe(plan); got != tt.want {
+				t.Errorf("Add() = %+v, want %+v", got, tt.want)
+			}
+			if after := describe(&Plan{Updates: tt.items}); after != before {
+				t.Errorf("Add() modified input items: %s -> %s", before.updates, after.updates)
+			}
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   []*models.InventoryItem
+		removes []designconfig.BaseItemCost
+		ok      bool
+		want    planResult
+	}{
+		{
+			name:    "smallest stacks first",
+			items:   []*models.InventoryItem{bagItem(1, 1, 10, 1), bagItem(2, 1, 3, 2), bagItem(3, 1, 6, 3)},
+			removes: []designconfig.BaseItemCost{{ItemId: 1, Count: 5}},
+			ok:      true,
+			want:    planResult{updates: "[3:1x4@3]", creates: "[]", deletes: "[2]", overflow: "[]"},
+		},
+		{
+			name:    "equal stacks take the later slot first",
+			items:   []*models.InventoryItem{bagItem(1, 1, 4, 1), bagItem(2, 1, 4, 2)},
+			removes: []designconfig.BaseItemCost{{ItemId: 1, Count: 2}},
+			ok:      true,
+			want:    planResult{updates: "[2:1x2@2]", creates: "[]", deletes: "[]", overflow: "[]"},
+		},
+		{
+			name:    "several templates",
+			items:   []*models.InventoryItem{bagItem(1, 1, 4, 1), bagItem(2, 2, 5, 2)},
+			removes: []designconfig.BaseItemCost{{ItemId: 1, Count: 4}, {ItemId: 2, Count: 1}},
+			ok:      true,
+			want:    planResult{updates: "[2:2x4@2]", creates: "[]", deletes: "[1]", overflow: "[]"},
+		},
+		{
+			name:    "insufficient count",
+			items:   []*models.InventoryItem{bagItem(1, 1, 4, 1)},
+			removes: []designconfig.BaseItemCost{{ItemId: 1, Count: 5}},
+		},
+		{
+			name:    "equipped items are not removed",
+			items:   []*models.InventoryItem{equippedItem(1, 1, 5, 1), bagItem(2, 1, 2, 2)},
+			removes: []designconfig.BaseItemCost{{ItemId: 1, Count: 3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, ok := Remove(tt.items, tt.removes, 100)
+			if ok != tt.ok {
+				t.Fatalf("Remove() ok = %v, want %v", ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if got := describe(plan); got != tt.want {
+				t.Errorf("Remove() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []*models.InventoryItem
+		want  planResult
+	}{
+		{
+			name:  "merges stacks and orders by rank",
+			items: []*models.InventoryItem{bagItem(1, 1, 4, 1), bagItem(2, 2, 5, 2), bagItem(3, 1, 3, 3)},
+			want:  planResult{updates: "[2:2x5@1 1:1x7@2]", creates: "[]", deletes: "[3]", overflow: "[]"},
+		},
+		{
+			name:  "splits stacks over the limit",
+			items: []*models.InventoryItem{bagItem(1, 1, 25, 1)},
+			want:  planResult{updates: "[1:1x10@1]", creates: "[1x10@2 1x5@3]", deletes: "[]", overflow: "[]"},
+		},
+		{
+			name:  "renumbers gaps and puts unranked templates last",
+			items: []*models.InventoryItem{bagItem(1, 3, 1, 5), equippedItem(2, 2, 1, 2), bagItem(3, 1, 10, 9)},
+			want:  planResult{updates: "[3:1x10@1 1:3x1@2]", creates: "[]", deletes: "[]", overflow: "[]"},
+		},
+		{
+			name:  "full stacks come first within a template",
+			items: []*models.InventoryItem{bagItem(1, 2, 2, 1), bagItem(2, 2, 5, 2)},
+			want:  planResult{updates: "[1:2x5@1 2:2x2@2]", creates: "[]", deletes: "[]", overflow: "[]"},
+		},
+		{
+			name:  "already compact",
+			items: []*models.InventoryItem{bagItem(1, 2, 5, 1), bagItem(2, 1, 10, 2)},
+			want:  planResult{updates: "[]", creates: "[]", deletes: "[]", overflow: "[]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := Compact(testRules, testUserID, tt.items, 100)
+			if got := describe(plan); got != tt.want {
+				t.Errorf("Compact() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
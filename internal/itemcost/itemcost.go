@@ -0,0 +1,87 @@
+package itemcost
+
+import (
+	"context"
+	"sort"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// Costs 配置中消耗和奖励的处理，按 currency 配置表区分货币和普通物品，用户服务和社交服务共用同一套规则
+type Costs struct {
+	configManager *designconfig.DesignConfigManager
+}
+
+// New 创建消耗和奖励处理器
+func New(configManager *designconfig.DesignConfigManager) *Costs {
+	return &Costs{configManager: configManager}
+}
+
+// IsCurrency 判断消耗或奖励配置中的 itemId 是否为货币
+func (c *Costs) IsCurrency(itemID int64) bool {
+	currencies, _ := c.configManager.GetConfig("currency").([]designconfig.CurrencyData)
+	for _, currency := range currencies {
+		if int64(currency.ID) == itemID {
+			return true
+		}
+	}
+	return false
+}
+
+// Split 将配置中的物品列表拆分为货币变动和普通物品，sign 为货币变动方向，扣除传 -1，发放传 1
+func (c *Costs) Split(costs []designconfig.BaseItemCost, sign int64) ([]models.CurrencyChange, []designconfig.BaseItemCost) {
+	var changes []models.CurrencyChange
+	var items []designconfig.BaseItemCost
+	for _, cost := range costs {
+		if c.IsCurrency(int64(cost.ItemId)) {
+			changes = append(changes, models.CurrencyChange{CurrencyID: int64(cost.ItemId), Amount: sign * int64(cost.Count)})
+		} else {
+			items = append(items, cost)
+		}
+	}
+	return changes, items
+}
+
+// MergeCurrencyChanges 合并同一货币的多次变动，并按货币ID排序，保证加锁顺序一致
+func MergeCurrencyChanges(changes []models.CurrencyChange) []models.CurrencyChange {
+	amounts := make(map[int64]int64, len(changes))
+	for _, change := range changes {
+		amounts[change.CurrencyID] += change.Amount
+	}
+
+	merged := make([]models.CurrencyChange, 0, len(amounts))
+	for currencyID, amount := range amounts {
+		if amount != 0 {
+			merged = append(merged, models.CurrencyChange{CurrencyID: currencyID, Amount: amount})
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].CurrencyID < merged[j].CurrencyID
+	})
+	return merged
+}
+
+// Consume 在事务中扣除配置的消耗，货币或物品不足时返回对应错误，由事务整体回滚
+// 货币通过钱包扣除并记录流水，idempotencyKey 保证重试时货币不会被重复扣除
+func (c *Costs) Consume(ctx context.Context, tx interfaces.Tx, userID int64, costs []designconfig.BaseItemCost, reason, source, idempotencyKey string) error {
+	changes, items := c.Split(costs, -1)
+	if changes = MergeCurrencyChanges(changes); len(changes) > 0 {
+		if _, err := tx.Wallet().ChangeCurrencies(ctx, userID, changes, reason, source, idempotencyKey); err != nil {
+			return err
+		}
+	}
+	return tx.RemoveItems(ctx, userID, items)
+}
+
+// Grant 在事务中发放配置的奖励，背包放不下的物品通过 overflowMail 补发
+func (c *Costs) Grant(ctx context.Context, tx interfaces.Tx, userID int64, rewards []designconfig.BaseItemCost, rules models.InventoryRules, reason, source, idempotencyKey string, overflowMail *models.Mail) error {
+	changes, items := c.Split(rewards, 1)
+	if changes = MergeCurrencyChanges(changes); len(changes) > 0 {
+		if _, err := tx.Wallet().ChangeCurrencies(ctx, userID, changes, reason, source, idempotencyKey); err != nil {
+			return err
+		}
+	}
+	return tx.AddItems(ctx, userID, items, rules, overflowMail)
+}
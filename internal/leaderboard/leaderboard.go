@@ -26,6 +26,12 @@ func (l *Leaderboard) ReportScore(ctx context.Context, leaderboard, userID strin
 	return l.cache.ZAdd(ctx, key, redis.Z{Score: float64(score), Member: userID}).Err()
 }
 
+// ReportBestScore 上报分数，只在高于玩家已有分数时更新，排行榜记录历史最高分
+func (l *Leaderboard) ReportBestScore(ctx context.Context, leaderboard, userID string, score int64) error {
+	key := fmt.Sprintf("leaderboard:%s", leaderboard)
+	return l.cache.ZAddGT(ctx, key, redis.Z{Score: float64(score), Member: userID}).Err()
+}
+
 // GetLeaderboard 查询排行榜
 func (l *Leaderboard) GetLeaderboard(ctx context.Context, leaderboard string, offset, limit int64) ([]redis.Z, error) {
 	key := fmt.Sprintf("leaderboard:%s", leaderboard)
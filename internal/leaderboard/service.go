@@ -76,8 +76,8 @@ func startScoreConsumer(consumer *mq.KafkaConsumer, lb *Leaderboard) {
 			return err
 		}
 
-		// 更新排行榜
-		if err := lb.ReportScore(ctx, "game_progress", scoreMsg.UserID, int64(scoreMsg.Score)); err != nil {
+		// 更新排行榜，每局上报一次，保留玩家的单局最高分
+		if err := lb.ReportBestScore(ctx, "game_progress", scoreMsg.UserID, int64(scoreMsg.Score)); err != nil {
 			utils.Error("Failed to update leaderboard", zap.Error(err))
 			return err
 		}
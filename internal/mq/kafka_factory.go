@@ -22,6 +22,10 @@ const (
 	UserBehavior KafkaClientType = "user_behavior"
 	// Notification 系统通知
 	Notification KafkaClientType = "notification"
+	// UserLevelUp 玩家升级事件
+	UserLevelUp KafkaClientType = "user_level_up"
+	// GameplayEvent 游戏事件，驱动任务和成就
+	GameplayEvent KafkaClientType = "gameplay_event"
 )
 
 // KafkaFactory Kafka工厂，管理所有Kafka客户端
@@ -71,6 +75,10 @@ func (f *KafkaFactory) GetProducer(clientType KafkaClientType) (*KafkaProducer,
 		kafkaConfig = f.config.UserBehavior
 	case Notification:
 		kafkaConfig = f.config.Notification
+	case UserLevelUp:
+		kafkaConfig = f.config.UserLevelUp
+	case GameplayEvent:
+		kafkaConfig = f.config.GameplayEvent
 	default:
 		return nil, fmt.Errorf("unknown kafka client type: %s", clientType)
 	}
@@ -115,6 +123,10 @@ func (f *KafkaFactory) GetConsumer(clientType KafkaClientType) (*KafkaConsumer,
 		kafkaConfig = f.config.UserBehavior
 	case Notification:
 		kafkaConfig = f.config.Notification
+	case UserLevelUp:
+		kafkaConfig = f.config.UserLevelUp
+	case GameplayEvent:
+		kafkaConfig = f.config.GameplayEvent
 	default:
 		return nil, fmt.Errorf("unknown kafka client type: %s", clientType)
 	}
@@ -21,6 +21,226 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 创建房间请求
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // 房主ID
+	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`                // 房间人数上限，0使用默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_internal_pb_game_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRoomRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+// 创建房间响应
+type CreateRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	GameId        string                 `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // 房间ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomResponse) Reset() {
+	*x = CreateRoomResponse{}
+	mi := &file_internal_pb_game_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomResponse) ProtoMessage() {}
+
+func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRoomResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateRoomResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateRoomResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// 开始游戏请求
+type StartGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // 操作者ID，必须为房主
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameRequest) Reset() {
+	*x = StartGameRequest{}
+	mi := &file_internal_pb_game_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRequest) ProtoMessage() {}
+
+func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
+func (*StartGameRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StartGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// 开始游戏响应
+type StartGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameResponse) Reset() {
+	*x = StartGameResponse{}
+	mi := &file_internal_pb_game_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameResponse) ProtoMessage() {}
+
+func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameResponse.ProtoReflect.Descriptor instead.
+func (*StartGameResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartGameResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StartGameResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type JoinGameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
@@ -31,7 +251,7 @@ type JoinGameRequest struct {
 
 func (x *JoinGameRequest) Reset() {
 	*x = JoinGameRequest{}
-	mi := &file_internal_pb_game_proto_msgTypes[0]
+	mi := &file_internal_pb_game_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -43,7 +263,7 @@ func (x *JoinGameRequest) String() string {
 func (*JoinGameRequest) ProtoMessage() {}
 
 func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_game_proto_msgTypes[0]
+	mi := &file_internal_pb_game_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,7 +276,7 @@ func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinGameRequest.ProtoReflect.Descriptor instead.
 func (*JoinGameRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_game_proto_rawDescGZIP(), []int{0}
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *JoinGameRequest) GetPlayerId() string {
@@ -83,7 +303,7 @@ type JoinGameResponse struct {
 
 func (x *JoinGameResponse) Reset() {
 	*x = JoinGameResponse{}
-	mi := &file_internal_pb_game_proto_msgTypes[1]
+	mi := &file_internal_pb_game_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -95,7 +315,7 @@ func (x *JoinGameResponse) String() string {
 func (*JoinGameResponse) ProtoMessage() {}
 
 func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_game_proto_msgTypes[1]
+	mi := &file_internal_pb_game_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,7 +328,7 @@ func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinGameResponse.ProtoReflect.Descriptor instead.
 func (*JoinGameResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_game_proto_rawDescGZIP(), []int{1}
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *JoinGameResponse) GetSuccess() bool {
@@ -135,7 +355,7 @@ type LeaveGameRequest struct {
 
 func (x *LeaveGameRequest) Reset() {
 	*x = LeaveGameRequest{}
-	mi := &file_internal_pb_game_proto_msgTypes[2]
+	mi := &file_internal_pb_game_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -147,7 +367,7 @@ func (x *LeaveGameRequest) String() string {
 func (*LeaveGameRequest) ProtoMessage() {}
 
 func (x *LeaveGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_game_proto_msgTypes[2]
+	mi := &file_internal_pb_game_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -160,7 +380,7 @@ func (x *LeaveGameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveGameRequest.ProtoReflect.Descriptor instead.
 func (*LeaveGameRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_game_proto_rawDescGZIP(), []int{2}
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LeaveGameRequest) GetPlayerId() string {
@@ -186,7 +406,7 @@ type LeaveGameResponse struct {
 
 func (x *LeaveGameResponse) Reset() {
 	*x = LeaveGameResponse{}
-	mi := &file_internal_pb_game_proto_msgTypes[3]
+	mi := &file_internal_pb_game_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -198,7 +418,7 @@ func (x *LeaveGameResponse) String() string {
 func (*LeaveGameResponse) ProtoMessage() {}
 
 func (x *LeaveGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_game_proto_msgTypes[3]
+	mi := &file_internal_pb_game_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -211,7 +431,7 @@ func (x *LeaveGameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveGameResponse.ProtoReflect.Descriptor instead.
 func (*LeaveGameResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_game_proto_rawDescGZIP(), []int{3}
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LeaveGameResponse) GetSuccess() bool {
@@ -230,7 +450,7 @@ type GameStateRequest struct {
 
 func (x *GameStateRequest) Reset() {
 	*x = GameStateRequest{}
-	mi := &file_internal_pb_game_proto_msgTypes[4]
+	mi := &file_internal_pb_game_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -242,7 +462,7 @@ func (x *GameStateRequest) String() string {
 func (*GameStateRequest) ProtoMessage() {}
 
 func (x *GameStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_game_proto_msgTypes[4]
+	mi := &file_internal_pb_game_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,7 +475,7 @@ func (x *GameStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameStateRequest.ProtoReflect.Descriptor instead.
 func (*GameStateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_game_proto_rawDescGZIP(), []int{4}
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GameStateRequest) GetGameId() string {
@@ -267,15 +487,23 @@ func (x *GameStateRequest) GetGameId() string {
 
 type GameStateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Players       []string               `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`                                   // 房间状态：waiting/running/finished
+	Players       []string               `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`                               // 房间内玩家
+	GameId        string                 `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                   // 房间ID
+	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`                // 房主ID
+	Capacity      int32                  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`                            // 人数上限
+	Tick          uint64                 `protobuf:"varint,6,opt,name=tick,proto3" json:"tick,omitempty"`                                    // 当前模拟帧号，游戏开始后有效
+	PlayerStates  []*GamePlayerState     `protobuf:"bytes,7,rep,name=player_states,json=playerStates,proto3" json:"player_states,omitempty"` // 玩家状态
+	Items         []*GameItem            `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`                                   // 剩余道具
+	Success       bool                   `protobuf:"varint,9,opt,name=success,proto3" json:"success,omitempty"`                              // 是否成功
+	Message       string                 `protobuf:"bytes,10,opt,name=message,proto3" json:"message,omitempty"`                              // 提示信息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GameStateResponse) Reset() {
 	*x = GameStateResponse{}
-	mi := &file_internal_pb_game_proto_msgTypes[5]
+	mi := &file_internal_pb_game_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -287,7 +515,7 @@ func (x *GameStateResponse) String() string {
 func (*GameStateResponse) ProtoMessage() {}
 
 func (x *GameStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_game_proto_msgTypes[5]
+	mi := &file_internal_pb_game_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +528,7 @@ func (x *GameStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameStateResponse.ProtoReflect.Descriptor instead.
 func (*GameStateResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_game_proto_rawDescGZIP(), []int{5}
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GameStateResponse) GetState() string {
@@ -317,31 +545,88 @@ func (x *GameStateResponse) GetPlayers() []string {
 	return nil
 }
 
-type PlayerActionRequest struct {
+func (x *GameStateResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameStateResponse) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GameStateResponse) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *GameStateResponse) GetTick() uint64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *GameStateResponse) GetPlayerStates() []*GamePlayerState {
+	if x != nil {
+		return x.PlayerStates
+	}
+	return nil
+}
+
+func (x *GameStateResponse) GetItems() []*GameItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GameStateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GameStateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 玩家对局状态
+type GamePlayerState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
-	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	X             int32                  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	Score         int64                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"` // 本局分数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerActionRequest) Reset() {
-	*x = PlayerActionRequest{}
-	mi := &file_internal_pb_game_proto_msgTypes[6]
+func (x *GamePlayerState) Reset() {
+	*x = GamePlayerState{}
+	mi := &file_internal_pb_game_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerActionRequest) String() string {
+func (x *GamePlayerState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerActionRequest) ProtoMessage() {}
+func (*GamePlayerState) ProtoMessage() {}
 
-func (x *PlayerActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_game_proto_msgTypes[6]
+func (x *GamePlayerState) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,62 +637,956 @@ func (x *PlayerActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerActionRequest.ProtoReflect.Descriptor instead.
-func (*PlayerActionRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_game_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GamePlayerState.ProtoReflect.Descriptor instead.
+func (*GamePlayerState) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PlayerActionRequest) GetPlayerId() string {
+func (x *GamePlayerState) GetPlayerId() string {
 	if x != nil {
 		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *PlayerActionRequest) GetGameId() string {
+func (x *GamePlayerState) GetX() int32 {
 	if x != nil {
-		return x.GameId
+		return x.X
 	}
-	return ""
+	return 0
+}
+
+func (x *GamePlayerState) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *GamePlayerState) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// 地图道具
+type GameItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	X             int32                  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameItem) Reset() {
+	*x = GameItem{}
+	mi := &file_internal_pb_game_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameItem) ProtoMessage() {}
+
+func (x *GameItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameItem.ProtoReflect.Descriptor instead.
+func (*GameItem) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GameItem) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *GameItem) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GameItem) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type PlayerActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`   // 操作类型：move/collect/complete_level
+	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"` // 操作参数（JSON）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerActionRequest) Reset() {
+	*x = PlayerActionRequest{}
+	mi := &file_internal_pb_game_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerActionRequest) ProtoMessage() {}
+
+func (x *PlayerActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerActionRequest.ProtoReflect.Descriptor instead.
+func (*PlayerActionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlayerActionRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerActionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
 }
 
 func (x *PlayerActionRequest) GetAction() string {
 	if x != nil {
-		return x.Action
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PlayerActionRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type PlayerActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tick          uint64                 `protobuf:"varint,3,opt,name=tick,proto3" json:"tick,omitempty"` // 处理该操作的帧号，结果通过帧推送下发
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerActionResponse) Reset() {
+	*x = PlayerActionResponse{}
+	mi := &file_internal_pb_game_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerActionResponse) ProtoMessage() {}
+
+func (x *PlayerActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerActionResponse.ProtoReflect.Descriptor instead.
+func (*PlayerActionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlayerActionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PlayerActionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlayerActionResponse) GetTick() uint64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+// 单个操作的处理结果
+type GameDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Accepted      bool                   `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"` // 是否通过校验
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`      // 被拒绝的原因
+	X             int32                  `protobuf:"varint,5,opt,name=x,proto3" json:"x,omitempty"`               // 处理后的玩家坐标
+	Y             int32                  `protobuf:"varint,6,opt,name=y,proto3" json:"y,omitempty"`
+	ItemId        int32                  `protobuf:"varint,7,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // 拾取的道具ID
+	ScoreGained   int64                  `protobuf:"varint,8,opt,name=score_gained,json=scoreGained,proto3" json:"score_gained,omitempty"`
+	Score         int64                  `protobuf:"varint,9,opt,name=score,proto3" json:"score,omitempty"` // 本局总分
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameDelta) Reset() {
+	*x = GameDelta{}
+	mi := &file_internal_pb_game_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameDelta) ProtoMessage() {}
+
+func (x *GameDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameDelta.ProtoReflect.Descriptor instead.
+func (*GameDelta) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GameDelta) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GameDelta) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *GameDelta) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *GameDelta) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GameDelta) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GameDelta) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *GameDelta) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *GameDelta) GetScoreGained() int64 {
+	if x != nil {
+		return x.ScoreGained
+	}
+	return 0
+}
+
+func (x *GameDelta) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// 对局帧推送
+type GameTickNotify struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Tick          uint64                 `protobuf:"varint,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	Deltas        []*GameDelta           `protobuf:"bytes,3,rep,name=deltas,proto3" json:"deltas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTickNotify) Reset() {
+	*x = GameTickNotify{}
+	mi := &file_internal_pb_game_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTickNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTickNotify) ProtoMessage() {}
+
+func (x *GameTickNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTickNotify.ProtoReflect.Descriptor instead.
+func (*GameTickNotify) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GameTickNotify) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameTickNotify) GetTick() uint64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *GameTickNotify) GetDeltas() []*GameDelta {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+// 加入匹配请求
+type JoinMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"` // 匹配模式，例如 1v1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinMatchRequest) Reset() {
+	*x = JoinMatchRequest{}
+	mi := &file_internal_pb_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinMatchRequest) ProtoMessage() {}
+
+func (x *JoinMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinMatchRequest.ProtoReflect.Descriptor instead.
+func (*JoinMatchRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *JoinMatchRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *JoinMatchRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// 加入匹配响应
+type JoinMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"` // 当前积分
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinMatchResponse) Reset() {
+	*x = JoinMatchResponse{}
+	mi := &file_internal_pb_game_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinMatchResponse) ProtoMessage() {}
+
+func (x *JoinMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinMatchResponse.ProtoReflect.Descriptor instead.
+func (*JoinMatchResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *JoinMatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *JoinMatchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JoinMatchResponse) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+// 取消匹配请求
+type CancelMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelMatchRequest) Reset() {
+	*x = CancelMatchRequest{}
+	mi := &file_internal_pb_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMatchRequest) ProtoMessage() {}
+
+func (x *CancelMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMatchRequest.ProtoReflect.Descriptor instead.
+func (*CancelMatchRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelMatchRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// 取消匹配响应
+type CancelMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelMatchResponse) Reset() {
+	*x = CancelMatchResponse{}
+	mi := &file_internal_pb_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMatchResponse) ProtoMessage() {}
+
+func (x *CancelMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMatchResponse.ProtoReflect.Descriptor instead.
+func (*CancelMatchResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelMatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelMatchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 匹配成功推送
+type MatchFoundNotify struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // 房间ID
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`                   // 匹配模式
+	Players       []string               `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`             // 房间内玩家
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchFoundNotify) Reset() {
+	*x = MatchFoundNotify{}
+	mi := &file_internal_pb_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchFoundNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchFoundNotify) ProtoMessage() {}
+
+func (x *MatchFoundNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchFoundNotify.ProtoReflect.Descriptor instead.
+func (*MatchFoundNotify) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MatchFoundNotify) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *MatchFoundNotify) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MatchFoundNotify) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// 匹配超时推送
+type MatchTimeoutNotify struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"` // 匹配模式
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchTimeoutNotify) Reset() {
+	*x = MatchTimeoutNotify{}
+	mi := &file_internal_pb_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchTimeoutNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchTimeoutNotify) ProtoMessage() {}
+
+func (x *MatchTimeoutNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchTimeoutNotify.ProtoReflect.Descriptor instead.
+func (*MatchTimeoutNotify) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MatchTimeoutNotify) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// 战斗单位
+type BattleUnit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Atk           int64                  `protobuf:"varint,2,opt,name=atk,proto3" json:"atk,omitempty"`
+	Def           int64                  `protobuf:"varint,3,opt,name=def,proto3" json:"def,omitempty"`
+	HpMax         int64                  `protobuf:"varint,4,opt,name=hp_max,json=hpMax,proto3" json:"hp_max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BattleUnit) Reset() {
+	*x = BattleUnit{}
+	mi := &file_internal_pb_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BattleUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattleUnit) ProtoMessage() {}
+
+func (x *BattleUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattleUnit.ProtoReflect.Descriptor instead.
+func (*BattleUnit) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BattleUnit) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *PlayerActionRequest) GetPayload() []byte {
+func (x *BattleUnit) GetAtk() int64 {
 	if x != nil {
-		return x.Payload
+		return x.Atk
 	}
-	return nil
+	return 0
 }
 
-type PlayerActionResponse struct {
+func (x *BattleUnit) GetDef() int64 {
+	if x != nil {
+		return x.Def
+	}
+	return 0
+}
+
+func (x *BattleUnit) GetHpMax() int64 {
+	if x != nil {
+		return x.HpMax
+	}
+	return 0
+}
+
+// 战斗事件，单位下标0为玩家，怪物从1开始
+type BattleEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Actor         int32                  `protobuf:"varint,2,opt,name=actor,proto3" json:"actor,omitempty"`   // 攻击方下标
+	Target        int32                  `protobuf:"varint,3,opt,name=target,proto3" json:"target,omitempty"` // 受击方下标
+	Damage        int64                  `protobuf:"varint,4,opt,name=damage,proto3" json:"damage,omitempty"`
+	Crit          bool                   `protobuf:"varint,5,opt,name=crit,proto3" json:"crit,omitempty"`
+	TargetHp      int64                  `protobuf:"varint,6,opt,name=target_hp,json=targetHp,proto3" json:"target_hp,omitempty"` // 受击后剩余血量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BattleEvent) Reset() {
+	*x = BattleEvent{}
+	mi := &file_internal_pb_game_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BattleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattleEvent) ProtoMessage() {}
+
+func (x *BattleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattleEvent.ProtoReflect.Descriptor instead.
+func (*BattleEvent) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BattleEvent) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *BattleEvent) GetActor() int32 {
+	if x != nil {
+		return x.Actor
+	}
+	return 0
+}
+
+func (x *BattleEvent) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *BattleEvent) GetDamage() int64 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *BattleEvent) GetCrit() bool {
+	if x != nil {
+		return x.Crit
+	}
+	return false
+}
+
+func (x *BattleEvent) GetTargetHp() int64 {
+	if x != nil {
+		return x.TargetHp
+	}
+	return 0
+}
+
+// 挑战关卡请求
+type StartBattleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	StageId       int32                  `protobuf:"varint,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"` // 关卡ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartBattleRequest) Reset() {
+	*x = StartBattleRequest{}
+	mi := &file_internal_pb_game_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartBattleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBattleRequest) ProtoMessage() {}
+
+func (x *StartBattleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBattleRequest.ProtoReflect.Descriptor instead.
+func (*StartBattleRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StartBattleRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *StartBattleRequest) GetStageId() int32 {
+	if x != nil {
+		return x.StageId
+	}
+	return 0
+}
+
+// 挑战关卡响应，客户端可使用seed和双方单位回放战斗
+type StartBattleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	BattleId      string                 `protobuf:"bytes,3,opt,name=battle_id,json=battleId,proto3" json:"battle_id,omitempty"`
+	Seed          int64                  `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"` // 随机种子
+	Player        *BattleUnit            `protobuf:"bytes,5,opt,name=player,proto3" json:"player,omitempty"`
+	Monsters      []*BattleUnit          `protobuf:"bytes,6,rep,name=monsters,proto3" json:"monsters,omitempty"`
+	MaxRounds     int32                  `protobuf:"varint,7,opt,name=max_rounds,json=maxRounds,proto3" json:"max_rounds,omitempty"`
+	Events        []*BattleEvent         `protobuf:"bytes,8,rep,name=events,proto3" json:"events,omitempty"` // 战斗日志
+	Win           bool                   `protobuf:"varint,9,opt,name=win,proto3" json:"win,omitempty"`
+	Rounds        int32                  `protobuf:"varint,10,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	Digest        string                 `protobuf:"bytes,11,opt,name=digest,proto3" json:"digest,omitempty"` // 战斗过程摘要
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerActionResponse) Reset() {
-	*x = PlayerActionResponse{}
-	mi := &file_internal_pb_game_proto_msgTypes[7]
+func (x *StartBattleResponse) Reset() {
+	*x = StartBattleResponse{}
+	mi := &file_internal_pb_game_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerActionResponse) String() string {
+func (x *StartBattleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerActionResponse) ProtoMessage() {}
+func (*StartBattleResponse) ProtoMessage() {}
 
-func (x *PlayerActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_game_proto_msgTypes[7]
+func (x *StartBattleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,19 +1597,212 @@ func (x *PlayerActionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerActionResponse.ProtoReflect.Descriptor instead.
-func (*PlayerActionResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_game_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use StartBattleResponse.ProtoReflect.Descriptor instead.
+func (*StartBattleResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *PlayerActionResponse) GetSuccess() bool {
+func (x *StartBattleResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *PlayerActionResponse) GetMessage() string {
+func (x *StartBattleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StartBattleResponse) GetBattleId() string {
+	if x != nil {
+		return x.BattleId
+	}
+	return ""
+}
+
+func (x *StartBattleResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *StartBattleResponse) GetPlayer() *BattleUnit {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *StartBattleResponse) GetMonsters() []*BattleUnit {
+	if x != nil {
+		return x.Monsters
+	}
+	return nil
+}
+
+func (x *StartBattleResponse) GetMaxRounds() int32 {
+	if x != nil {
+		return x.MaxRounds
+	}
+	return 0
+}
+
+func (x *StartBattleResponse) GetEvents() []*BattleEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *StartBattleResponse) GetWin() bool {
+	if x != nil {
+		return x.Win
+	}
+	return false
+}
+
+func (x *StartBattleResponse) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *StartBattleResponse) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+// 复核战斗结果请求
+type VerifyBattleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	BattleId      string                 `protobuf:"bytes,2,opt,name=battle_id,json=battleId,proto3" json:"battle_id,omitempty"`
+	Win           bool                   `protobuf:"varint,3,opt,name=win,proto3" json:"win,omitempty"`
+	Rounds        int32                  `protobuf:"varint,4,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	Digest        string                 `protobuf:"bytes,5,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyBattleRequest) Reset() {
+	*x = VerifyBattleRequest{}
+	mi := &file_internal_pb_game_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBattleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBattleRequest) ProtoMessage() {}
+
+func (x *VerifyBattleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBattleRequest.ProtoReflect.Descriptor instead.
+func (*VerifyBattleRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VerifyBattleRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *VerifyBattleRequest) GetBattleId() string {
+	if x != nil {
+		return x.BattleId
+	}
+	return ""
+}
+
+func (x *VerifyBattleRequest) GetWin() bool {
+	if x != nil {
+		return x.Win
+	}
+	return false
+}
+
+func (x *VerifyBattleRequest) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *VerifyBattleRequest) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+// 复核战斗结果响应
+type VerifyBattleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyBattleResponse) Reset() {
+	*x = VerifyBattleResponse{}
+	mi := &file_internal_pb_game_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBattleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBattleResponse) ProtoMessage() {}
+
+func (x *VerifyBattleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_game_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBattleResponse.ProtoReflect.Descriptor instead.
+func (*VerifyBattleResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_game_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VerifyBattleResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyBattleResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
@@ -441,7 +1813,20 @@ var File_internal_pb_game_proto protoreflect.FileDescriptor
 
 const file_internal_pb_game_proto_rawDesc = "" +
 	"\n" +
-	"\x16internal/pb/game.proto\x12\x04game\"G\n" +
+	"\x16internal/pb/game.proto\x12\x04game\"L\n" +
+	"\x11CreateRoomRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\"a\n" +
+	"\x12CreateRoomResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\tR\x06gameId\"H\n" +
+	"\x10StartGameRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\"G\n" +
+	"\x11StartGameResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"G\n" +
 	"\x0fJoinGameRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x17\n" +
 	"\agame_id\x18\x02 \x01(\tR\x06gameId\"F\n" +
@@ -454,23 +1839,120 @@ const file_internal_pb_game_proto_rawDesc = "" +
 	"\x11LeaveGameResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"+\n" +
 	"\x10GameStateRequest\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\"C\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"\xbd\x02\n" +
 	"\x11GameStateResponse\x12\x14\n" +
 	"\x05state\x18\x01 \x01(\tR\x05state\x12\x18\n" +
-	"\aplayers\x18\x02 \x03(\tR\aplayers\"}\n" +
+	"\aplayers\x18\x02 \x03(\tR\aplayers\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\tR\x06gameId\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x05R\bcapacity\x12\x12\n" +
+	"\x04tick\x18\x06 \x01(\x04R\x04tick\x12:\n" +
+	"\rplayer_states\x18\a \x03(\v2\x15.game.GamePlayerStateR\fplayerStates\x12$\n" +
+	"\x05items\x18\b \x03(\v2\x0e.game.GameItemR\x05items\x12\x18\n" +
+	"\asuccess\x18\t \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\n" +
+	" \x01(\tR\amessage\"`\n" +
+	"\x0fGamePlayerState\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x05R\x01y\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x03R\x05score\"?\n" +
+	"\bGameItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x05R\x06itemId\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x05R\x01y\"}\n" +
 	"\x13PlayerActionRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x17\n" +
 	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x16\n" +
 	"\x06action\x18\x03 \x01(\tR\x06action\x12\x18\n" +
-	"\apayload\x18\x04 \x01(\fR\apayload\"J\n" +
+	"\apayload\x18\x04 \x01(\fR\apayload\"^\n" +
 	"\x14PlayerActionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x8e\x02\n" +
-	"\vGameService\x129\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04tick\x18\x03 \x01(\x04R\x04tick\"\xe2\x01\n" +
+	"\tGameDelta\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1a\n" +
+	"\baccepted\x18\x03 \x01(\bR\baccepted\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\f\n" +
+	"\x01x\x18\x05 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x06 \x01(\x05R\x01y\x12\x17\n" +
+	"\aitem_id\x18\a \x01(\x05R\x06itemId\x12!\n" +
+	"\fscore_gained\x18\b \x01(\x03R\vscoreGained\x12\x14\n" +
+	"\x05score\x18\t \x01(\x03R\x05score\"f\n" +
+	"\x0eGameTickNotify\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x12\n" +
+	"\x04tick\x18\x02 \x01(\x04R\x04tick\x12'\n" +
+	"\x06deltas\x18\x03 \x03(\v2\x0f.game.GameDeltaR\x06deltas\"C\n" +
+	"\x10JoinMatchRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\"_\n" +
+	"\x11JoinMatchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\"1\n" +
+	"\x12CancelMatchRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\"I\n" +
+	"\x13CancelMatchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Y\n" +
+	"\x10MatchFoundNotify\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x18\n" +
+	"\aplayers\x18\x03 \x03(\tR\aplayers\"(\n" +
+	"\x12MatchTimeoutNotify\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"[\n" +
+	"\n" +
+	"BattleUnit\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03atk\x18\x02 \x01(\x03R\x03atk\x12\x10\n" +
+	"\x03def\x18\x03 \x01(\x03R\x03def\x12\x15\n" +
+	"\x06hp_max\x18\x04 \x01(\x03R\x05hpMax\"\x9a\x01\n" +
+	"\vBattleEvent\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\x05R\x05actor\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\x05R\x06target\x12\x16\n" +
+	"\x06damage\x18\x04 \x01(\x03R\x06damage\x12\x12\n" +
+	"\x04crit\x18\x05 \x01(\bR\x04crit\x12\x1b\n" +
+	"\ttarget_hp\x18\x06 \x01(\x03R\btargetHp\"L\n" +
+	"\x12StartBattleRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\bstage_id\x18\x02 \x01(\x05R\astageId\"\xde\x02\n" +
+	"\x13StartBattleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\tbattle_id\x18\x03 \x01(\tR\bbattleId\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\x03R\x04seed\x12(\n" +
+	"\x06player\x18\x05 \x01(\v2\x10.game.BattleUnitR\x06player\x12,\n" +
+	"\bmonsters\x18\x06 \x03(\v2\x10.game.BattleUnitR\bmonsters\x12\x1d\n" +
+	"\n" +
+	"max_rounds\x18\a \x01(\x05R\tmaxRounds\x12)\n" +
+	"\x06events\x18\b \x03(\v2\x11.game.BattleEventR\x06events\x12\x10\n" +
+	"\x03win\x18\t \x01(\bR\x03win\x12\x16\n" +
+	"\x06rounds\x18\n" +
+	" \x01(\x05R\x06rounds\x12\x16\n" +
+	"\x06digest\x18\v \x01(\tR\x06digest\"\x91\x01\n" +
+	"\x13VerifyBattleRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1b\n" +
+	"\tbattle_id\x18\x02 \x01(\tR\bbattleId\x12\x10\n" +
+	"\x03win\x18\x03 \x01(\bR\x03win\x12\x16\n" +
+	"\x06rounds\x18\x04 \x01(\x05R\x06rounds\x12\x16\n" +
+	"\x06digest\x18\x05 \x01(\tR\x06digest\"F\n" +
+	"\x14VerifyBattleResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\x9a\x05\n" +
+	"\vGameService\x12?\n" +
+	"\n" +
+	"CreateRoom\x12\x17.game.CreateRoomRequest\x1a\x18.game.CreateRoomResponse\x12<\n" +
+	"\tStartGame\x12\x16.game.StartGameRequest\x1a\x17.game.StartGameResponse\x129\n" +
 	"\bJoinGame\x12\x15.game.JoinGameRequest\x1a\x16.game.JoinGameResponse\x12<\n" +
 	"\tLeaveGame\x12\x16.game.LeaveGameRequest\x1a\x17.game.LeaveGameResponse\x12?\n" +
 	"\fGetGameState\x12\x16.game.GameStateRequest\x1a\x17.game.GameStateResponse\x12E\n" +
-	"\fPlayerAction\x12\x19.game.PlayerActionRequest\x1a\x1a.game.PlayerActionResponseB\rZ\vinternal/pbb\x06proto3"
+	"\fPlayerAction\x12\x19.game.PlayerActionRequest\x1a\x1a.game.PlayerActionResponse\x12<\n" +
+	"\tJoinMatch\x12\x16.game.JoinMatchRequest\x1a\x17.game.JoinMatchResponse\x12B\n" +
+	"\vCancelMatch\x12\x18.game.CancelMatchRequest\x1a\x19.game.CancelMatchResponse\x12B\n" +
+	"\vStartBattle\x12\x18.game.StartBattleRequest\x1a\x19.game.StartBattleResponse\x12E\n" +
+	"\fVerifyBattle\x12\x19.game.VerifyBattleRequest\x1a\x1a.game.VerifyBattleResponseB\rZ\vinternal/pbb\x06proto3"
 
 var (
 	file_internal_pb_game_proto_rawDescOnce sync.Once
@@ -484,31 +1966,69 @@ func file_internal_pb_game_proto_rawDescGZIP() []byte {
 	return file_internal_pb_game_proto_rawDescData
 }
 
-var file_internal_pb_game_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_internal_pb_game_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_internal_pb_game_proto_goTypes = []any{
-	(*JoinGameRequest)(nil),      // 0: game.JoinGameRequest
-	(*JoinGameResponse)(nil),     // 1: game.JoinGameResponse
-	(*LeaveGameRequest)(nil),     // 2: game.LeaveGameRequest
-	(*LeaveGameResponse)(nil),    // 3: game.LeaveGameResponse
-	(*GameStateRequest)(nil),     // 4: game.GameStateRequest
-	(*GameStateResponse)(nil),    // 5: game.GameStateResponse
-	(*PlayerActionRequest)(nil),  // 6: game.PlayerActionRequest
-	(*PlayerActionResponse)(nil), // 7: game.PlayerActionResponse
+	(*CreateRoomRequest)(nil),    // 0: game.CreateRoomRequest
+	(*CreateRoomResponse)(nil),   // 1: game.CreateRoomResponse
+	(*StartGameRequest)(nil),     // 2: game.StartGameRequest
+	(*StartGameResponse)(nil),    // 3: game.StartGameResponse
+	(*JoinGameRequest)(nil),      // 4: game.JoinGameRequest
+	(*JoinGameResponse)(nil),     // 5: game.JoinGameResponse
+	(*LeaveGameRequest)(nil),     // 6: game.LeaveGameRequest
+	(*LeaveGameResponse)(nil),    // 7: game.LeaveGameResponse
+	(*GameStateRequest)(nil),     // 8: game.GameStateRequest
+	(*GameStateResponse)(nil),    // 9: game.GameStateResponse
+	(*GamePlayerState)(nil),      // 10: game.GamePlayerState
+	(*GameItem)(nil),             // 11: game.GameItem
+	(*PlayerActionRequest)(nil),  // 12: game.PlayerActionRequest
+	(*PlayerActionResponse)(nil), // 13: game.PlayerActionResponse
+	(*GameDelta)(nil),            // 14: game.GameDelta
+	(*GameTickNotify)(nil),       // 15: game.GameTickNotify
+	(*JoinMatchRequest)(nil),     // 16: game.JoinMatchRequest
+	(*JoinMatchResponse)(nil),    // 17: game.JoinMatchResponse
+	(*CancelMatchRequest)(nil),   // 18: game.CancelMatchRequest
+	(*CancelMatchResponse)(nil),  // 19: game.CancelMatchResponse
+	(*MatchFoundNotify)(nil),     // 20: game.MatchFoundNotify
+	(*MatchTimeoutNotify)(nil),   // 21: game.MatchTimeoutNotify
+	(*BattleUnit)(nil),           // 22: game.BattleUnit
+	(*BattleEvent)(nil),          // 23: game.BattleEvent
+	(*StartBattleRequest)(nil),   // 24: game.StartBattleRequest
+	(*StartBattleResponse)(nil),  // 25: game.StartBattleResponse
+	(*VerifyBattleRequest)(nil),  // 26: game.VerifyBattleRequest
+	(*VerifyBattleResponse)(nil), // 27: game.VerifyBattleResponse
 }
 var file_internal_pb_game_proto_depIdxs = []int32{
-	0, // 0: game.GameService.JoinGame:input_type -> game.JoinGameRequest
-	2, // 1: game.GameService.LeaveGame:input_type -> game.LeaveGameRequest
-	4, // 2: game.GameService.GetGameState:input_type -> game.GameStateRequest
-	6, // 3: game.GameService.PlayerAction:input_type -> game.PlayerActionRequest
-	1, // 4: game.GameService.JoinGame:output_type -> game.JoinGameResponse
-	3, // 5: game.GameService.LeaveGame:output_type -> game.LeaveGameResponse
-	5, // 6: game.GameService.GetGameState:output_type -> game.GameStateResponse
-	7, // 7: game.GameService.PlayerAction:output_type -> game.PlayerActionResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	10, // 0: game.GameStateResponse.player_states:type_name -> game.GamePlayerState
+	11, // 1: game.GameStateResponse.items:type_name -> game.GameItem
+	14, // 2: game.GameTickNotify.deltas:type_name -> game.GameDelta
+	22, // 3: game.StartBattleResponse.player:type_name -> game.BattleUnit
+	22, // 4: game.StartBattleResponse.monsters:type_name -> game.BattleUnit
+	23, // 5: game.StartBattleResponse.events:type_name -> game.BattleEvent
+	0,  // 6: game.GameService.CreateRoom:input_type -> game.CreateRoomRequest
+	2,  // 7: game.GameService.StartGame:input_type -> game.StartGameRequest
+	4,  // 8: game.GameService.JoinGame:input_type -> game.JoinGameRequest
+	6,  // 9: game.GameService.LeaveGame:input_type -> game.LeaveGameRequest
+	8,  // 10: game.GameService.GetGameState:input_type -> game.GameStateRequest
+	12, // 11: game.GameService.PlayerAction:input_type -> game.PlayerActionRequest
+	16, // 12: game.GameService.JoinMatch:input_type -> game.JoinMatchRequest
+	18, // 13: game.GameService.CancelMatch:input_type -> game.CancelMatchRequest
+	24, // 14: game.GameService.StartBattle:input_type -> game.StartBattleRequest
+	26, // 15: game.GameService.VerifyBattle:input_type -> game.VerifyBattleRequest
+	1,  // 16: game.GameService.CreateRoom:output_type -> game.CreateRoomResponse
+	3,  // 17: game.GameService.StartGame:output_type -> game.StartGameResponse
+	5,  // 18: game.GameService.JoinGame:output_type -> game.JoinGameResponse
+	7,  // 19: game.GameService.LeaveGame:output_type -> game.LeaveGameResponse
+	9,  // 20: game.GameService.GetGameState:output_type -> game.GameStateResponse
+	13, // 21: game.GameService.PlayerAction:output_type -> game.PlayerActionResponse
+	17, // 22: game.GameService.JoinMatch:output_type -> game.JoinMatchResponse
+	19, // 23: game.GameService.CancelMatch:output_type -> game.CancelMatchResponse
+	25, // 24: game.GameService.StartBattle:output_type -> game.StartBattleResponse
+	27, // 25: game.GameService.VerifyBattle:output_type -> game.VerifyBattleResponse
+	16, // [16:26] is the sub-list for method output_type
+	6,  // [6:16] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_internal_pb_game_proto_init() }
@@ -522,7 +2042,7 @@ func file_internal_pb_game_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_pb_game_proto_rawDesc), len(file_internal_pb_game_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
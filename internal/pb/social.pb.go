@@ -172,6 +172,52 @@ func (BatchHandleFriendRequestRequest_Action) EnumDescriptor() ([]byte, []int) {
 	return file_internal_pb_social_proto_rawDescGZIP(), []int{13, 0}
 }
 
+type HandleGuildInvitationRequest_Action int32
+
+const (
+	HandleGuildInvitationRequest_ACCEPT HandleGuildInvitationRequest_Action = 0
+	HandleGuildInvitationRequest_REJECT HandleGuildInvitationRequest_Action = 1
+)
+
+// Enum value maps for HandleGuildInvitationRequest_Action.
+var (
+	HandleGuildInvitationRequest_Action_name = map[int32]string{
+		0: "ACCEPT",
+		1: "REJECT",
+	}
+	HandleGuildInvitationRequest_Action_value = map[string]int32{
+		"ACCEPT": 0,
+		"REJECT": 1,
+	}
+)
+
+func (x HandleGuildInvitationRequest_Action) Enum() *HandleGuildInvitationRequest_Action {
+	p := new(HandleGuildInvitationRequest_Action)
+	*p = x
+	return p
+}
+
+func (x HandleGuildInvitationRequest_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HandleGuildInvitationRequest_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_pb_social_proto_enumTypes[3].Descriptor()
+}
+
+func (HandleGuildInvitationRequest_Action) Type() protoreflect.EnumType {
+	return &file_internal_pb_social_proto_enumTypes[3]
+}
+
+func (x HandleGuildInvitationRequest_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HandleGuildInvitationRequest_Action.Descriptor instead.
+func (HandleGuildInvitationRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{29, 0}
+}
+
 type HandleGuildApplicationRequest_Action int32
 
 const (
@@ -202,11 +248,11 @@ func (x HandleGuildApplicationRequest_Action) String() string {
 }
 
 func (HandleGuildApplicationRequest_Action) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_pb_social_proto_enumTypes[3].Descriptor()
+	return file_internal_pb_social_proto_enumTypes[4].Descriptor()
 }
 
 func (HandleGuildApplicationRequest_Action) Type() protoreflect.EnumType {
-	return &file_internal_pb_social_proto_enumTypes[3]
+	return &file_internal_pb_social_proto_enumTypes[4]
 }
 
 func (x HandleGuildApplicationRequest_Action) Number() protoreflect.EnumNumber {
@@ -215,7 +261,7 @@ func (x HandleGuildApplicationRequest_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HandleGuildApplicationRequest_Action.Descriptor instead.
 func (HandleGuildApplicationRequest_Action) EnumDescriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{29, 0}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{33, 0}
 }
 
 // 聊天消息
@@ -1620,6 +1666,206 @@ func (x *InviteToGuildResponse) GetSuccess() bool {
 	return false
 }
 
+type GetUserGuildInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserGuildInvitationsRequest) Reset() {
+	*x = GetUserGuildInvitationsRequest{}
+	mi := &file_internal_pb_social_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserGuildInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserGuildInvitationsRequest) ProtoMessage() {}
+
+func (x *GetUserGuildInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserGuildInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserGuildInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetUserGuildInvitationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserGuildInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*GuildInvitation     `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"` // 未过期的邀请
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserGuildInvitationsResponse) Reset() {
+	*x = GetUserGuildInvitationsResponse{}
+	mi := &file_internal_pb_social_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserGuildInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserGuildInvitationsResponse) ProtoMessage() {}
+
+func (x *GetUserGuildInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserGuildInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserGuildInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetUserGuildInvitationsResponse) GetInvitations() []*GuildInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type HandleGuildInvitationRequest struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	UserId        int64                               `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 被邀请者ID
+	InvitationId  int64                               `protobuf:"varint,2,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Action        HandleGuildInvitationRequest_Action `protobuf:"varint,3,opt,name=action,proto3,enum=social.HandleGuildInvitationRequest_Action" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleGuildInvitationRequest) Reset() {
+	*x = HandleGuildInvitationRequest{}
+	mi := &file_internal_pb_social_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleGuildInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleGuildInvitationRequest) ProtoMessage() {}
+
+func (x *HandleGuildInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleGuildInvitationRequest.ProtoReflect.Descriptor instead.
+func (*HandleGuildInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HandleGuildInvitationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HandleGuildInvitationRequest) GetInvitationId() int64 {
+	if x != nil {
+		return x.InvitationId
+	}
+	return 0
+}
+
+func (x *HandleGuildInvitationRequest) GetAction() HandleGuildInvitationRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return HandleGuildInvitationRequest_ACCEPT
+}
+
+type HandleGuildInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	GuildId       int64                  `protobuf:"varint,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"` // 接受邀请后加入的帮派ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleGuildInvitationResponse) Reset() {
+	*x = HandleGuildInvitationResponse{}
+	mi := &file_internal_pb_social_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleGuildInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleGuildInvitationResponse) ProtoMessage() {}
+
+func (x *HandleGuildInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleGuildInvitationResponse.ProtoReflect.Descriptor instead.
+func (*HandleGuildInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HandleGuildInvitationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HandleGuildInvitationResponse) GetGuildId() int64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
 type GetGuildApplicationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GuildId       int64                  `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
@@ -1629,7 +1875,7 @@ type GetGuildApplicationsRequest struct {
 
 func (x *GetGuildApplicationsRequest) Reset() {
 	*x = GetGuildApplicationsRequest{}
-	mi := &file_internal_pb_social_proto_msgTypes[27]
+	mi := &file_internal_pb_social_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1641,7 +1887,7 @@ func (x *GetGuildApplicationsRequest) String() string {
 func (*GetGuildApplicationsRequest) ProtoMessage() {}
 
 func (x *GetGuildApplicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[27]
+	mi := &file_internal_pb_social_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1654,7 +1900,7 @@ func (x *GetGuildApplicationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGuildApplicationsRequest.ProtoReflect.Descriptor instead.
 func (*GetGuildApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{27}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetGuildApplicationsRequest) GetGuildId() int64 {
@@ -1673,7 +1919,7 @@ type GetGuildApplicationsResponse struct {
 
 func (x *GetGuildApplicationsResponse) Reset() {
 	*x = GetGuildApplicationsResponse{}
-	mi := &file_internal_pb_social_proto_msgTypes[28]
+	mi := &file_internal_pb_social_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1685,7 +1931,7 @@ func (x *GetGuildApplicationsResponse) String() string {
 func (*GetGuildApplicationsResponse) ProtoMessage() {}
 
 func (x *GetGuildApplicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[28]
+	mi := &file_internal_pb_social_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1698,7 +1944,7 @@ func (x *GetGuildApplicationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGuildApplicationsResponse.ProtoReflect.Descriptor instead.
 func (*GetGuildApplicationsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{28}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetGuildApplicationsResponse) GetApplications() []*GuildApplication {
@@ -1719,7 +1965,7 @@ type HandleGuildApplicationRequest struct {
 
 func (x *HandleGuildApplicationRequest) Reset() {
 	*x = HandleGuildApplicationRequest{}
-	mi := &file_internal_pb_social_proto_msgTypes[29]
+	mi := &file_internal_pb_social_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1731,7 +1977,7 @@ func (x *HandleGuildApplicationRequest) String() string {
 func (*HandleGuildApplicationRequest) ProtoMessage() {}
 
 func (x *HandleGuildApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[29]
+	mi := &file_internal_pb_social_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1744,7 +1990,7 @@ func (x *HandleGuildApplicationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandleGuildApplicationRequest.ProtoReflect.Descriptor instead.
 func (*HandleGuildApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{29}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *HandleGuildApplicationRequest) GetOperatorId() int64 {
@@ -1777,7 +2023,7 @@ type HandleGuildApplicationResponse struct {
 
 func (x *HandleGuildApplicationResponse) Reset() {
 	*x = HandleGuildApplicationResponse{}
-	mi := &file_internal_pb_social_proto_msgTypes[30]
+	mi := &file_internal_pb_social_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1789,7 +2035,7 @@ func (x *HandleGuildApplicationResponse) String() string {
 func (*HandleGuildApplicationResponse) ProtoMessage() {}
 
 func (x *HandleGuildApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[30]
+	mi := &file_internal_pb_social_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1802,7 +2048,7 @@ func (x *HandleGuildApplicationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandleGuildApplicationResponse.ProtoReflect.Descriptor instead.
 func (*HandleGuildApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{30}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *HandleGuildApplicationResponse) GetSuccess() bool {
@@ -1823,7 +2069,7 @@ type KickGuildMemberRequest struct {
 
 func (x *KickGuildMemberRequest) Reset() {
 	*x = KickGuildMemberRequest{}
-	mi := &file_internal_pb_social_proto_msgTypes[31]
+	mi := &file_internal_pb_social_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1835,7 +2081,7 @@ func (x *KickGuildMemberRequest) String() string {
 func (*KickGuildMemberRequest) ProtoMessage() {}
 
 func (x *KickGuildMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[31]
+	mi := &file_internal_pb_social_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1848,7 +2094,7 @@ func (x *KickGuildMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KickGuildMemberRequest.ProtoReflect.Descriptor instead.
 func (*KickGuildMemberRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{31}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *KickGuildMemberRequest) GetOperatorId() int64 {
@@ -1881,7 +2127,7 @@ type KickGuildMemberResponse struct {
 
 func (x *KickGuildMemberResponse) Reset() {
 	*x = KickGuildMemberResponse{}
-	mi := &file_internal_pb_social_proto_msgTypes[32]
+	mi := &file_internal_pb_social_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1893,7 +2139,7 @@ func (x *KickGuildMemberResponse) String() string {
 func (*KickGuildMemberResponse) ProtoMessage() {}
 
 func (x *KickGuildMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[32]
+	mi := &file_internal_pb_social_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1906,7 +2152,7 @@ func (x *KickGuildMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KickGuildMemberResponse.ProtoReflect.Descriptor instead.
 func (*KickGuildMemberResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{32}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *KickGuildMemberResponse) GetSuccess() bool {
@@ -1928,7 +2174,7 @@ type ChangeMemberRoleRequest struct {
 
 func (x *ChangeMemberRoleRequest) Reset() {
 	*x = ChangeMemberRoleRequest{}
-	mi := &file_internal_pb_social_proto_msgTypes[33]
+	mi := &file_internal_pb_social_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1940,7 +2186,7 @@ func (x *ChangeMemberRoleRequest) String() string {
 func (*ChangeMemberRoleRequest) ProtoMessage() {}
 
 func (x *ChangeMemberRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[33]
+	mi := &file_internal_pb_social_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1953,7 +2199,7 @@ func (x *ChangeMemberRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeMemberRoleRequest.ProtoReflect.Descriptor instead.
 func (*ChangeMemberRoleRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{33}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ChangeMemberRoleRequest) GetOperatorId() int64 {
@@ -1993,7 +2239,7 @@ type ChangeMemberRoleResponse struct {
 
 func (x *ChangeMemberRoleResponse) Reset() {
 	*x = ChangeMemberRoleResponse{}
-	mi := &file_internal_pb_social_proto_msgTypes[34]
+	mi := &file_internal_pb_social_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2005,7 +2251,7 @@ func (x *ChangeMemberRoleResponse) String() string {
 func (*ChangeMemberRoleResponse) ProtoMessage() {}
 
 func (x *ChangeMemberRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[34]
+	mi := &file_internal_pb_social_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,7 +2264,7 @@ func (x *ChangeMemberRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeMemberRoleResponse.ProtoReflect.Descriptor instead.
 func (*ChangeMemberRoleResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{34}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ChangeMemberRoleResponse) GetSuccess() bool {
@@ -2028,29 +2274,30 @@ func (x *ChangeMemberRoleResponse) GetSuccess() bool {
 	return false
 }
 
-type TransferGuildMasterRequest struct {
+type UpdateGuildAnnouncementRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoleId        int64                  `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	NewMasterId   int64                  `protobuf:"varint,2,opt,name=new_master_id,json=newMasterId,proto3" json:"new_master_id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作者ID
+	GuildId       int64                  `protobuf:"varint,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Announcement  string                 `protobuf:"bytes,3,opt,name=announcement,proto3" json:"announcement,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferGuildMasterRequest) Reset() {
-	*x = TransferGuildMasterRequest{}
-	mi := &file_internal_pb_social_proto_msgTypes[35]
+func (x *UpdateGuildAnnouncementRequest) Reset() {
+	*x = UpdateGuildAnnouncementRequest{}
+	mi := &file_internal_pb_social_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferGuildMasterRequest) String() string {
+func (x *UpdateGuildAnnouncementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferGuildMasterRequest) ProtoMessage() {}
+func (*UpdateGuildAnnouncementRequest) ProtoMessage() {}
 
-func (x *TransferGuildMasterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[35]
+func (x *UpdateGuildAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2061,14 +2308,117 @@ func (x *TransferGuildMasterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferGuildMasterRequest.ProtoReflect.Descriptor instead.
-func (*TransferGuildMasterRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use UpdateGuildAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGuildAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *TransferGuildMasterRequest) GetRoleId() int64 {
+func (x *UpdateGuildAnnouncementRequest) GetOperatorId() int64 {
 	if x != nil {
-		return x.RoleId
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *UpdateGuildAnnouncementRequest) GetGuildId() int64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *UpdateGuildAnnouncementRequest) GetAnnouncement() string {
+	if x != nil {
+		return x.Announcement
+	}
+	return ""
+}
+
+type UpdateGuildAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGuildAnnouncementResponse) Reset() {
+	*x = UpdateGuildAnnouncementResponse{}
+	mi := &file_internal_pb_social_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGuildAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuildAnnouncementResponse) ProtoMessage() {}
+
+func (x *UpdateGuildAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGuildAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGuildAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateGuildAnnouncementResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type TransferGuildMasterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        int64                  `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	NewMasterId   int64                  `protobuf:"varint,2,opt,name=new_master_id,json=newMasterId,proto3" json:"new_master_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferGuildMasterRequest) Reset() {
+	*x = TransferGuildMasterRequest{}
+	mi := &file_internal_pb_social_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferGuildMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferGuildMasterRequest) ProtoMessage() {}
+
+func (x *TransferGuildMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferGuildMasterRequest.ProtoReflect.Descriptor instead.
+func (*TransferGuildMasterRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TransferGuildMasterRequest) GetRoleId() int64 {
+	if x != nil {
+		return x.RoleId
 	}
 	return 0
 }
@@ -2089,7 +2439,7 @@ type TransferGuildMasterResponse struct {
 
 func (x *TransferGuildMasterResponse) Reset() {
 	*x = TransferGuildMasterResponse{}
-	mi := &file_internal_pb_social_proto_msgTypes[36]
+	mi := &file_internal_pb_social_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2101,7 +2451,7 @@ func (x *TransferGuildMasterResponse) String() string {
 func (*TransferGuildMasterResponse) ProtoMessage() {}
 
 func (x *TransferGuildMasterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[36]
+	mi := &file_internal_pb_social_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2114,7 +2464,7 @@ func (x *TransferGuildMasterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferGuildMasterResponse.ProtoReflect.Descriptor instead.
 func (*TransferGuildMasterResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{36}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *TransferGuildMasterResponse) GetSuccess() bool {
@@ -2133,7 +2483,7 @@ type DisbandGuildRequest struct {
 
 func (x *DisbandGuildRequest) Reset() {
 	*x = DisbandGuildRequest{}
-	mi := &file_internal_pb_social_proto_msgTypes[37]
+	mi := &file_internal_pb_social_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2145,7 +2495,7 @@ func (x *DisbandGuildRequest) String() string {
 func (*DisbandGuildRequest) ProtoMessage() {}
 
 func (x *DisbandGuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[37]
+	mi := &file_internal_pb_social_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2158,7 +2508,7 @@ func (x *DisbandGuildRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisbandGuildRequest.ProtoReflect.Descriptor instead.
 func (*DisbandGuildRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{37}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *DisbandGuildRequest) GetRoleId() int64 {
@@ -2177,7 +2527,7 @@ type DisbandGuildResponse struct {
 
 func (x *DisbandGuildResponse) Reset() {
 	*x = DisbandGuildResponse{}
-	mi := &file_internal_pb_social_proto_msgTypes[38]
+	mi := &file_internal_pb_social_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2189,7 +2539,7 @@ func (x *DisbandGuildResponse) String() string {
 func (*DisbandGuildResponse) ProtoMessage() {}
 
 func (x *DisbandGuildResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[38]
+	mi := &file_internal_pb_social_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2202,7 +2552,7 @@ func (x *DisbandGuildResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisbandGuildResponse.ProtoReflect.Descriptor instead.
 func (*DisbandGuildResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{38}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *DisbandGuildResponse) GetSuccess() bool {
@@ -2221,7 +2571,7 @@ type LeaveGuildRequest struct {
 
 func (x *LeaveGuildRequest) Reset() {
 	*x = LeaveGuildRequest{}
-	mi := &file_internal_pb_social_proto_msgTypes[39]
+	mi := &file_internal_pb_social_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2233,7 +2583,7 @@ func (x *LeaveGuildRequest) String() string {
 func (*LeaveGuildRequest) ProtoMessage() {}
 
 func (x *LeaveGuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[39]
+	mi := &file_internal_pb_social_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2246,7 +2596,7 @@ func (x *LeaveGuildRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveGuildRequest.ProtoReflect.Descriptor instead.
 func (*LeaveGuildRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{39}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *LeaveGuildRequest) GetRoleId() int64 {
@@ -2265,7 +2615,7 @@ type LeaveGuildResponse struct {
 
 func (x *LeaveGuildResponse) Reset() {
 	*x = LeaveGuildResponse{}
-	mi := &file_internal_pb_social_proto_msgTypes[40]
+	mi := &file_internal_pb_social_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2277,7 +2627,7 @@ func (x *LeaveGuildResponse) String() string {
 func (*LeaveGuildResponse) ProtoMessage() {}
 
 func (x *LeaveGuildResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[40]
+	mi := &file_internal_pb_social_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2290,7 +2640,7 @@ func (x *LeaveGuildResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveGuildResponse.ProtoReflect.Descriptor instead.
 func (*LeaveGuildResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{40}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *LeaveGuildResponse) GetSuccess() bool {
@@ -2311,7 +2661,7 @@ type GetGuildListRequest struct {
 
 func (x *GetGuildListRequest) Reset() {
 	*x = GetGuildListRequest{}
-	mi := &file_internal_pb_social_proto_msgTypes[41]
+	mi := &file_internal_pb_social_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2323,7 +2673,7 @@ func (x *GetGuildListRequest) String() string {
 func (*GetGuildListRequest) ProtoMessage() {}
 
 func (x *GetGuildListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[41]
+	mi := &file_internal_pb_social_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2336,7 +2686,7 @@ func (x *GetGuildListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGuildListRequest.ProtoReflect.Descriptor instead.
 func (*GetGuildListRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{41}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetGuildListRequest) GetPage() int32 {
@@ -2366,7 +2716,7 @@ type GetGuildListResponse struct {
 
 func (x *GetGuildListResponse) Reset() {
 	*x = GetGuildListResponse{}
-	mi := &file_internal_pb_social_proto_msgTypes[42]
+	mi := &file_internal_pb_social_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2378,7 +2728,7 @@ func (x *GetGuildListResponse) String() string {
 func (*GetGuildListResponse) ProtoMessage() {}
 
 func (x *GetGuildListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[42]
+	mi := &file_internal_pb_social_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2391,7 +2741,7 @@ func (x *GetGuildListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGuildListResponse.ProtoReflect.Descriptor instead.
 func (*GetGuildListResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{42}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetGuildListResponse) GetGuilds() []*GuildInfo {
@@ -2422,6 +2772,242 @@ func (x *GetGuildListResponse) GetPageSize() int32 {
 	return 0
 }
 
+// 帮派捐献请求
+type DonateToGuildRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
+	GuildId       int64                  `protobuf:"varint,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`    // 帮派ID
+	DonateId      int64                  `protobuf:"varint,3,opt,name=donate_id,json=donateId,proto3" json:"donate_id,omitempty"` // 捐献项ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DonateToGuildRequest) Reset() {
+	*x = DonateToGuildRequest{}
+	mi := &file_internal_pb_social_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DonateToGuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DonateToGuildRequest) ProtoMessage() {}
+
+func (x *DonateToGuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DonateToGuildRequest.ProtoReflect.Descriptor instead.
+func (*DonateToGuildRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DonateToGuildRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DonateToGuildRequest) GetGuildId() int64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *DonateToGuildRequest) GetDonateId() int64 {
+	if x != nil {
+		return x.DonateId
+	}
+	return 0
+}
+
+// 帮派捐献响应
+type DonateToGuildResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                     // 是否成功
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                      // 消息
+	GuildLevel     int32                  `protobuf:"varint,3,opt,name=guild_level,json=guildLevel,proto3" json:"guild_level,omitempty"`             // 捐献后的帮派等级
+	GuildExp       int64                  `protobuf:"varint,4,opt,name=guild_exp,json=guildExp,proto3" json:"guild_exp,omitempty"`                   // 捐献后的帮派累计经验
+	RemainingTimes int32                  `protobuf:"varint,5,opt,name=remaining_times,json=remainingTimes,proto3" json:"remaining_times,omitempty"` // 该捐献项今日剩余次数，不限次数为-1
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DonateToGuildResponse) Reset() {
+	*x = DonateToGuildResponse{}
+	mi := &file_internal_pb_social_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DonateToGuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DonateToGuildResponse) ProtoMessage() {}
+
+func (x *DonateToGuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DonateToGuildResponse.ProtoReflect.Descriptor instead.
+func (*DonateToGuildResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DonateToGuildResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DonateToGuildResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DonateToGuildResponse) GetGuildLevel() int32 {
+	if x != nil {
+		return x.GuildLevel
+	}
+	return 0
+}
+
+func (x *DonateToGuildResponse) GetGuildExp() int64 {
+	if x != nil {
+		return x.GuildExp
+	}
+	return 0
+}
+
+func (x *DonateToGuildResponse) GetRemainingTimes() int32 {
+	if x != nil {
+		return x.RemainingTimes
+	}
+	return 0
+}
+
+// 获取帮派捐献记录请求
+type GetGuildDonationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       int64                  `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"` // 帮派ID
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                    // 返回条数，默认50，最多100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuildDonationsRequest) Reset() {
+	*x = GetGuildDonationsRequest{}
+	mi := &file_internal_pb_social_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuildDonationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuildDonationsRequest) ProtoMessage() {}
+
+func (x *GetGuildDonationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuildDonationsRequest.ProtoReflect.Descriptor instead.
+func (*GetGuildDonationsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetGuildDonationsRequest) GetGuildId() int64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *GetGuildDonationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 获取帮派捐献记录响应
+type GetGuildDonationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Donations     []*GuildDonation       `protobuf:"bytes,1,rep,name=donations,proto3" json:"donations,omitempty"` // 捐献记录，按时间倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuildDonationsResponse) Reset() {
+	*x = GetGuildDonationsResponse{}
+	mi := &file_internal_pb_social_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuildDonationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuildDonationsResponse) ProtoMessage() {}
+
+func (x *GetGuildDonationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuildDonationsResponse.ProtoReflect.Descriptor instead.
+func (*GetGuildDonationsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetGuildDonationsResponse) GetDonations() []*GuildDonation {
+	if x != nil {
+		return x.Donations
+	}
+	return nil
+}
+
 // 帮派信息
 type GuildInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2433,13 +3019,17 @@ type GuildInfo struct {
 	MasterId      int64                  `protobuf:"varint,6,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
 	MemberCount   int32                  `protobuf:"varint,7,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
 	MaxMembers    int32                  `protobuf:"varint,8,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	Level         int32                  `protobuf:"varint,9,opt,name=level,proto3" json:"level,omitempty"`                                      // 帮派等级
+	Exp           int64                  `protobuf:"varint,10,opt,name=exp,proto3" json:"exp,omitempty"`                                         // 累计经验
+	NextLevelExp  int64                  `protobuf:"varint,11,opt,name=next_level_exp,json=nextLevelExp,proto3" json:"next_level_exp,omitempty"` // 升到下一级所需的累计经验，已满级为0
+	PerkIds       []int64                `protobuf:"varint,12,rep,packed,name=perk_ids,json=perkIds,proto3" json:"perk_ids,omitempty"`           // 已解锁的帮派福利ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GuildInfo) Reset() {
 	*x = GuildInfo{}
-	mi := &file_internal_pb_social_proto_msgTypes[43]
+	mi := &file_internal_pb_social_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2451,7 +3041,7 @@ func (x *GuildInfo) String() string {
 func (*GuildInfo) ProtoMessage() {}
 
 func (x *GuildInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[43]
+	mi := &file_internal_pb_social_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2464,7 +3054,7 @@ func (x *GuildInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GuildInfo.ProtoReflect.Descriptor instead.
 func (*GuildInfo) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{43}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GuildInfo) GetId() int64 {
@@ -2523,6 +3113,34 @@ func (x *GuildInfo) GetMaxMembers() int32 {
 	return 0
 }
 
+func (x *GuildInfo) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetExp() int64 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetNextLevelExp() int64 {
+	if x != nil {
+		return x.NextLevelExp
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetPerkIds() []int64 {
+	if x != nil {
+		return x.PerkIds
+	}
+	return nil
+}
+
 // 帮派成员
 type GuildMember struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -2531,13 +3149,14 @@ type GuildMember struct {
 	Role           GuildRole              `protobuf:"varint,3,opt,name=role,proto3,enum=social.GuildRole" json:"role,omitempty"`
 	JoinTime       int64                  `protobuf:"varint,4,opt,name=join_time,json=joinTime,proto3" json:"join_time,omitempty"`
 	LastActiveTime int64                  `protobuf:"varint,5,opt,name=last_active_time,json=lastActiveTime,proto3" json:"last_active_time,omitempty"`
+	Contribution   int64                  `protobuf:"varint,6,opt,name=contribution,proto3" json:"contribution,omitempty"` // 累计贡献
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GuildMember) Reset() {
 	*x = GuildMember{}
-	mi := &file_internal_pb_social_proto_msgTypes[44]
+	mi := &file_internal_pb_social_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2549,7 +3168,7 @@ func (x *GuildMember) String() string {
 func (*GuildMember) ProtoMessage() {}
 
 func (x *GuildMember) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[44]
+	mi := &file_internal_pb_social_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2562,7 +3181,7 @@ func (x *GuildMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GuildMember.ProtoReflect.Descriptor instead.
 func (*GuildMember) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{44}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GuildMember) GetUserId() int64 {
@@ -2600,6 +3219,98 @@ func (x *GuildMember) GetLastActiveTime() int64 {
 	return 0
 }
 
+func (x *GuildMember) GetContribution() int64 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+// 帮派捐献记录
+type GuildDonation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DonateId      int64                  `protobuf:"varint,3,opt,name=donate_id,json=donateId,proto3" json:"donate_id,omitempty"` // 捐献项ID
+	Exp           int64                  `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`                           // 增加的帮派经验
+	Contribution  int64                  `protobuf:"varint,5,opt,name=contribution,proto3" json:"contribution,omitempty"`         // 增加的个人贡献
+	Time          int64                  `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`                         // 捐献时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildDonation) Reset() {
+	*x = GuildDonation{}
+	mi := &file_internal_pb_social_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildDonation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildDonation) ProtoMessage() {}
+
+func (x *GuildDonation) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildDonation.ProtoReflect.Descriptor instead.
+func (*GuildDonation) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GuildDonation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GuildDonation) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GuildDonation) GetDonateId() int64 {
+	if x != nil {
+		return x.DonateId
+	}
+	return 0
+}
+
+func (x *GuildDonation) GetExp() int64 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *GuildDonation) GetContribution() int64 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+func (x *GuildDonation) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
 // 帮派申请
 type GuildApplication struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2614,7 +3325,7 @@ type GuildApplication struct {
 
 func (x *GuildApplication) Reset() {
 	*x = GuildApplication{}
-	mi := &file_internal_pb_social_proto_msgTypes[45]
+	mi := &file_internal_pb_social_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2626,7 +3337,7 @@ func (x *GuildApplication) String() string {
 func (*GuildApplication) ProtoMessage() {}
 
 func (x *GuildApplication) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[45]
+	mi := &file_internal_pb_social_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2639,7 +3350,7 @@ func (x *GuildApplication) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GuildApplication.ProtoReflect.Descriptor instead.
 func (*GuildApplication) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{45}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GuildApplication) GetId() int64 {
@@ -2677,6 +3388,91 @@ func (x *GuildApplication) GetApplyTime() int64 {
 	return 0
 }
 
+// 帮派邀请
+type GuildInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GuildId       int64                  `protobuf:"varint,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	GuildName     string                 `protobuf:"bytes,3,opt,name=guild_name,json=guildName,proto3" json:"guild_name,omitempty"`
+	InviterId     int64                  `protobuf:"varint,4,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
+	InviteTime    int64                  `protobuf:"varint,5,opt,name=invite_time,json=inviteTime,proto3" json:"invite_time,omitempty"`
+	ExpireTime    int64                  `protobuf:"varint,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildInvitation) Reset() {
+	*x = GuildInvitation{}
+	mi := &file_internal_pb_social_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildInvitation) ProtoMessage() {}
+
+func (x *GuildInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_social_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildInvitation.ProtoReflect.Descriptor instead.
+func (*GuildInvitation) Descriptor() ([]byte, []int) {
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GuildInvitation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GuildInvitation) GetGuildId() int64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *GuildInvitation) GetGuildName() string {
+	if x != nil {
+		return x.GuildName
+	}
+	return ""
+}
+
+func (x *GuildInvitation) GetInviterId() int64 {
+	if x != nil {
+		return x.InviterId
+	}
+	return 0
+}
+
+func (x *GuildInvitation) GetInviteTime() int64 {
+	if x != nil {
+		return x.InviteTime
+	}
+	return 0
+}
+
+func (x *GuildInvitation) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
 type FriendInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -2687,7 +3483,7 @@ type FriendInfo struct {
 
 func (x *FriendInfo) Reset() {
 	*x = FriendInfo{}
-	mi := &file_internal_pb_social_proto_msgTypes[46]
+	mi := &file_internal_pb_social_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2699,7 +3495,7 @@ func (x *FriendInfo) String() string {
 func (*FriendInfo) ProtoMessage() {}
 
 func (x *FriendInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[46]
+	mi := &file_internal_pb_social_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2712,7 +3508,7 @@ func (x *FriendInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendInfo.ProtoReflect.Descriptor instead.
 func (*FriendInfo) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{46}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *FriendInfo) GetUserId() int64 {
@@ -2741,7 +3537,7 @@ type FriendRequestInfo struct {
 
 func (x *FriendRequestInfo) Reset() {
 	*x = FriendRequestInfo{}
-	mi := &file_internal_pb_social_proto_msgTypes[47]
+	mi := &file_internal_pb_social_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2753,7 +3549,7 @@ func (x *FriendRequestInfo) String() string {
 func (*FriendRequestInfo) ProtoMessage() {}
 
 func (x *FriendRequestInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_social_proto_msgTypes[47]
+	mi := &file_internal_pb_social_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2766,7 +3562,7 @@ func (x *FriendRequestInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendRequestInfo.ProtoReflect.Descriptor instead.
 func (*FriendRequestInfo) Descriptor() ([]byte, []int) {
-	return file_internal_pb_social_proto_rawDescGZIP(), []int{47}
+	return file_internal_pb_social_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *FriendRequestInfo) GetRequestId() int64 {
@@ -2903,7 +3699,23 @@ const file_internal_pb_social_proto_rawDesc = "" +
 	"invitee_id\x18\x02 \x01(\x03R\tinviteeId\x12\x19\n" +
 	"\bguild_id\x18\x03 \x01(\x03R\aguildId\"1\n" +
 	"\x15InviteToGuildResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"8\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"9\n" +
+	"\x1eGetUserGuildInvitationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\\\n" +
+	"\x1fGetUserGuildInvitationsResponse\x129\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x17.social.GuildInvitationR\vinvitations\"\xc3\x01\n" +
+	"\x1cHandleGuildInvitationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rinvitation_id\x18\x02 \x01(\x03R\finvitationId\x12C\n" +
+	"\x06action\x18\x03 \x01(\x0e2+.social.HandleGuildInvitationRequest.ActionR\x06action\" \n" +
+	"\x06Action\x12\n" +
+	"\n" +
+	"\x06ACCEPT\x10\x00\x12\n" +
+	"\n" +
+	"\x06REJECT\x10\x01\"T\n" +
+	"\x1dHandleGuildInvitationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\x03R\aguildId\"8\n" +
 	"\x1bGetGuildApplicationsRequest\x12\x19\n" +
 	"\bguild_id\x18\x01 \x01(\x03R\aguildId\"\\\n" +
 	"\x1cGetGuildApplicationsResponse\x12<\n" +
@@ -2934,6 +3746,13 @@ const file_internal_pb_social_proto_rawDesc = "" +
 	"\bguild_id\x18\x03 \x01(\x03R\aguildId\x12,\n" +
 	"\bnew_role\x18\x04 \x01(\x0e2\x11.social.GuildRoleR\anewRole\"4\n" +
 	"\x18ChangeMemberRoleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x80\x01\n" +
+	"\x1eUpdateGuildAnnouncementRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\x03R\aguildId\x12\"\n" +
+	"\fannouncement\x18\x03 \x01(\tR\fannouncement\";\n" +
+	"\x1fUpdateGuildAnnouncementResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"Y\n" +
 	"\x1aTransferGuildMasterRequest\x12\x17\n" +
 	"\arole_id\x18\x01 \x01(\x03R\x06roleId\x12\"\n" +
@@ -2955,7 +3774,23 @@ const file_internal_pb_social_proto_rawDesc = "" +
 	"\x06guilds\x18\x01 \x03(\v2\x11.social.GuildInfoR\x06guilds\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xf5\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"g\n" +
+	"\x14DonateToGuildRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\x03R\aguildId\x12\x1b\n" +
+	"\tdonate_id\x18\x03 \x01(\x03R\bdonateId\"\xb2\x01\n" +
+	"\x15DonateToGuildResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\vguild_level\x18\x03 \x01(\x05R\n" +
+	"guildLevel\x12\x1b\n" +
+	"\tguild_exp\x18\x04 \x01(\x03R\bguildExp\x12'\n" +
+	"\x0fremaining_times\x18\x05 \x01(\x05R\x0eremainingTimes\"K\n" +
+	"\x18GetGuildDonationsRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\x03R\aguildId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"P\n" +
+	"\x19GetGuildDonationsResponse\x123\n" +
+	"\tdonations\x18\x01 \x03(\v2\x15.social.GuildDonationR\tdonations\"\xde\x02\n" +
 	"\tGuildInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -2966,20 +3801,44 @@ const file_internal_pb_social_proto_rawDesc = "" +
 	"\tmaster_id\x18\x06 \x01(\x03R\bmasterId\x12!\n" +
 	"\fmember_count\x18\a \x01(\x05R\vmemberCount\x12\x1f\n" +
 	"\vmax_members\x18\b \x01(\x05R\n" +
-	"maxMembers\"\xb0\x01\n" +
+	"maxMembers\x12\x14\n" +
+	"\x05level\x18\t \x01(\x05R\x05level\x12\x10\n" +
+	"\x03exp\x18\n" +
+	" \x01(\x03R\x03exp\x12$\n" +
+	"\x0enext_level_exp\x18\v \x01(\x03R\fnextLevelExp\x12\x19\n" +
+	"\bperk_ids\x18\f \x03(\x03R\aperkIds\"\xd4\x01\n" +
 	"\vGuildMember\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12%\n" +
 	"\x04role\x18\x03 \x01(\x0e2\x11.social.GuildRoleR\x04role\x12\x1b\n" +
 	"\tjoin_time\x18\x04 \x01(\x03R\bjoinTime\x12(\n" +
-	"\x10last_active_time\x18\x05 \x01(\x03R\x0elastActiveTime\"\x91\x01\n" +
+	"\x10last_active_time\x18\x05 \x01(\x03R\x0elastActiveTime\x12\"\n" +
+	"\fcontribution\x18\x06 \x01(\x03R\fcontribution\"\x9f\x01\n" +
+	"\rGuildDonation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tdonate_id\x18\x03 \x01(\x03R\bdonateId\x12\x10\n" +
+	"\x03exp\x18\x04 \x01(\x03R\x03exp\x12\"\n" +
+	"\fcontribution\x18\x05 \x01(\x03R\fcontribution\x12\x12\n" +
+	"\x04time\x18\x06 \x01(\x03R\x04time\"\x91\x01\n" +
 	"\x10GuildApplication\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x12\x19\n" +
 	"\bguild_id\x18\x04 \x01(\x03R\aguildId\x12\x1d\n" +
 	"\n" +
-	"apply_time\x18\x05 \x01(\x03R\tapplyTime\"A\n" +
+	"apply_time\x18\x05 \x01(\x03R\tapplyTime\"\xbc\x01\n" +
+	"\x0fGuildInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\x03R\aguildId\x12\x1d\n" +
+	"\n" +
+	"guild_name\x18\x03 \x01(\tR\tguildName\x12\x1d\n" +
+	"\n" +
+	"inviter_id\x18\x04 \x01(\x03R\tinviterId\x12\x1f\n" +
+	"\vinvite_time\x18\x05 \x01(\x03R\n" +
+	"inviteTime\x12\x1f\n" +
+	"\vexpire_time\x18\x06 \x01(\x03R\n" +
+	"expireTime\"A\n" +
 	"\n" +
 	"FriendInfo\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
@@ -3002,7 +3861,7 @@ const file_internal_pb_social_proto_rawDesc = "" +
 	"\n" +
 	"\x06MEMBER\x10\x04\x12\x0e\n" +
 	"\n" +
-	"APPRENTICE\x10\x052\x8e\x0e\n" +
+	"APPRENTICE\x10\x052\xf4\x11\n" +
 	"\rSocialService\x12L\n" +
 	"\rGetFriendList\x12\x1c.social.GetFriendListRequest\x1a\x1d.social.GetFriendListResponse\x12X\n" +
 	"\x11SendFriendRequest\x12 .social.SendFriendRequestRequest\x1a!.social.SendFriendRequestResponse\x12a\n" +
@@ -3014,16 +3873,21 @@ const file_internal_pb_social_proto_rawDesc = "" +
 	"\fGetGuildInfo\x12\x1b.social.GetGuildInfoRequest\x1a\x1c.social.GetGuildInfoResponse\x12R\n" +
 	"\x0fGetGuildMembers\x12\x1e.social.GetGuildMembersRequest\x1a\x1f.social.GetGuildMembersResponse\x12I\n" +
 	"\fApplyToGuild\x12\x1b.social.ApplyToGuildRequest\x1a\x1c.social.ApplyToGuildResponse\x12L\n" +
-	"\rInviteToGuild\x12\x1c.social.InviteToGuildRequest\x1a\x1d.social.InviteToGuildResponse\x12a\n" +
+	"\rInviteToGuild\x12\x1c.social.InviteToGuildRequest\x1a\x1d.social.InviteToGuildResponse\x12j\n" +
+	"\x17GetUserGuildInvitations\x12&.social.GetUserGuildInvitationsRequest\x1a'.social.GetUserGuildInvitationsResponse\x12d\n" +
+	"\x15HandleGuildInvitation\x12$.social.HandleGuildInvitationRequest\x1a%.social.HandleGuildInvitationResponse\x12a\n" +
 	"\x14GetGuildApplications\x12#.social.GetGuildApplicationsRequest\x1a$.social.GetGuildApplicationsResponse\x12g\n" +
 	"\x16HandleGuildApplication\x12%.social.HandleGuildApplicationRequest\x1a&.social.HandleGuildApplicationResponse\x12R\n" +
 	"\x0fKickGuildMember\x12\x1e.social.KickGuildMemberRequest\x1a\x1f.social.KickGuildMemberResponse\x12U\n" +
-	"\x10ChangeMemberRole\x12\x1f.social.ChangeMemberRoleRequest\x1a .social.ChangeMemberRoleResponse\x12^\n" +
+	"\x10ChangeMemberRole\x12\x1f.social.ChangeMemberRoleRequest\x1a .social.ChangeMemberRoleResponse\x12j\n" +
+	"\x17UpdateGuildAnnouncement\x12&.social.UpdateGuildAnnouncementRequest\x1a'.social.UpdateGuildAnnouncementResponse\x12^\n" +
 	"\x13TransferGuildMaster\x12\".social.TransferGuildMasterRequest\x1a#.social.TransferGuildMasterResponse\x12I\n" +
 	"\fDisbandGuild\x12\x1b.social.DisbandGuildRequest\x1a\x1c.social.DisbandGuildResponse\x12C\n" +
 	"\n" +
 	"LeaveGuild\x12\x19.social.LeaveGuildRequest\x1a\x1a.social.LeaveGuildResponse\x12I\n" +
-	"\fGetGuildList\x12\x1b.social.GetGuildListRequest\x1a\x1c.social.GetGuildListResponse\x12R\n" +
+	"\fGetGuildList\x12\x1b.social.GetGuildListRequest\x1a\x1c.social.GetGuildListResponse\x12L\n" +
+	"\rDonateToGuild\x12\x1c.social.DonateToGuildRequest\x1a\x1d.social.DonateToGuildResponse\x12X\n" +
+	"\x11GetGuildDonations\x12 .social.GetGuildDonationsRequest\x1a!.social.GetGuildDonationsResponse\x12R\n" +
 	"\x0fSendChatMessage\x12\x1e.social.SendChatMessageRequest\x1a\x1f.social.SendChatMessageResponse\x12R\n" +
 	"\x0fGetChatMessages\x12\x1e.social.GetChatMessagesRequest\x1a\x1f.social.GetChatMessagesResponseB\rZ\vinternal/pbb\x06proto3"
 
@@ -3039,122 +3903,148 @@ func file_internal_pb_social_proto_rawDescGZIP() []byte {
 	return file_internal_pb_social_proto_rawDescData
 }
 
-var file_internal_pb_social_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_internal_pb_social_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_internal_pb_social_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_internal_pb_social_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_internal_pb_social_proto_goTypes = []any{
 	(GuildRole)(0),                              // 0: social.GuildRole
 	(HandleFriendRequestRequest_Action)(0),      // 1: social.HandleFriendRequestRequest.Action
 	(BatchHandleFriendRequestRequest_Action)(0), // 2: social.BatchHandleFriendRequestRequest.Action
-	(HandleGuildApplicationRequest_Action)(0),   // 3: social.HandleGuildApplicationRequest.Action
-	(*ChatMessage)(nil),                         // 4: social.ChatMessage
-	(*SendChatMessageRequest)(nil),              // 5: social.SendChatMessageRequest
-	(*SendChatMessageResponse)(nil),             // 6: social.SendChatMessageResponse
-	(*GetChatMessagesRequest)(nil),              // 7: social.GetChatMessagesRequest
-	(*GetChatMessagesResponse)(nil),             // 8: social.GetChatMessagesResponse
-	(*GetFriendListRequest)(nil),                // 9: social.GetFriendListRequest
-	(*GetFriendListResponse)(nil),               // 10: social.GetFriendListResponse
-	(*SendFriendRequestRequest)(nil),            // 11: social.SendFriendRequestRequest
-	(*SendFriendRequestResponse)(nil),           // 12: social.SendFriendRequestResponse
-	(*GetFriendRequestListRequest)(nil),         // 13: social.GetFriendRequestListRequest
-	(*GetFriendRequestListResponse)(nil),        // 14: social.GetFriendRequestListResponse
-	(*HandleFriendRequestRequest)(nil),          // 15: social.HandleFriendRequestRequest
-	(*HandleFriendRequestResponse)(nil),         // 16: social.HandleFriendRequestResponse
-	(*BatchHandleFriendRequestRequest)(nil),     // 17: social.BatchHandleFriendRequestRequest
-	(*BatchHandleFriendRequestResponse)(nil),    // 18: social.BatchHandleFriendRequestResponse
-	(*DeleteFriendRequest)(nil),                 // 19: social.DeleteFriendRequest
-	(*DeleteFriendResponse)(nil),                // 20: social.DeleteFriendResponse
-	(*CreateGuildRequest)(nil),                  // 21: social.CreateGuildRequest
-	(*CreateGuildResponse)(nil),                 // 22: social.CreateGuildResponse
-	(*GetGuildInfoRequest)(nil),                 // 23: social.GetGuildInfoRequest
-	(*GetGuildInfoResponse)(nil),                // 24: social.GetGuildInfoResponse
-	(*GetGuildMembersRequest)(nil),              // 25: social.GetGuildMembersRequest
-	(*GetGuildMembersResponse)(nil),             // 26: social.GetGuildMembersResponse
-	(*ApplyToGuildRequest)(nil),                 // 27: social.ApplyToGuildRequest
-	(*ApplyToGuildResponse)(nil),                // 28: social.ApplyToGuildResponse
-	(*InviteToGuildRequest)(nil),                // 29: social.InviteToGuildRequest
-	(*InviteToGuildResponse)(nil),               // 30: social.InviteToGuildResponse
-	(*GetGuildApplicationsRequest)(nil),         // 31: social.GetGuildApplicationsRequest
-	(*GetGuildApplicationsResponse)(nil),        // 32: social.GetGuildApplicationsResponse
-	(*HandleGuildApplicationRequest)(nil),       // 33: social.HandleGuildApplicationRequest
-	(*HandleGuildApplicationResponse)(nil),      // 34: social.HandleGuildApplicationResponse
-	(*KickGuildMemberRequest)(nil),              // 35: social.KickGuildMemberRequest
-	(*KickGuildMemberResponse)(nil),             // 36: social.KickGuildMemberResponse
-	(*ChangeMemberRoleRequest)(nil),             // 37: social.ChangeMemberRoleRequest
-	(*ChangeMemberRoleResponse)(nil),            // 38: social.ChangeMemberRoleResponse
-	(*TransferGuildMasterRequest)(nil),          // 39: social.TransferGuildMasterRequest
-	(*TransferGuildMasterResponse)(nil),         // 40: social.TransferGuildMasterResponse
-	(*DisbandGuildRequest)(nil),                 // 41: social.DisbandGuildRequest
-	(*DisbandGuildResponse)(nil),                // 42: social.DisbandGuildResponse
-	(*LeaveGuildRequest)(nil),                   // 43: social.LeaveGuildRequest
-	(*LeaveGuildResponse)(nil),                  // 44: social.LeaveGuildResponse
-	(*GetGuildListRequest)(nil),                 // 45: social.GetGuildListRequest
-	(*GetGuildListResponse)(nil),                // 46: social.GetGuildListResponse
-	(*GuildInfo)(nil),                           // 47: social.GuildInfo
-	(*GuildMember)(nil),                         // 48: social.GuildMember
-	(*GuildApplication)(nil),                    // 49: social.GuildApplication
-	(*FriendInfo)(nil),                          // 50: social.FriendInfo
-	(*FriendRequestInfo)(nil),                   // 51: social.FriendRequestInfo
+	(HandleGuildInvitationRequest_Action)(0),    // 3: social.HandleGuildInvitationRequest.Action
+	(HandleGuildApplicationRequest_Action)(0),   // 4: social.HandleGuildApplicationRequest.Action
+	(*ChatMessage)(nil),                         // 5: social.ChatMessage
+	(*SendChatMessageRequest)(nil),              // 6: social.SendChatMessageRequest
+	(*SendChatMessageResponse)(nil),             // 7: social.SendChatMessageResponse
+	(*GetChatMessagesRequest)(nil),              // 8: social.GetChatMessagesRequest
+	(*GetChatMessagesResponse)(nil),             // 9: social.GetChatMessagesResponse
+	(*GetFriendListRequest)(nil),                // 10: social.GetFriendListRequest
+	(*GetFriendListResponse)(nil),               // 11: social.GetFriendListResponse
+	(*SendFriendRequestRequest)(nil),            // 12: social.SendFriendRequestRequest
+	(*SendFriendRequestResponse)(nil),           // 13: social.SendFriendRequestResponse
+	(*GetFriendRequestListRequest)(nil),         // 14: social.GetFriendRequestListRequest
+	(*GetFriendRequestListResponse)(nil),        // 15: social.GetFriendRequestListResponse
+	(*HandleFriendRequestRequest)(nil),          // 16: social.HandleFriendRequestRequest
+	(*HandleFriendRequestResponse)(nil),         // 17: social.HandleFriendRequestResponse
+	(*BatchHandleFriendRequestRequest)(nil),     // 18: social.BatchHandleFriendRequestRequest
+	(*BatchHandleFriendRequestResponse)(nil),    // 19: social.BatchHandleFriendRequestResponse
+	(*DeleteFriendRequest)(nil),                 // 20: social.DeleteFriendRequest
+	(*DeleteFriendResponse)(nil),                // 21: social.DeleteFriendResponse
+	(*CreateGuildRequest)(nil),                  // 22: social.CreateGuildRequest
+	(*CreateGuildResponse)(nil),                 // 23: social.CreateGuildResponse
+	(*GetGuildInfoRequest)(nil),                 // 24: social.GetGuildInfoRequest
+	(*GetGuildInfoResponse)(nil),                // 25: social.GetGuildInfoResponse
+	(*GetGuildMembersRequest)(nil),              // 26: social.GetGuildMembersRequest
+	(*GetGuildMembersResponse)(nil),             // 27: social.GetGuildMembersResponse
+	(*ApplyToGuildRequest)(nil),                 // 28: social.ApplyToGuildRequest
+	(*ApplyToGuildResponse)(nil),                // 29: social.ApplyToGuildResponse
+	(*InviteToGuildRequest)(nil),                // 30: social.InviteToGuildRequest
+	(*InviteToGuildResponse)(nil),               // 31: social.InviteToGuildResponse
+	(*GetUserGuildInvitationsRequest)(nil),      // 32: social.GetUserGuildInvitationsRequest
+	(*GetUserGuildInvitationsResponse)(nil),     // 33: social.GetUserGuildInvitationsResponse
+	(*HandleGuildInvitationRequest)(nil),        // 34: social.HandleGuildInvitationRequest
+	(*HandleGuildInvitationResponse)(nil),       // 35: social.HandleGuildInvitationResponse
+	(*GetGuildApplicationsRequest)(nil),         // 36: social.GetGuildApplicationsRequest
+	(*GetGuildApplicationsResponse)(nil),        // 37: social.GetGuildApplicationsResponse
+	(*HandleGuildApplicationRequest)(nil),       // 38: social.HandleGuildApplicationRequest
+	(*HandleGuildApplicationResponse)(nil),      // 39: social.HandleGuildApplicationResponse
+	(*KickGuildMemberRequest)(nil),              // 40: social.KickGuildMemberRequest
+	(*KickGuildMemberResponse)(nil),             // 41: social.KickGuildMemberResponse
+	(*ChangeMemberRoleRequest)(nil),             // 42: social.ChangeMemberRoleRequest
+	(*ChangeMemberRoleResponse)(nil),            // 43: social.ChangeMemberRoleResponse
+	(*UpdateGuildAnnouncementRequest)(nil),      // 44: social.UpdateGuildAnnouncementRequest
+	(*UpdateGuildAnnouncementResponse)(nil),     // 45: social.UpdateGuildAnnouncementResponse
+	(*TransferGuildMasterRequest)(nil),          // 46: social.TransferGuildMasterRequest
+	(*TransferGuildMasterResponse)(nil),         // 47: social.TransferGuildMasterResponse
+	(*DisbandGuildRequest)(nil),                 // 48: social.DisbandGuildRequest
+	(*DisbandGuildResponse)(nil),                // 49: social.DisbandGuildResponse
+	(*LeaveGuildRequest)(nil),                   // 50: social.LeaveGuildRequest
+	(*LeaveGuildResponse)(nil),                  // 51: social.LeaveGuildResponse
+	(*GetGuildListRequest)(nil),                 // 52: social.GetGuildListRequest
+	(*GetGuildListResponse)(nil),                // 53: social.GetGuildListResponse
+	(*DonateToGuildRequest)(nil),                // 54: social.DonateToGuildRequest
+	(*DonateToGuildResponse)(nil),               // 55: social.DonateToGuildResponse
+	(*GetGuildDonationsRequest)(nil),            // 56: social.GetGuildDonationsRequest
+	(*GetGuildDonationsResponse)(nil),           // 57: social.GetGuildDonationsResponse
+	(*GuildInfo)(nil),                           // 58: social.GuildInfo
+	(*GuildMember)(nil),                         // 59: social.GuildMember
+	(*GuildDonation)(nil),                       // 60: social.GuildDonation
+	(*GuildApplication)(nil),                    // 61: social.GuildApplication
+	(*GuildInvitation)(nil),                     // 62: social.GuildInvitation
+	(*FriendInfo)(nil),                          // 63: social.FriendInfo
+	(*FriendRequestInfo)(nil),                   // 64: social.FriendRequestInfo
 }
 var file_internal_pb_social_proto_depIdxs = []int32{
-	4,  // 0: social.GetChatMessagesResponse.messages:type_name -> social.ChatMessage
-	50, // 1: social.GetFriendListResponse.friends:type_name -> social.FriendInfo
-	51, // 2: social.GetFriendRequestListResponse.requests:type_name -> social.FriendRequestInfo
+	5,  // 0: social.GetChatMessagesResponse.messages:type_name -> social.ChatMessage
+	63, // 1: social.GetFriendListResponse.friends:type_name -> social.FriendInfo
+	64, // 2: social.GetFriendRequestListResponse.requests:type_name -> social.FriendRequestInfo
 	1,  // 3: social.HandleFriendRequestRequest.action:type_name -> social.HandleFriendRequestRequest.Action
 	2,  // 4: social.BatchHandleFriendRequestRequest.action:type_name -> social.BatchHandleFriendRequestRequest.Action
-	47, // 5: social.GetGuildInfoResponse.guild:type_name -> social.GuildInfo
-	48, // 6: social.GetGuildMembersResponse.members:type_name -> social.GuildMember
-	49, // 7: social.GetGuildApplicationsResponse.applications:type_name -> social.GuildApplication
-	3,  // 8: social.HandleGuildApplicationRequest.action:type_name -> social.HandleGuildApplicationRequest.Action
-	0,  // 9: social.ChangeMemberRoleRequest.new_role:type_name -> social.GuildRole
-	47, // 10: social.GetGuildListResponse.guilds:type_name -> social.GuildInfo
-	0,  // 11: social.GuildMember.role:type_name -> social.GuildRole
-	9,  // 12: social.SocialService.GetFriendList:input_type -> social.GetFriendListRequest
-	11, // 13: social.SocialService.SendFriendRequest:input_type -> social.SendFriendRequestRequest
-	13, // 14: social.SocialService.GetFriendRequestList:input_type -> social.GetFriendRequestListRequest
-	15, // 15: social.SocialService.HandleFriendRequest:input_type -> social.HandleFriendRequestRequest
-	17, // 16: social.SocialService.BatchHandleFriendRequest:input_type -> social.BatchHandleFriendRequestRequest
-	19, // 17: social.SocialService.DeleteFriend:input_type -> social.DeleteFriendRequest
-	21, // 18: social.SocialService.CreateGuild:input_type -> social.CreateGuildRequest
-	23, // 19: social.SocialService.GetGuildInfo:input_type -> social.GetGuildInfoRequest
-	25, // 20: social.SocialService.GetGuildMembers:input_type -> social.GetGuildMembersRequest
-	27, // 21: social.SocialService.ApplyToGuild:input_type -> social.ApplyToGuildRequest
-	29, // 22: social.SocialService.InviteToGuild:input_type -> social.InviteToGuildRequest
-	31, // 23: social.SocialService.GetGuildApplications:input_type -> social.GetGuildApplicationsRequest
-	33, // 24: social.SocialService.HandleGuildApplication:input_type -> social.HandleGuildApplicationRequest
-	35, // 25: social.SocialService.KickGuildMember:input_type -> social.KickGuildMemberRequest
-	37, // 26: social.SocialService.ChangeMemberRole:input_type -> social.ChangeMemberRoleRequest
-	39, // 27: social.SocialService.TransferGuildMaster:input_type -> social.TransferGuildMasterRequest
-	41, // 28: social.SocialService.DisbandGuild:input_type -> social.DisbandGuildRequest
-	43, // 29: social.SocialService.LeaveGuild:input_type -> social.LeaveGuildRequest
-	45, // 30: social.SocialService.GetGuildList:input_type -> social.GetGuildListRequest
-	5,  // 31: social.SocialService.SendChatMessage:input_type -> social.SendChatMessageRequest
-	7,  // 32: social.SocialService.GetChatMessages:input_type -> social.GetChatMessagesRequest
-	10, // 33: social.SocialService.GetFriendList:output_type -> social.GetFriendListResponse
-	12, // 34: social.SocialService.SendFriendRequest:output_type -> social.SendFriendRequestResponse
-	14, // 35: social.SocialService.GetFriendRequestList:output_type -> social.GetFriendRequestListResponse
-	16, // 36: social.SocialService.HandleFriendRequest:output_type -> social.HandleFriendRequestResponse
-	18, // 37: social.SocialService.BatchHandleFriendRequest:output_type -> social.BatchHandleFriendRequestResponse
-	20, // 38: social.SocialService.DeleteFriend:output_type -> social.DeleteFriendResponse
-	22, // 39: social.SocialService.CreateGuild:output_type -> social.CreateGuildResponse
-	24, // 40: social.SocialService.GetGuildInfo:output_type -> social.GetGuildInfoResponse
-	26, // 41: social.SocialService.GetGuildMembers:output_type -> social.GetGuildMembersResponse
-	28, // 42: social.SocialService.ApplyToGuild:output_type -> social.ApplyToGuildResponse
-	30, // 43: social.SocialService.InviteToGuild:output_type -> social.InviteToGuildResponse
-	32, // 44: social.SocialService.GetGuildApplications:output_type -> social.GetGuildApplicationsResponse
-	34, // 45: social.SocialService.HandleGuildApplication:output_type -> social.HandleGuildApplicationResponse
-	36, // 46: social.SocialService.KickGuildMember:output_type -> social.KickGuildMemberResponse
-	38, // 47: social.SocialService.ChangeMemberRole:output_type -> social.ChangeMemberRoleResponse
-	40, // 48: social.SocialService.TransferGuildMaster:output_type -> social.TransferGuildMasterResponse
-	42, // 49: social.SocialService.DisbandGuild:output_type -> social.DisbandGuildResponse
-	44, // 50: social.SocialService.LeaveGuild:output_type -> social.LeaveGuildResponse
-	46, // 51: social.SocialService.GetGuildList:output_type -> social.GetGuildListResponse
-	6,  // 52: social.SocialService.SendChatMessage:output_type -> social.SendChatMessageResponse
-	8,  // 53: social.SocialService.GetChatMessages:output_type -> social.GetChatMessagesResponse
-	33, // [33:54] is the sub-list for method output_type
-	12, // [12:33] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	58, // 5: social.GetGuildInfoResponse.guild:type_name -> social.GuildInfo
+	59, // 6: social.GetGuildMembersResponse.members:type_name -> social.GuildMember
+	62, // 7: social.GetUserGuildInvitationsResponse.invitations:type_name -> social.GuildInvitation
+	3,  // 8: social.HandleGuildInvitationRequest.action:type_name -> social.HandleGuildInvitationRequest.Action
+	61, // 9: social.GetGuildApplicationsResponse.applications:type_name -> social.GuildApplication
+	4,  // 10: social.HandleGuildApplicationRequest.action:type_name -> social.HandleGuildApplicationRequest.Action
+	0,  // 11: social.ChangeMemberRoleRequest.new_role:type_name -> social.GuildRole
+	58, // 12: social.GetGuildListResponse.guilds:type_name -> social.GuildInfo
+	60, // 13: social.GetGuildDonationsResponse.donations:type_name -> social.GuildDonation
+	0,  // 14: social.GuildMember.role:type_name -> social.GuildRole
+	10, // 15: social.SocialService.GetFriendList:input_type -> social.GetFriendListRequest
+	12, // 16: social.SocialService.SendFriendRequest:input_type -> social.SendFriendRequestRequest
+	14, // 17: social.SocialService.GetFriendRequestList:input_type -> social.GetFriendRequestListRequest
+	16, // 18: social.SocialService.HandleFriendRequest:input_type -> social.HandleFriendRequestRequest
+	18, // 19: social.SocialService.BatchHandleFriendRequest:input_type -> social.BatchHandleFriendRequestRequest
+	20, // 20: social.SocialService.DeleteFriend:input_type -> social.DeleteFriendRequest
+	22, // 21: social.SocialService.CreateGuild:input_type -> social.CreateGuildRequest
+	24, // 22: social.SocialService.GetGuildInfo:input_type -> social.GetGuildInfoRequest
+	26, // 23: social.SocialService.GetGuildMembers:input_type -> social.GetGuildMembersRequest
+	28, // 24: social.SocialService.ApplyToGuild:input_type -> social.ApplyToGuildRequest
+	30, // 25: social.SocialService.InviteToGuild:input_type -> social.InviteToGuildRequest
+	32, // 26: social.SocialService.GetUserGuildInvitations:input_type -> social.GetUserGuildInvitationsRequest
+	34, // 27: social.SocialService.HandleGuildInvitation:input_type -> social.HandleGuildInvitationRequest
+	36, // 28: social.SocialService.GetGuildApplications:input_type -> social.GetGuildApplicationsRequest
+	38, // 29: social.SocialService.HandleGuildApplication:input_type -> social.HandleGuildApplicationRequest
+	40, // 30: social.SocialService.KickGuildMember:input_type -> social.KickGuildMemberRequest
+	42, // 31: social.SocialService.ChangeMemberRole:input_type -> social.ChangeMemberRoleRequest
+	44, // 32: social.SocialService.UpdateGuildAnnouncement:input_type -> social.UpdateGuildAnnouncementRequest
+	46, // 33: social.SocialService.TransferGuildMaster:input_type -> social.TransferGuildMasterRequest
+	48, // 34: social.SocialService.DisbandGuild:input_type -> social.DisbandGuildRequest
+	50, // 35: social.SocialService.LeaveGuild:input_type -> social.LeaveGuildRequest
+	52, // 36: social.SocialService.GetGuildList:input_type -> social.GetGuildListRequest
+	54, // 37: social.SocialService.DonateToGuild:input_type -> social.DonateToGuildRequest
+	56, // 38: social.SocialService.GetGuildDonations:input_type -> social.GetGuildDonationsRequest
+	6,  // 39: social.SocialService.SendChatMessage:input_type -> social.SendChatMessageRequest
+	8,  // 40: social.SocialService.GetChatMessages:input_type -> social.GetChatMessagesRequest
+	11, // 41: social.SocialService.GetFriendList:output_type -> social.GetFriendListResponse
+	13, // 42: social.SocialService.SendFriendRequest:output_type -> social.SendFriendRequestResponse
+	15, // 43: social.SocialService.GetFriendRequestList:output_type -> social.GetFriendRequestListResponse
+	17, // 44: social.SocialService.HandleFriendRequest:output_type -> social.HandleFriendRequestResponse
+	19, // 45: social.SocialService.BatchHandleFriendRequest:output_type -> social.BatchHandleFriendRequestResponse
+	21, // 46: social.SocialService.DeleteFriend:output_type -> social.DeleteFriendResponse
+	23, // 47: social.SocialService.CreateGuild:output_type -> social.CreateGuildResponse
+	25, // 48: social.SocialService.GetGuildInfo:output_type -> social.GetGuildInfoResponse
+	27, // 49: social.SocialService.GetGuildMembers:output_type -> social.GetGuildMembersResponse
+	29, // 50: social.SocialService.ApplyToGuild:output_type -> social.ApplyToGuildResponse
+	31, // 51: social.SocialService.InviteToGuild:output_type -> social.InviteToGuildResponse
+	33, // 52: social.SocialService.GetUserGuildInvitations:output_type -> social.GetUserGuildInvitationsResponse
+	35, // 53: social.SocialService.HandleGuildInvitation:output_type -> social.HandleGuildInvitationResponse
+	37, // 54: social.SocialService.GetGuildApplications:output_type -> social.GetGuildApplicationsResponse
+	39, // 55: social.SocialService.HandleGuildApplication:output_type -> social.HandleGuildApplicationResponse
+	41, // 56: social.SocialService.KickGuildMember:output_type -> social.KickGuildMemberResponse
+	43, // 57: social.SocialService.ChangeMemberRole:output_type -> social.ChangeMemberRoleResponse
+	45, // 58: social.SocialService.UpdateGuildAnnouncement:output_type -> social.UpdateGuildAnnouncementResponse
+	47, // 59: social.SocialService.TransferGuildMaster:output_type -> social.TransferGuildMasterResponse
+	49, // 60: social.SocialService.DisbandGuild:output_type -> social.DisbandGuildResponse
+	51, // 61: social.SocialService.LeaveGuild:output_type -> social.LeaveGuildResponse
+	53, // 62: social.SocialService.GetGuildList:output_type -> social.GetGuildListResponse
+	55, // 63: social.SocialService.DonateToGuild:output_type -> social.DonateToGuildResponse
+	57, // 64: social.SocialService.GetGuildDonations:output_type -> social.GetGuildDonationsResponse
+	7,  // 65: social.SocialService.SendChatMessage:output_type -> social.SendChatMessageResponse
+	9,  // 66: social.SocialService.GetChatMessages:output_type -> social.GetChatMessagesResponse
+	41, // [41:67] is the sub-list for method output_type
+	15, // [15:41] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_internal_pb_social_proto_init() }
@@ -3167,8 +4057,8 @@ func file_internal_pb_social_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_pb_social_proto_rawDesc), len(file_internal_pb_social_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   48,
+			NumEnums:      5,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
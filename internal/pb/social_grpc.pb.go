@@ -30,14 +30,19 @@ const (
 	SocialService_GetGuildMembers_FullMethodName          = "/social.SocialService/GetGuildMembers"
 	SocialService_ApplyToGuild_FullMethodName             = "/social.SocialService/ApplyToGuild"
 	SocialService_InviteToGuild_FullMethodName            = "/social.SocialService/InviteToGuild"
+	SocialService_GetUserGuildInvitations_FullMethodName  = "/social.SocialService/GetUserGuildInvitations"
+	SocialService_HandleGuildInvitation_FullMethodName    = "/social.SocialService/HandleGuildInvitation"
 	SocialService_GetGuildApplications_FullMethodName     = "/social.SocialService/GetGuildApplications"
 	SocialService_HandleGuildApplication_FullMethodName   = "/social.SocialService/HandleGuildApplication"
 	SocialService_KickGuildMember_FullMethodName          = "/social.SocialService/KickGuildMember"
 	SocialService_ChangeMemberRole_FullMethodName         = "/social.SocialService/ChangeMemberRole"
+	SocialService_UpdateGuildAnnouncement_FullMethodName  = "/social.SocialService/UpdateGuildAnnouncement"
 	SocialService_TransferGuildMaster_FullMethodName      = "/social.SocialService/TransferGuildMaster"
 	SocialService_DisbandGuild_FullMethodName             = "/social.SocialService/DisbandGuild"
 	SocialService_LeaveGuild_FullMethodName               = "/social.SocialService/LeaveGuild"
 	SocialService_GetGuildList_FullMethodName             = "/social.SocialService/GetGuildList"
+	SocialService_DonateToGuild_FullMethodName            = "/social.SocialService/DonateToGuild"
+	SocialService_GetGuildDonations_FullMethodName        = "/social.SocialService/GetGuildDonations"
 	SocialService_SendChatMessage_FullMethodName          = "/social.SocialService/SendChatMessage"
 	SocialService_GetChatMessages_FullMethodName          = "/social.SocialService/GetChatMessages"
 )
@@ -69,6 +74,10 @@ type SocialServiceClient interface {
 	ApplyToGuild(ctx context.Context, in *ApplyToGuildRequest, opts ...grpc.CallOption) (*ApplyToGuildResponse, error)
 	// 邀请加入帮派
 	InviteToGuild(ctx context.Context, in *InviteToGuildRequest, opts ...grpc.CallOption) (*InviteToGuildResponse, error)
+	// 获取收到的帮派邀请列表
+	GetUserGuildInvitations(ctx context.Context, in *GetUserGuildInvitationsRequest, opts ...grpc.CallOption) (*GetUserGuildInvitationsResponse, error)
+	// 处理帮派邀请
+	HandleGuildInvitation(ctx context.Context, in *HandleGuildInvitationRequest, opts ...grpc.CallOption) (*HandleGuildInvitationResponse, error)
 	// 获取帮派申请列表
 	GetGuildApplications(ctx context.Context, in *GetGuildApplicationsRequest, opts ...grpc.CallOption) (*GetGuildApplicationsResponse, error)
 	// 处理帮派申请
@@ -77,6 +86,8 @@ type SocialServiceClient interface {
 	KickGuildMember(ctx context.Context, in *KickGuildMemberRequest, opts ...grpc.CallOption) (*KickGuildMemberResponse, error)
 	// 修改成员职位
 	ChangeMemberRole(ctx context.Context, in *ChangeMemberRoleRequest, opts ...grpc.CallOption) (*ChangeMemberRoleResponse, error)
+	// 修改帮派公告
+	UpdateGuildAnnouncement(ctx context.Context, in *UpdateGuildAnnouncementRequest, opts ...grpc.CallOption) (*UpdateGuildAnnouncementResponse, error)
 	// 转让帮主
 	TransferGuildMaster(ctx context.Context, in *TransferGuildMasterRequest, opts ...grpc.CallOption) (*TransferGuildMasterResponse, error)
 	// 解散帮派
@@ -85,6 +96,10 @@ type SocialServiceClient interface {
 	LeaveGuild(ctx context.Context, in *LeaveGuildRequest, opts ...grpc.CallOption) (*LeaveGuildResponse, error)
 	// 获取帮派列表(分页)
 	GetGuildList(ctx context.Context, in *GetGuildListRequest, opts ...grpc.CallOption) (*GetGuildListResponse, error)
+	// 帮派捐献
+	DonateToGuild(ctx context.Context, in *DonateToGuildRequest, opts ...grpc.CallOption) (*DonateToGuildResponse, error)
+	// 获取帮派捐献记录
+	GetGuildDonations(ctx context.Context, in *GetGuildDonationsRequest, opts ...grpc.CallOption) (*GetGuildDonationsResponse, error)
 	// 聊天相关接口
 	// 发送世界消息
 	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error)
@@ -210,6 +225,26 @@ func (c *socialServiceClient) InviteToGuild(ctx context.Context, in *InviteToGui
 	return out, nil
 }
 
+func (c *socialServiceClient) GetUserGuildInvitations(ctx context.Context, in *GetUserGuildInvitationsRequest, opts ...grpc.CallOption) (*GetUserGuildInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserGuildInvitationsResponse)
+	err := c.cc.Invoke(ctx, SocialService_GetUserGuildInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) HandleGuildInvitation(ctx context.Context, in *HandleGuildInvitationRequest, opts ...grpc.CallOption) (*HandleGuildInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandleGuildInvitationResponse)
+	err := c.cc.Invoke(ctx, SocialService_HandleGuildInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *socialServiceClient) GetGuildApplications(ctx context.Context, in *GetGuildApplicationsRequest, opts ...grpc.CallOption) (*GetGuildApplicationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetGuildApplicationsResponse)
@@ -250,6 +285,16 @@ func (c *socialServiceClient) ChangeMemberRole(ctx context.Context, in *ChangeMe
 	return out, nil
 }
 
+func (c *socialServiceClient) UpdateGuildAnnouncement(ctx context.Context, in *UpdateGuildAnnouncementRequest, opts ...grpc.CallOption) (*UpdateGuildAnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGuildAnnouncementResponse)
+	err := c.cc.Invoke(ctx, SocialService_UpdateGuildAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *socialServiceClient) TransferGuildMaster(ctx context.Context, in *TransferGuildMasterRequest, opts ...grpc.CallOption) (*TransferGuildMasterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TransferGuildMasterResponse)
@@ -290,6 +335,26 @@ func (c *socialServiceClient) GetGuildList(ctx context.Context, in *GetGuildList
 	return out, nil
 }
 
+func (c *socialServiceClient) DonateToGuild(ctx context.Context, in *DonateToGuildRequest, opts ...grpc.CallOption) (*DonateToGuildResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DonateToGuildResponse)
+	err := c.cc.Invoke(ctx, SocialService_DonateToGuild_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) GetGuildDonations(ctx context.Context, in *GetGuildDonationsRequest, opts ...grpc.CallOption) (*GetGuildDonationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGuildDonationsResponse)
+	err := c.cc.Invoke(ctx, SocialService_GetGuildDonations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *socialServiceClient) SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SendChatMessageResponse)
@@ -337,6 +402,10 @@ type SocialServiceServer interface {
 	ApplyToGuild(context.Context, *ApplyToGuildRequest) (*ApplyToGuildResponse, error)
 	// 邀请加入帮派
 	InviteToGuild(context.Context, *InviteToGuildRequest) (*InviteToGuildResponse, error)
+	// 获取收到的帮派邀请列表
+	GetUserGuildInvitations(context.Context, *GetUserGuildInvitationsRequest) (*GetUserGuildInvitationsResponse, error)
+	// 处理帮派邀请
+	HandleGuildInvitation(context.Context, *HandleGuildInvitationRequest) (*HandleGuildInvitationResponse, error)
 	// 获取帮派申请列表
 	GetGuildApplications(context.Context, *GetGuildApplicationsRequest) (*GetGuildApplicationsResponse, error)
 	// 处理帮派申请
@@ -345,6 +414,8 @@ type SocialServiceServer interface {
 	KickGuildMember(context.Context, *KickGuildMemberRequest) (*KickGuildMemberResponse, error)
 	// 修改成员职位
 	ChangeMemberRole(context.Context, *ChangeMemberRoleRequest) (*ChangeMemberRoleResponse, error)
+	// 修改帮派公告
+	UpdateGuildAnnouncement(context.Context, *UpdateGuildAnnouncementRequest) (*UpdateGuildAnnouncementResponse, error)
 	// 转让帮主
 	TransferGuildMaster(context.Context, *TransferGuildMasterRequest) (*TransferGuildMasterResponse, error)
 	// 解散帮派
@@ -353,6 +424,10 @@ type SocialServiceServer interface {
 	LeaveGuild(context.Context, *LeaveGuildRequest) (*LeaveGuildResponse, error)
 	// 获取帮派列表(分页)
 	GetGuildList(context.Context, *GetGuildListRequest) (*GetGuildListResponse, error)
+	// 帮派捐献
+	DonateToGuild(context.Context, *DonateToGuildRequest) (*DonateToGuildResponse, error)
+	// 获取帮派捐献记录
+	GetGuildDonations(context.Context, *GetGuildDonationsRequest) (*GetGuildDonationsResponse, error)
 	// 聊天相关接口
 	// 发送世界消息
 	SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error)
@@ -401,6 +476,12 @@ func (UnimplementedSocialServiceServer) ApplyToGuild(context.Context, *ApplyToGu
 func (UnimplementedSocialServiceServer) InviteToGuild(context.Context, *InviteToGuildRequest) (*InviteToGuildResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InviteToGuild not implemented")
 }
+func (UnimplementedSocialServiceServer) GetUserGuildInvitations(context.Context, *GetUserGuildInvitationsRequest) (*GetUserGuildInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserGuildInvitations not implemented")
+}
+func (UnimplementedSocialServiceServer) HandleGuildInvitation(context.Context, *HandleGuildInvitationRequest) (*HandleGuildInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleGuildInvitation not implemented")
+}
 func (UnimplementedSocialServiceServer) GetGuildApplications(context.Context, *GetGuildApplicationsRequest) (*GetGuildApplicationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGuildApplications not implemented")
 }
@@ -413,6 +494,9 @@ func (UnimplementedSocialServiceServer) KickGuildMember(context.Context, *KickGu
 func (UnimplementedSocialServiceServer) ChangeMemberRole(context.Context, *ChangeMemberRoleRequest) (*ChangeMemberRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeMemberRole not implemented")
 }
+func (UnimplementedSocialServiceServer) UpdateGuildAnnouncement(context.Context, *UpdateGuildAnnouncementRequest) (*UpdateGuildAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuildAnnouncement not implemented")
+}
 func (UnimplementedSocialServiceServer) TransferGuildMaster(context.Context, *TransferGuildMasterRequest) (*TransferGuildMasterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferGuildMaster not implemented")
 }
@@ -425,6 +509,12 @@ func (UnimplementedSocialServiceServer) LeaveGuild(context.Context, *LeaveGuildR
 func (UnimplementedSocialServiceServer) GetGuildList(context.Context, *GetGuildListRequest) (*GetGuildListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGuildList not implemented")
 }
+func (UnimplementedSocialServiceServer) DonateToGuild(context.Context, *DonateToGuildRequest) (*DonateToGuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DonateToGuild not implemented")
+}
+func (UnimplementedSocialServiceServer) GetGuildDonations(context.Context, *GetGuildDonationsRequest) (*GetGuildDonationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuildDonations not implemented")
+}
 func (UnimplementedSocialServiceServer) SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendChatMessage not implemented")
 }
@@ -650,6 +740,42 @@ func _SocialService_InviteToGuild_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SocialService_GetUserGuildInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserGuildInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).GetUserGuildInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_GetUserGuildInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).GetUserGuildInvitations(ctx, req.(*GetUserGuildInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_HandleGuildInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleGuildInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).HandleGuildInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_HandleGuildInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).HandleGuildInvitation(ctx, req.(*HandleGuildInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SocialService_GetGuildApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetGuildApplicationsRequest)
 	if err := dec(in); err != nil {
@@ -722,6 +848,24 @@ func _SocialService_ChangeMemberRole_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SocialService_UpdateGuildAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGuildAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).UpdateGuildAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_UpdateGuildAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).UpdateGuildAnnouncement(ctx, req.(*UpdateGuildAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SocialService_TransferGuildMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransferGuildMasterRequest)
 	if err := dec(in); err != nil {
@@ -794,6 +938,42 @@ func _SocialService_GetGuildList_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SocialService_DonateToGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DonateToGuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).DonateToGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_DonateToGuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).DonateToGuild(ctx, req.(*DonateToGuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_GetGuildDonations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildDonationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).GetGuildDonations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_GetGuildDonations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).GetGuildDonations(ctx, req.(*GetGuildDonationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SocialService_SendChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendChatMessageRequest)
 	if err := dec(in); err != nil {
@@ -881,6 +1061,14 @@ var SocialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InviteToGuild",
 			Handler:    _SocialService_InviteToGuild_Handler,
 		},
+		{
+			MethodName: "GetUserGuildInvitations",
+			Handler:    _SocialService_GetUserGuildInvitations_Handler,
+		},
+		{
+			MethodName: "HandleGuildInvitation",
+			Handler:    _SocialService_HandleGuildInvitation_Handler,
+		},
 		{
 			MethodName: "GetGuildApplications",
 			Handler:    _SocialService_GetGuildApplications_Handler,
@@ -897,6 +1085,10 @@ var SocialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeMemberRole",
 			Handler:    _SocialService_ChangeMemberRole_Handler,
 		},
+		{
+			MethodName: "UpdateGuildAnnouncement",
+			Handler:    _SocialService_UpdateGuildAnnouncement_Handler,
+		},
 		{
 			MethodName: "TransferGuildMaster",
 			Handler:    _SocialService_TransferGuildMaster_Handler,
@@ -913,6 +1105,14 @@ var SocialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGuildList",
 			Handler:    _SocialService_GetGuildList_Handler,
 		},
+		{
+			MethodName: "DonateToGuild",
+			Handler:    _SocialService_DonateToGuild_Handler,
+		},
+		{
+			MethodName: "GetGuildDonations",
+			Handler:    _SocialService_GetGuildDonations_Handler,
+		},
 		{
 			MethodName: "SendChatMessage",
 			Handler:    _SocialService_SendChatMessage_Handler,
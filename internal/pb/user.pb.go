@@ -248,6 +248,7 @@ type Item struct {
 	Color         int32                  `protobuf:"varint,7,opt,name=color,proto3" json:"color,omitempty"`                             // 物品品质
 	Stack         int32                  `protobuf:"varint,8,opt,name=stack,proto3" json:"stack,omitempty"`                             // 堆叠上限
 	Equipped      bool                   `protobuf:"varint,9,opt,name=equipped,proto3" json:"equipped,omitempty"`                       // 是否已装备
+	Position      int32                  `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`                      // 格子序号
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -345,10 +346,19 @@ func (x *Item) GetEquipped() bool {
 	return false
 }
 
+func (x *Item) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
 type Inventory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Used          int32                  `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`                                  // 已占用格子数
+	ExpandTimes   int32                  `protobuf:"varint,4,opt,name=expand_times,json=expandTimes,proto3" json:"expand_times,omitempty"` // 已扩容次数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -397,6 +407,20 @@ func (x *Inventory) GetCapacity() int32 {
 	return 0
 }
 
+func (x *Inventory) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *Inventory) GetExpandTimes() int32 {
+	if x != nil {
+		return x.ExpandTimes
+	}
+	return 0
+}
+
 type GetInventoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -698,6 +722,7 @@ type UseItemRequest struct {
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // 客户端请求ID，重试时保持不变
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -753,9 +778,22 @@ func (x *UseItemRequest) GetCount() int32 {
 	return 0
 }
 
+func (x *UseItemRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
 type UseItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rewards       []*Item                `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"` // 获得的货币和物品
+	Exp           int32                  `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`        // 增加的玩家经验
+	Level         int32                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`    // 使用后的玩家等级
+	Pets          []*Pet                 `protobuf:"bytes,6,rep,name=pets,proto3" json:"pets,omitempty"`       // 孵化的宠物
+	Buffs         []*Buff                `protobuf:"bytes,7,rep,name=buffs,proto3" json:"buffs,omitempty"`     // 获得或延长的增益
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -797,32 +835,72 @@ func (x *UseItemResponse) GetSuccess() bool {
 	return false
 }
 
-// 装备信息
-type Equipment struct {
+func (x *UseItemResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UseItemResponse) GetRewards() []*Item {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *UseItemResponse) GetExp() int32 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *UseItemResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *UseItemResponse) GetPets() []*Pet {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+func (x *UseItemResponse) GetBuffs() []*Buff {
+	if x != nil {
+		return x.Buffs
+	}
+	return nil
+}
+
+// 增益信息
+type Buff struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                   // 装备实例ID
-	TemplateId    int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 装备模板ID
-	Slot          int32                  `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`                               // 装备槽位
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                // 装备名称
-	Properties    string                 `protobuf:"bytes,5,opt,name=properties,proto3" json:"properties,omitempty"`                    // JSON格式的装备属性
+	BuffId        int64                  `protobuf:"varint,1,opt,name=buff_id,json=buffId,proto3" json:"buff_id,omitempty"`       // 增益ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                          // 增益名称
+	ExpireAt      int64                  `protobuf:"varint,3,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"` // 过期时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Equipment) Reset() {
-	*x = Equipment{}
+func (x *Buff) Reset() {
+	*x = Buff{}
 	mi := &file_internal_pb_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Equipment) String() string {
+func (x *Buff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Equipment) ProtoMessage() {}
+func (*Buff) ProtoMessage() {}
 
-func (x *Equipment) ProtoReflect() protoreflect.Message {
+func (x *Buff) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -834,68 +912,53 @@ func (x *Equipment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Equipment.ProtoReflect.Descriptor instead.
-func (*Equipment) Descriptor() ([]byte, []int) {
+// Deprecated: Use Buff.ProtoReflect.Descriptor instead.
+func (*Buff) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Equipment) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Equipment) GetTemplateId() int64 {
+func (x *Buff) GetBuffId() int64 {
 	if x != nil {
-		return x.TemplateId
-	}
-	return 0
-}
-
-func (x *Equipment) GetSlot() int32 {
-	if x != nil {
-		return x.Slot
+		return x.BuffId
 	}
 	return 0
 }
 
-func (x *Equipment) GetName() string {
+func (x *Buff) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Equipment) GetProperties() string {
+func (x *Buff) GetExpireAt() int64 {
 	if x != nil {
-		return x.Properties
+		return x.ExpireAt
 	}
-	return ""
+	return 0
 }
 
-// 获取装备请求
-type GetEquipmentsRequest struct {
+type GetBuffsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEquipmentsRequest) Reset() {
-	*x = GetEquipmentsRequest{}
+func (x *GetBuffsRequest) Reset() {
+	*x = GetBuffsRequest{}
 	mi := &file_internal_pb_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEquipmentsRequest) String() string {
+func (x *GetBuffsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEquipmentsRequest) ProtoMessage() {}
+func (*GetBuffsRequest) ProtoMessage() {}
 
-func (x *GetEquipmentsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBuffsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -907,40 +970,39 @@ func (x *GetEquipmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEquipmentsRequest.ProtoReflect.Descriptor instead.
-func (*GetEquipmentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBuffsRequest.ProtoReflect.Descriptor instead.
+func (*GetBuffsRequest) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetEquipmentsRequest) GetUserId() int64 {
+func (x *GetBuffsRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-// 获取装备响应
-type GetEquipmentsResponse struct {
+type GetBuffsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Equipments    []*Equipment           `protobuf:"bytes,1,rep,name=equipments,proto3" json:"equipments,omitempty"` // 装备列表
+	Buffs         []*Buff                `protobuf:"bytes,1,rep,name=buffs,proto3" json:"buffs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEquipmentsResponse) Reset() {
-	*x = GetEquipmentsResponse{}
+func (x *GetBuffsResponse) Reset() {
+	*x = GetBuffsResponse{}
 	mi := &file_internal_pb_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEquipmentsResponse) String() string {
+func (x *GetBuffsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEquipmentsResponse) ProtoMessage() {}
+func (*GetBuffsResponse) ProtoMessage() {}
 
-func (x *GetEquipmentsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBuffsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -952,42 +1014,39 @@ func (x *GetEquipmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEquipmentsResponse.ProtoReflect.Descriptor instead.
-func (*GetEquipmentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBuffsResponse.ProtoReflect.Descriptor instead.
+func (*GetBuffsResponse) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetEquipmentsResponse) GetEquipments() []*Equipment {
+func (x *GetBuffsResponse) GetBuffs() []*Buff {
 	if x != nil {
-		return x.Equipments
+		return x.Buffs
 	}
 	return nil
 }
 
-// 装备物品请求
-type EquipItemRequest struct {
+type ExpandInventoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
-	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // 背包物品ID
-	Slot          int32                  `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`                   // 装备槽位
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EquipItemRequest) Reset() {
-	*x = EquipItemRequest{}
+func (x *ExpandInventoryRequest) Reset() {
+	*x = ExpandInventoryRequest{}
 	mi := &file_internal_pb_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EquipItemRequest) String() string {
+func (x *ExpandInventoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EquipItemRequest) ProtoMessage() {}
+func (*ExpandInventoryRequest) ProtoMessage() {}
 
-func (x *EquipItemRequest) ProtoReflect() protoreflect.Message {
+func (x *ExpandInventoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -999,55 +1058,42 @@ func (x *EquipItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EquipItemRequest.ProtoReflect.Descriptor instead.
-func (*EquipItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpandInventoryRequest.ProtoReflect.Descriptor instead.
+func (*ExpandInventoryRequest) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *EquipItemRequest) GetUserId() int64 {
+func (x *ExpandInventoryRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *EquipItemRequest) GetItemId() int64 {
-	if x != nil {
-		return x.ItemId
-	}
-	return 0
-}
-
-func (x *EquipItemRequest) GetSlot() int32 {
-	if x != nil {
-		return x.Slot
-	}
-	return 0
-}
-
-// 装备物品响应
-type EquipItemResponse struct {
+type ExpandInventoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"` // 扩容后的背包容量
+	Cost          []*Item                `protobuf:"bytes,4,rep,name=cost,proto3" json:"cost,omitempty"`          // 本次扩容消耗
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EquipItemResponse) Reset() {
-	*x = EquipItemResponse{}
+func (x *ExpandInventoryResponse) Reset() {
+	*x = ExpandInventoryResponse{}
 	mi := &file_internal_pb_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EquipItemResponse) String() string {
+func (x *ExpandInventoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EquipItemResponse) ProtoMessage() {}
+func (*ExpandInventoryResponse) ProtoMessage() {}
 
-func (x *EquipItemResponse) ProtoReflect() protoreflect.Message {
+func (x *ExpandInventoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1059,48 +1105,60 @@ func (x *EquipItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EquipItemResponse.ProtoReflect.Descriptor instead.
-func (*EquipItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpandInventoryResponse.ProtoReflect.Descriptor instead.
+func (*ExpandInventoryResponse) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *EquipItemResponse) GetSuccess() bool {
+func (x *ExpandInventoryResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *EquipItemResponse) GetMessage() string {
+func (x *ExpandInventoryResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// 卸下装备请求
-type UnequipItemRequest struct {
+func (x *ExpandInventoryResponse) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *ExpandInventoryResponse) GetCost() []*Item {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+type SortInventoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
-	Slot          int32                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`                   // 装备槽位
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnequipItemRequest) Reset() {
-	*x = UnequipItemRequest{}
+func (x *SortInventoryRequest) Reset() {
+	*x = SortInventoryRequest{}
 	mi := &file_internal_pb_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnequipItemRequest) String() string {
+func (x *SortInventoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnequipItemRequest) ProtoMessage() {}
+func (*SortInventoryRequest) ProtoMessage() {}
 
-func (x *UnequipItemRequest) ProtoReflect() protoreflect.Message {
+func (x *SortInventoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1112,48 +1170,41 @@ func (x *UnequipItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnequipItemRequest.ProtoReflect.Descriptor instead.
-func (*UnequipItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SortInventoryRequest.ProtoReflect.Descriptor instead.
+func (*SortInventoryRequest) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UnequipItemRequest) GetUserId() int64 {
+func (x *SortInventoryRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UnequipItemRequest) GetSlot() int32 {
-	if x != nil {
-		return x.Slot
-	}
-	return 0
-}
-
-// 卸下装备响应
-type UnequipItemResponse struct {
+type SortInventoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Inventory     *Inventory             `protobuf:"bytes,3,opt,name=inventory,proto3" json:"inventory,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnequipItemResponse) Reset() {
-	*x = UnequipItemResponse{}
+func (x *SortInventoryResponse) Reset() {
+	*x = SortInventoryResponse{}
 	mi := &file_internal_pb_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnequipItemResponse) String() string {
+func (x *SortInventoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnequipItemResponse) ProtoMessage() {}
+func (*SortInventoryResponse) ProtoMessage() {}
 
-func (x *UnequipItemResponse) ProtoReflect() protoreflect.Message {
+func (x *SortInventoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1165,47 +1216,61 @@ func (x *UnequipItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnequipItemResponse.ProtoReflect.Descriptor instead.
-func (*UnequipItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SortInventoryResponse.ProtoReflect.Descriptor instead.
+func (*SortInventoryResponse) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UnequipItemResponse) GetSuccess() bool {
+func (x *SortInventoryResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UnequipItemResponse) GetMessage() string {
+func (x *SortInventoryResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// 获取玩家信息
-type GetUserInfoRequest struct {
+func (x *SortInventoryResponse) GetInventory() *Inventory {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+// 装备信息
+type Equipment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 玩家ID
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // 装备实例ID
+	TemplateId    int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`       // 装备模板ID
+	Slot          int32                  `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`                                     // 装备槽位
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                      // 装备名称
+	Properties    string                 `protobuf:"bytes,5,opt,name=properties,proto3" json:"properties,omitempty"`                          // JSON格式的装备属性，已计入强化加成和词条
+	ItemId        int64                  `protobuf:"varint,6,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                   // 背包物品实例ID
+	EnhanceLevel  int32                  `protobuf:"varint,7,opt,name=enhance_level,json=enhanceLevel,proto3" json:"enhance_level,omitempty"` // 强化等级
+	Affixes       []*EquipmentAffix      `protobuf:"bytes,8,rep,name=affixes,proto3" json:"affixes,omitempty"`                                // 洗练词条
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserInfoRequest) Reset() {
-	*x = GetUserInfoRequest{}
+func (x *Equipment) Reset() {
+	*x = Equipment{}
 	mi := &file_internal_pb_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserInfoRequest) String() string {
+func (x *Equipment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserInfoRequest) ProtoMessage() {}
+func (*Equipment) ProtoMessage() {}
 
-func (x *GetUserInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *Equipment) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1217,108 +1282,92 @@ func (x *GetUserInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetUserInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Equipment.ProtoReflect.Descriptor instead.
+func (*Equipment) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetUserInfoRequest) GetUserId() int64 {
+func (x *Equipment) GetId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-// 获取玩家信息
-type GetUserInfoResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 玩家ID
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                    // 玩家名称
-	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`                 // 等级
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Equipment) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
 }
 
-func (x *GetUserInfoResponse) Reset() {
-	*x = GetUserInfoResponse{}
-	mi := &file_internal_pb_user_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Equipment) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
 }
 
-func (x *GetUserInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Equipment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*GetUserInfoResponse) ProtoMessage() {}
-
-func (x *GetUserInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[22]
+func (x *Equipment) GetProperties() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Properties
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetUserInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetUserInfoResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{22}
+	return ""
 }
 
-func (x *GetUserInfoResponse) GetUserId() int64 {
+func (x *Equipment) GetItemId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.ItemId
 	}
 	return 0
 }
 
-func (x *GetUserInfoResponse) GetName() string {
+func (x *Equipment) GetEnhanceLevel() int32 {
 	if x != nil {
-		return x.Name
+		return x.EnhanceLevel
 	}
-	return ""
+	return 0
 }
 
-func (x *GetUserInfoResponse) GetLevel() int32 {
+func (x *Equipment) GetAffixes() []*EquipmentAffix {
 	if x != nil {
-		return x.Level
+		return x.Affixes
 	}
-	return 0
+	return nil
 }
 
-// 卡牌信息
-type Card struct {
+// 装备词条
+type EquipmentAffix struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                   // 卡牌实例ID
-	TemplateId    int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 卡牌模板ID
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                // 卡牌名称
-	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`                             // 卡牌等级
-	Star          int32                  `protobuf:"varint,5,opt,name=star,proto3" json:"star,omitempty"`                               // 卡牌星级
-	Activated     bool                   `protobuf:"varint,6,opt,name=activated,proto3" json:"activated,omitempty"`                     // 是否已激活
-	Properties    string                 `protobuf:"bytes,7,opt,name=properties,proto3" json:"properties,omitempty"`                    // JSON格式的卡牌属性
+	AffixId       int64                  `protobuf:"varint,1,opt,name=affix_id,json=affixId,proto3" json:"affix_id,omitempty"` // 词条ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                       // 词条名称
+	Properties    string                 `protobuf:"bytes,3,opt,name=properties,proto3" json:"properties,omitempty"`           // JSON格式的词条属性
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Card) Reset() {
-	*x = Card{}
-	mi := &file_internal_pb_user_proto_msgTypes[23]
+func (x *EquipmentAffix) Reset() {
+	*x = EquipmentAffix{}
+	mi := &file_internal_pb_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Card) String() string {
+func (x *EquipmentAffix) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Card) ProtoMessage() {}
+func (*EquipmentAffix) ProtoMessage() {}
 
-func (x *Card) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[23]
+func (x *EquipmentAffix) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,83 +1378,55 @@ func (x *Card) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Card.ProtoReflect.Descriptor instead.
-func (*Card) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *Card) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use EquipmentAffix.ProtoReflect.Descriptor instead.
+func (*EquipmentAffix) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Card) GetTemplateId() int64 {
+func (x *EquipmentAffix) GetAffixId() int64 {
 	if x != nil {
-		return x.TemplateId
+		return x.AffixId
 	}
 	return 0
 }
 
-func (x *Card) GetName() string {
+func (x *EquipmentAffix) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Card) GetLevel() int32 {
-	if x != nil {
-		return x.Level
-	}
-	return 0
-}
-
-func (x *Card) GetStar() int32 {
-	if x != nil {
-		return x.Star
-	}
-	return 0
-}
-
-func (x *Card) GetActivated() bool {
-	if x != nil {
-		return x.Activated
-	}
-	return false
-}
-
-func (x *Card) GetProperties() string {
+func (x *EquipmentAffix) GetProperties() string {
 	if x != nil {
 		return x.Properties
 	}
 	return ""
 }
 
-// 获取玩家卡牌请求
-type GetUserCardsRequest struct {
+// 获取装备请求
+type GetEquipmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserCardsRequest) Reset() {
-	*x = GetUserCardsRequest{}
-	mi := &file_internal_pb_user_proto_msgTypes[24]
+func (x *GetEquipmentsRequest) Reset() {
+	*x = GetEquipmentsRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserCardsRequest) String() string {
+func (x *GetEquipmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserCardsRequest) ProtoMessage() {}
+func (*GetEquipmentsRequest) ProtoMessage() {}
 
-func (x *GetUserCardsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[24]
+func (x *GetEquipmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,41 +1437,41 @@ func (x *GetUserCardsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserCardsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserCardsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetEquipmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetEquipmentsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetUserCardsRequest) GetUserId() int64 {
+func (x *GetEquipmentsRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-// 获取玩家卡牌响应
-type GetUserCardsResponse struct {
+// 获取装备响应
+type GetEquipmentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"` // 卡牌列表
+	Equipments    []*Equipment           `protobuf:"bytes,1,rep,name=equipments,proto3" json:"equipments,omitempty"` // 装备列表
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserCardsResponse) Reset() {
-	*x = GetUserCardsResponse{}
-	mi := &file_internal_pb_user_proto_msgTypes[25]
+func (x *GetEquipmentsResponse) Reset() {
+	*x = GetEquipmentsResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserCardsResponse) String() string {
+func (x *GetEquipmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserCardsResponse) ProtoMessage() {}
+func (*GetEquipmentsResponse) ProtoMessage() {}
 
-func (x *GetUserCardsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[25]
+func (x *GetEquipmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,42 +1482,43 @@ func (x *GetUserCardsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserCardsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserCardsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetEquipmentsResponse.ProtoReflect.Descriptor instead.
+func (*GetEquipmentsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetUserCardsResponse) GetCards() []*Card {
+func (x *GetEquipmentsResponse) GetEquipments() []*Equipment {
 	if x != nil {
-		return x.Cards
+		return x.Equipments
 	}
 	return nil
 }
 
-// 激活卡牌请求
-type ActivateCardRequest struct {
+// 装备物品请求
+type EquipItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 用户ID
-	TemplateId    int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 卡牌模板ID
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // 背包物品ID
+	Slot          int32                  `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`                   // 装备槽位
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateCardRequest) Reset() {
-	*x = ActivateCardRequest{}
-	mi := &file_internal_pb_user_proto_msgTypes[26]
+func (x *EquipItemRequest) Reset() {
+	*x = EquipItemRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateCardRequest) String() string {
+func (x *EquipItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateCardRequest) ProtoMessage() {}
+func (*EquipItemRequest) ProtoMessage() {}
 
-func (x *ActivateCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[26]
+func (x *EquipItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,27 +1529,34 @@ func (x *ActivateCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateCardRequest.ProtoReflect.Descriptor instead.
-func (*ActivateCardRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use EquipItemRequest.ProtoReflect.Descriptor instead.
+func (*EquipItemRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ActivateCardRequest) GetUserId() int64 {
+func (x *EquipItemRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *ActivateCardRequest) GetTemplateId() int64 {
+func (x *EquipItemRequest) GetItemId() int64 {
 	if x != nil {
-		return x.TemplateId
+		return x.ItemId
 	}
 	return 0
 }
 
-// 激活卡牌响应
-type ActivateCardResponse struct {
+func (x *EquipItemRequest) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+// 装备物品响应
+type EquipItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
@@ -1535,21 +1564,21 @@ type ActivateCardResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateCardResponse) Reset() {
-	*x = ActivateCardResponse{}
-	mi := &file_internal_pb_user_proto_msgTypes[27]
+func (x *EquipItemResponse) Reset() {
+	*x = EquipItemResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateCardResponse) String() string {
+func (x *EquipItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateCardResponse) ProtoMessage() {}
+func (*EquipItemResponse) ProtoMessage() {}
 
-func (x *ActivateCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[27]
+func (x *EquipItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1560,49 +1589,49 @@ func (x *ActivateCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateCardResponse.ProtoReflect.Descriptor instead.
-func (*ActivateCardResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use EquipItemResponse.ProtoReflect.Descriptor instead.
+func (*EquipItemResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ActivateCardResponse) GetSuccess() bool {
+func (x *EquipItemResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ActivateCardResponse) GetMessage() string {
+func (x *EquipItemResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// 卡牌升级请求
-type UpgradeCardRequest struct {
+// 卸下装备请求
+type UnequipItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
-	CardId        int64                  `protobuf:"varint,2,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"` // 卡牌ID
+	Slot          int32                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`                   // 装备槽位
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpgradeCardRequest) Reset() {
-	*x = UpgradeCardRequest{}
-	mi := &file_internal_pb_user_proto_msgTypes[28]
+func (x *UnequipItemRequest) Reset() {
+	*x = UnequipItemRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpgradeCardRequest) String() string {
+func (x *UnequipItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradeCardRequest) ProtoMessage() {}
+func (*UnequipItemRequest) ProtoMessage() {}
 
-func (x *UpgradeCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[28]
+func (x *UnequipItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1613,27 +1642,27 @@ func (x *UpgradeCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradeCardRequest.ProtoReflect.Descriptor instead.
-func (*UpgradeCardRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UnequipItemRequest.ProtoReflect.Descriptor instead.
+func (*UnequipItemRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpgradeCardRequest) GetUserId() int64 {
+func (x *UnequipItemRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpgradeCardRequest) GetCardId() int64 {
+func (x *UnequipItemRequest) GetSlot() int32 {
 	if x != nil {
-		return x.CardId
+		return x.Slot
 	}
 	return 0
 }
 
-// 卡牌升级响应
-type UpgradeCardResponse struct {
+// 卸下装备响应
+type UnequipItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
@@ -1641,21 +1670,21 @@ type UpgradeCardResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpgradeCardResponse) Reset() {
-	*x = UpgradeCardResponse{}
-	mi := &file_internal_pb_user_proto_msgTypes[29]
+func (x *UnequipItemResponse) Reset() {
+	*x = UnequipItemResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpgradeCardResponse) String() string {
+func (x *UnequipItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradeCardResponse) ProtoMessage() {}
+func (*UnequipItemResponse) ProtoMessage() {}
 
-func (x *UpgradeCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[29]
+func (x *UnequipItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,49 +1695,50 @@ func (x *UpgradeCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradeCardResponse.ProtoReflect.Descriptor instead.
-func (*UpgradeCardResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use UnequipItemResponse.ProtoReflect.Descriptor instead.
+func (*UnequipItemResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UpgradeCardResponse) GetSuccess() bool {
+func (x *UnequipItemResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpgradeCardResponse) GetMessage() string {
+func (x *UnequipItemResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// 卡牌升星请求
-type UpgradeCardStarRequest struct {
+// 强化装备请求
+type EnhanceEquipmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
-	CardId        int64                  `protobuf:"varint,2,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"` // 卡牌ID
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // 用户ID
+	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`         // 背包物品ID
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // 客户端请求ID，重试时保持不变
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpgradeCardStarRequest) Reset() {
-	*x = UpgradeCardStarRequest{}
-	mi := &file_internal_pb_user_proto_msgTypes[30]
+func (x *EnhanceEquipmentRequest) Reset() {
+	*x = EnhanceEquipmentRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpgradeCardStarRequest) String() string {
+func (x *EnhanceEquipmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradeCardStarRequest) ProtoMessage() {}
+func (*EnhanceEquipmentRequest) ProtoMessage() {}
 
-func (x *UpgradeCardStarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[30]
+func (x *EnhanceEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1719,49 +1749,59 @@ func (x *UpgradeCardStarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradeCardStarRequest.ProtoReflect.Descriptor instead.
-func (*UpgradeCardStarRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use EnhanceEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*EnhanceEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpgradeCardStarRequest) GetUserId() int64 {
+func (x *EnhanceEquipmentRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpgradeCardStarRequest) GetCardId() int64 {
+func (x *EnhanceEquipmentRequest) GetItemId() int64 {
 	if x != nil {
-		return x.CardId
+		return x.ItemId
 	}
 	return 0
 }
 
-// 卡牌升星响应
-type UpgradeCardStarResponse struct {
+func (x *EnhanceEquipmentRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// 强化装备响应，强化失败时同样扣除消耗
+type EnhanceEquipmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`   // 请求是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`    // 消息
+	Enhanced      bool                   `protobuf:"varint,3,opt,name=enhanced,proto3" json:"enhanced,omitempty"` // 是否强化成功
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`       // 当前强化等级
+	Cost          []*Item                `protobuf:"bytes,5,rep,name=cost,proto3" json:"cost,omitempty"`          // 消耗
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpgradeCardStarResponse) Reset() {
-	*x = UpgradeCardStarResponse{}
-	mi := &file_internal_pb_user_proto_msgTypes[31]
+func (x *EnhanceEquipmentResponse) Reset() {
+	*x = EnhanceEquipmentResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpgradeCardStarResponse) String() string {
+func (x *EnhanceEquipmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradeCardStarResponse) ProtoMessage() {}
+func (*EnhanceEquipmentResponse) ProtoMessage() {}
 
-func (x *UpgradeCardStarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[31]
+func (x *EnhanceEquipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1772,54 +1812,71 @@ func (x *UpgradeCardStarResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradeCardStarResponse.ProtoReflect.Descriptor instead.
-func (*UpgradeCardStarResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use EnhanceEquipmentResponse.ProtoReflect.Descriptor instead.
+func (*EnhanceEquipmentResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UpgradeCardStarResponse) GetSuccess() bool {
+func (x *EnhanceEquipmentResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpgradeCardStarResponse) GetMessage() string {
+func (x *EnhanceEquipmentResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// 宠物信息
-type Pet struct {
+func (x *EnhanceEquipmentResponse) GetEnhanced() bool {
+	if x != nil {
+		return x.Enhanced
+	}
+	return false
+}
+
+func (x *EnhanceEquipmentResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *EnhanceEquipmentResponse) GetCost() []*Item {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+// 洗练装备请求
+type RefineEquipmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                   // 宠物实例ID
-	TemplateId    int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 宠物模板ID
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                // 宠物名称
-	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`                             // 宠物等级
-	Exp           int32                  `protobuf:"varint,5,opt,name=exp,proto3" json:"exp,omitempty"`                                 // 当前经验
-	IsBattle      bool                   `protobuf:"varint,6,opt,name=is_battle,json=isBattle,proto3" json:"is_battle,omitempty"`       // 是否出战
-	Properties    string                 `protobuf:"bytes,7,opt,name=properties,proto3" json:"properties,omitempty"`                    // JSON格式的宠物属性
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // 用户ID
+	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`         // 背包物品ID
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // 客户端请求ID，重试时保持不变
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Pet) Reset() {
-	*x = Pet{}
-	mi := &file_internal_pb_user_proto_msgTypes[32]
+func (x *RefineEquipmentRequest) Reset() {
+	*x = RefineEquipmentRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Pet) String() string {
+func (x *RefineEquipmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pet) ProtoMessage() {}
+func (*RefineEquipmentRequest) ProtoMessage() {}
 
-func (x *Pet) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[32]
+func (x *RefineEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1830,82 +1887,123 @@ func (x *Pet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pet.ProtoReflect.Descriptor instead.
-func (*Pet) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use RefineEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*RefineEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Pet) GetId() int64 {
+func (x *RefineEquipmentRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *Pet) GetTemplateId() int64 {
+func (x *RefineEquipmentRequest) GetItemId() int64 {
 	if x != nil {
-		return x.TemplateId
+		return x.ItemId
 	}
 	return 0
 }
 
-func (x *Pet) GetName() string {
+func (x *RefineEquipmentRequest) GetRequestId() string {
 	if x != nil {
-		return x.Name
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *Pet) GetLevel() int32 {
-	if x != nil {
-		return x.Level
-	}
-	return 0
+// 洗练装备响应
+type RefineEquipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Affixes       []*EquipmentAffix      `protobuf:"bytes,3,rep,name=affixes,proto3" json:"affixes,omitempty"`  // 洗练后的词条
+	Cost          []*Item                `protobuf:"bytes,4,rep,name=cost,proto3" json:"cost,omitempty"`        // 消耗
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Pet) GetExp() int32 {
+func (x *RefineEquipmentResponse) Reset() {
+	*x = RefineEquipmentResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefineEquipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefineEquipmentResponse) ProtoMessage() {}
+
+func (x *RefineEquipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[32]
 	if x != nil {
-		return x.Exp
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Pet) GetIsBattle() bool {
+// Deprecated: Use RefineEquipmentResponse.ProtoReflect.Descriptor instead.
+func (*RefineEquipmentResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RefineEquipmentResponse) GetSuccess() bool {
 	if x != nil {
-		return x.IsBattle
+		return x.Success
 	}
 	return false
 }
 
-func (x *Pet) GetProperties() string {
+func (x *RefineEquipmentResponse) GetMessage() string {
 	if x != nil {
-		return x.Properties
+		return x.Message
 	}
 	return ""
 }
 
-// 获取玩家宠物请求
-type GetUserPetsRequest struct {
+func (x *RefineEquipmentResponse) GetAffixes() []*EquipmentAffix {
+	if x != nil {
+		return x.Affixes
+	}
+	return nil
+}
+
+func (x *RefineEquipmentResponse) GetCost() []*Item {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+// 获取玩家信息
+type GetUserInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 玩家ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserPetsRequest) Reset() {
-	*x = GetUserPetsRequest{}
+func (x *GetUserInfoRequest) Reset() {
+	*x = GetUserInfoRequest{}
 	mi := &file_internal_pb_user_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPetsRequest) String() string {
+func (x *GetUserInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPetsRequest) ProtoMessage() {}
+func (*GetUserInfoRequest) ProtoMessage() {}
 
-func (x *GetUserPetsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1917,40 +2015,44 @@ func (x *GetUserPetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPetsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserPetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetUserInfoRequest) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetUserPetsRequest) GetUserId() int64 {
+func (x *GetUserInfoRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-// 获取玩家宠物响应
-type GetUserPetsResponse struct {
+// 获取玩家信息
+type GetUserInfoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pets          []*Pet                 `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty"` // 宠物列表
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 玩家ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                   // 玩家名称
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`                                // 等级
+	Attributes    *UserAttributes        `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`                       // 最终属性
+	CombatPower   int64                  `protobuf:"varint,5,opt,name=combat_power,json=combatPower,proto3" json:"combat_power,omitempty"` // 战力
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserPetsResponse) Reset() {
-	*x = GetUserPetsResponse{}
+func (x *GetUserInfoResponse) Reset() {
+	*x = GetUserInfoResponse{}
 	mi := &file_internal_pb_user_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPetsResponse) String() string {
+func (x *GetUserInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPetsResponse) ProtoMessage() {}
+func (*GetUserInfoResponse) ProtoMessage() {}
 
-func (x *GetUserPetsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUserInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1962,41 +2064,70 @@ func (x *GetUserPetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPetsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserPetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetUserInfoResponse) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetUserPetsResponse) GetPets() []*Pet {
+func (x *GetUserInfoResponse) GetUserId() int64 {
 	if x != nil {
-		return x.Pets
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUserInfoResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GetUserInfoResponse) GetAttributes() *UserAttributes {
+	if x != nil {
+		return x.Attributes
 	}
 	return nil
 }
 
-// 添加宠物请求
-type AddPetRequest struct {
+func (x *GetUserInfoResponse) GetCombatPower() int64 {
+	if x != nil {
+		return x.CombatPower
+	}
+	return 0
+}
+
+// 玩家最终属性
+type UserAttributes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 用户ID
-	TemplateId    int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 宠物模板ID
+	Atk           int64                  `protobuf:"varint,1,opt,name=atk,proto3" json:"atk,omitempty"`                  // 攻击
+	Def           int64                  `protobuf:"varint,2,opt,name=def,proto3" json:"def,omitempty"`                  // 防御
+	HpMax         int64                  `protobuf:"varint,3,opt,name=hp_max,json=hpMax,proto3" json:"hp_max,omitempty"` // 生命上限
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPetRequest) Reset() {
-	*x = AddPetRequest{}
+func (x *UserAttributes) Reset() {
+	*x = UserAttributes{}
 	mi := &file_internal_pb_user_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPetRequest) String() string {
+func (x *UserAttributes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPetRequest) ProtoMessage() {}
+func (*UserAttributes) ProtoMessage() {}
 
-func (x *AddPetRequest) ProtoReflect() protoreflect.Message {
+func (x *UserAttributes) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2008,48 +2139,60 @@ func (x *AddPetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPetRequest.ProtoReflect.Descriptor instead.
-func (*AddPetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserAttributes.ProtoReflect.Descriptor instead.
+func (*UserAttributes) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *AddPetRequest) GetUserId() int64 {
+func (x *UserAttributes) GetAtk() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Atk
 	}
 	return 0
 }
 
-func (x *AddPetRequest) GetTemplateId() int64 {
+func (x *UserAttributes) GetDef() int64 {
 	if x != nil {
-		return x.TemplateId
+		return x.Def
 	}
 	return 0
 }
 
-// 添加宠物响应
-type AddPetResponse struct {
+func (x *UserAttributes) GetHpMax() int64 {
+	if x != nil {
+		return x.HpMax
+	}
+	return 0
+}
+
+// 卡牌信息
+type Card struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                   // 卡牌实例ID
+	TemplateId    int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 卡牌模板ID
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                // 卡牌名称
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`                             // 卡牌等级
+	Star          int32                  `protobuf:"varint,5,opt,name=star,proto3" json:"star,omitempty"`                               // 卡牌星级
+	Activated     bool                   `protobuf:"varint,6,opt,name=activated,proto3" json:"activated,omitempty"`                     // 是否已激活
+	Properties    string                 `protobuf:"bytes,7,opt,name=properties,proto3" json:"properties,omitempty"`                    // JSON格式的卡牌属性
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPetResponse) Reset() {
-	*x = AddPetResponse{}
+func (x *Card) Reset() {
+	*x = Card{}
 	mi := &file_internal_pb_user_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPetResponse) String() string {
+func (x *Card) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPetResponse) ProtoMessage() {}
+func (*Card) ProtoMessage() {}
 
-func (x *AddPetResponse) ProtoReflect() protoreflect.Message {
+func (x *Card) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2061,49 +2204,82 @@ func (x *AddPetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPetResponse.ProtoReflect.Descriptor instead.
-func (*AddPetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *AddPetResponse) GetSuccess() bool {
+func (x *Card) GetId() int64 {
 	if x != nil {
-		return x.Success
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Card) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *Card) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Card) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Card) GetStar() int32 {
+	if x != nil {
+		return x.Star
+	}
+	return 0
+}
+
+func (x *Card) GetActivated() bool {
+	if x != nil {
+		return x.Activated
 	}
 	return false
 }
 
-func (x *AddPetResponse) GetMessage() string {
+func (x *Card) GetProperties() string {
 	if x != nil {
-		return x.Message
+		return x.Properties
 	}
 	return ""
 }
 
-// 设置宠物出战状态请求
-type SetPetBattleStatusRequest struct {
+// 获取玩家卡牌请求
+type GetUserCardsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
-	PetId         int64                  `protobuf:"varint,2,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`          // 宠物ID
-	IsBattle      bool                   `protobuf:"varint,3,opt,name=is_battle,json=isBattle,proto3" json:"is_battle,omitempty"` // 是否出战
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPetBattleStatusRequest) Reset() {
-	*x = SetPetBattleStatusRequest{}
+func (x *GetUserCardsRequest) Reset() {
+	*x = GetUserCardsRequest{}
 	mi := &file_internal_pb_user_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPetBattleStatusRequest) String() string {
+func (x *GetUserCardsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPetBattleStatusRequest) ProtoMessage() {}
+func (*GetUserCardsRequest) ProtoMessage() {}
 
-func (x *SetPetBattleStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserCardsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2115,55 +2291,41 @@ func (x *SetPetBattleStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPetBattleStatusRequest.ProtoReflect.Descriptor instead.
-func (*SetPetBattleStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserCardsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserCardsRequest) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SetPetBattleStatusRequest) GetUserId() int64 {
+func (x *GetUserCardsRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *SetPetBattleStatusRequest) GetPetId() int64 {
-	if x != nil {
-		return x.PetId
-	}
-	return 0
-}
-
-func (x *SetPetBattleStatusRequest) GetIsBattle() bool {
-	if x != nil {
-		return x.IsBattle
-	}
-	return false
-}
-
-// 设置宠物出战状态响应
-type SetPetBattleStatusResponse struct {
+// 获取玩家卡牌响应
+type GetUserCardsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`     // 卡牌列表
+	Bonuses       []*CardSetBonus        `protobuf:"bytes,2,rep,name=bonuses,proto3" json:"bonuses,omitempty"` // 生效中的羁绊加成
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPetBattleStatusResponse) Reset() {
-	*x = SetPetBattleStatusResponse{}
+func (x *GetUserCardsResponse) Reset() {
+	*x = GetUserCardsResponse{}
 	mi := &file_internal_pb_user_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPetBattleStatusResponse) String() string {
+func (x *GetUserCardsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPetBattleStatusResponse) ProtoMessage() {}
+func (*GetUserCardsResponse) ProtoMessage() {}
 
-func (x *SetPetBattleStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUserCardsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2175,49 +2337,53 @@ func (x *SetPetBattleStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPetBattleStatusResponse.ProtoReflect.Descriptor instead.
-func (*SetPetBattleStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserCardsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserCardsResponse) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *SetPetBattleStatusResponse) GetSuccess() bool {
+func (x *GetUserCardsResponse) GetCards() []*Card {
 	if x != nil {
-		return x.Success
+		return x.Cards
 	}
-	return false
+	return nil
 }
 
-func (x *SetPetBattleStatusResponse) GetMessage() string {
+func (x *GetUserCardsResponse) GetBonuses() []*CardSetBonus {
 	if x != nil {
-		return x.Message
+		return x.Bonuses
 	}
-	return ""
+	return nil
 }
 
-// 增加宠物经验请求
-type AddPetExpRequest struct {
+// 卡牌羁绊加成
+type CardSetBonus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
-	PetId         int64                  `protobuf:"varint,2,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`    // 宠物ID
-	Exp           int32                  `protobuf:"varint,3,opt,name=exp,proto3" json:"exp,omitempty"`                     // 增加的经验值
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                    // 羁绊加成ID
+	SetId         int64                  `protobuf:"varint,2,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"` // 羁绊ID
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                 // 羁绊名称
+	Cards         []int64                `protobuf:"varint,4,rep,packed,name=cards,proto3" json:"cards,omitempty"`       // 需要的卡牌模板ID
+	Level         int32                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`              // 每张卡牌需要达到的等级
+	Star          int32                  `protobuf:"varint,6,opt,name=star,proto3" json:"star,omitempty"`                // 每张卡牌需要达到的星级
+	Properties    string                 `protobuf:"bytes,7,opt,name=properties,proto3" json:"properties,omitempty"`     // JSON格式的加成属性
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPetExpRequest) Reset() {
-	*x = AddPetExpRequest{}
+func (x *CardSetBonus) Reset() {
+	*x = CardSetBonus{}
 	mi := &file_internal_pb_user_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPetExpRequest) String() string {
+func (x *CardSetBonus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPetExpRequest) ProtoMessage() {}
+func (*CardSetBonus) ProtoMessage() {}
 
-func (x *AddPetExpRequest) ProtoReflect() protoreflect.Message {
+func (x *CardSetBonus) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2229,55 +2395,83 @@ func (x *AddPetExpRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPetExpRequest.ProtoReflect.Descriptor instead.
-func (*AddPetExpRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CardSetBonus.ProtoReflect.Descriptor instead.
+func (*CardSetBonus) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AddPetExpRequest) GetUserId() int64 {
+func (x *CardSetBonus) GetId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *AddPetExpRequest) GetPetId() int64 {
+func (x *CardSetBonus) GetSetId() int64 {
 	if x != nil {
-		return x.PetId
+		return x.SetId
 	}
 	return 0
 }
 
-func (x *AddPetExpRequest) GetExp() int32 {
+func (x *CardSetBonus) GetName() string {
 	if x != nil {
-		return x.Exp
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CardSetBonus) GetCards() []int64 {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *CardSetBonus) GetLevel() int32 {
+	if x != nil {
+		return x.Level
 	}
 	return 0
 }
 
-// 增加宠物经验响应
-type AddPetExpResponse struct {
+func (x *CardSetBonus) GetStar() int32 {
+	if x != nil {
+		return x.Star
+	}
+	return 0
+}
+
+func (x *CardSetBonus) GetProperties() string {
+	if x != nil {
+		return x.Properties
+	}
+	return ""
+}
+
+// 激活卡牌请求
+type ActivateCardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 用户ID
+	TemplateId    int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 卡牌模板ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPetExpResponse) Reset() {
-	*x = AddPetExpResponse{}
+func (x *ActivateCardRequest) Reset() {
+	*x = ActivateCardRequest{}
 	mi := &file_internal_pb_user_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPetExpResponse) String() string {
+func (x *ActivateCardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPetExpResponse) ProtoMessage() {}
+func (*ActivateCardRequest) ProtoMessage() {}
 
-func (x *AddPetExpResponse) ProtoReflect() protoreflect.Message {
+func (x *ActivateCardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2289,52 +2483,49 @@ func (x *AddPetExpResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPetExpResponse.ProtoReflect.Descriptor instead.
-func (*AddPetExpResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivateCardRequest.ProtoReflect.Descriptor instead.
+func (*ActivateCardRequest) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AddPetExpResponse) GetSuccess() bool {
+func (x *ActivateCardRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Success
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
-func (x *AddPetExpResponse) GetMessage() string {
+func (x *ActivateCardRequest) GetTemplateId() int64 {
 	if x != nil {
-		return x.Message
+		return x.TemplateId
 	}
-	return ""
+	return 0
 }
 
-// 月签到信息
-type MonthlySignInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`                                          // 年份
-	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`                                        // 月份
-	SignDays      []int32                `protobuf:"varint,3,rep,packed,name=sign_days,json=signDays,proto3" json:"sign_days,omitempty"`           // 已签到的日期列表
-	TotalSignDays int32                  `protobuf:"varint,4,opt,name=total_sign_days,json=totalSignDays,proto3" json:"total_sign_days,omitempty"` // 累计签到天数
-	CanSignToday  bool                   `protobuf:"varint,5,opt,name=can_sign_today,json=canSignToday,proto3" json:"can_sign_today,omitempty"`    // 今日是否可以签到
-	Today         int32                  `protobuf:"varint,6,opt,name=today,proto3" json:"today,omitempty"`                                        // 今日日期
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 激活卡牌响应
+type ActivateCardResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                       // 是否成功
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                        // 消息
+	UnlockedBonuses []*CardSetBonus        `protobuf:"bytes,3,rep,name=unlocked_bonuses,json=unlockedBonuses,proto3" json:"unlocked_bonuses,omitempty"` // 本次新生效的羁绊加成
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *MonthlySignInfo) Reset() {
-	*x = MonthlySignInfo{}
+func (x *ActivateCardResponse) Reset() {
+	*x = ActivateCardResponse{}
 	mi := &file_internal_pb_user_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonthlySignInfo) String() string {
+func (x *ActivateCardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonthlySignInfo) ProtoMessage() {}
+func (*ActivateCardResponse) ProtoMessage() {}
 
-func (x *MonthlySignInfo) ProtoReflect() protoreflect.Message {
+func (x *ActivateCardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_pb_user_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2346,76 +2537,4744 @@ func (x *MonthlySignInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonthlySignInfo.ProtoReflect.Descriptor instead.
-func (*MonthlySignInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivateCardResponse.ProtoReflect.Descriptor instead.
+func (*ActivateCardResponse) Descriptor() ([]byte, []int) {
 	return file_internal_pb_user_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *MonthlySignInfo) GetYear() int32 {
+func (x *ActivateCardResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Year
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *MonthlySignInfo) GetMonth() int32 {
+func (x *ActivateCardResponse) GetMessage() string {
 	if x != nil {
-		return x.Month
+		return x.Message
 	}
-	return 0
+	return ""
+}
+
+func (x *ActivateCardResponse) GetUnlockedBonuses() []*CardSetBonus {
+	if x != nil {
+		return x.UnlockedBonuses
+	}
+	return nil
+}
+
+// 卡牌升级请求
+type UpgradeCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	CardId        int64                  `protobuf:"varint,2,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"` // 卡牌ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeCardRequest) Reset() {
+	*x = UpgradeCardRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeCardRequest) ProtoMessage() {}
+
+func (x *UpgradeCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeCardRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeCardRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpgradeCardRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpgradeCardRequest) GetCardId() int64 {
+	if x != nil {
+		return x.CardId
+	}
+	return 0
+}
+
+// 卡牌升级响应
+type UpgradeCardResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                       // 是否成功
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                        // 消息
+	UnlockedBonuses []*CardSetBonus        `protobuf:"bytes,3,rep,name=unlocked_bonuses,json=unlockedBonuses,proto3" json:"unlocked_bonuses,omitempty"` // 本次新生效的羁绊加成
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpgradeCardResponse) Reset() {
+	*x = UpgradeCardResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeCardResponse) ProtoMessage() {}
+
+func (x *UpgradeCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeCardResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeCardResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpgradeCardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpgradeCardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpgradeCardResponse) GetUnlockedBonuses() []*CardSetBonus {
+	if x != nil {
+		return x.UnlockedBonuses
+	}
+	return nil
+}
+
+// 卡牌升星请求
+type UpgradeCardStarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	CardId        int64                  `protobuf:"varint,2,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"` // 卡牌ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeCardStarRequest) Reset() {
+	*x = UpgradeCardStarRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeCardStarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeCardStarRequest) ProtoMessage() {}
+
+func (x *UpgradeCardStarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeCardStarRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeCardStarRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpgradeCardStarRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpgradeCardStarRequest) GetCardId() int64 {
+	if x != nil {
+		return x.CardId
+	}
+	return 0
+}
+
+// 卡牌升星响应
+type UpgradeCardStarResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                       // 是否成功
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                        // 消息
+	UnlockedBonuses []*CardSetBonus        `protobuf:"bytes,3,rep,name=unlocked_bonuses,json=unlockedBonuses,proto3" json:"unlocked_bonuses,omitempty"` // 本次新生效的羁绊加成
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpgradeCardStarResponse) Reset() {
+	*x = UpgradeCardStarResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeCardStarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeCardStarResponse) ProtoMessage() {}
+
+func (x *UpgradeCardStarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeCardStarResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeCardStarResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpgradeCardStarResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpgradeCardStarResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpgradeCardStarResponse) GetUnlockedBonuses() []*CardSetBonus {
+	if x != nil {
+		return x.UnlockedBonuses
+	}
+	return nil
+}
+
+// 宠物信息
+type Pet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                   // 宠物实例ID
+	TemplateId    int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 宠物模板ID
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                // 宠物名称
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`                             // 宠物等级
+	Exp           int32                  `protobuf:"varint,5,opt,name=exp,proto3" json:"exp,omitempty"`                                 // 当前经验
+	IsBattle      bool                   `protobuf:"varint,6,opt,name=is_battle,json=isBattle,proto3" json:"is_battle,omitempty"`       // 是否出战
+	Properties    string                 `protobuf:"bytes,7,opt,name=properties,proto3" json:"properties,omitempty"`                    // JSON格式的宠物属性
+	Star          int32                  `protobuf:"varint,8,opt,name=star,proto3" json:"star,omitempty"`                               // 星级
+	BattleSlot    int32                  `protobuf:"varint,9,opt,name=battle_slot,json=battleSlot,proto3" json:"battle_slot,omitempty"` // 出战位，0表示未出战
+	Skills        []*PetSkill            `protobuf:"bytes,10,rep,name=skills,proto3" json:"skills,omitempty"`                           // 已学会的技能
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pet) Reset() {
+	*x = Pet{}
+	mi := &file_internal_pb_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pet) ProtoMessage() {}
+
+func (x *Pet) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pet.ProtoReflect.Descriptor instead.
+func (*Pet) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Pet) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Pet) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *Pet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pet) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Pet) GetExp() int32 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *Pet) GetIsBattle() bool {
+	if x != nil {
+		return x.IsBattle
+	}
+	return false
+}
+
+func (x *Pet) GetProperties() string {
+	if x != nil {
+		return x.Properties
+	}
+	return ""
+}
+
+func (x *Pet) GetStar() int32 {
+	if x != nil {
+		return x.Star
+	}
+	return 0
+}
+
+func (x *Pet) GetBattleSlot() int32 {
+	if x != nil {
+		return x.BattleSlot
+	}
+	return 0
+}
+
+func (x *Pet) GetSkills() []*PetSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+// 宠物技能
+type PetSkill struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          int32                  `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`                      // 技能槽序号
+	SkillId       int64                  `protobuf:"varint,2,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"` // 技能ID
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                       // 技能名称
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetSkill) Reset() {
+	*x = PetSkill{}
+	mi := &file_internal_pb_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetSkill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetSkill) ProtoMessage() {}
+
+func (x *PetSkill) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetSkill.ProtoReflect.Descriptor instead.
+func (*PetSkill) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PetSkill) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *PetSkill) GetSkillId() int64 {
+	if x != nil {
+		return x.SkillId
+	}
+	return 0
+}
+
+func (x *PetSkill) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// 获取玩家宠物请求
+type GetUserPetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPetsRequest) Reset() {
+	*x = GetUserPetsRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPetsRequest) ProtoMessage() {}
+
+func (x *GetUserPetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPetsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPetsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetUserPetsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取玩家宠物响应
+type GetUserPetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pets          []*Pet                 `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty"` // 宠物列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPetsResponse) Reset() {
+	*x = GetUserPetsResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPetsResponse) ProtoMessage() {}
+
+func (x *GetUserPetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPetsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPetsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetUserPetsResponse) GetPets() []*Pet {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+// 添加宠物请求
+type AddPetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 用户ID
+	TemplateId    int64                  `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 宠物模板ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPetRequest) Reset() {
+	*x = AddPetRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPetRequest) ProtoMessage() {}
+
+func (x *AddPetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPetRequest.ProtoReflect.Descriptor instead.
+func (*AddPetRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AddPetRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddPetRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+// 添加宠物响应
+type AddPetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPetResponse) Reset() {
+	*x = AddPetResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPetResponse) ProtoMessage() {}
+
+func (x *AddPetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPetResponse.ProtoReflect.Descriptor instead.
+func (*AddPetResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AddPetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddPetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 设置宠物出战状态请求
+type SetPetBattleStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
+	PetId         int64                  `protobuf:"varint,2,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`          // 宠物ID
+	IsBattle      bool                   `protobuf:"varint,3,opt,name=is_battle,json=isBattle,proto3" json:"is_battle,omitempty"` // 是否出战
+	Slot          int32                  `protobuf:"varint,4,opt,name=slot,proto3" json:"slot,omitempty"`                         // 出战位，出战时不传默认为第1个出战位
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPetBattleStatusRequest) Reset() {
+	*x = SetPetBattleStatusRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPetBattleStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPetBattleStatusRequest) ProtoMessage() {}
+
+func (x *SetPetBattleStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPetBattleStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetPetBattleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SetPetBattleStatusRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetPetBattleStatusRequest) GetPetId() int64 {
+	if x != nil {
+		return x.PetId
+	}
+	return 0
+}
+
+func (x *SetPetBattleStatusRequest) GetIsBattle() bool {
+	if x != nil {
+		return x.IsBattle
+	}
+	return false
+}
+
+func (x *SetPetBattleStatusRequest) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+// 设置宠物出战状态响应
+type SetPetBattleStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPetBattleStatusResponse) Reset() {
+	*x = SetPetBattleStatusResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPetBattleStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPetBattleStatusResponse) ProtoMessage() {}
+
+func (x *SetPetBattleStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPetBattleStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetPetBattleStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetPetBattleStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetPetBattleStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 增加宠物经验请求
+type AddPetExpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	PetId         int64                  `protobuf:"varint,2,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`    // 宠物ID
+	Exp           int32                  `protobuf:"varint,3,opt,name=exp,proto3" json:"exp,omitempty"`                     // 增加的经验值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPetExpRequest) Reset() {
+	*x = AddPetExpRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPetExpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPetExpRequest) ProtoMessage() {}
+
+func (x *AddPetExpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPetExpRequest.ProtoReflect.Descriptor instead.
+func (*AddPetExpRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AddPetExpRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddPetExpRequest) GetPetId() int64 {
+	if x != nil {
+		return x.PetId
+	}
+	return 0
+}
+
+func (x *AddPetExpRequest) GetExp() int32 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+// 增加宠物经验响应
+type AddPetExpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPetExpResponse) Reset() {
+	*x = AddPetExpResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPetExpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPetExpResponse) ProtoMessage() {}
+
+func (x *AddPetExpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPetExpResponse.ProtoReflect.Descriptor instead.
+func (*AddPetExpResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AddPetExpResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddPetExpResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 宠物升星请求
+type EvolvePetRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                  // 用户ID
+	PetId          int64                  `protobuf:"varint,2,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`                                     // 宠物ID
+	MaterialPetIds []int64                `protobuf:"varint,3,rep,packed,name=material_pet_ids,json=materialPetIds,proto3" json:"material_pet_ids,omitempty"` // 作为材料消耗的同模板宠物ID
+	RequestId      string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`                          // 客户端请求ID，重试时保持不变
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EvolvePetRequest) Reset() {
+	*x = EvolvePetRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvolvePetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvolvePetRequest) ProtoMessage() {}
+
+func (x *EvolvePetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvolvePetRequest.ProtoReflect.Descriptor instead.
+func (*EvolvePetRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *EvolvePetRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EvolvePetRequest) GetPetId() int64 {
+	if x != nil {
+		return x.PetId
+	}
+	return 0
+}
+
+func (x *EvolvePetRequest) GetMaterialPetIds() []int64 {
+	if x != nil {
+		return x.MaterialPetIds
+	}
+	return nil
+}
+
+func (x *EvolvePetRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// 宠物升星响应
+type EvolvePetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Star          int32                  `protobuf:"varint,3,opt,name=star,proto3" json:"star,omitempty"`       // 升星后的星级
+	Cost          []*Item                `protobuf:"bytes,4,rep,name=cost,proto3" json:"cost,omitempty"`        // 消耗的货币和道具
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvolvePetResponse) Reset() {
+	*x = EvolvePetResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvolvePetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvolvePetResponse) ProtoMessage() {}
+
+func (x *EvolvePetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvolvePetResponse.ProtoReflect.Descriptor instead.
+func (*EvolvePetResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *EvolvePetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EvolvePetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EvolvePetResponse) GetStar() int32 {
+	if x != nil {
+		return x.Star
+	}
+	return 0
+}
+
+func (x *EvolvePetResponse) GetCost() []*Item {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+// 学习宠物技能请求
+type LearnPetSkillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // 用户ID
+	PetId         int64                  `protobuf:"varint,2,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`            // 宠物ID
+	Slot          int32                  `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`                           // 技能槽序号
+	SkillId       int64                  `protobuf:"varint,4,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`      // 技能ID
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // 客户端请求ID，重试时保持不变
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearnPetSkillRequest) Reset() {
+	*x = LearnPetSkillRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearnPetSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearnPetSkillRequest) ProtoMessage() {}
+
+func (x *LearnPetSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearnPetSkillRequest.ProtoReflect.Descriptor instead.
+func (*LearnPetSkillRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LearnPetSkillRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LearnPetSkillRequest) GetPetId() int64 {
+	if x != nil {
+		return x.PetId
+	}
+	return 0
+}
+
+func (x *LearnPetSkillRequest) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *LearnPetSkillRequest) GetSkillId() int64 {
+	if x != nil {
+		return x.SkillId
+	}
+	return 0
+}
+
+func (x *LearnPetSkillRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// 学习宠物技能响应
+type LearnPetSkillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Cost          []*Item                `protobuf:"bytes,3,rep,name=cost,proto3" json:"cost,omitempty"`        // 消耗的货币和道具
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearnPetSkillResponse) Reset() {
+	*x = LearnPetSkillResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearnPetSkillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearnPetSkillResponse) ProtoMessage() {}
+
+func (x *LearnPetSkillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearnPetSkillResponse.ProtoReflect.Descriptor instead.
+func (*LearnPetSkillResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *LearnPetSkillResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LearnPetSkillResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LearnPetSkillResponse) GetCost() []*Item {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+// 放生宠物请求
+type ReleasePetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // 用户ID
+	PetId         int64                  `protobuf:"varint,2,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`            // 宠物ID
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // 客户端请求ID，重试时保持不变
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleasePetRequest) Reset() {
+	*x = ReleasePetRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleasePetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasePetRequest) ProtoMessage() {}
+
+func (x *ReleasePetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasePetRequest.ProtoReflect.Descriptor instead.
+func (*ReleasePetRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReleasePetRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReleasePetRequest) GetPetId() int64 {
+	if x != nil {
+		return x.PetId
+	}
+	return 0
+}
+
+func (x *ReleasePetRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// 放生宠物响应
+type ReleasePetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Rewards       []*Item                `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`  // 返还的资源
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleasePetResponse) Reset() {
+	*x = ReleasePetResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleasePetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasePetResponse) ProtoMessage() {}
+
+func (x *ReleasePetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasePetResponse.ProtoReflect.Descriptor instead.
+func (*ReleasePetResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ReleasePetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleasePetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReleasePetResponse) GetRewards() []*Item {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// 月签到信息
+type MonthlySignInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`                                          // 年份
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`                                        // 月份
+	SignDays      []int32                `protobuf:"varint,3,rep,packed,name=sign_days,json=signDays,proto3" json:"sign_days,omitempty"`           // 已签到的日期列表
+	TotalSignDays int32                  `protobuf:"varint,4,opt,name=total_sign_days,json=totalSignDays,proto3" json:"total_sign_days,omitempty"` // 累计签到天数
+	CanSignToday  bool                   `protobuf:"varint,5,opt,name=can_sign_today,json=canSignToday,proto3" json:"can_sign_today,omitempty"`    // 今日是否可以签到
+	Today         int32                  `protobuf:"varint,6,opt,name=today,proto3" json:"today,omitempty"`                                        // 今日日期
+	MakeupCount   int32                  `protobuf:"varint,7,opt,name=makeup_count,json=makeupCount,proto3" json:"makeup_count,omitempty"`         // 本月已补签次数
+	MakeupLimit   int32                  `protobuf:"varint,8,opt,name=makeup_limit,json=makeupLimit,proto3" json:"makeup_limit,omitempty"`         // 每月补签次数上限
+	MakeupCost    []*Item                `protobuf:"bytes,9,rep,name=makeup_cost,json=makeupCost,proto3" json:"makeup_cost,omitempty"`             // 下一次补签的消耗，已达上限时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlySignInfo) Reset() {
+	*x = MonthlySignInfo{}
+	mi := &file_internal_pb_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlySignInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlySignInfo) ProtoMessage() {}
+
+func (x *MonthlySignInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlySignInfo.ProtoReflect.Descriptor instead.
+func (*MonthlySignInfo) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MonthlySignInfo) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *MonthlySignInfo) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *MonthlySignInfo) GetSignDays() []int32 {
+	if x != nil {
+		return x.SignDays
+	}
+	return nil
+}
+
+func (x *MonthlySignInfo) GetTotalSignDays() int32 {
+	if x != nil {
+		return x.TotalSignDays
+	}
+	return 0
+}
+
+func (x *MonthlySignInfo) GetCanSignToday() bool {
+	if x != nil {
+		return x.CanSignToday
+	}
+	return false
+}
+
+func (x *MonthlySignInfo) GetToday() int32 {
+	if x != nil {
+		return x.Today
+	}
+	return 0
+}
+
+func (x *MonthlySignInfo) GetMakeupCount() int32 {
+	if x != nil {
+		return x.MakeupCount
+	}
+	return 0
+}
+
+func (x *MonthlySignInfo) GetMakeupLimit() int32 {
+	if x != nil {
+		return x.MakeupLimit
+	}
+	return 0
+}
+
+func (x *MonthlySignInfo) GetMakeupCost() []*Item {
+	if x != nil {
+		return x.MakeupCost
+	}
+	return nil
+}
+
+// 获取月签到信息请求
+type GetMonthlySignInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonthlySignInfoRequest) Reset() {
+	*x = GetMonthlySignInfoRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthlySignInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthlySignInfoRequest) ProtoMessage() {}
+
+func (x *GetMonthlySignInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthlySignInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetMonthlySignInfoRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetMonthlySignInfoRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取月签到信息响应
+type GetMonthlySignInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *MonthlySignInfo       `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"` // 月签到信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonthlySignInfoResponse) Reset() {
+	*x = GetMonthlySignInfoResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthlySignInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthlySignInfoResponse) ProtoMessage() {}
+
+func (x *GetMonthlySignInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthlySignInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetMonthlySignInfoResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetMonthlySignInfoResponse) GetInfo() *MonthlySignInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// 月签到请求
+type MonthlySignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlySignRequest) Reset() {
+	*x = MonthlySignRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlySignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlySignRequest) ProtoMessage() {}
+
+func (x *MonthlySignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlySignRequest.ProtoReflect.Descriptor instead.
+func (*MonthlySignRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MonthlySignRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 月签到响应
+type MonthlySignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Rewards       []*Item                `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`  // 签到奖励
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlySignResponse) Reset() {
+	*x = MonthlySignResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlySignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlySignResponse) ProtoMessage() {}
+
+func (x *MonthlySignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlySignResponse.ProtoReflect.Descriptor instead.
+func (*MonthlySignResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MonthlySignResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MonthlySignResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MonthlySignResponse) GetRewards() []*Item {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// 领取月签到累计奖励请求
+type ClaimMonthlySignRewardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`                   // 累计天数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimMonthlySignRewardRequest) Reset() {
+	*x = ClaimMonthlySignRewardRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimMonthlySignRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMonthlySignRewardRequest) ProtoMessage() {}
+
+func (x *ClaimMonthlySignRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMonthlySignRewardRequest.ProtoReflect.Descriptor instead.
+func (*ClaimMonthlySignRewardRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ClaimMonthlySignRewardRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ClaimMonthlySignRewardRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// 领取月签到累计奖励响应
+type ClaimMonthlySignRewardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Rewards       []*Item                `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`  // 奖励
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimMonthlySignRewardResponse) Reset() {
+	*x = ClaimMonthlySignRewardResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimMonthlySignRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMonthlySignRewardResponse) ProtoMessage() {}
+
+func (x *ClaimMonthlySignRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMonthlySignRewardResponse.ProtoReflect.Descriptor instead.
+func (*ClaimMonthlySignRewardResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ClaimMonthlySignRewardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ClaimMonthlySignRewardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ClaimMonthlySignRewardResponse) GetRewards() []*Item {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// 月签到补签请求
+type MonthlySignMakeupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Day           int32                  `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`                     // 补签日期，只能是本月今日之前的日期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlySignMakeupRequest) Reset() {
+	*x = MonthlySignMakeupRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlySignMakeupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlySignMakeupRequest) ProtoMessage() {}
+
+func (x *MonthlySignMakeupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlySignMakeupRequest.ProtoReflect.Descriptor instead.
+func (*MonthlySignMakeupRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MonthlySignMakeupRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MonthlySignMakeupRequest) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+// 月签到补签响应
+type MonthlySignMakeupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Rewards       []*Item                `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`  // 补签日期的签到奖励
+	Cost          []*Item                `protobuf:"bytes,4,rep,name=cost,proto3" json:"cost,omitempty"`        // 本次补签的消耗
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlySignMakeupResponse) Reset() {
+	*x = MonthlySignMakeupResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlySignMakeupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlySignMakeupResponse) ProtoMessage() {}
+
+func (x *MonthlySignMakeupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlySignMakeupResponse.ProtoReflect.Descriptor instead.
+func (*MonthlySignMakeupResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *MonthlySignMakeupResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MonthlySignMakeupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MonthlySignMakeupResponse) GetRewards() []*Item {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *MonthlySignMakeupResponse) GetCost() []*Item {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+// 历史月份签到记录
+type MonthlySignHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`                                          // 年份
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`                                        // 月份
+	SignDays      []int32                `protobuf:"varint,3,rep,packed,name=sign_days,json=signDays,proto3" json:"sign_days,omitempty"`           // 已签到的日期列表
+	TotalSignDays int32                  `protobuf:"varint,4,opt,name=total_sign_days,json=totalSignDays,proto3" json:"total_sign_days,omitempty"` // 累计签到天数
+	MakeupCount   int32                  `protobuf:"varint,5,opt,name=makeup_count,json=makeupCount,proto3" json:"makeup_count,omitempty"`         // 补签次数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlySignHistory) Reset() {
+	*x = MonthlySignHistory{}
+	mi := &file_internal_pb_user_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlySignHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlySignHistory) ProtoMessage() {}
+
+func (x *MonthlySignHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlySignHistory.ProtoReflect.Descriptor instead.
+func (*MonthlySignHistory) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MonthlySignHistory) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *MonthlySignHistory) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *MonthlySignHistory) GetSignDays() []int32 {
+	if x != nil {
+		return x.SignDays
+	}
+	return nil
+}
+
+func (x *MonthlySignHistory) GetTotalSignDays() int32 {
+	if x != nil {
+		return x.TotalSignDays
+	}
+	return 0
+}
+
+func (x *MonthlySignHistory) GetMakeupCount() int32 {
+	if x != nil {
+		return x.MakeupCount
+	}
+	return 0
+}
+
+// 获取历史月份签到记录请求
+type GetMonthlySignHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                 // 返回的月份数，默认12
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonthlySignHistoryRequest) Reset() {
+	*x = GetMonthlySignHistoryRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthlySignHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthlySignHistoryRequest) ProtoMessage() {}
+
+func (x *GetMonthlySignHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthlySignHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMonthlySignHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetMonthlySignHistoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetMonthlySignHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 获取历史月份签到记录响应
+type GetMonthlySignHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*MonthlySignHistory  `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"` // 按年月倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonthlySignHistoryResponse) Reset() {
+	*x = GetMonthlySignHistoryResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthlySignHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthlySignHistoryResponse) ProtoMessage() {}
+
+func (x *GetMonthlySignHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthlySignHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMonthlySignHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetMonthlySignHistoryResponse) GetHistory() []*MonthlySignHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// 增加玩家经验请求
+type AddUserExpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Exp           int32                  `protobuf:"varint,2,opt,name=exp,proto3" json:"exp,omitempty"`                     // 增加的经验值
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                // 经验来源，例如 battle、quest、monthly_sign
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUserExpRequest) Reset() {
+	*x = AddUserExpRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUserExpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserExpRequest) ProtoMessage() {}
+
+func (x *AddUserExpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserExpRequest.ProtoReflect.Descriptor instead.
+func (*AddUserExpRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AddUserExpRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddUserExpRequest) GetExp() int32 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *AddUserExpRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// 增加玩家经验响应
+type AddUserExpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                               // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                // 消息
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`                                   // 当前等级
+	Exp           int32                  `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`                                       // 当前经验
+	LevelsGained  int32                  `protobuf:"varint,5,opt,name=levels_gained,json=levelsGained,proto3" json:"levels_gained,omitempty"` // 本次提升的等级数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUserExpResponse) Reset() {
+	*x = AddUserExpResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUserExpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserExpResponse) ProtoMessage() {}
+
+func (x *AddUserExpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserExpResponse.ProtoReflect.Descriptor instead.
+func (*AddUserExpResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AddUserExpResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddUserExpResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddUserExpResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AddUserExpResponse) GetExp() int32 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *AddUserExpResponse) GetLevelsGained() int32 {
+	if x != nil {
+		return x.LevelsGained
+	}
+	return 0
+}
+
+// 邮件附件
+type MailAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 物品模板ID
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                             // 数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MailAttachment) Reset() {
+	*x = MailAttachment{}
+	mi := &file_internal_pb_user_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MailAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailAttachment) ProtoMessage() {}
+
+func (x *MailAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailAttachment.ProtoReflect.Descriptor instead.
+func (*MailAttachment) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *MailAttachment) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *MailAttachment) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 邮件
+type Mail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MailId        int64                  `protobuf:"varint,1,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"`            // 邮件ID
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`                              // 邮件类型：1系统 2全服 3玩家
+	SenderId      int64                  `protobuf:"varint,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`      // 发件人ID，系统邮件为0
+	SenderName    string                 `protobuf:"bytes,4,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"` // 发件人名称
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`                             // 标题
+	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`                         // 正文
+	Attachments   []*Item                `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`                 // 附件
+	IsRead        bool                   `protobuf:"varint,8,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`            // 是否已读
+	IsClaimed     bool                   `protobuf:"varint,9,opt,name=is_claimed,json=isClaimed,proto3" json:"is_claimed,omitempty"`   // 附件是否已领取
+	ExpireAt      int64                  `protobuf:"varint,10,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`     // 过期时间
+	CreatedAt     int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // 发送时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mail) Reset() {
+	*x = Mail{}
+	mi := &file_internal_pb_user_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mail) ProtoMessage() {}
+
+func (x *Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mail.ProtoReflect.Descriptor instead.
+func (*Mail) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Mail) GetMailId() int64 {
+	if x != nil {
+		return x.MailId
+	}
+	return 0
+}
+
+func (x *Mail) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Mail) GetSenderId() int64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *Mail) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *Mail) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Mail) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Mail) GetAttachments() []*Item {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Mail) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *Mail) GetIsClaimed() bool {
+	if x != nil {
+		return x.IsClaimed
+	}
+	return false
+}
+
+func (x *Mail) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *Mail) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 邮件到达通知
+type NewMailNotify struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mail          *Mail                  `protobuf:"bytes,1,opt,name=mail,proto3" json:"mail,omitempty"` // 新邮件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewMailNotify) Reset() {
+	*x = NewMailNotify{}
+	mi := &file_internal_pb_user_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewMailNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewMailNotify) ProtoMessage() {}
+
+func (x *NewMailNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewMailNotify.ProtoReflect.Descriptor instead.
+func (*NewMailNotify) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *NewMailNotify) GetMail() *Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+// 获取邮件列表请求
+type GetMailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMailsRequest) Reset() {
+	*x = GetMailsRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMailsRequest) ProtoMessage() {}
+
+func (x *GetMailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMailsRequest.ProtoReflect.Descriptor instead.
+func (*GetMailsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetMailsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取邮件列表响应
+type GetMailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mails         []*Mail                `protobuf:"bytes,1,rep,name=mails,proto3" json:"mails,omitempty"`                                 // 邮件列表
+	UnreadCount   int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"` // 未读邮件数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMailsResponse) Reset() {
+	*x = GetMailsResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMailsResponse) ProtoMessage() {}
+
+func (x *GetMailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMailsResponse.ProtoReflect.Descriptor instead.
+func (*GetMailsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetMailsResponse) GetMails() []*Mail {
+	if x != nil {
+		return x.Mails
+	}
+	return nil
+}
+
+func (x *GetMailsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+// 阅读邮件请求
+type ReadMailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	MailId        int64                  `protobuf:"varint,2,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"` // 邮件ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadMailRequest) Reset() {
+	*x = ReadMailRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadMailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMailRequest) ProtoMessage() {}
+
+func (x *ReadMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMailRequest.ProtoReflect.Descriptor instead.
+func (*ReadMailRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ReadMailRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReadMailRequest) GetMailId() int64 {
+	if x != nil {
+		return x.MailId
+	}
+	return 0
+}
+
+// 阅读邮件响应
+type ReadMailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Mail          *Mail                  `protobuf:"bytes,3,opt,name=mail,proto3" json:"mail,omitempty"`        // 邮件内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadMailResponse) Reset() {
+	*x = ReadMailResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadMailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMailResponse) ProtoMessage() {}
+
+func (x *ReadMailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMailResponse.ProtoReflect.Descriptor instead.
+func (*ReadMailResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ReadMailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReadMailResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReadMailResponse) GetMail() *Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+// 领取邮件附件请求
+type ClaimMailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	MailId        int64                  `protobuf:"varint,2,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"` // 邮件ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimMailRequest) Reset() {
+	*x = ClaimMailRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimMailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMailRequest) ProtoMessage() {}
+
+func (x *ClaimMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMailRequest.ProtoReflect.Descriptor instead.
+func (*ClaimMailRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ClaimMailRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ClaimMailRequest) GetMailId() int64 {
+	if x != nil {
+		return x.MailId
+	}
+	return 0
+}
+
+// 领取邮件附件响应
+type ClaimMailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Rewards       []*Item                `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`  // 领取到的物品
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimMailResponse) Reset() {
+	*x = ClaimMailResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimMailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMailResponse) ProtoMessage() {}
+
+func (x *ClaimMailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMailResponse.ProtoReflect.Descriptor instead.
+func (*ClaimMailResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ClaimMailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ClaimMailResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ClaimMailResponse) GetRewards() []*Item {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// 删除邮件请求
+type DeleteMailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	MailId        int64                  `protobuf:"varint,2,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"` // 邮件ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMailRequest) Reset() {
+	*x = DeleteMailRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMailRequest) ProtoMessage() {}
+
+func (x *DeleteMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMailRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMailRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeleteMailRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteMailRequest) GetMailId() int64 {
+	if x != nil {
+		return x.MailId
+	}
+	return 0
+}
+
+// 删除邮件响应
+type DeleteMailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMailResponse) Reset() {
+	*x = DeleteMailResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMailResponse) ProtoMessage() {}
+
+func (x *DeleteMailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMailResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMailResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteMailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteMailResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 发送玩家邮件请求
+type SendMailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 发件人ID
+	ReceiverId    int64                  `protobuf:"varint,2,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"` // 收件人ID
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                              // 标题
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                          // 正文
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMailRequest) Reset() {
+	*x = SendMailRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMailRequest) ProtoMessage() {}
+
+func (x *SendMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMailRequest.ProtoReflect.Descriptor instead.
+func (*SendMailRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SendMailRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SendMailRequest) GetReceiverId() int64 {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return 0
+}
+
+func (x *SendMailRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SendMailRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// 发送玩家邮件响应
+type SendMailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`             // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`              // 消息
+	MailId        int64                  `protobuf:"varint,3,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"` // 邮件ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMailResponse) Reset() {
+	*x = SendMailResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMailResponse) ProtoMessage() {}
+
+func (x *SendMailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMailResponse.ProtoReflect.Descriptor instead.
+func (*SendMailResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SendMailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendMailResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendMailResponse) GetMailId() int64 {
+	if x != nil {
+		return x.MailId
+	}
+	return 0
+}
+
+// 发送系统邮件请求
+type SendSystemMailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`   // 收件人ID列表
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                              // 标题
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                          // 正文
+	Attachments   []*MailAttachment      `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`                  // 附件
+	ExpireDays    int32                  `protobuf:"varint,5,opt,name=expire_days,json=expireDays,proto3" json:"expire_days,omitempty"` // 有效天数，0使用默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendSystemMailRequest) Reset() {
+	*x = SendSystemMailRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendSystemMailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSystemMailRequest) ProtoMessage() {}
+
+func (x *SendSystemMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSystemMailRequest.ProtoReflect.Descriptor instead.
+func (*SendSystemMailRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SendSystemMailRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *SendSystemMailRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SendSystemMailRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SendSystemMailRequest) GetAttachments() []*MailAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *SendSystemMailRequest) GetExpireDays() int32 {
+	if x != nil {
+		return x.ExpireDays
+	}
+	return 0
+}
+
+// 发送系统邮件响应
+type SendSystemMailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendSystemMailResponse) Reset() {
+	*x = SendSystemMailResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendSystemMailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSystemMailResponse) ProtoMessage() {}
+
+func (x *SendSystemMailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSystemMailResponse.ProtoReflect.Descriptor instead.
+func (*SendSystemMailResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SendSystemMailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendSystemMailResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 发送全服邮件请求
+type SendBroadcastMailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`                              // 标题
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                          // 正文
+	Attachments   []*MailAttachment      `protobuf:"bytes,3,rep,name=attachments,proto3" json:"attachments,omitempty"`                  // 附件
+	ExpireDays    int32                  `protobuf:"varint,4,opt,name=expire_days,json=expireDays,proto3" json:"expire_days,omitempty"` // 有效天数，0使用默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendBroadcastMailRequest) Reset() {
+	*x = SendBroadcastMailRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendBroadcastMailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendBroadcastMailRequest) ProtoMessage() {}
+
+func (x *SendBroadcastMailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendBroadcastMailRequest.ProtoReflect.Descriptor instead.
+func (*SendBroadcastMailRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SendBroadcastMailRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SendBroadcastMailRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SendBroadcastMailRequest) GetAttachments() []*MailAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *SendBroadcastMailRequest) GetExpireDays() int32 {
+	if x != nil {
+		return x.ExpireDays
+	}
+	return 0
+}
+
+// 发送全服邮件响应
+type SendBroadcastMailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                            // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                             // 消息
+	BroadcastId   int64                  `protobuf:"varint,3,opt,name=broadcast_id,json=broadcastId,proto3" json:"broadcast_id,omitempty"` // 全服邮件ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendBroadcastMailResponse) Reset() {
+	*x = SendBroadcastMailResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendBroadcastMailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendBroadcastMailResponse) ProtoMessage() {}
+
+func (x *SendBroadcastMailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendBroadcastMailResponse.ProtoReflect.Descriptor instead.
+func (*SendBroadcastMailResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SendBroadcastMailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendBroadcastMailResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendBroadcastMailResponse) GetBroadcastId() int64 {
+	if x != nil {
+		return x.BroadcastId
+	}
+	return 0
+}
+
+// 货币余额
+type CurrencyBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyId    int64                  `protobuf:"varint,1,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"` // 货币ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                // 货币名称
+	Balance       int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`                         // 余额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyBalance) Reset() {
+	*x = CurrencyBalance{}
+	mi := &file_internal_pb_user_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyBalance) ProtoMessage() {}
+
+func (x *CurrencyBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyBalance.ProtoReflect.Descriptor instead.
+func (*CurrencyBalance) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CurrencyBalance) GetCurrencyId() int64 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *CurrencyBalance) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CurrencyBalance) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+// 货币流水
+type CurrencyLedgerEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // 流水ID
+	CurrencyId    int64                  `protobuf:"varint,2,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`       // 货币ID
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                                 // 变动数量，负数为扣除
+	BalanceAfter  int64                  `protobuf:"varint,4,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"` // 变动后余额
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                  // 变动原因
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`                                  // 关联来源
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`          // 变动时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyLedgerEntry) Reset() {
+	*x = CurrencyLedgerEntry{}
+	mi := &file_internal_pb_user_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyLedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyLedgerEntry) ProtoMessage() {}
+
+func (x *CurrencyLedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyLedgerEntry.ProtoReflect.Descriptor instead.
+func (*CurrencyLedgerEntry) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CurrencyLedgerEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CurrencyLedgerEntry) GetCurrencyId() int64 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *CurrencyLedgerEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CurrencyLedgerEntry) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *CurrencyLedgerEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CurrencyLedgerEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CurrencyLedgerEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 获取货币余额请求
+type GetWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletRequest) ProtoMessage() {}
+
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetWalletRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取货币余额响应
+type GetWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*CurrencyBalance     `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"` // 各货币余额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletResponse) Reset() {
+	*x = GetWalletResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletResponse) ProtoMessage() {}
+
+func (x *GetWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetWalletResponse) GetBalances() []*CurrencyBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// 获取货币流水请求
+type GetCurrencyHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 用户ID
+	CurrencyId    int64                  `protobuf:"varint,2,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"` // 货币ID，0表示全部货币
+	BeforeId      int64                  `protobuf:"varint,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`       // 分页游标，返回ID小于该值的流水，0表示从最新开始
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                             // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyHistoryRequest) Reset() {
+	*x = GetCurrencyHistoryRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyHistoryRequest) ProtoMessage() {}
+
+func (x *GetCurrencyHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrencyHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetCurrencyHistoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCurrencyHistoryRequest) GetCurrencyId() int64 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *GetCurrencyHistoryRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *GetCurrencyHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 获取货币流水响应
+type GetCurrencyHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*CurrencyLedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`                 // 流水列表
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"` // 是否还有更多
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyHistoryResponse) Reset() {
+	*x = GetCurrencyHistoryResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyHistoryResponse) ProtoMessage() {}
+
+func (x *GetCurrencyHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrencyHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetCurrencyHistoryResponse) GetEntries() []*CurrencyLedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetCurrencyHistoryResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 变更货币请求
+type ChangeCurrencyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                        // 用户ID
+	CurrencyId     int64                  `protobuf:"varint,2,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`            // 货币ID
+	Amount         int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                                      // 变动数量，负数为扣除
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                                       // 变动原因
+	Source         string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`                                       // 关联来源
+	IdempotencyKey string                 `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 幂等键，重试时使用相同的值
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChangeCurrencyRequest) Reset() {
+	*x = ChangeCurrencyRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeCurrencyRequest) ProtoMessage() {}
+
+func (x *ChangeCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*ChangeCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ChangeCurrencyRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangeCurrencyRequest) GetCurrencyId() int64 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *ChangeCurrencyRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ChangeCurrencyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ChangeCurrencyRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ChangeCurrencyRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// 变更货币响应
+type ChangeCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
+	Balance       int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"` // 变更后余额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeCurrencyResponse) Reset() {
+	*x = ChangeCurrencyResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeCurrencyResponse) ProtoMessage() {}
+
+func (x *ChangeCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*ChangeCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ChangeCurrencyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ChangeCurrencyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChangeCurrencyResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+// 商店商品
+type ShopGoods struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int64                  `protobuf:"varint,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`             // 商品ID
+	Items         []*Item                `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                                 // 购买获得的物品
+	Price         []*Item                `protobuf:"bytes,3,rep,name=price,proto3" json:"price,omitempty"`                                 // 价格
+	LimitType     int32                  `protobuf:"varint,4,opt,name=limit_type,json=limitType,proto3" json:"limit_type,omitempty"`       // 限购类型：0不限购 1每日 2每周 3终身
+	LimitCount    int32                  `protobuf:"varint,5,opt,name=limit_count,json=limitCount,proto3" json:"limit_count,omitempty"`    // 限购次数
+	BoughtCount   int32                  `protobuf:"varint,6,opt,name=bought_count,json=boughtCount,proto3" json:"bought_count,omitempty"` // 当前周期已购买次数
+	ResetAt       int64                  `protobuf:"varint,7,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`             // 下次重置时间，不重置为0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShopGoods) Reset() {
+	*x = ShopGoods{}
+	mi := &file_internal_pb_user_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShopGoods) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShopGoods) ProtoMessage() {}
+
+func (x *ShopGoods) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShopGoods.ProtoReflect.Descriptor instead.
+func (*ShopGoods) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ShopGoods) GetGoodsId() int64 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *ShopGoods) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ShopGoods) GetPrice() []*Item {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ShopGoods) GetLimitType() int32 {
+	if x != nil {
+		return x.LimitType
+	}
+	return 0
+}
+
+func (x *ShopGoods) GetLimitCount() int32 {
+	if x != nil {
+		return x.LimitCount
+	}
+	return 0
+}
+
+func (x *ShopGoods) GetBoughtCount() int32 {
+	if x != nil {
+		return x.BoughtCount
+	}
+	return 0
+}
+
+func (x *ShopGoods) GetResetAt() int64 {
+	if x != nil {
+		return x.ResetAt
+	}
+	return 0
+}
+
+// 获取商店商品列表请求
+type GetShopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	ShopId        int64                  `protobuf:"varint,2,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"` // 商店ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShopRequest) Reset() {
+	*x = GetShopRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShopRequest) ProtoMessage() {}
+
+func (x *GetShopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShopRequest.ProtoReflect.Descriptor instead.
+func (*GetShopRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetShopRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetShopRequest) GetShopId() int64 {
+	if x != nil {
+		return x.ShopId
+	}
+	return 0
+}
+
+// 获取商店商品列表响应
+type GetShopResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShopId        int64                  `protobuf:"varint,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"` // 商店ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                    // 商店名称
+	Goods         []*ShopGoods           `protobuf:"bytes,3,rep,name=goods,proto3" json:"goods,omitempty"`                  // 商品列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShopResponse) Reset() {
+	*x = GetShopResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShopResponse) ProtoMessage() {}
+
+func (x *GetShopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShopResponse.ProtoReflect.Descriptor instead.
+func (*GetShopResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetShopResponse) GetShopId() int64 {
+	if x != nil {
+		return x.ShopId
+	}
+	return 0
+}
+
+func (x *GetShopResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetShopResponse) GetGoods() []*ShopGoods {
+	if x != nil {
+		return x.Goods
+	}
+	return nil
+}
+
+// 购买商品请求
+type BuyGoodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // 用户ID
+	GoodsId       int64                  `protobuf:"varint,2,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`      // 商品ID
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                   // 购买数量
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // 客户端请求ID，重试时保持不变
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyGoodsRequest) Reset() {
+	*x = BuyGoodsRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyGoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyGoodsRequest) ProtoMessage() {}
+
+func (x *BuyGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyGoodsRequest.ProtoReflect.Descriptor instead.
+func (*BuyGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *BuyGoodsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BuyGoodsRequest) GetGoodsId() int64 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *BuyGoodsRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BuyGoodsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// 购买商品响应
+type BuyGoodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                            // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                             // 消息
+	Rewards       []*Item                `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`                             // 获得的物品
+	BoughtCount   int32                  `protobuf:"varint,4,opt,name=bought_count,json=boughtCount,proto3" json:"bought_count,omitempty"` // 当前周期已购买次数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyGoodsResponse) Reset() {
+	*x = BuyGoodsResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyGoodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyGoodsResponse) ProtoMessage() {}
+
+func (x *BuyGoodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyGoodsResponse.ProtoReflect.Descriptor instead.
+func (*BuyGoodsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *BuyGoodsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BuyGoodsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BuyGoodsResponse) GetRewards() []*Item {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *BuyGoodsResponse) GetBoughtCount() int32 {
+	if x != nil {
+		return x.BoughtCount
+	}
+	return 0
+}
+
+// 卡池掉落公示概率
+type GachaDropRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DropId        int64                  `protobuf:"varint,1,opt,name=drop_id,json=dropId,proto3" json:"drop_id,omitempty"`             // 掉落ID
+	RewardType    int32                  `protobuf:"varint,2,opt,name=reward_type,json=rewardType,proto3" json:"reward_type,omitempty"` // 奖励类型：1物品或货币 2卡牌 3宠物
+	RewardId      int64                  `protobuf:"varint,3,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`       // 奖励模板ID
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                // 奖励名称
+	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`                             // 奖励数量
+	IsRare        bool                   `protobuf:"varint,6,opt,name=is_rare,json=isRare,proto3" json:"is_rare,omitempty"`             // 是否稀有
+	Rate          int32                  `protobuf:"varint,7,opt,name=rate,proto3" json:"rate,omitempty"`                               // 基础概率（万分比）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GachaDropRate) Reset() {
+	*x = GachaDropRate{}
+	mi := &file_internal_pb_user_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GachaDropRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GachaDropRate) ProtoMessage() {}
+
+func (x *GachaDropRate) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GachaDropRate.ProtoReflect.Descriptor instead.
+func (*GachaDropRate) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GachaDropRate) GetDropId() int64 {
+	if x != nil {
+		return x.DropId
+	}
+	return 0
+}
+
+func (x *GachaDropRate) GetRewardType() int32 {
+	if x != nil {
+		return x.RewardType
+	}
+	return 0
+}
+
+func (x *GachaDropRate) GetRewardId() int64 {
+	if x != nil {
+		return x.RewardId
+	}
+	return 0
+}
+
+func (x *GachaDropRate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GachaDropRate) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GachaDropRate) GetIsRare() bool {
+	if x != nil {
+		return x.IsRare
+	}
+	return false
+}
+
+func (x *GachaDropRate) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+// 获取卡池信息请求
+type GetGachaPoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	PoolId        int64                  `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // 卡池ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGachaPoolRequest) Reset() {
+	*x = GetGachaPoolRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGachaPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGachaPoolRequest) ProtoMessage() {}
+
+func (x *GetGachaPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGachaPoolRequest.ProtoReflect.Descriptor instead.
+func (*GetGachaPoolRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetGachaPoolRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetGachaPoolRequest) GetPoolId() int64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+// 获取卡池信息响应
+type GetGachaPoolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PoolId        int64                  `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`                        // 卡池ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                           // 卡池名称
+	Cost          []*Item                `protobuf:"bytes,3,rep,name=cost,proto3" json:"cost,omitempty"`                                           // 单抽消耗
+	TenCost       []*Item                `protobuf:"bytes,4,rep,name=ten_cost,json=tenCost,proto3" json:"ten_cost,omitempty"`                      // 十连消耗
+	PityCount     int32                  `protobuf:"varint,5,opt,name=pity_count,json=pityCount,proto3" json:"pity_count,omitempty"`               // 距上次抽中稀有的连续次数
+	SoftPityStart int32                  `protobuf:"varint,6,opt,name=soft_pity_start,json=softPityStart,proto3" json:"soft_pity_start,omitempty"` // 软保底起始抽数
+	HardPity      int32                  `protobuf:"varint,7,opt,name=hard_pity,json=hardPity,proto3" json:"hard_pity,omitempty"`                  // 硬保底抽数
+	Rates         []*GachaDropRate       `protobuf:"bytes,8,rep,name=rates,proto3" json:"rates,omitempty"`                                         // 掉落概率公示
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGachaPoolResponse) Reset() {
+	*x = GetGachaPoolResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGachaPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGachaPoolResponse) ProtoMessage() {}
+
+func (x *GetGachaPoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGachaPoolResponse.ProtoReflect.Descriptor instead.
+func (*GetGachaPoolResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetGachaPoolResponse) GetPoolId() int64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *GetGachaPoolResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGachaPoolResponse) GetCost() []*Item {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *GetGachaPoolResponse) GetTenCost() []*Item {
+	if x != nil {
+		return x.TenCost
+	}
+	return nil
+}
+
+func (x *GetGachaPoolResponse) GetPityCount() int32 {
+	if x != nil {
+		return x.PityCount
+	}
+	return 0
+}
+
+func (x *GetGachaPoolResponse) GetSoftPityStart() int32 {
+	if x != nil {
+		return x.SoftPityStart
+	}
+	return 0
+}
+
+func (x *GetGachaPoolResponse) GetHardPity() int32 {
+	if x != nil {
+		return x.HardPity
+	}
+	return 0
+}
+
+func (x *GetGachaPoolResponse) GetRates() []*GachaDropRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+// 抽卡奖励
+type GachaReward struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DropId         int64                  `protobuf:"varint,1,opt,name=drop_id,json=dropId,proto3" json:"drop_id,omitempty"`                        // 掉落ID
+	RewardType     int32                  `protobuf:"varint,2,opt,name=reward_type,json=rewardType,proto3" json:"reward_type,omitempty"`            // 奖励类型：1物品或货币 2卡牌 3宠物
+	RewardId       int64                  `protobuf:"varint,3,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`                  // 奖励模板ID
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                           // 奖励名称
+	Count          int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`                                        // 奖励数量
+	IsRare         bool                   `protobuf:"varint,6,opt,name=is_rare,json=isRare,proto3" json:"is_rare,omitempty"`                        // 是否稀有
+	Converted      bool                   `protobuf:"varint,7,opt,name=converted,proto3" json:"converted,omitempty"`                                // 重复卡牌是否已转换为激活材料
+	ConvertedItems []*Item                `protobuf:"bytes,8,rep,name=converted_items,json=convertedItems,proto3" json:"converted_items,omitempty"` // 转换获得的物品
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GachaReward) Reset() {
+	*x = GachaReward{}
+	mi := &file_internal_pb_user_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GachaReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GachaReward) ProtoMessage() {}
+
+func (x *GachaReward) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GachaReward.ProtoReflect.Descriptor instead.
+func (*GachaReward) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GachaReward) GetDropId() int64 {
+	if x != nil {
+		return x.DropId
+	}
+	return 0
+}
+
+func (x *GachaReward) GetRewardType() int32 {
+	if x != nil {
+		return x.RewardType
+	}
+	return 0
+}
+
+func (x *GachaReward) GetRewardId() int64 {
+	if x != nil {
+		return x.RewardId
+	}
+	return 0
+}
+
+func (x *GachaReward) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GachaReward) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GachaReward) GetIsRare() bool {
+	if x != nil {
+		return x.IsRare
+	}
+	return false
+}
+
+func (x *GachaReward) GetConverted() bool {
+	if x != nil {
+		return x.Converted
+	}
+	return false
+}
+
+func (x *GachaReward) GetConvertedItems() []*Item {
+	if x != nil {
+		return x.ConvertedItems
+	}
+	return nil
+}
+
+// 抽卡请求
+type GachaPullRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // 用户ID
+	PoolId        int64                  `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`         // 卡池ID
+	Times         int32                  `protobuf:"varint,3,opt,name=times,proto3" json:"times,omitempty"`                         // 抽取次数：1单抽 10十连
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // 客户端请求ID，重试时保持不变
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GachaPullRequest) Reset() {
+	*x = GachaPullRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GachaPullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GachaPullRequest) ProtoMessage() {}
+
+func (x *GachaPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GachaPullRequest.ProtoReflect.Descriptor instead.
+func (*GachaPullRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GachaPullRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GachaPullRequest) GetPoolId() int64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *GachaPullRequest) GetTimes() int32 {
+	if x != nil {
+		return x.Times
+	}
+	return 0
+}
+
+func (x *GachaPullRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// 抽卡响应
+type GachaPullResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                      // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                       // 消息
+	BatchId       int64                  `protobuf:"varint,3,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`       // 批次ID
+	Rewards       []*GachaReward         `protobuf:"bytes,4,rep,name=rewards,proto3" json:"rewards,omitempty"`                       // 抽卡奖励
+	PityCount     int32                  `protobuf:"varint,5,opt,name=pity_count,json=pityCount,proto3" json:"pity_count,omitempty"` // 抽取后的保底计数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GachaPullResponse) Reset() {
+	*x = GachaPullResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GachaPullResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GachaPullResponse) ProtoMessage() {}
+
+func (x *GachaPullResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GachaPullResponse.ProtoReflect.Descriptor instead.
+func (*GachaPullResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GachaPullResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GachaPullResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GachaPullResponse) GetBatchId() int64 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+func (x *GachaPullResponse) GetRewards() []*GachaReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *GachaPullResponse) GetPityCount() int32 {
+	if x != nil {
+		return x.PityCount
+	}
+	return 0
+}
+
+// 抽卡记录
+type GachaRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                    // 记录ID
+	BatchId       int64                  `protobuf:"varint,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`           // 批次ID
+	PoolId        int64                  `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`              // 卡池ID
+	DropId        int64                  `protobuf:"varint,4,opt,name=drop_id,json=dropId,proto3" json:"drop_id,omitempty"`              // 掉落ID
+	RewardType    int32                  `protobuf:"varint,5,opt,name=reward_type,json=rewardType,proto3" json:"reward_type,omitempty"`  // 奖励类型
+	RewardId      int64                  `protobuf:"varint,6,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`        // 奖励模板ID
+	Name          string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`                                 // 奖励名称
+	Count         int32                  `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`                              // 奖励数量
+	IsRare        bool                   `protobuf:"varint,9,opt,name=is_rare,json=isRare,proto3" json:"is_rare,omitempty"`              // 是否稀有
+	Converted     bool                   `protobuf:"varint,10,opt,name=converted,proto3" json:"converted,omitempty"`                     // 重复卡牌是否已转换为激活材料
+	PityBefore    int32                  `protobuf:"varint,11,opt,name=pity_before,json=pityBefore,proto3" json:"pity_before,omitempty"` // 抽取前的保底计数
+	CreatedAt     int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // 抽取时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GachaRecord) Reset() {
+	*x = GachaRecord{}
+	mi := &file_internal_pb_user_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GachaRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GachaRecord) ProtoMessage() {}
+
+func (x *GachaRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GachaRecord.ProtoReflect.Descriptor instead.
+func (*GachaRecord) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GachaRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GachaRecord) GetBatchId() int64 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+func (x *GachaRecord) GetPoolId() int64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *GachaRecord) GetDropId() int64 {
+	if x != nil {
+		return x.DropId
+	}
+	return 0
+}
+
+func (x *GachaRecord) GetRewardType() int32 {
+	if x != nil {
+		return x.RewardType
+	}
+	return 0
+}
+
+func (x *GachaRecord) GetRewardId() int64 {
+	if x != nil {
+		return x.RewardId
+	}
+	return 0
+}
+
+func (x *GachaRecord) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GachaRecord) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GachaRecord) GetIsRare() bool {
+	if x != nil {
+		return x.IsRare
+	}
+	return false
+}
+
+func (x *GachaRecord) GetConverted() bool {
+	if x != nil {
+		return x.Converted
+	}
+	return false
+}
+
+func (x *GachaRecord) GetPityBefore() int32 {
+	if x != nil {
+		return x.PityBefore
+	}
+	return 0
+}
+
+func (x *GachaRecord) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 获取抽卡记录请求
+type GetGachaHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
+	PoolId        int64                  `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`       // 卡池ID，0为全部卡池
+	BeforeId      int64                  `protobuf:"varint,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"` // 分页游标，返回ID小于该值的记录，0为从最新开始
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                       // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGachaHistoryRequest) Reset() {
+	*x = GetGachaHistoryRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGachaHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGachaHistoryRequest) ProtoMessage() {}
+
+func (x *GetGachaHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *MonthlySignInfo) GetSignDays() []int32 {
+// Deprecated: Use GetGachaHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetGachaHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetGachaHistoryRequest) GetUserId() int64 {
 	if x != nil {
-		return x.SignDays
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-func (x *MonthlySignInfo) GetTotalSignDays() int32 {
+func (x *GetGachaHistoryRequest) GetPoolId() int64 {
 	if x != nil {
-		return x.TotalSignDays
+		return x.PoolId
 	}
 	return 0
 }
 
-func (x *MonthlySignInfo) GetCanSignToday() bool {
+func (x *GetGachaHistoryRequest) GetBeforeId() int64 {
 	if x != nil {
-		return x.CanSignToday
+		return x.BeforeId
 	}
-	return false
+	return 0
 }
 
-func (x *MonthlySignInfo) GetToday() int32 {
+func (x *GetGachaHistoryRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Today
+		return x.Limit
 	}
 	return 0
 }
 
-// 获取月签到信息请求
-type GetMonthlySignInfoRequest struct {
+// 获取抽卡记录响应
+type GetGachaHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Records       []*GachaRecord         `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`                 // 抽卡记录
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"` // 是否还有更多
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMonthlySignInfoRequest) Reset() {
-	*x = GetMonthlySignInfoRequest{}
-	mi := &file_internal_pb_user_proto_msgTypes[42]
+func (x *GetGachaHistoryResponse) Reset() {
+	*x = GetGachaHistoryResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMonthlySignInfoRequest) String() string {
+func (x *GetGachaHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMonthlySignInfoRequest) ProtoMessage() {}
+func (*GetGachaHistoryResponse) ProtoMessage() {}
 
-func (x *GetMonthlySignInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[42]
+func (x *GetGachaHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,41 +7285,56 @@ func (x *GetMonthlySignInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMonthlySignInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetMonthlySignInfoRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GetGachaHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetGachaHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GetMonthlySignInfoRequest) GetUserId() int64 {
+func (x *GetGachaHistoryResponse) GetRecords() []*GachaRecord {
 	if x != nil {
-		return x.UserId
+		return x.Records
 	}
-	return 0
+	return nil
 }
 
-// 获取月签到信息响应
-type GetMonthlySignInfoResponse struct {
+func (x *GetGachaHistoryResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 任务
+type Quest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Info          *MonthlySignInfo       `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"` // 月签到信息
+	QuestId       int64                  `protobuf:"varint,1,opt,name=quest_id,json=questId,proto3" json:"quest_id,omitempty"` // 任务ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                       // 任务名称
+	Type          int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`                      // 任务类型：1每日 2每周 3成就
+	Event         string                 `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`                     // 推进进度的游戏事件类型
+	Progress      int64                  `protobuf:"varint,5,opt,name=progress,proto3" json:"progress,omitempty"`              // 当前进度
+	Target        int64                  `protobuf:"varint,6,opt,name=target,proto3" json:"target,omitempty"`                  // 目标进度
+	Claimed       bool                   `protobuf:"varint,7,opt,name=claimed,proto3" json:"claimed,omitempty"`                // 奖励是否已领取
+	Rewards       []*Item                `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards,omitempty"`                 // 任务奖励
+	ResetAt       int64                  `protobuf:"varint,9,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"` // 下次重置时间，成就为0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMonthlySignInfoResponse) Reset() {
-	*x = GetMonthlySignInfoResponse{}
-	mi := &file_internal_pb_user_proto_msgTypes[43]
+func (x *Quest) Reset() {
+	*x = Quest{}
+	mi := &file_internal_pb_user_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMonthlySignInfoResponse) String() string {
+func (x *Quest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMonthlySignInfoResponse) ProtoMessage() {}
+func (*Quest) ProtoMessage() {}
 
-func (x *GetMonthlySignInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[43]
+func (x *Quest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,41 +7345,98 @@ func (x *GetMonthlySignInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMonthlySignInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetMonthlySignInfoResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use Quest.ProtoReflect.Descriptor instead.
+func (*Quest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *GetMonthlySignInfoResponse) GetInfo() *MonthlySignInfo {
+func (x *Quest) GetQuestId() int64 {
 	if x != nil {
-		return x.Info
+		return x.QuestId
+	}
+	return 0
+}
+
+func (x *Quest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Quest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Quest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Quest) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Quest) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *Quest) GetClaimed() bool {
+	if x != nil {
+		return x.Claimed
+	}
+	return false
+}
+
+func (x *Quest) GetRewards() []*Item {
+	if x != nil {
+		return x.Rewards
 	}
 	return nil
 }
 
-// 月签到请求
-type MonthlySignRequest struct {
+func (x *Quest) GetResetAt() int64 {
+	if x != nil {
+		return x.ResetAt
+	}
+	return 0
+}
+
+// 获取任务列表请求
+type GetQuestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`                   // 任务类型，0为全部
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MonthlySignRequest) Reset() {
-	*x = MonthlySignRequest{}
-	mi := &file_internal_pb_user_proto_msgTypes[44]
+func (x *GetQuestsRequest) Reset() {
+	*x = GetQuestsRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonthlySignRequest) String() string {
+func (x *GetQuestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonthlySignRequest) ProtoMessage() {}
+func (*GetQuestsRequest) ProtoMessage() {}
 
-func (x *MonthlySignRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[44]
+func (x *GetQuestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,43 +7447,48 @@ func (x *MonthlySignRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonthlySignRequest.ProtoReflect.Descriptor instead.
-func (*MonthlySignRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetQuestsRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *MonthlySignRequest) GetUserId() int64 {
+func (x *GetQuestsRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-// 月签到响应
-type MonthlySignResponse struct {
+func (x *GetQuestsRequest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+// 获取任务列表响应
+type GetQuestsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
-	Rewards       []*Item                `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`  // 签到奖励
+	Quests        []*Quest               `protobuf:"bytes,1,rep,name=quests,proto3" json:"quests,omitempty"` // 任务列表
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MonthlySignResponse) Reset() {
-	*x = MonthlySignResponse{}
-	mi := &file_internal_pb_user_proto_msgTypes[45]
+func (x *GetQuestsResponse) Reset() {
+	*x = GetQuestsResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonthlySignResponse) String() string {
+func (x *GetQuestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonthlySignResponse) ProtoMessage() {}
+func (*GetQuestsResponse) ProtoMessage() {}
 
-func (x *MonthlySignResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[45]
+func (x *GetQuestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2563,56 +7499,42 @@ func (x *MonthlySignResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonthlySignResponse.ProtoReflect.Descriptor instead.
-func (*MonthlySignResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *MonthlySignResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *MonthlySignResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use GetQuestsResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *MonthlySignResponse) GetRewards() []*Item {
+func (x *GetQuestsResponse) GetQuests() []*Quest {
 	if x != nil {
-		return x.Rewards
+		return x.Quests
 	}
 	return nil
 }
 
-// 领取月签到累计奖励请求
-type ClaimMonthlySignRewardRequest struct {
+// 领取任务奖励请求
+type ClaimQuestRewardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
-	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`                   // 累计天数
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // 用户ID
+	QuestId       int64                  `protobuf:"varint,2,opt,name=quest_id,json=questId,proto3" json:"quest_id,omitempty"` // 任务ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClaimMonthlySignRewardRequest) Reset() {
-	*x = ClaimMonthlySignRewardRequest{}
-	mi := &file_internal_pb_user_proto_msgTypes[46]
+func (x *ClaimQuestRewardRequest) Reset() {
+	*x = ClaimQuestRewardRequest{}
+	mi := &file_internal_pb_user_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClaimMonthlySignRewardRequest) String() string {
+func (x *ClaimQuestRewardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimMonthlySignRewardRequest) ProtoMessage() {}
+func (*ClaimQuestRewardRequest) ProtoMessage() {}
 
-func (x *ClaimMonthlySignRewardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[46]
+func (x *ClaimQuestRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2623,50 +7545,50 @@ func (x *ClaimMonthlySignRewardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimMonthlySignRewardRequest.ProtoReflect.Descriptor instead.
-func (*ClaimMonthlySignRewardRequest) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ClaimQuestRewardRequest.ProtoReflect.Descriptor instead.
+func (*ClaimQuestRewardRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *ClaimMonthlySignRewardRequest) GetUserId() int64 {
+func (x *ClaimQuestRewardRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *ClaimMonthlySignRewardRequest) GetDays() int32 {
+func (x *ClaimQuestRewardRequest) GetQuestId() int64 {
 	if x != nil {
-		return x.Days
+		return x.QuestId
 	}
 	return 0
 }
 
-// 领取月签到累计奖励响应
-type ClaimMonthlySignRewardResponse struct {
+// 领取任务奖励响应
+type ClaimQuestRewardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // 消息
-	Rewards       []*Item                `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`  // 奖励
+	Rewards       []*Item                `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`  // 获得的奖励
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClaimMonthlySignRewardResponse) Reset() {
-	*x = ClaimMonthlySignRewardResponse{}
-	mi := &file_internal_pb_user_proto_msgTypes[47]
+func (x *ClaimQuestRewardResponse) Reset() {
+	*x = ClaimQuestRewardResponse{}
+	mi := &file_internal_pb_user_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClaimMonthlySignRewardResponse) String() string {
+func (x *ClaimQuestRewardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimMonthlySignRewardResponse) ProtoMessage() {}
+func (*ClaimQuestRewardResponse) ProtoMessage() {}
 
-func (x *ClaimMonthlySignRewardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_pb_user_proto_msgTypes[47]
+func (x *ClaimQuestRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_user_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2677,26 +7599,26 @@ func (x *ClaimMonthlySignRewardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimMonthlySignRewardResponse.ProtoReflect.Descriptor instead.
-func (*ClaimMonthlySignRewardResponse) Descriptor() ([]byte, []int) {
-	return file_internal_pb_user_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ClaimQuestRewardResponse.ProtoReflect.Descriptor instead.
+func (*ClaimQuestRewardResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_user_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *ClaimMonthlySignRewardResponse) GetSuccess() bool {
+func (x *ClaimQuestRewardResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ClaimMonthlySignRewardResponse) GetMessage() string {
+func (x *ClaimQuestRewardResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ClaimMonthlySignRewardResponse) GetRewards() []*Item {
+func (x *ClaimQuestRewardResponse) GetRewards() []*Item {
 	if x != nil {
 		return x.Rewards
 	}
@@ -2721,7 +7643,7 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
 	"\n" +
-	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"\xe1\x01\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"\xfd\x01\n" +
 	"\x04Item\x12\x17\n" +
 	"\aitem_id\x18\x01 \x01(\x03R\x06itemId\x12\x1f\n" +
 	"\vtemplate_id\x18\x02 \x01(\x03R\n" +
@@ -2732,11 +7654,15 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"\bsub_type\x18\x06 \x01(\x05R\asubType\x12\x14\n" +
 	"\x05color\x18\a \x01(\x05R\x05color\x12\x14\n" +
 	"\x05stack\x18\b \x01(\x05R\x05stack\x12\x1a\n" +
-	"\bequipped\x18\t \x01(\bR\bequipped\"I\n" +
+	"\bequipped\x18\t \x01(\bR\bequipped\x12\x1a\n" +
+	"\bposition\x18\n" +
+	" \x01(\x05R\bposition\"\x80\x01\n" +
 	"\tInventory\x12 \n" +
 	"\x05items\x18\x01 \x03(\v2\n" +
 	".user.ItemR\x05items\x12\x1a\n" +
-	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\".\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\x12\x12\n" +
+	"\x04used\x18\x03 \x01(\x05R\x04used\x12!\n" +
+	"\fexpand_times\x18\x04 \x01(\x05R\vexpandTimes\".\n" +
 	"\x13GetInventoryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"E\n" +
 	"\x14GetInventoryResponse\x12-\n" +
@@ -2752,13 +7678,46 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\x12\x14\n" +
 	"\x05count\x18\x03 \x01(\x05R\x05count\".\n" +
 	"\x12RemoveItemResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"X\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"w\n" +
 	"\x0eUseItemRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
 	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x05R\x05count\"+\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\"\xd4\x01\n" +
 	"\x0fUseItemResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x84\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\arewards\x18\x03 \x03(\v2\n" +
+	".user.ItemR\arewards\x12\x10\n" +
+	"\x03exp\x18\x04 \x01(\x05R\x03exp\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\x05R\x05level\x12\x1d\n" +
+	"\x04pets\x18\x06 \x03(\v2\t.user.PetR\x04pets\x12 \n" +
+	"\x05buffs\x18\a \x03(\v2\n" +
+	".user.BuffR\x05buffs\"P\n" +
+	"\x04Buff\x12\x17\n" +
+	"\abuff_id\x18\x01 \x01(\x03R\x06buffId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\texpire_at\x18\x03 \x01(\x03R\bexpireAt\"*\n" +
+	"\x0fGetBuffsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"4\n" +
+	"\x10GetBuffsResponse\x12 \n" +
+	"\x05buffs\x18\x01 \x03(\v2\n" +
+	".user.BuffR\x05buffs\"1\n" +
+	"\x16ExpandInventoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x89\x01\n" +
+	"\x17ExpandInventoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bcapacity\x18\x03 \x01(\x05R\bcapacity\x12\x1e\n" +
+	"\x04cost\x18\x04 \x03(\v2\n" +
+	".user.ItemR\x04cost\"/\n" +
+	"\x14SortInventoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"z\n" +
+	"\x15SortInventoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\tinventory\x18\x03 \x01(\v2\x0f.user.InventoryR\tinventory\"\xf2\x01\n" +
 	"\tEquipment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vtemplate_id\x18\x02 \x01(\x03R\n" +
@@ -2767,6 +7726,15 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1e\n" +
 	"\n" +
 	"properties\x18\x05 \x01(\tR\n" +
+	"properties\x12\x17\n" +
+	"\aitem_id\x18\x06 \x01(\x03R\x06itemId\x12#\n" +
+	"\renhance_level\x18\a \x01(\x05R\fenhanceLevel\x12.\n" +
+	"\aaffixes\x18\b \x03(\v2\x14.user.EquipmentAffixR\aaffixes\"_\n" +
+	"\x0eEquipmentAffix\x12\x19\n" +
+	"\baffix_id\x18\x01 \x01(\x03R\aaffixId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"properties\x18\x03 \x01(\tR\n" +
 	"properties\"/\n" +
 	"\x14GetEquipmentsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"H\n" +
@@ -2786,13 +7754,44 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"\x04slot\x18\x02 \x01(\x05R\x04slot\"I\n" +
 	"\x13UnequipItemResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"-\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"j\n" +
+	"\x17EnhanceEquipmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\xa0\x01\n" +
+	"\x18EnhanceEquipmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\benhanced\x18\x03 \x01(\bR\benhanced\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12\x1e\n" +
+	"\x04cost\x18\x05 \x03(\v2\n" +
+	".user.ItemR\x04cost\"i\n" +
+	"\x16RefineEquipmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\x9d\x01\n" +
+	"\x17RefineEquipmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\aaffixes\x18\x03 \x03(\v2\x14.user.EquipmentAffixR\aaffixes\x12\x1e\n" +
+	"\x04cost\x18\x04 \x03(\v2\n" +
+	".user.ItemR\x04cost\"-\n" +
 	"\x12GetUserInfoRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"X\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xb1\x01\n" +
 	"\x13GetUserInfoResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05level\x18\x03 \x01(\x05R\x05level\"\xb3\x01\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\x124\n" +
+	"\n" +
+	"attributes\x18\x04 \x01(\v2\x14.user.UserAttributesR\n" +
+	"attributes\x12!\n" +
+	"\fcombat_power\x18\x05 \x01(\x03R\vcombatPower\"K\n" +
+	"\x0eUserAttributes\x12\x10\n" +
+	"\x03atk\x18\x01 \x01(\x03R\x03atk\x12\x10\n" +
+	"\x03def\x18\x02 \x01(\x03R\x03def\x12\x15\n" +
+	"\x06hp_max\x18\x03 \x01(\x03R\x05hpMax\"\xb3\x01\n" +
 	"\x04Card\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vtemplate_id\x18\x02 \x01(\x03R\n" +
@@ -2805,29 +7804,43 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"properties\x18\a \x01(\tR\n" +
 	"properties\".\n" +
 	"\x13GetUserCardsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"8\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"f\n" +
 	"\x14GetUserCardsResponse\x12 \n" +
 	"\x05cards\x18\x01 \x03(\v2\n" +
-	".user.CardR\x05cards\"O\n" +
+	".user.CardR\x05cards\x12,\n" +
+	"\abonuses\x18\x02 \x03(\v2\x12.user.CardSetBonusR\abonuses\"\xa9\x01\n" +
+	"\fCardSetBonus\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06set_id\x18\x02 \x01(\x03R\x05setId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05cards\x18\x04 \x03(\x03R\x05cards\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\x05R\x05level\x12\x12\n" +
+	"\x04star\x18\x06 \x01(\x05R\x04star\x12\x1e\n" +
+	"\n" +
+	"properties\x18\a \x01(\tR\n" +
+	"properties\"O\n" +
 	"\x13ActivateCardRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
 	"\vtemplate_id\x18\x02 \x01(\x03R\n" +
-	"templateId\"J\n" +
+	"templateId\"\x89\x01\n" +
 	"\x14ActivateCardResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"F\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12=\n" +
+	"\x10unlocked_bonuses\x18\x03 \x03(\v2\x12.user.CardSetBonusR\x0funlockedBonuses\"F\n" +
 	"\x12UpgradeCardRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
-	"\acard_id\x18\x02 \x01(\x03R\x06cardId\"I\n" +
+	"\acard_id\x18\x02 \x01(\x03R\x06cardId\"\x88\x01\n" +
 	"\x13UpgradeCardResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"J\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12=\n" +
+	"\x10unlocked_bonuses\x18\x03 \x03(\v2\x12.user.CardSetBonusR\x0funlockedBonuses\"J\n" +
 	"\x16UpgradeCardStarRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
-	"\acard_id\x18\x02 \x01(\x03R\x06cardId\"M\n" +
+	"\acard_id\x18\x02 \x01(\x03R\x06cardId\"\x8c\x01\n" +
 	"\x17UpgradeCardStarResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xaf\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12=\n" +
+	"\x10unlocked_bonuses\x18\x03 \x03(\v2\x12.user.CardSetBonusR\x0funlockedBonuses\"\x8c\x02\n" +
 	"\x03Pet\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vtemplate_id\x18\x02 \x01(\x03R\n" +
@@ -2838,7 +7851,16 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"\tis_battle\x18\x06 \x01(\bR\bisBattle\x12\x1e\n" +
 	"\n" +
 	"properties\x18\a \x01(\tR\n" +
-	"properties\"-\n" +
+	"properties\x12\x12\n" +
+	"\x04star\x18\b \x01(\x05R\x04star\x12\x1f\n" +
+	"\vbattle_slot\x18\t \x01(\x05R\n" +
+	"battleSlot\x12&\n" +
+	"\x06skills\x18\n" +
+	" \x03(\v2\x0e.user.PetSkillR\x06skills\"M\n" +
+	"\bPetSkill\x12\x12\n" +
+	"\x04slot\x18\x01 \x01(\x05R\x04slot\x12\x19\n" +
+	"\bskill_id\x18\x02 \x01(\x03R\askillId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"-\n" +
 	"\x12GetUserPetsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"4\n" +
 	"\x13GetUserPetsResponse\x12\x1d\n" +
@@ -2849,11 +7871,12 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"templateId\"D\n" +
 	"\x0eAddPetResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"h\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"|\n" +
 	"\x19SetPetBattleStatusRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
 	"\x06pet_id\x18\x02 \x01(\x03R\x05petId\x12\x1b\n" +
-	"\tis_battle\x18\x03 \x01(\bR\bisBattle\"P\n" +
+	"\tis_battle\x18\x03 \x01(\bR\bisBattle\x12\x12\n" +
+	"\x04slot\x18\x04 \x01(\x05R\x04slot\"P\n" +
 	"\x1aSetPetBattleStatusResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"T\n" +
@@ -2863,14 +7886,53 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"\x03exp\x18\x03 \x01(\x05R\x03exp\"G\n" +
 	"\x11AddPetExpResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xbc\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8b\x01\n" +
+	"\x10EvolvePetRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06pet_id\x18\x02 \x01(\x03R\x05petId\x12(\n" +
+	"\x10material_pet_ids\x18\x03 \x03(\x03R\x0ematerialPetIds\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\"{\n" +
+	"\x11EvolvePetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04star\x18\x03 \x01(\x05R\x04star\x12\x1e\n" +
+	"\x04cost\x18\x04 \x03(\v2\n" +
+	".user.ItemR\x04cost\"\x94\x01\n" +
+	"\x14LearnPetSkillRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06pet_id\x18\x02 \x01(\x03R\x05petId\x12\x12\n" +
+	"\x04slot\x18\x03 \x01(\x05R\x04slot\x12\x19\n" +
+	"\bskill_id\x18\x04 \x01(\x03R\askillId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\"k\n" +
+	"\x15LearnPetSkillResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1e\n" +
+	"\x04cost\x18\x03 \x03(\v2\n" +
+	".user.ItemR\x04cost\"b\n" +
+	"\x11ReleasePetRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06pet_id\x18\x02 \x01(\x03R\x05petId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"n\n" +
+	"\x12ReleasePetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\arewards\x18\x03 \x03(\v2\n" +
+	".user.ItemR\arewards\"\xaf\x02\n" +
 	"\x0fMonthlySignInfo\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x1b\n" +
 	"\tsign_days\x18\x03 \x03(\x05R\bsignDays\x12&\n" +
 	"\x0ftotal_sign_days\x18\x04 \x01(\x05R\rtotalSignDays\x12$\n" +
 	"\x0ecan_sign_today\x18\x05 \x01(\bR\fcanSignToday\x12\x14\n" +
-	"\x05today\x18\x06 \x01(\x05R\x05today\"4\n" +
+	"\x05today\x18\x06 \x01(\x05R\x05today\x12!\n" +
+	"\fmakeup_count\x18\a \x01(\x05R\vmakeupCount\x12!\n" +
+	"\fmakeup_limit\x18\b \x01(\x05R\vmakeupLimit\x12+\n" +
+	"\vmakeup_cost\x18\t \x03(\v2\n" +
+	".user.ItemR\n" +
+	"makeupCost\"4\n" +
 	"\x19GetMonthlySignInfoRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"G\n" +
 	"\x1aGetMonthlySignInfoResponse\x12)\n" +
@@ -2889,7 +7951,288 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
 	"\arewards\x18\x03 \x03(\v2\n" +
-	".user.ItemR\arewards2\xb6\v\n" +
+	".user.ItemR\arewards\"E\n" +
+	"\x18MonthlySignMakeupRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x10\n" +
+	"\x03day\x18\x02 \x01(\x05R\x03day\"\x95\x01\n" +
+	"\x19MonthlySignMakeupResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\arewards\x18\x03 \x03(\v2\n" +
+	".user.ItemR\arewards\x12\x1e\n" +
+	"\x04cost\x18\x04 \x03(\v2\n" +
+	".user.ItemR\x04cost\"\xa6\x01\n" +
+	"\x12MonthlySignHistory\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x1b\n" +
+	"\tsign_days\x18\x03 \x03(\x05R\bsignDays\x12&\n" +
+	"\x0ftotal_sign_days\x18\x04 \x01(\x05R\rtotalSignDays\x12!\n" +
+	"\fmakeup_count\x18\x05 \x01(\x05R\vmakeupCount\"M\n" +
+	"\x1cGetMonthlySignHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"S\n" +
+	"\x1dGetMonthlySignHistoryResponse\x122\n" +
+	"\ahistory\x18\x01 \x03(\v2\x18.user.MonthlySignHistoryR\ahistory\"V\n" +
+	"\x11AddUserExpRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x10\n" +
+	"\x03exp\x18\x02 \x01(\x05R\x03exp\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\"\x95\x01\n" +
+	"\x12AddUserExpResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\x12\x10\n" +
+	"\x03exp\x18\x04 \x01(\x05R\x03exp\x12#\n" +
+	"\rlevels_gained\x18\x05 \x01(\x05R\flevelsGained\"G\n" +
+	"\x0eMailAttachment\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03R\n" +
+	"templateId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xc3\x02\n" +
+	"\x04Mail\x12\x17\n" +
+	"\amail_id\x18\x01 \x01(\x03R\x06mailId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\x03R\bsenderId\x12\x1f\n" +
+	"\vsender_name\x18\x04 \x01(\tR\n" +
+	"senderName\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\x12,\n" +
+	"\vattachments\x18\a \x03(\v2\n" +
+	".user.ItemR\vattachments\x12\x17\n" +
+	"\ais_read\x18\b \x01(\bR\x06isRead\x12\x1d\n" +
+	"\n" +
+	"is_claimed\x18\t \x01(\bR\tisClaimed\x12\x1b\n" +
+	"\texpire_at\x18\n" +
+	" \x01(\x03R\bexpireAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\"/\n" +
+	"\rNewMailNotify\x12\x1e\n" +
+	"\x04mail\x18\x01 \x01(\v2\n" +
+	".user.MailR\x04mail\"*\n" +
+	"\x0fGetMailsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"W\n" +
+	"\x10GetMailsResponse\x12 \n" +
+	"\x05mails\x18\x01 \x03(\v2\n" +
+	".user.MailR\x05mails\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x05R\vunreadCount\"C\n" +
+	"\x0fReadMailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\amail_id\x18\x02 \x01(\x03R\x06mailId\"f\n" +
+	"\x10ReadMailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1e\n" +
+	"\x04mail\x18\x03 \x01(\v2\n" +
+	".user.MailR\x04mail\"D\n" +
+	"\x10ClaimMailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\amail_id\x18\x02 \x01(\x03R\x06mailId\"m\n" +
+	"\x11ClaimMailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\arewards\x18\x03 \x03(\v2\n" +
+	".user.ItemR\arewards\"E\n" +
+	"\x11DeleteMailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\amail_id\x18\x02 \x01(\x03R\x06mailId\"H\n" +
+	"\x12DeleteMailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"{\n" +
+	"\x0fSendMailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vreceiver_id\x18\x02 \x01(\x03R\n" +
+	"receiverId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"_\n" +
+	"\x10SendMailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\amail_id\x18\x03 \x01(\x03R\x06mailId\"\xbb\x01\n" +
+	"\x15SendSystemMailRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x126\n" +
+	"\vattachments\x18\x04 \x03(\v2\x14.user.MailAttachmentR\vattachments\x12\x1f\n" +
+	"\vexpire_days\x18\x05 \x01(\x05R\n" +
+	"expireDays\"L\n" +
+	"\x16SendSystemMailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa3\x01\n" +
+	"\x18SendBroadcastMailRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x126\n" +
+	"\vattachments\x18\x03 \x03(\v2\x14.user.MailAttachmentR\vattachments\x12\x1f\n" +
+	"\vexpire_days\x18\x04 \x01(\x05R\n" +
+	"expireDays\"r\n" +
+	"\x19SendBroadcastMailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\fbroadcast_id\x18\x03 \x01(\x03R\vbroadcastId\"`\n" +
+	"\x0fCurrencyBalance\x12\x1f\n" +
+	"\vcurrency_id\x18\x01 \x01(\x03R\n" +
+	"currencyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance\"\xd2\x01\n" +
+	"\x13CurrencyLedgerEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vcurrency_id\x18\x02 \x01(\x03R\n" +
+	"currencyId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12#\n" +
+	"\rbalance_after\x18\x04 \x01(\x03R\fbalanceAfter\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"+\n" +
+	"\x10GetWalletRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"F\n" +
+	"\x11GetWalletResponse\x121\n" +
+	"\bbalances\x18\x01 \x03(\v2\x15.user.CurrencyBalanceR\bbalances\"\x88\x01\n" +
+	"\x19GetCurrencyHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vcurrency_id\x18\x02 \x01(\x03R\n" +
+	"currencyId\x12\x1b\n" +
+	"\tbefore_id\x18\x03 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"l\n" +
+	"\x1aGetCurrencyHistoryResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.user.CurrencyLedgerEntryR\aentries\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xc2\x01\n" +
+	"\x15ChangeCurrencyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vcurrency_id\x18\x02 \x01(\x03R\n" +
+	"currencyId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"f\n" +
+	"\x16ChangeCurrencyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance\"\xe8\x01\n" +
+	"\tShopGoods\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\x03R\agoodsId\x12 \n" +
+	"\x05items\x18\x02 \x03(\v2\n" +
+	".user.ItemR\x05items\x12 \n" +
+	"\x05price\x18\x03 \x03(\v2\n" +
+	".user.ItemR\x05price\x12\x1d\n" +
+	"\n" +
+	"limit_type\x18\x04 \x01(\x05R\tlimitType\x12\x1f\n" +
+	"\vlimit_count\x18\x05 \x01(\x05R\n" +
+	"limitCount\x12!\n" +
+	"\fbought_count\x18\x06 \x01(\x05R\vboughtCount\x12\x19\n" +
+	"\breset_at\x18\a \x01(\x03R\aresetAt\"B\n" +
+	"\x0eGetShopRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\ashop_id\x18\x02 \x01(\x03R\x06shopId\"e\n" +
+	"\x0fGetShopResponse\x12\x17\n" +
+	"\ashop_id\x18\x01 \x01(\x03R\x06shopId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x05goods\x18\x03 \x03(\v2\x0f.user.ShopGoodsR\x05goods\"\x80\x01\n" +
+	"\x0fBuyGoodsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bgoods_id\x18\x02 \x01(\x03R\agoodsId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\"\x8f\x01\n" +
+	"\x10BuyGoodsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\arewards\x18\x03 \x03(\v2\n" +
+	".user.ItemR\arewards\x12!\n" +
+	"\fbought_count\x18\x04 \x01(\x05R\vboughtCount\"\xbd\x01\n" +
+	"\rGachaDropRate\x12\x17\n" +
+	"\adrop_id\x18\x01 \x01(\x03R\x06dropId\x12\x1f\n" +
+	"\vreward_type\x18\x02 \x01(\x05R\n" +
+	"rewardType\x12\x1b\n" +
+	"\treward_id\x18\x03 \x01(\x03R\brewardId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count\x12\x17\n" +
+	"\ais_rare\x18\x06 \x01(\bR\x06isRare\x12\x12\n" +
+	"\x04rate\x18\a \x01(\x05R\x04rate\"G\n" +
+	"\x13GetGachaPoolRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apool_id\x18\x02 \x01(\x03R\x06poolId\"\x99\x02\n" +
+	"\x14GetGachaPoolResponse\x12\x17\n" +
+	"\apool_id\x18\x01 \x01(\x03R\x06poolId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\x04cost\x18\x03 \x03(\v2\n" +
+	".user.ItemR\x04cost\x12%\n" +
+	"\bten_cost\x18\x04 \x03(\v2\n" +
+	".user.ItemR\atenCost\x12\x1d\n" +
+	"\n" +
+	"pity_count\x18\x05 \x01(\x05R\tpityCount\x12&\n" +
+	"\x0fsoft_pity_start\x18\x06 \x01(\x05R\rsoftPityStart\x12\x1b\n" +
+	"\thard_pity\x18\a \x01(\x05R\bhardPity\x12)\n" +
+	"\x05rates\x18\b \x03(\v2\x13.user.GachaDropRateR\x05rates\"\xfa\x01\n" +
+	"\vGachaReward\x12\x17\n" +
+	"\adrop_id\x18\x01 \x01(\x03R\x06dropId\x12\x1f\n" +
+	"\vreward_type\x18\x02 \x01(\x05R\n" +
+	"rewardType\x12\x1b\n" +
+	"\treward_id\x18\x03 \x01(\x03R\brewardId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count\x12\x17\n" +
+	"\ais_rare\x18\x06 \x01(\bR\x06isRare\x12\x1c\n" +
+	"\tconverted\x18\a \x01(\bR\tconverted\x123\n" +
+	"\x0fconverted_items\x18\b \x03(\v2\n" +
+	".user.ItemR\x0econvertedItems\"y\n" +
+	"\x10GachaPullRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apool_id\x18\x02 \x01(\x03R\x06poolId\x12\x14\n" +
+	"\x05times\x18\x03 \x01(\x05R\x05times\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\"\xae\x01\n" +
+	"\x11GachaPullResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
+	"\bbatch_id\x18\x03 \x01(\x03R\abatchId\x12+\n" +
+	"\arewards\x18\x04 \x03(\v2\x11.user.GachaRewardR\arewards\x12\x1d\n" +
+	"\n" +
+	"pity_count\x18\x05 \x01(\x05R\tpityCount\"\xc9\x02\n" +
+	"\vGachaRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bbatch_id\x18\x02 \x01(\x03R\abatchId\x12\x17\n" +
+	"\apool_id\x18\x03 \x01(\x03R\x06poolId\x12\x17\n" +
+	"\adrop_id\x18\x04 \x01(\x03R\x06dropId\x12\x1f\n" +
+	"\vreward_type\x18\x05 \x01(\x05R\n" +
+	"rewardType\x12\x1b\n" +
+	"\treward_id\x18\x06 \x01(\x03R\brewardId\x12\x12\n" +
+	"\x04name\x18\a \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\b \x01(\x05R\x05count\x12\x17\n" +
+	"\ais_rare\x18\t \x01(\bR\x06isRare\x12\x1c\n" +
+	"\tconverted\x18\n" +
+	" \x01(\bR\tconverted\x12\x1f\n" +
+	"\vpity_before\x18\v \x01(\x05R\n" +
+	"pityBefore\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\"}\n" +
+	"\x16GetGachaHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apool_id\x18\x02 \x01(\x03R\x06poolId\x12\x1b\n" +
+	"\tbefore_id\x18\x03 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"a\n" +
+	"\x17GetGachaHistoryResponse\x12+\n" +
+	"\arecords\x18\x01 \x03(\v2\x11.user.GachaRecordR\arecords\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xef\x01\n" +
+	"\x05Quest\x12\x19\n" +
+	"\bquest_id\x18\x01 \x01(\x03R\aquestId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\x05R\x04type\x12\x14\n" +
+	"\x05event\x18\x04 \x01(\tR\x05event\x12\x1a\n" +
+	"\bprogress\x18\x05 \x01(\x03R\bprogress\x12\x16\n" +
+	"\x06target\x18\x06 \x01(\x03R\x06target\x12\x18\n" +
+	"\aclaimed\x18\a \x01(\bR\aclaimed\x12$\n" +
+	"\arewards\x18\b \x03(\v2\n" +
+	".user.ItemR\arewards\x12\x19\n" +
+	"\breset_at\x18\t \x01(\x03R\aresetAt\"?\n" +
+	"\x10GetQuestsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\"8\n" +
+	"\x11GetQuestsResponse\x12#\n" +
+	"\x06quests\x18\x01 \x03(\v2\v.user.QuestR\x06quests\"M\n" +
+	"\x17ClaimQuestRewardRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bquest_id\x18\x02 \x01(\x03R\aquestId\"t\n" +
+	"\x18ClaimQuestRewardResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\arewards\x18\x03 \x03(\v2\n" +
+	".user.ItemR\arewards2\x80\x1b\n" +
 	"\vUserService\x129\n" +
 	"\bRegister\x12\x15.user.RegisterRequest\x1a\x16.user.RegisterResponse\x120\n" +
 	"\x05Login\x12\x12.user.LoginRequest\x1a\x13.user.LoginResponse\x12E\n" +
@@ -2897,10 +8240,15 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"\aAddItem\x12\x14.user.AddItemRequest\x1a\x15.user.AddItemResponse\x12?\n" +
 	"\n" +
 	"RemoveItem\x12\x17.user.RemoveItemRequest\x1a\x18.user.RemoveItemResponse\x126\n" +
-	"\aUseItem\x12\x14.user.UseItemRequest\x1a\x15.user.UseItemResponse\x12H\n" +
+	"\aUseItem\x12\x14.user.UseItemRequest\x1a\x15.user.UseItemResponse\x12N\n" +
+	"\x0fExpandInventory\x12\x1c.user.ExpandInventoryRequest\x1a\x1d.user.ExpandInventoryResponse\x12H\n" +
+	"\rSortInventory\x12\x1a.user.SortInventoryRequest\x1a\x1b.user.SortInventoryResponse\x129\n" +
+	"\bGetBuffs\x12\x15.user.GetBuffsRequest\x1a\x16.user.GetBuffsResponse\x12H\n" +
 	"\rGetEquipments\x12\x1a.user.GetEquipmentsRequest\x1a\x1b.user.GetEquipmentsResponse\x12<\n" +
 	"\tEquipItem\x12\x16.user.EquipItemRequest\x1a\x17.user.EquipItemResponse\x12B\n" +
-	"\vUnequipItem\x12\x18.user.UnequipItemRequest\x1a\x19.user.UnequipItemResponse\x12B\n" +
+	"\vUnequipItem\x12\x18.user.UnequipItemRequest\x1a\x19.user.UnequipItemResponse\x12Q\n" +
+	"\x10EnhanceEquipment\x12\x1d.user.EnhanceEquipmentRequest\x1a\x1e.user.EnhanceEquipmentResponse\x12N\n" +
+	"\x0fRefineEquipment\x12\x1c.user.RefineEquipmentRequest\x1a\x1d.user.RefineEquipmentResponse\x12B\n" +
 	"\vGetUserInfo\x12\x18.user.GetUserInfoRequest\x1a\x19.user.GetUserInfoResponse\x12E\n" +
 	"\fGetUserCards\x12\x19.user.GetUserCardsRequest\x1a\x1a.user.GetUserCardsResponse\x12E\n" +
 	"\fActivateCard\x12\x19.user.ActivateCardRequest\x1a\x1a.user.ActivateCardResponse\x12B\n" +
@@ -2909,10 +8257,36 @@ const file_internal_pb_user_proto_rawDesc = "" +
 	"\vGetUserPets\x12\x18.user.GetUserPetsRequest\x1a\x19.user.GetUserPetsResponse\x123\n" +
 	"\x06AddPet\x12\x13.user.AddPetRequest\x1a\x14.user.AddPetResponse\x12W\n" +
 	"\x12SetPetBattleStatus\x12\x1f.user.SetPetBattleStatusRequest\x1a .user.SetPetBattleStatusResponse\x12<\n" +
-	"\tAddPetExp\x12\x16.user.AddPetExpRequest\x1a\x17.user.AddPetExpResponse\x12W\n" +
+	"\tAddPetExp\x12\x16.user.AddPetExpRequest\x1a\x17.user.AddPetExpResponse\x12<\n" +
+	"\tEvolvePet\x12\x16.user.EvolvePetRequest\x1a\x17.user.EvolvePetResponse\x12H\n" +
+	"\rLearnPetSkill\x12\x1a.user.LearnPetSkillRequest\x1a\x1b.user.LearnPetSkillResponse\x12?\n" +
+	"\n" +
+	"ReleasePet\x12\x17.user.ReleasePetRequest\x1a\x18.user.ReleasePetResponse\x12W\n" +
 	"\x12GetMonthlySignInfo\x12\x1f.user.GetMonthlySignInfoRequest\x1a .user.GetMonthlySignInfoResponse\x12B\n" +
 	"\vMonthlySign\x12\x18.user.MonthlySignRequest\x1a\x19.user.MonthlySignResponse\x12c\n" +
-	"\x16ClaimMonthlySignReward\x12#.user.ClaimMonthlySignRewardRequest\x1a$.user.ClaimMonthlySignRewardResponseB\rZ\vinternal/pbb\x06proto3"
+	"\x16ClaimMonthlySignReward\x12#.user.ClaimMonthlySignRewardRequest\x1a$.user.ClaimMonthlySignRewardResponse\x12T\n" +
+	"\x11MonthlySignMakeup\x12\x1e.user.MonthlySignMakeupRequest\x1a\x1f.user.MonthlySignMakeupResponse\x12`\n" +
+	"\x15GetMonthlySignHistory\x12\".user.GetMonthlySignHistoryRequest\x1a#.user.GetMonthlySignHistoryResponse\x12?\n" +
+	"\n" +
+	"AddUserExp\x12\x17.user.AddUserExpRequest\x1a\x18.user.AddUserExpResponse\x129\n" +
+	"\bGetMails\x12\x15.user.GetMailsRequest\x1a\x16.user.GetMailsResponse\x129\n" +
+	"\bReadMail\x12\x15.user.ReadMailRequest\x1a\x16.user.ReadMailResponse\x12<\n" +
+	"\tClaimMail\x12\x16.user.ClaimMailRequest\x1a\x17.user.ClaimMailResponse\x12?\n" +
+	"\n" +
+	"DeleteMail\x12\x17.user.DeleteMailRequest\x1a\x18.user.DeleteMailResponse\x129\n" +
+	"\bSendMail\x12\x15.user.SendMailRequest\x1a\x16.user.SendMailResponse\x12K\n" +
+	"\x0eSendSystemMail\x12\x1b.user.SendSystemMailRequest\x1a\x1c.user.SendSystemMailResponse\x12T\n" +
+	"\x11SendBroadcastMail\x12\x1e.user.SendBroadcastMailRequest\x1a\x1f.user.SendBroadcastMailResponse\x12<\n" +
+	"\tGetWallet\x12\x16.user.GetWalletRequest\x1a\x17.user.GetWalletResponse\x12W\n" +
+	"\x12GetCurrencyHistory\x12\x1f.user.GetCurrencyHistoryRequest\x1a .user.GetCurrencyHistoryResponse\x12K\n" +
+	"\x0eChangeCurrency\x12\x1b.user.ChangeCurrencyRequest\x1a\x1c.user.ChangeCurrencyResponse\x126\n" +
+	"\aGetShop\x12\x14.user.GetShopRequest\x1a\x15.user.GetShopResponse\x129\n" +
+	"\bBuyGoods\x12\x15.user.BuyGoodsRequest\x1a\x16.user.BuyGoodsResponse\x12E\n" +
+	"\fGetGachaPool\x12\x19.user.GetGachaPoolRequest\x1a\x1a.user.GetGachaPoolResponse\x12<\n" +
+	"\tGachaPull\x12\x16.user.GachaPullRequest\x1a\x17.user.GachaPullResponse\x12N\n" +
+	"\x0fGetGachaHistory\x12\x1c.user.GetGachaHistoryRequest\x1a\x1d.user.GetGachaHistoryResponse\x12<\n" +
+	"\tGetQuests\x12\x16.user.GetQuestsRequest\x1a\x17.user.GetQuestsResponse\x12Q\n" +
+	"\x10ClaimQuestReward\x12\x1d.user.ClaimQuestRewardRequest\x1a\x1e.user.ClaimQuestRewardResponseB\rZ\vinternal/pbb\x06proto3"
 
 var (
 	file_internal_pb_user_proto_rawDescOnce sync.Once
@@ -2926,7 +8300,7 @@ func file_internal_pb_user_proto_rawDescGZIP() []byte {
 	return file_internal_pb_user_proto_rawDescData
 }
 
-var file_internal_pb_user_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_internal_pb_user_proto_msgTypes = make([]protoimpl.MessageInfo, 120)
 var file_internal_pb_user_proto_goTypes = []any{
 	(*RegisterRequest)(nil),                // 0: user.RegisterRequest
 	(*RegisterResponse)(nil),               // 1: user.RegisterResponse
@@ -2942,97 +8316,270 @@ var file_internal_pb_user_proto_goTypes = []any{
 	(*RemoveItemResponse)(nil),             // 11: user.RemoveItemResponse
 	(*UseItemRequest)(nil),                 // 12: user.UseItemRequest
 	(*UseItemResponse)(nil),                // 13: user.UseItemResponse
-	(*Equipment)(nil),                      // 14: user.Equipment
-	(*GetEquipmentsRequest)(nil),           // 15: user.GetEquipmentsRequest
-	(*GetEquipmentsResponse)(nil),          // 16: user.GetEquipmentsResponse
-	(*EquipItemRequest)(nil),               // 17: user.EquipItemRequest
-	(*EquipItemResponse)(nil),              // 18: user.EquipItemResponse
-	(*UnequipItemRequest)(nil),             // 19: user.UnequipItemRequest
-	(*UnequipItemResponse)(nil),            // 20: user.UnequipItemResponse
-	(*GetUserInfoRequest)(nil),             // 21: user.GetUserInfoRequest
-	(*GetUserInfoResponse)(nil),            // 22: user.GetUserInfoResponse
-	(*Card)(nil),                           // 23: user.Card
-	(*GetUserCardsRequest)(nil),            // 24: user.GetUserCardsRequest
-	(*GetUserCardsResponse)(nil),           // 25: user.GetUserCardsResponse
-	(*ActivateCardRequest)(nil),            // 26: user.ActivateCardRequest
-	(*ActivateCardResponse)(nil),           // 27: user.ActivateCardResponse
-	(*UpgradeCardRequest)(nil),             // 28: user.UpgradeCardRequest
-	(*UpgradeCardResponse)(nil),            // 29: user.UpgradeCardResponse
-	(*UpgradeCardStarRequest)(nil),         // 30: user.UpgradeCardStarRequest
-	(*UpgradeCardStarResponse)(nil),        // 31: user.UpgradeCardStarResponse
-	(*Pet)(nil),                            // 32: user.Pet
-	(*GetUserPetsRequest)(nil),             // 33: user.GetUserPetsRequest
-	(*GetUserPetsResponse)(nil),            // 34: user.GetUserPetsResponse
-	(*AddPetRequest)(nil),                  // 35: user.AddPetRequest
-	(*AddPetResponse)(nil),                 // 36: user.AddPetResponse
-	(*SetPetBattleStatusRequest)(nil),      // 37: user.SetPetBattleStatusRequest
-	(*SetPetBattleStatusResponse)(nil),     // 38: user.SetPetBattleStatusResponse
-	(*AddPetExpRequest)(nil),               // 39: user.AddPetExpRequest
-	(*AddPetExpResponse)(nil),              // 40: user.AddPetExpResponse
-	(*MonthlySignInfo)(nil),                // 41: user.MonthlySignInfo
-	(*GetMonthlySignInfoRequest)(nil),      // 42: user.GetMonthlySignInfoRequest
-	(*GetMonthlySignInfoResponse)(nil),     // 43: user.GetMonthlySignInfoResponse
-	(*MonthlySignRequest)(nil),             // 44: user.MonthlySignRequest
-	(*MonthlySignResponse)(nil),            // 45: user.MonthlySignResponse
-	(*ClaimMonthlySignRewardRequest)(nil),  // 46: user.ClaimMonthlySignRewardRequest
-	(*ClaimMonthlySignRewardResponse)(nil), // 47: user.ClaimMonthlySignRewardResponse
+	(*Buff)(nil),                           // 14: user.Buff
+	(*GetBuffsRequest)(nil),                // 15: user.GetBuffsRequest
+	(*GetBuffsResponse)(nil),               // 16: user.GetBuffsResponse
+	(*ExpandInventoryRequest)(nil),         // 17: user.ExpandInventoryRequest
+	(*ExpandInventoryResponse)(nil),        // 18: user.ExpandInventoryResponse
+	(*SortInventoryRequest)(nil),           // 19: user.SortInventoryRequest
+	(*SortInventoryResponse)(nil),          // 20: user.SortInventoryResponse
+	(*Equipment)(nil),                      // 21: user.Equipment
+	(*EquipmentAffix)(nil),                 // 22: user.EquipmentAffix
+	(*GetEquipmentsRequest)(nil),           // 23: user.GetEquipmentsRequest
+	(*GetEquipmentsResponse)(nil),          // 24: user.GetEquipmentsResponse
+	(*EquipItemRequest)(nil),               // 25: user.EquipItemRequest
+	(*EquipItemResponse)(nil),              // 26: user.EquipItemResponse
+	(*UnequipItemRequest)(nil),             // 27: user.UnequipItemRequest
+	(*UnequipItemResponse)(nil),            // 28: user.UnequipItemResponse
+	(*EnhanceEquipmentRequest)(nil),        // 29: user.EnhanceEquipmentRequest
+	(*EnhanceEquipmentResponse)(nil),       // 30: user.EnhanceEquipmentResponse
+	(*RefineEquipmentRequest)(nil),         // 31: user.RefineEquipmentRequest
+	(*RefineEquipmentResponse)(nil),        // 32: user.RefineEquipmentResponse
+	(*GetUserInfoRequest)(nil),             // 33: user.GetUserInfoRequest
+	(*GetUserInfoResponse)(nil),            // 34: user.GetUserInfoResponse
+	(*UserAttributes)(nil),                 // 35: user.UserAttributes
+	(*Card)(nil),                           // 36: user.Card
+	(*GetUserCardsRequest)(nil),            // 37: user.GetUserCardsRequest
+	(*GetUserCardsResponse)(nil),           // 38: user.GetUserCardsResponse
+	(*CardSetBonus)(nil),                   // 39: user.CardSetBonus
+	(*ActivateCardRequest)(nil),            // 40: user.ActivateCardRequest
+	(*ActivateCardResponse)(nil),           // 41: user.ActivateCardResponse
+	(*UpgradeCardRequest)(nil),             // 42: user.UpgradeCardRequest
+	(*UpgradeCardResponse)(nil),            // 43: user.UpgradeCardResponse
+	(*UpgradeCardStarRequest)(nil),         // 44: user.UpgradeCardStarRequest
+	(*UpgradeCardStarResponse)(nil),        // 45: user.UpgradeCardStarResponse
+	(*Pet)(nil),                            // 46: user.Pet
+	(*PetSkill)(nil),                       // 47: user.PetSkill
+	(*GetUserPetsRequest)(nil),             // 48: user.GetUserPetsRequest
+	(*GetUserPetsResponse)(nil),            // 49: user.GetUserPetsResponse
+	(*AddPetRequest)(nil),                  // 50: user.AddPetRequest
+	(*AddPetResponse)(nil),                 // 51: user.AddPetResponse
+	(*SetPetBattleStatusRequest)(nil),      // 52: user.SetPetBattleStatusRequest
+	(*SetPetBattleStatusResponse)(nil),     // 53: user.SetPetBattleStatusResponse
+	(*AddPetExpRequest)(nil),               // 54: user.AddPetExpRequest
+	(*AddPetExpResponse)(nil),              // 55: user.AddPetExpResponse
+	(*EvolvePetRequest)(nil),               // 56: user.EvolvePetRequest
+	(*EvolvePetResponse)(nil),              // 57: user.EvolvePetResponse
+	(*LearnPetSkillRequest)(nil),           // 58: user.LearnPetSkillRequest
+	(*LearnPetSkillResponse)(nil),          // 59: user.LearnPetSkillResponse
+	(*ReleasePetRequest)(nil),              // 60: user.ReleasePetRequest
+	(*ReleasePetResponse)(nil),             // 61: user.ReleasePetResponse
+	(*MonthlySignInfo)(nil),                // 62: user.MonthlySignInfo
+	(*GetMonthlySignInfoRequest)(nil),      // 63: user.GetMonthlySignInfoRequest
+	(*GetMonthlySignInfoResponse)(nil),     // 64: user.GetMonthlySignInfoResponse
+	(*MonthlySignRequest)(nil),             // 65: user.MonthlySignRequest
+	(*MonthlySignResponse)(nil),            // 66: user.MonthlySignResponse
+	(*ClaimMonthlySignRewardRequest)(nil),  // 67: user.ClaimMonthlySignRewardRequest
+	(*ClaimMonthlySignRewardResponse)(nil), // 68: user.ClaimMonthlySignRewardResponse
+	(*MonthlySignMakeupRequest)(nil),       // 69: user.MonthlySignMakeupRequest
+	(*MonthlySignMakeupResponse)(nil),      // 70: user.MonthlySignMakeupResponse
+	(*MonthlySignHistory)(nil),             // 71: user.MonthlySignHistory
+	(*GetMonthlySignHistoryRequest)(nil),   // 72: user.GetMonthlySignHistoryRequest
+	(*GetMonthlySignHistoryResponse)(nil),  // 73: user.GetMonthlySignHistoryResponse
+	(*AddUserExpRequest)(nil),              // 74: user.AddUserExpRequest
+	(*AddUserExpResponse)(nil),             // 75: user.AddUserExpResponse
+	(*MailAttachment)(nil),                 // 76: user.MailAttachment
+	(*Mail)(nil),                           // 77: user.Mail
+	(*NewMailNotify)(nil),                  // 78: user.NewMailNotify
+	(*GetMailsRequest)(nil),                // 79: user.GetMailsRequest
+	(*GetMailsResponse)(nil),               // 80: user.GetMailsResponse
+	(*ReadMailRequest)(nil),                // 81: user.ReadMailRequest
+	(*ReadMailResponse)(nil),               // 82: user.ReadMailResponse
+	(*ClaimMailRequest)(nil),               // 83: user.ClaimMailRequest
+	(*ClaimMailResponse)(nil),              // 84: user.ClaimMailResponse
+	(*DeleteMailRequest)(nil),              // 85: user.DeleteMailRequest
+	(*DeleteMailResponse)(nil),             // 86: user.DeleteMailResponse
+	(*SendMailRequest)(nil),                // 87: user.SendMailRequest
+	(*SendMailResponse)(nil),               // 88: user.SendMailResponse
+	(*SendSystemMailRequest)(nil),          // 89: user.SendSystemMailRequest
+	(*SendSystemMailResponse)(nil),         // 90: user.SendSystemMailResponse
+	(*SendBroadcastMailRequest)(nil),       // 91: user.SendBroadcastMailRequest
+	(*SendBroadcastMailResponse)(nil),      // 92: user.SendBroadcastMailResponse
+	(*CurrencyBalance)(nil),                // 93: user.CurrencyBalance
+	(*CurrencyLedgerEntry)(nil),            // 94: user.CurrencyLedgerEntry
+	(*GetWalletRequest)(nil),               // 95: user.GetWalletRequest
+	(*GetWalletResponse)(nil),              // 96: user.GetWalletResponse
+	(*GetCurrencyHistoryRequest)(nil),      // 97: user.GetCurrencyHistoryRequest
+	(*GetCurrencyHistoryResponse)(nil),     // 98: user.GetCurrencyHistoryResponse
+	(*ChangeCurrencyRequest)(nil),          // 99: user.ChangeCurrencyRequest
+	(*ChangeCurrencyResponse)(nil),         // 100: user.ChangeCurrencyResponse
+	(*ShopGoods)(nil),                      // 101: user.ShopGoods
+	(*GetShopRequest)(nil),                 // 102: user.GetShopRequest
+	(*GetShopResponse)(nil),                // 103: user.GetShopResponse
+	(*BuyGoodsRequest)(nil),                // 104: user.BuyGoodsRequest
+	(*BuyGoodsResponse)(nil),               // 105: user.BuyGoodsResponse
+	(*GachaDropRate)(nil),                  // 106: user.GachaDropRate
+	(*GetGachaPoolRequest)(nil),            // 107: user.GetGachaPoolRequest
+	(*GetGachaPoolResponse)(nil),           // 108: user.GetGachaPoolResponse
+	(*GachaReward)(nil),                    // 109: user.GachaReward
+	(*GachaPullRequest)(nil),               // 110: user.GachaPullRequest
+	(*GachaPullResponse)(nil),              // 111: user.GachaPullResponse
+	(*GachaRecord)(nil),                    // 112: user.GachaRecord
+	(*GetGachaHistoryRequest)(nil),         // 113: user.GetGachaHistoryRequest
+	(*GetGachaHistoryResponse)(nil),        // 114: user.GetGachaHistoryResponse
+	(*Quest)(nil),                          // 115: user.Quest
+	(*GetQuestsRequest)(nil),               // 116: user.GetQuestsRequest
+	(*GetQuestsResponse)(nil),              // 117: user.GetQuestsResponse
+	(*ClaimQuestRewardRequest)(nil),        // 118: user.ClaimQuestRewardRequest
+	(*ClaimQuestRewardResponse)(nil),       // 119: user.ClaimQuestRewardResponse
 }
 var file_internal_pb_user_proto_depIdxs = []int32{
-	4,  // 0: user.Inventory.items:type_name -> user.Item
-	5,  // 1: user.GetInventoryResponse.inventory:type_name -> user.Inventory
-	14, // 2: user.GetEquipmentsResponse.equipments:type_name -> user.Equipment
-	23, // 3: user.GetUserCardsResponse.cards:type_name -> user.Card
-	32, // 4: user.GetUserPetsResponse.pets:type_name -> user.Pet
-	41, // 5: user.GetMonthlySignInfoResponse.info:type_name -> user.MonthlySignInfo
-	4,  // 6: user.MonthlySignResponse.rewards:type_name -> user.Item
-	4,  // 7: user.ClaimMonthlySignRewardResponse.rewards:type_name -> user.Item
-	0,  // 8: user.UserService.Register:input_type -> user.RegisterRequest
-	2,  // 9: user.UserService.Login:input_type -> user.LoginRequest
-	6,  // 10: user.UserService.GetInventory:input_type -> user.GetInventoryRequest
-	8,  // 11: user.UserService.AddItem:input_type -> user.AddItemRequest
-	10, // 12: user.UserService.RemoveItem:input_type -> user.RemoveItemRequest
-	12, // 13: user.UserService.UseItem:input_type -> user.UseItemRequest
-	15, // 14: user.UserService.GetEquipments:input_type -> user.GetEquipmentsRequest
-	17, // 15: user.UserService.EquipItem:input_type -> user.EquipItemRequest
-	19, // 16: user.UserService.UnequipItem:input_type -> user.UnequipItemRequest
-	21, // 17: user.UserService.GetUserInfo:input_type -> user.GetUserInfoRequest
-	24, // 18: user.UserService.GetUserCards:input_type -> user.GetUserCardsRequest
-	26, // 19: user.UserService.ActivateCard:input_type -> user.ActivateCardRequest
-	28, // 20: user.UserService.UpgradeCard:input_type -> user.UpgradeCardRequest
-	30, // 21: user.UserService.UpgradeCardStar:input_type -> user.UpgradeCardStarRequest
-	33, // 22: user.UserService.GetUserPets:input_type -> user.GetUserPetsRequest
-	35, // 23: user.UserService.AddPet:input_type -> user.AddPetRequest
-	37, // 24: user.UserService.SetPetBattleStatus:input_type -> user.SetPetBattleStatusRequest
-	39, // 25: user.UserService.AddPetExp:input_type -> user.AddPetExpRequest
-	42, // 26: user.UserService.GetMonthlySignInfo:input_type -> user.GetMonthlySignInfoRequest
-	44, // 27: user.UserService.MonthlySign:input_type -> user.MonthlySignRequest
-	46, // 28: user.UserService.ClaimMonthlySignReward:input_type -> user.ClaimMonthlySignRewardRequest
-	1,  // 29: user.UserService.Register:output_type -> user.RegisterResponse
-	3,  // 30: user.UserService.Login:output_type -> user.LoginResponse
-	7,  // 31: user.UserService.GetInventory:output_type -> user.GetInventoryResponse
-	9,  // 32: user.UserService.AddItem:output_type -> user.AddItemResponse
-	11, // 33: user.UserService.RemoveItem:output_type -> user.RemoveItemResponse
-	13, // 34: user.UserService.UseItem:output_type -> user.UseItemResponse
-	16, // 35: user.UserService.GetEquipments:output_type -> user.GetEquipmentsResponse
-	18, // 36: user.UserService.EquipItem:output_type -> user.EquipItemResponse
-	20, // 37: user.UserService.UnequipItem:output_type -> user.UnequipItemResponse
-	22, // 38: user.UserService.GetUserInfo:output_type -> user.GetUserInfoResponse
-	25, // 39: user.UserService.GetUserCards:output_type -> user.GetUserCardsResponse
-	27, // 40: user.UserService.ActivateCard:output_type -> user.ActivateCardResponse
-	29, // 41: user.UserService.UpgradeCard:output_type -> user.UpgradeCardResponse
-	31, // 42: user.UserService.UpgradeCardStar:output_type -> user.UpgradeCardStarResponse
-	34, // 43: user.UserService.GetUserPets:output_type -> user.GetUserPetsResponse
-	36, // 44: user.UserService.AddPet:output_type -> user.AddPetResponse
-	38, // 45: user.UserService.SetPetBattleStatus:output_type -> user.SetPetBattleStatusResponse
-	40, // 46: user.UserService.AddPetExp:output_type -> user.AddPetExpResponse
-	43, // 47: user.UserService.GetMonthlySignInfo:output_type -> user.GetMonthlySignInfoResponse
-	45, // 48: user.UserService.MonthlySign:output_type -> user.MonthlySignResponse
-	47, // 49: user.UserService.ClaimMonthlySignReward:output_type -> user.ClaimMonthlySignRewardResponse
-	29, // [29:50] is the sub-list for method output_type
-	8,  // [8:29] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	4,   // 0: user.Inventory.items:type_name -> user.Item
+	5,   // 1: user.GetInventoryResponse.inventory:type_name -> user.Inventory
+	4,   // 2: user.UseItemResponse.rewards:type_name -> user.Item
+	46,  // 3: user.UseItemResponse.pets:type_name -> user.Pet
+	14,  // 4: user.UseItemResponse.buffs:type_name -> user.Buff
+	14,  // 5: user.GetBuffsResponse.buffs:type_name -> user.Buff
+	4,   // 6: user.ExpandInventoryResponse.cost:type_name -> user.Item
+	5,   // 7: user.SortInventoryResponse.inventory:type_name -> user.Inventory
+	22,  // 8: user.Equipment.affixes:type_name -> user.EquipmentAffix
+	21,  // 9: user.GetEquipmentsResponse.equipments:type_name -> user.Equipment
+	4,   // 10: user.EnhanceEquipmentResponse.cost:type_name -> user.Item
+	22,  // 11: user.RefineEquipmentResponse.affixes:type_name -> user.EquipmentAffix
+	4,   // 12: user.RefineEquipmentResponse.cost:type_name -> user.Item
+	35,  // 13: user.GetUserInfoResponse.attributes:type_name -> user.UserAttributes
+	36,  // 14: user.GetUserCardsResponse.cards:type_name -> user.Card
+	39,  // 15: user.GetUserCardsResponse.bonuses:type_name -> user.CardSetBonus
+	39,  // 16: user.ActivateCardResponse.unlocked_bonuses:type_name -> user.CardSetBonus
+	39,  // 17: user.UpgradeCardResponse.unlocked_bonuses:type_name -> user.CardSetBonus
+	39,  // 18: user.UpgradeCardStarResponse.unlocked_bonuses:type_name -> user.CardSetBonus
+	47,  // 19: user.Pet.skills:type_name -> user.PetSkill
+	46,  // 20: user.GetUserPetsResponse.pets:type_name -> user.Pet
+	4,   // 21: user.EvolvePetResponse.cost:type_name -> user.Item
+	4,   // 22: user.LearnPetSkillResponse.cost:type_name -> user.Item
+	4,   // 23: user.ReleasePetResponse.rewards:type_name -> user.Item
+	4,   // 24: user.MonthlySignInfo.makeup_cost:type_name -> user.Item
+	62,  // 25: user.GetMonthlySignInfoResponse.info:type_name -> user.MonthlySignInfo
+	4,   // 26: user.MonthlySignResponse.rewards:type_name -> user.Item
+	4,   // 27: user.ClaimMonthlySignRewardResponse.rewards:type_name -> user.Item
+	4,   // 28: user.MonthlySignMakeupResponse.rewards:type_name -> user.Item
+	4,   // 29: user.MonthlySignMakeupResponse.cost:type_name -> user.Item
+	71,  // 30: user.GetMonthlySignHistoryResponse.history:type_name -> user.MonthlySignHistory
+	4,   // 31: user.Mail.attachments:type_name -> user.Item
+	77,  // 32: user.NewMailNotify.mail:type_name -> user.Mail
+	77,  // 33: user.GetMailsResponse.mails:type_name -> user.Mail
+	77,  // 34: user.ReadMailResponse.mail:type_name -> user.Mail
+	4,   // 35: user.ClaimMailResponse.rewards:type_name -> user.Item
+	76,  // 36: user.SendSystemMailRequest.attachments:type_name -> user.MailAttachment
+	76,  // 37: user.SendBroadcastMailRequest.attachments:type_name -> user.MailAttachment
+	93,  // 38: user.GetWalletResponse.balances:type_name -> user.CurrencyBalance
+	94,  // 39: user.GetCurrencyHistoryResponse.entries:type_name -> user.CurrencyLedgerEntry
+	4,   // 40: user.ShopGoods.items:type_name -> user.Item
+	4,   // 41: user.ShopGoods.price:type_name -> user.Item
+	101, // 42: user.GetShopResponse.goods:type_name -> user.ShopGoods
+	4,   // 43: user.BuyGoodsResponse.rewards:type_name -> user.Item
+	4,   // 44: user.GetGachaPoolResponse.cost:type_name -> user.Item
+	4,   // 45: user.GetGachaPoolResponse.ten_cost:type_name -> user.Item
+	106, // 46: user.GetGachaPoolResponse.rates:type_name -> user.GachaDropRate
+	4,   // 47: user.GachaReward.converted_items:type_name -> user.Item
+	109, // 48: user.GachaPullResponse.rewards:type_name -> user.GachaReward
+	112, // 49: user.GetGachaHistoryResponse.records:type_name -> user.GachaRecord
+	4,   // 50: user.Quest.rewards:type_name -> user.Item
+	115, // 51: user.GetQuestsResponse.quests:type_name -> user.Quest
+	4,   // 52: user.ClaimQuestRewardResponse.rewards:type_name -> user.Item
+	0,   // 53: user.UserService.Register:input_type -> user.RegisterRequest
+	2,   // 54: user.UserService.Login:input_type -> user.LoginRequest
+	6,   // 55: user.UserService.GetInventory:input_type -> user.GetInventoryRequest
+	8,   // 56: user.UserService.AddItem:input_type -> user.AddItemRequest
+	10,  // 57: user.UserService.RemoveItem:input_type -> user.RemoveItemRequest
+	12,  // 58: user.UserService.UseItem:input_type -> user.UseItemRequest
+	17,  // 59: user.UserService.ExpandInventory:input_type -> user.ExpandInventoryRequest
+	19,  // 60: user.UserService.SortInventory:input_type -> user.SortInventoryRequest
+	15,  // 61: user.UserService.GetBuffs:input_type -> user.GetBuffsRequest
+	23,  // 62: user.UserService.GetEquipments:input_type -> user.GetEquipmentsRequest
+	25,  // 63: user.UserService.EquipItem:input_type -> user.EquipItemRequest
+	27,  // 64: user.UserService.UnequipItem:input_type -> user.UnequipItemRequest
+	29,  // 65: user.UserService.EnhanceEquipment:input_type -> user.EnhanceEquipmentRequest
+	31,  // 66: user.UserService.RefineEquipment:input_type -> user.RefineEquipmentRequest
+	33,  // 67: user.UserService.GetUserInfo:input_type -> user.GetUserInfoRequest
+	37,  // 68: user.UserService.GetUserCards:input_type -> user.GetUserCardsRequest
+	40,  // 69: user.UserService.ActivateCard:input_type -> user.ActivateCardRequest
+	42,  // 70: user.UserService.UpgradeCard:input_type -> user.UpgradeCardRequest
+	44,  // 71: user.UserService.UpgradeCardStar:input_type -> user.UpgradeCardStarRequest
+	48,  // 72: user.UserService.GetUserPets:input_type -> user.GetUserPetsRequest
+	50,  // 73: user.UserService.AddPet:input_type -> user.AddPetRequest
+	52,  // 74: user.UserService.SetPetBattleStatus:input_type -> user.SetPetBattleStatusRequest
+	54,  // 75: user.UserService.AddPetExp:input_type -> user.AddPetExpRequest
+	56,  // 76: user.UserService.EvolvePet:input_type -> user.EvolvePetRequest
+	58,  // 77: user.UserService.LearnPetSkill:input_type -> user.LearnPetSkillRequest
+	60,  // 78: user.UserService.ReleasePet:input_type -> user.ReleasePetRequest
+	63,  // 79: user.UserService.GetMonthlySignInfo:input_type -> user.GetMonthlySignInfoRequest
+	65,  // 80: user.UserService.MonthlySign:input_type -> user.MonthlySignRequest
+	67,  // 81: user.UserService.ClaimMonthlySignReward:input_type -> user.ClaimMonthlySignRewardRequest
+	69,  // 82: user.UserService.MonthlySignMakeup:input_type -> user.MonthlySignMakeupRequest
+	72,  // 83: user.UserService.GetMonthlySignHistory:input_type -> user.GetMonthlySignHistoryRequest
+	74,  // 84: user.UserService.AddUserExp:input_type -> user.AddUserExpRequest
+	79,  // 85: user.UserService.GetMails:input_type -> user.GetMailsRequest
+	81,  // 86: user.UserService.ReadMail:input_type -> user.ReadMailRequest
+	83,  // 87: user.UserService.ClaimMail:input_type -> user.ClaimMailRequest
+	85,  // 88: user.UserService.DeleteMail:input_type -> user.DeleteMailRequest
+	87,  // 89: user.UserService.SendMail:input_type -> user.SendMailRequest
+	89,  // 90: user.UserService.SendSystemMail:input_type -> user.SendSystemMailRequest
+	91,  // 91: user.UserService.SendBroadcastMail:input_type -> user.SendBroadcastMailRequest
+	95,  // 92: user.UserService.GetWallet:input_type -> user.GetWalletRequest
+	97,  // 93: user.UserService.GetCurrencyHistory:input_type -> user.GetCurrencyHistoryRequest
+	99,  // 94: user.UserService.ChangeCurrency:input_type -> user.ChangeCurrencyRequest
+	102, // 95: user.UserService.GetShop:input_type -> user.GetShopRequest
+	104, // 96: user.UserService.BuyGoods:input_type -> user.BuyGoodsRequest
+	107, // 97: user.UserService.GetGachaPool:input_type -> user.GetGachaPoolRequest
+	110, // 98: user.UserService.GachaPull:input_type -> user.GachaPullRequest
+	113, // 99: user.UserService.GetGachaHistory:input_type -> user.GetGachaHistoryRequest
+	116, // 100: user.UserService.GetQuests:input_type -> user.GetQuestsRequest
+	118, // 101: user.UserService.ClaimQuestReward:input_type -> user.ClaimQuestRewardRequest
+	1,   // 102: user.UserService.Register:output_type -> user.RegisterResponse
+	3,   // 103: user.UserService.Login:output_type -> user.LoginResponse
+	7,   // 104: user.UserService.GetInventory:output_type -> user.GetInventoryResponse
+	9,   // 105: user.UserService.AddItem:output_type -> user.AddItemResponse
+	11,  // 106: user.UserService.RemoveItem:output_type -> user.RemoveItemResponse
+	13,  // 107: user.UserService.UseItem:output_type -> user.UseItemResponse
+	18,  // 108: user.UserService.ExpandInventory:output_type -> user.ExpandInventoryResponse
+	20,  // 109: user.UserService.SortInventory:output_type -> user.SortInventoryResponse
+	16,  // 110: user.UserService.GetBuffs:output_type -> user.GetBuffsResponse
+	24,  // 111: user.UserService.GetEquipments:output_type -> user.GetEquipmentsResponse
+	26,  // 112: user.UserService.EquipItem:output_type -> user.EquipItemResponse
+	28,  // 113: user.UserService.UnequipItem:output_type -> user.UnequipItemResponse
+	30,  // 114: user.UserService.EnhanceEquipment:output_type -> user.EnhanceEquipmentResponse
+	32,  // 115: user.UserService.RefineEquipment:output_type -> user.RefineEquipmentResponse
+	34,  // 116: user.UserService.GetUserInfo:output_type -> user.GetUserInfoResponse
+	38,  // 117: user.UserService.GetUserCards:output_type -> user.GetUserCardsResponse
+	41,  // 118: user.UserService.ActivateCard:output_type -> user.ActivateCardResponse
+	43,  // 119: user.UserService.UpgradeCard:output_type -> user.UpgradeCardResponse
+	45,  // 120: user.UserService.UpgradeCardStar:output_type -> user.UpgradeCardStarResponse
+	49,  // 121: user.UserService.GetUserPets:output_type -> user.GetUserPetsResponse
+	51,  // 122: user.UserService.AddPet:output_type -> user.AddPetResponse
+	53,  // 123: user.UserService.SetPetBattleStatus:output_type -> user.SetPetBattleStatusResponse
+	55,  // 124: user.UserService.AddPetExp:output_type -> user.AddPetExpResponse
+	57,  // 125: user.UserService.EvolvePet:output_type -> user.EvolvePetResponse
+	59,  // 126: user.UserService.LearnPetSkill:output_type -> user.LearnPetSkillResponse
+	61,  // 127: user.UserService.ReleasePet:output_type -> user.ReleasePetResponse
+	64,  // 128: user.UserService.GetMonthlySignInfo:output_type -> user.GetMonthlySignInfoResponse
+	66,  // 129: user.UserService.MonthlySign:output_type -> user.MonthlySignResponse
+	68,  // 130: user.UserService.ClaimMonthlySignReward:output_type -> user.ClaimMonthlySignRewardResponse
+	70,  // 131: user.UserService.MonthlySignMakeup:output_type -> user.MonthlySignMakeupResponse
+	73,  // 132: user.UserService.GetMonthlySignHistory:output_type -> user.GetMonthlySignHistoryResponse
+	75,  // 133: user.UserService.AddUserExp:output_type -> user.AddUserExpResponse
+	80,  // 134: user.UserService.GetMails:output_type -> user.GetMailsResponse
+	82,  // 135: user.UserService.ReadMail:output_type -> user.ReadMailResponse
+	84,  // 136: user.UserService.ClaimMail:output_type -> user.ClaimMailResponse
+	86,  // 137: user.UserService.DeleteMail:output_type -> user.DeleteMailResponse
+	88,  // 138: user.UserService.SendMail:output_type -> user.SendMailResponse
+	90,  // 139: user.UserService.SendSystemMail:output_type -> user.SendSystemMailResponse
+	92,  // 140: user.UserService.SendBroadcastMail:output_type -> user.SendBroadcastMailResponse
+	96,  // 141: user.UserService.GetWallet:output_type -> user.GetWalletResponse
+	98,  // 142: user.UserService.GetCurrencyHistory:output_type -> user.GetCurrencyHistoryResponse
+	100, // 143: user.UserService.ChangeCurrency:output_type -> user.ChangeCurrencyResponse
+	103, // 144: user.UserService.GetShop:output_type -> user.GetShopResponse
+	105, // 145: user.UserService.BuyGoods:output_type -> user.BuyGoodsResponse
+	108, // 146: user.UserService.GetGachaPool:output_type -> user.GetGachaPoolResponse
+	111, // 147: user.UserService.GachaPull:output_type -> user.GachaPullResponse
+	114, // 148: user.UserService.GetGachaHistory:output_type -> user.GetGachaHistoryResponse
+	117, // 149: user.UserService.GetQuests:output_type -> user.GetQuestsResponse
+	119, // 150: user.UserService.ClaimQuestReward:output_type -> user.ClaimQuestRewardResponse
+	102, // [102:151] is the sub-list for method output_type
+	53,  // [53:102] is the sub-list for method input_type
+	53,  // [53:53] is the sub-list for extension type_name
+	53,  // [53:53] is the sub-list for extension extendee
+	0,   // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_internal_pb_user_proto_init() }
@@ -3046,7 +8593,7 @@ func file_internal_pb_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_pb_user_proto_rawDesc), len(file_internal_pb_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   48,
+			NumMessages:   120,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
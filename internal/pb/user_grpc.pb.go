@@ -25,9 +25,14 @@ const (
 	UserService_AddItem_FullMethodName                = "/user.UserService/AddItem"
 	UserService_RemoveItem_FullMethodName             = "/user.UserService/RemoveItem"
 	UserService_UseItem_FullMethodName                = "/user.UserService/UseItem"
+	UserService_ExpandInventory_FullMethodName        = "/user.UserService/ExpandInventory"
+	UserService_SortInventory_FullMethodName          = "/user.UserService/SortInventory"
+	UserService_GetBuffs_FullMethodName               = "/user.UserService/GetBuffs"
 	UserService_GetEquipments_FullMethodName          = "/user.UserService/GetEquipments"
 	UserService_EquipItem_FullMethodName              = "/user.UserService/EquipItem"
 	UserService_UnequipItem_FullMethodName            = "/user.UserService/UnequipItem"
+	UserService_EnhanceEquipment_FullMethodName       = "/user.UserService/EnhanceEquipment"
+	UserService_RefineEquipment_FullMethodName        = "/user.UserService/RefineEquipment"
 	UserService_GetUserInfo_FullMethodName            = "/user.UserService/GetUserInfo"
 	UserService_GetUserCards_FullMethodName           = "/user.UserService/GetUserCards"
 	UserService_ActivateCard_FullMethodName           = "/user.UserService/ActivateCard"
@@ -37,9 +42,32 @@ const (
 	UserService_AddPet_FullMethodName                 = "/user.UserService/AddPet"
 	UserService_SetPetBattleStatus_FullMethodName     = "/user.UserService/SetPetBattleStatus"
 	UserService_AddPetExp_FullMethodName              = "/user.UserService/AddPetExp"
+	UserService_EvolvePet_FullMethodName              = "/user.UserService/EvolvePet"
+	UserService_LearnPetSkill_FullMethodName          = "/user.UserService/LearnPetSkill"
+	UserService_ReleasePet_FullMethodName             = "/user.UserService/ReleasePet"
 	UserService_GetMonthlySignInfo_FullMethodName     = "/user.UserService/GetMonthlySignInfo"
 	UserService_MonthlySign_FullMethodName            = "/user.UserService/MonthlySign"
 	UserService_ClaimMonthlySignReward_FullMethodName = "/user.UserService/ClaimMonthlySignReward"
+	UserService_MonthlySignMakeup_FullMethodName      = "/user.UserService/MonthlySignMakeup"
+	UserService_GetMonthlySignHistory_FullMethodName  = "/user.UserService/GetMonthlySignHistory"
+	UserService_AddUserExp_FullMethodName             = "/user.UserService/AddUserExp"
+	UserService_GetMails_FullMethodName               = "/user.UserService/GetMails"
+	UserService_ReadMail_FullMethodName               = "/user.UserService/ReadMail"
+	UserService_ClaimMail_FullMethodName              = "/user.UserService/ClaimMail"
+	UserService_DeleteMail_FullMethodName             = "/user.UserService/DeleteMail"
+	UserService_SendMail_FullMethodName               = "/user.UserService/SendMail"
+	UserService_SendSystemMail_FullMethodName         = "/user.UserService/SendSystemMail"
+	UserService_SendBroadcastMail_FullMethodName      = "/user.UserService/SendBroadcastMail"
+	UserService_GetWallet_FullMethodName              = "/user.UserService/GetWallet"
+	UserService_GetCurrencyHistory_FullMethodName     = "/user.UserService/GetCurrencyHistory"
+	UserService_ChangeCurrency_FullMethodName         = "/user.UserService/ChangeCurrency"
+	UserService_GetShop_FullMethodName                = "/user.UserService/GetShop"
+	UserService_BuyGoods_FullMethodName               = "/user.UserService/BuyGoods"
+	UserService_GetGachaPool_FullMethodName           = "/user.UserService/GetGachaPool"
+	UserService_GachaPull_FullMethodName              = "/user.UserService/GachaPull"
+	UserService_GetGachaHistory_FullMethodName        = "/user.UserService/GetGachaHistory"
+	UserService_GetQuests_FullMethodName              = "/user.UserService/GetQuests"
+	UserService_ClaimQuestReward_FullMethodName       = "/user.UserService/ClaimQuestReward"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -58,12 +86,22 @@ type UserServiceClient interface {
 	RemoveItem(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*RemoveItemResponse, error)
 	// 使用物品
 	UseItem(ctx context.Context, in *UseItemRequest, opts ...grpc.CallOption) (*UseItemResponse, error)
+	// 背包扩容
+	ExpandInventory(ctx context.Context, in *ExpandInventoryRequest, opts ...grpc.CallOption) (*ExpandInventoryResponse, error)
+	// 整理背包
+	SortInventory(ctx context.Context, in *SortInventoryRequest, opts ...grpc.CallOption) (*SortInventoryResponse, error)
+	// 获取生效中的增益
+	GetBuffs(ctx context.Context, in *GetBuffsRequest, opts ...grpc.CallOption) (*GetBuffsResponse, error)
 	// 获取装备信息
 	GetEquipments(ctx context.Context, in *GetEquipmentsRequest, opts ...grpc.CallOption) (*GetEquipmentsResponse, error)
 	// 装备物品
 	EquipItem(ctx context.Context, in *EquipItemRequest, opts ...grpc.CallOption) (*EquipItemResponse, error)
 	// 卸下装备
 	UnequipItem(ctx context.Context, in *UnequipItemRequest, opts ...grpc.CallOption) (*UnequipItemResponse, error)
+	// 强化装备
+	EnhanceEquipment(ctx context.Context, in *EnhanceEquipmentRequest, opts ...grpc.CallOption) (*EnhanceEquipmentResponse, error)
+	// 洗练装备
+	RefineEquipment(ctx context.Context, in *RefineEquipmentRequest, opts ...grpc.CallOption) (*RefineEquipmentResponse, error)
 	// 获取玩家信息
 	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error)
 	// 获取玩家所有卡牌信息
@@ -82,12 +120,58 @@ type UserServiceClient interface {
 	SetPetBattleStatus(ctx context.Context, in *SetPetBattleStatusRequest, opts ...grpc.CallOption) (*SetPetBattleStatusResponse, error)
 	// 增加宠物经验
 	AddPetExp(ctx context.Context, in *AddPetExpRequest, opts ...grpc.CallOption) (*AddPetExpResponse, error)
+	// 宠物升星
+	EvolvePet(ctx context.Context, in *EvolvePetRequest, opts ...grpc.CallOption) (*EvolvePetResponse, error)
+	// 学习宠物技能
+	LearnPetSkill(ctx context.Context, in *LearnPetSkillRequest, opts ...grpc.CallOption) (*LearnPetSkillResponse, error)
+	// 放生宠物
+	ReleasePet(ctx context.Context, in *ReleasePetRequest, opts ...grpc.CallOption) (*ReleasePetResponse, error)
 	// 获取月签到信息
 	GetMonthlySignInfo(ctx context.Context, in *GetMonthlySignInfoRequest, opts ...grpc.CallOption) (*GetMonthlySignInfoResponse, error)
 	// 月签到
 	MonthlySign(ctx context.Context, in *MonthlySignRequest, opts ...grpc.CallOption) (*MonthlySignResponse, error)
 	// 领取月签到累计奖励
 	ClaimMonthlySignReward(ctx context.Context, in *ClaimMonthlySignRewardRequest, opts ...grpc.CallOption) (*ClaimMonthlySignRewardResponse, error)
+	// 月签到补签
+	MonthlySignMakeup(ctx context.Context, in *MonthlySignMakeupRequest, opts ...grpc.CallOption) (*MonthlySignMakeupResponse, error)
+	// 获取历史月份签到记录
+	GetMonthlySignHistory(ctx context.Context, in *GetMonthlySignHistoryRequest, opts ...grpc.CallOption) (*GetMonthlySignHistoryResponse, error)
+	// 增加玩家经验（仅供内部服务调用，不经网关开放）
+	AddUserExp(ctx context.Context, in *AddUserExpRequest, opts ...grpc.CallOption) (*AddUserExpResponse, error)
+	// 获取邮件列表
+	GetMails(ctx context.Context, in *GetMailsRequest, opts ...grpc.CallOption) (*GetMailsResponse, error)
+	// 阅读邮件
+	ReadMail(ctx context.Context, in *ReadMailRequest, opts ...grpc.CallOption) (*ReadMailResponse, error)
+	// 领取邮件附件
+	ClaimMail(ctx context.Context, in *ClaimMailRequest, opts ...grpc.CallOption) (*ClaimMailResponse, error)
+	// 删除邮件
+	DeleteMail(ctx context.Context, in *DeleteMailRequest, opts ...grpc.CallOption) (*DeleteMailResponse, error)
+	// 给其他玩家发送邮件
+	SendMail(ctx context.Context, in *SendMailRequest, opts ...grpc.CallOption) (*SendMailResponse, error)
+	// 发送系统邮件（仅供内部服务调用，不经网关开放）
+	SendSystemMail(ctx context.Context, in *SendSystemMailRequest, opts ...grpc.CallOption) (*SendSystemMailResponse, error)
+	// 发送全服邮件（仅供内部服务调用，不经网关开放）
+	SendBroadcastMail(ctx context.Context, in *SendBroadcastMailRequest, opts ...grpc.CallOption) (*SendBroadcastMailResponse, error)
+	// 获取货币余额
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
+	// 获取货币流水
+	GetCurrencyHistory(ctx context.Context, in *GetCurrencyHistoryRequest, opts ...grpc.CallOption) (*GetCurrencyHistoryResponse, error)
+	// 变更货币（仅供内部服务调用，不经网关开放）
+	ChangeCurrency(ctx context.Context, in *ChangeCurrencyRequest, opts ...grpc.CallOption) (*ChangeCurrencyResponse, error)
+	// 获取商店商品列表
+	GetShop(ctx context.Context, in *GetShopRequest, opts ...grpc.CallOption) (*GetShopResponse, error)
+	// 购买商品
+	BuyGoods(ctx context.Context, in *BuyGoodsRequest, opts ...grpc.CallOption) (*BuyGoodsResponse, error)
+	// 获取卡池信息
+	GetGachaPool(ctx context.Context, in *GetGachaPoolRequest, opts ...grpc.CallOption) (*GetGachaPoolResponse, error)
+	// 抽卡
+	GachaPull(ctx context.Context, in *GachaPullRequest, opts ...grpc.CallOption) (*GachaPullResponse, error)
+	// 获取抽卡记录
+	GetGachaHistory(ctx context.Context, in *GetGachaHistoryRequest, opts ...grpc.CallOption) (*GetGachaHistoryResponse, error)
+	// 获取任务和成就列表
+	GetQuests(ctx context.Context, in *GetQuestsRequest, opts ...grpc.CallOption) (*GetQuestsResponse, error)
+	// 领取任务奖励
+	ClaimQuestReward(ctx context.Context, in *ClaimQuestRewardRequest, opts ...grpc.CallOption) (*ClaimQuestRewardResponse, error)
 }
 
 type userServiceClient struct {
@@ -158,6 +242,36 @@ func (c *userServiceClient) UseItem(ctx context.Context, in *UseItemRequest, opt
 	return out, nil
 }
 
+func (c *userServiceClient) ExpandInventory(ctx context.Context, in *ExpandInventoryRequest, opts ...grpc.CallOption) (*ExpandInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpandInventoryResponse)
+	err := c.cc.Invoke(ctx, UserService_ExpandInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SortInventory(ctx context.Context, in *SortInventoryRequest, opts ...grpc.CallOption) (*SortInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SortInventoryResponse)
+	err := c.cc.Invoke(ctx, UserService_SortInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetBuffs(ctx context.Context, in *GetBuffsRequest, opts ...grpc.CallOption) (*GetBuffsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBuffsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetBuffs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetEquipments(ctx context.Context, in *GetEquipmentsRequest, opts ...grpc.CallOption) (*GetEquipmentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetEquipmentsResponse)
@@ -188,6 +302,26 @@ func (c *userServiceClient) UnequipItem(ctx context.Context, in *UnequipItemRequ
 	return out, nil
 }
 
+func (c *userServiceClient) EnhanceEquipment(ctx context.Context, in *EnhanceEquipmentRequest, opts ...grpc.CallOption) (*EnhanceEquipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnhanceEquipmentResponse)
+	err := c.cc.Invoke(ctx, UserService_EnhanceEquipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RefineEquipment(ctx context.Context, in *RefineEquipmentRequest, opts ...grpc.CallOption) (*RefineEquipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefineEquipmentResponse)
+	err := c.cc.Invoke(ctx, UserService_RefineEquipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserInfoResponse)
@@ -278,6 +412,36 @@ func (c *userServiceClient) AddPetExp(ctx context.Context, in *AddPetExpRequest,
 	return out, nil
 }
 
+func (c *userServiceClient) EvolvePet(ctx context.Context, in *EvolvePetRequest, opts ...grpc.CallOption) (*EvolvePetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvolvePetResponse)
+	err := c.cc.Invoke(ctx, UserService_EvolvePet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) LearnPetSkill(ctx context.Context, in *LearnPetSkillRequest, opts ...grpc.CallOption) (*LearnPetSkillResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearnPetSkillResponse)
+	err := c.cc.Invoke(ctx, UserService_LearnPetSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReleasePet(ctx context.Context, in *ReleasePetRequest, opts ...grpc.CallOption) (*ReleasePetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleasePetResponse)
+	err := c.cc.Invoke(ctx, UserService_ReleasePet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetMonthlySignInfo(ctx context.Context, in *GetMonthlySignInfoRequest, opts ...grpc.CallOption) (*GetMonthlySignInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMonthlySignInfoResponse)
@@ -308,6 +472,206 @@ func (c *userServiceClient) ClaimMonthlySignReward(ctx context.Context, in *Clai
 	return out, nil
 }
 
+func (c *userServiceClient) MonthlySignMakeup(ctx context.Context, in *MonthlySignMakeupRequest, opts ...grpc.CallOption) (*MonthlySignMakeupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MonthlySignMakeupResponse)
+	err := c.cc.Invoke(ctx, UserService_MonthlySignMakeup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetMonthlySignHistory(ctx context.Context, in *GetMonthlySignHistoryRequest, opts ...grpc.CallOption) (*GetMonthlySignHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMonthlySignHistoryResponse)
+	err := c.cc.Invoke(ctx, UserService_GetMonthlySignHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddUserExp(ctx context.Context, in *AddUserExpRequest, opts ...grpc.CallOption) (*AddUserExpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddUserExpResponse)
+	err := c.cc.Invoke(ctx, UserService_AddUserExp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetMails(ctx context.Context, in *GetMailsRequest, opts ...grpc.CallOption) (*GetMailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMailsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetMails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReadMail(ctx context.Context, in *ReadMailRequest, opts ...grpc.CallOption) (*ReadMailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadMailResponse)
+	err := c.cc.Invoke(ctx, UserService_ReadMail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ClaimMail(ctx context.Context, in *ClaimMailRequest, opts ...grpc.CallOption) (*ClaimMailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimMailResponse)
+	err := c.cc.Invoke(ctx, UserService_ClaimMail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteMail(ctx context.Context, in *DeleteMailRequest, opts ...grpc.CallOption) (*DeleteMailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMailResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteMail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SendMail(ctx context.Context, in *SendMailRequest, opts ...grpc.CallOption) (*SendMailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendMailResponse)
+	err := c.cc.Invoke(ctx, UserService_SendMail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SendSystemMail(ctx context.Context, in *SendSystemMailRequest, opts ...grpc.CallOption) (*SendSystemMailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendSystemMailResponse)
+	err := c.cc.Invoke(ctx, UserService_SendSystemMail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SendBroadcastMail(ctx context.Context, in *SendBroadcastMailRequest, opts ...grpc.CallOption) (*SendBroadcastMailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendBroadcastMailResponse)
+	err := c.cc.Invoke(ctx, UserService_SendBroadcastMail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletResponse)
+	err := c.cc.Invoke(ctx, UserService_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetCurrencyHistory(ctx context.Context, in *GetCurrencyHistoryRequest, opts ...grpc.CallOption) (*GetCurrencyHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCurrencyHistoryResponse)
+	err := c.cc.Invoke(ctx, UserService_GetCurrencyHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ChangeCurrency(ctx context.Context, in *ChangeCurrencyRequest, opts ...grpc.CallOption) (*ChangeCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeCurrencyResponse)
+	err := c.cc.Invoke(ctx, UserService_ChangeCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetShop(ctx context.Context, in *GetShopRequest, opts ...grpc.CallOption) (*GetShopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShopResponse)
+	err := c.cc.Invoke(ctx, UserService_GetShop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BuyGoods(ctx context.Context, in *BuyGoodsRequest, opts ...grpc.CallOption) (*BuyGoodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuyGoodsResponse)
+	err := c.cc.Invoke(ctx, UserService_BuyGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetGachaPool(ctx context.Context, in *GetGachaPoolRequest, opts ...grpc.CallOption) (*GetGachaPoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGachaPoolResponse)
+	err := c.cc.Invoke(ctx, UserService_GetGachaPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GachaPull(ctx context.Context, in *GachaPullRequest, opts ...grpc.CallOption) (*GachaPullResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GachaPullResponse)
+	err := c.cc.Invoke(ctx, UserService_GachaPull_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetGachaHistory(ctx context.Context, in *GetGachaHistoryRequest, opts ...grpc.CallOption) (*GetGachaHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGachaHistoryResponse)
+	err := c.cc.Invoke(ctx, UserService_GetGachaHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetQuests(ctx context.Context, in *GetQuestsRequest, opts ...grpc.CallOption) (*GetQuestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuestsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetQuests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ClaimQuestReward(ctx context.Context, in *ClaimQuestRewardRequest, opts ...grpc.CallOption) (*ClaimQuestRewardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimQuestRewardResponse)
+	err := c.cc.Invoke(ctx, UserService_ClaimQuestReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -324,12 +688,22 @@ type UserServiceServer interface {
 	RemoveItem(context.Context, *RemoveItemRequest) (*RemoveItemResponse, error)
 	// 使用物品
 	UseItem(context.Context, *UseItemRequest) (*UseItemResponse, error)
+	// 背包扩容
+	ExpandInventory(context.Context, *ExpandInventoryRequest) (*ExpandInventoryResponse, error)
+	// 整理背包
+	SortInventory(context.Context, *SortInventoryRequest) (*SortInventoryResponse, error)
+	// 获取生效中的增益
+	GetBuffs(context.Context, *GetBuffsRequest) (*GetBuffsResponse, error)
 	// 获取装备信息
 	GetEquipments(context.Context, *GetEquipmentsRequest) (*GetEquipmentsResponse, error)
 	// 装备物品
 	EquipItem(context.Context, *EquipItemRequest) (*EquipItemResponse, error)
 	// 卸下装备
 	UnequipItem(context.Context, *UnequipItemRequest) (*UnequipItemResponse, error)
+	// 强化装备
+	EnhanceEquipment(context.Context, *EnhanceEquipmentRequest) (*EnhanceEquipmentResponse, error)
+	// 洗练装备
+	RefineEquipment(context.Context, *RefineEquipmentRequest) (*RefineEquipmentResponse, error)
 	// 获取玩家信息
 	GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error)
 	// 获取玩家所有卡牌信息
@@ -348,12 +722,58 @@ type UserServiceServer interface {
 	SetPetBattleStatus(context.Context, *SetPetBattleStatusRequest) (*SetPetBattleStatusResponse, error)
 	// 增加宠物经验
 	AddPetExp(context.Context, *AddPetExpRequest) (*AddPetExpResponse, error)
+	// 宠物升星
+	EvolvePet(context.Context, *EvolvePetRequest) (*EvolvePetResponse, error)
+	// 学习宠物技能
+	LearnPetSkill(context.Context, *LearnPetSkillRequest) (*LearnPetSkillResponse, error)
+	// 放生宠物
+	ReleasePet(context.Context, *ReleasePetRequest) (*ReleasePetResponse, error)
 	// 获取月签到信息
 	GetMonthlySignInfo(context.Context, *GetMonthlySignInfoRequest) (*GetMonthlySignInfoResponse, error)
 	// 月签到
 	MonthlySign(context.Context, *MonthlySignRequest) (*MonthlySignResponse, error)
 	// 领取月签到累计奖励
 	ClaimMonthlySignReward(context.Context, *ClaimMonthlySignRewardRequest) (*ClaimMonthlySignRewardResponse, error)
+	// 月签到补签
+	MonthlySignMakeup(context.Context, *MonthlySignMakeupRequest) (*MonthlySignMakeupResponse, error)
+	// 获取历史月份签到记录
+	GetMonthlySignHistory(context.Context, *GetMonthlySignHistoryRequest) (*GetMonthlySignHistoryResponse, error)
+	// 增加玩家经验（仅供内部服务调用，不经网关开放）
+	AddUserExp(context.Context, *AddUserExpRequest) (*AddUserExpResponse, error)
+	// 获取邮件列表
+	GetMails(context.Context, *GetMailsRequest) (*GetMailsResponse, error)
+	// 阅读邮件
+	ReadMail(context.Context, *ReadMailRequest) (*ReadMailResponse, error)
+	// 领取邮件附件
+	ClaimMail(context.Context, *ClaimMailRequest) (*ClaimMailResponse, error)
+	// 删除邮件
+	DeleteMail(context.Context, *DeleteMailRequest) (*DeleteMailResponse, error)
+	// 给其他玩家发送邮件
+	SendMail(context.Context, *SendMailRequest) (*SendMailResponse, error)
+	// 发送系统邮件（仅供内部服务调用，不经网关开放）
+	SendSystemMail(context.Context, *SendSystemMailRequest) (*SendSystemMailResponse, error)
+	// 发送全服邮件（仅供内部服务调用，不经网关开放）
+	SendBroadcastMail(context.Context, *SendBroadcastMailRequest) (*SendBroadcastMailResponse, error)
+	// 获取货币余额
+	GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error)
+	// 获取货币流水
+	GetCurrencyHistory(context.Context, *GetCurrencyHistoryRequest) (*GetCurrencyHistoryResponse, error)
+	// 变更货币（仅供内部服务调用，不经网关开放）
+	ChangeCurrency(context.Context, *ChangeCurrencyRequest) (*ChangeCurrencyResponse, error)
+	// 获取商店商品列表
+	GetShop(context.Context, *GetShopRequest) (*GetShopResponse, error)
+	// 购买商品
+	BuyGoods(context.Context, *BuyGoodsRequest) (*BuyGoodsResponse, error)
+	// 获取卡池信息
+	GetGachaPool(context.Context, *GetGachaPoolRequest) (*GetGachaPoolResponse, error)
+	// 抽卡
+	GachaPull(context.Context, *GachaPullRequest) (*GachaPullResponse, error)
+	// 获取抽卡记录
+	GetGachaHistory(context.Context, *GetGachaHistoryRequest) (*GetGachaHistoryResponse, error)
+	// 获取任务和成就列表
+	GetQuests(context.Context, *GetQuestsRequest) (*GetQuestsResponse, error)
+	// 领取任务奖励
+	ClaimQuestReward(context.Context, *ClaimQuestRewardRequest) (*ClaimQuestRewardResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -382,6 +802,15 @@ func (UnimplementedUserServiceServer) RemoveItem(context.Context, *RemoveItemReq
 func (UnimplementedUserServiceServer) UseItem(context.Context, *UseItemRequest) (*UseItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UseItem not implemented")
 }
+func (UnimplementedUserServiceServer) ExpandInventory(context.Context, *ExpandInventoryRequest) (*ExpandInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpandInventory not implemented")
+}
+func (UnimplementedUserServiceServer) SortInventory(context.Context, *SortInventoryRequest) (*SortInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortInventory not implemented")
+}
+func (UnimplementedUserServiceServer) GetBuffs(context.Context, *GetBuffsRequest) (*GetBuffsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuffs not implemented")
+}
 func (UnimplementedUserServiceServer) GetEquipments(context.Context, *GetEquipmentsRequest) (*GetEquipmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEquipments not implemented")
 }
@@ -391,6 +820,12 @@ func (UnimplementedUserServiceServer) EquipItem(context.Context, *EquipItemReque
 func (UnimplementedUserServiceServer) UnequipItem(context.Context, *UnequipItemRequest) (*UnequipItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnequipItem not implemented")
 }
+func (UnimplementedUserServiceServer) EnhanceEquipment(context.Context, *EnhanceEquipmentRequest) (*EnhanceEquipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnhanceEquipment not implemented")
+}
+func (UnimplementedUserServiceServer) RefineEquipment(context.Context, *RefineEquipmentRequest) (*RefineEquipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefineEquipment not implemented")
+}
 func (UnimplementedUserServiceServer) GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfo not implemented")
 }
@@ -418,6 +853,15 @@ func (UnimplementedUserServiceServer) SetPetBattleStatus(context.Context, *SetPe
 func (UnimplementedUserServiceServer) AddPetExp(context.Context, *AddPetExpRequest) (*AddPetExpResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPetExp not implemented")
 }
+func (UnimplementedUserServiceServer) EvolvePet(context.Context, *EvolvePetRequest) (*EvolvePetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvolvePet not implemented")
+}
+func (UnimplementedUserServiceServer) LearnPetSkill(context.Context, *LearnPetSkillRequest) (*LearnPetSkillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LearnPetSkill not implemented")
+}
+func (UnimplementedUserServiceServer) ReleasePet(context.Context, *ReleasePetRequest) (*ReleasePetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePet not implemented")
+}
 func (UnimplementedUserServiceServer) GetMonthlySignInfo(context.Context, *GetMonthlySignInfoRequest) (*GetMonthlySignInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMonthlySignInfo not implemented")
 }
@@ -427,6 +871,66 @@ func (UnimplementedUserServiceServer) MonthlySign(context.Context, *MonthlySignR
 func (UnimplementedUserServiceServer) ClaimMonthlySignReward(context.Context, *ClaimMonthlySignRewardRequest) (*ClaimMonthlySignRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimMonthlySignReward not implemented")
 }
+func (UnimplementedUserServiceServer) MonthlySignMakeup(context.Context, *MonthlySignMakeupRequest) (*MonthlySignMakeupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonthlySignMakeup not implemented")
+}
+func (UnimplementedUserServiceServer) GetMonthlySignHistory(context.Context, *GetMonthlySignHistoryRequest) (*GetMonthlySignHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMonthlySignHistory not implemented")
+}
+func (UnimplementedUserServiceServer) AddUserExp(context.Context, *AddUserExpRequest) (*AddUserExpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserExp not implemented")
+}
+func (UnimplementedUserServiceServer) GetMails(context.Context, *GetMailsRequest) (*GetMailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMails not implemented")
+}
+func (UnimplementedUserServiceServer) ReadMail(context.Context, *ReadMailRequest) (*ReadMailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadMail not implemented")
+}
+func (UnimplementedUserServiceServer) ClaimMail(context.Context, *ClaimMailRequest) (*ClaimMailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimMail not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteMail(context.Context, *DeleteMailRequest) (*DeleteMailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMail not implemented")
+}
+func (UnimplementedUserServiceServer) SendMail(context.Context, *SendMailRequest) (*SendMailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMail not implemented")
+}
+func (UnimplementedUserServiceServer) SendSystemMail(context.Context, *SendSystemMailRequest) (*SendSystemMailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSystemMail not implemented")
+}
+func (UnimplementedUserServiceServer) SendBroadcastMail(context.Context, *SendBroadcastMailRequest) (*SendBroadcastMailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendBroadcastMail not implemented")
+}
+func (UnimplementedUserServiceServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedUserServiceServer) GetCurrencyHistory(context.Context, *GetCurrencyHistoryRequest) (*GetCurrencyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyHistory not implemented")
+}
+func (UnimplementedUserServiceServer) ChangeCurrency(context.Context, *ChangeCurrencyRequest) (*ChangeCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeCurrency not implemented")
+}
+func (UnimplementedUserServiceServer) GetShop(context.Context, *GetShopRequest) (*GetShopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShop not implemented")
+}
+func (UnimplementedUserServiceServer) BuyGoods(context.Context, *BuyGoodsRequest) (*BuyGoodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyGoods not implemented")
+}
+func (UnimplementedUserServiceServer) GetGachaPool(context.Context, *GetGachaPoolRequest) (*GetGachaPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGachaPool not implemented")
+}
+func (UnimplementedUserServiceServer) GachaPull(context.Context, *GachaPullRequest) (*GachaPullResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GachaPull not implemented")
+}
+func (UnimplementedUserServiceServer) GetGachaHistory(context.Context, *GetGachaHistoryRequest) (*GetGachaHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGachaHistory not implemented")
+}
+func (UnimplementedUserServiceServer) GetQuests(context.Context, *GetQuestsRequest) (*GetQuestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuests not implemented")
+}
+func (UnimplementedUserServiceServer) ClaimQuestReward(context.Context, *ClaimQuestRewardRequest) (*ClaimQuestRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimQuestReward not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -556,89 +1060,179 @@ func _UserService_UseItem_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetEquipments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEquipmentsRequest)
+func _UserService_ExpandInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandInventoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetEquipments(ctx, in)
+		return srv.(UserServiceServer).ExpandInventory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetEquipments_FullMethodName,
+		FullMethod: UserService_ExpandInventory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetEquipments(ctx, req.(*GetEquipmentsRequest))
+		return srv.(UserServiceServer).ExpandInventory(ctx, req.(*ExpandInventoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_EquipItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EquipItemRequest)
+func _UserService_SortInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortInventoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).EquipItem(ctx, in)
+		return srv.(UserServiceServer).SortInventory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_EquipItem_FullMethodName,
+		FullMethod: UserService_SortInventory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).EquipItem(ctx, req.(*EquipItemRequest))
+		return srv.(UserServiceServer).SortInventory(ctx, req.(*SortInventoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UnequipItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnequipItemRequest)
+func _UserService_GetBuffs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBuffsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UnequipItem(ctx, in)
+		return srv.(UserServiceServer).GetBuffs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UnequipItem_FullMethodName,
+		FullMethod: UserService_GetBuffs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UnequipItem(ctx, req.(*UnequipItemRequest))
+		return srv.(UserServiceServer).GetBuffs(ctx, req.(*GetBuffsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserInfoRequest)
+func _UserService_GetEquipments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEquipmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserInfo(ctx, in)
+		return srv.(UserServiceServer).GetEquipments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUserInfo_FullMethodName,
+		FullMethod: UserService_GetEquipments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserInfo(ctx, req.(*GetUserInfoRequest))
+		return srv.(UserServiceServer).GetEquipments(ctx, req.(*GetEquipmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserCardsRequest)
+func _UserService_EquipItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EquipItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserCards(ctx, in)
+		return srv.(UserServiceServer).EquipItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUserCards_FullMethodName,
+		FullMethod: UserService_EquipItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).EquipItem(ctx, req.(*EquipItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnequipItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnequipItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnequipItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnequipItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnequipItem(ctx, req.(*UnequipItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_EnhanceEquipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnhanceEquipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).EnhanceEquipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_EnhanceEquipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).EnhanceEquipment(ctx, req.(*EnhanceEquipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RefineEquipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefineEquipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RefineEquipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RefineEquipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RefineEquipment(ctx, req.(*RefineEquipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserInfo(ctx, req.(*GetUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserCards_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(UserServiceServer).GetUserCards(ctx, req.(*GetUserCardsRequest))
@@ -772,6 +1366,60 @@ func _UserService_AddPetExp_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_EvolvePet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvolvePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).EvolvePet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_EvolvePet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).EvolvePet(ctx, req.(*EvolvePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_LearnPetSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LearnPetSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).LearnPetSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_LearnPetSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).LearnPetSkill(ctx, req.(*LearnPetSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReleasePet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReleasePet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReleasePet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReleasePet(ctx, req.(*ReleasePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetMonthlySignInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMonthlySignInfoRequest)
 	if err := dec(in); err != nil {
@@ -826,6 +1474,366 @@ func _UserService_ClaimMonthlySignReward_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_MonthlySignMakeup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonthlySignMakeupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).MonthlySignMakeup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_MonthlySignMakeup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).MonthlySignMakeup(ctx, req.(*MonthlySignMakeupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetMonthlySignHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMonthlySignHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetMonthlySignHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetMonthlySignHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetMonthlySignHistory(ctx, req.(*GetMonthlySignHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddUserExp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserExpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddUserExp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddUserExp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddUserExp(ctx, req.(*AddUserExpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetMails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetMails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetMails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetMails(ctx, req.(*GetMailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReadMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReadMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReadMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReadMail(ctx, req.(*ReadMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ClaimMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ClaimMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ClaimMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ClaimMail(ctx, req.(*ClaimMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteMail(ctx, req.(*DeleteMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SendMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SendMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SendMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SendMail(ctx, req.(*SendMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SendSystemMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendSystemMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SendSystemMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SendSystemMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SendSystemMail(ctx, req.(*SendSystemMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SendBroadcastMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendBroadcastMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SendBroadcastMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SendBroadcastMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SendBroadcastMail(ctx, req.(*SendBroadcastMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetCurrencyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetCurrencyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetCurrencyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetCurrencyHistory(ctx, req.(*GetCurrencyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ChangeCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangeCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangeCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangeCurrency(ctx, req.(*ChangeCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetShop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetShop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetShop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetShop(ctx, req.(*GetShopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BuyGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BuyGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BuyGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BuyGoods(ctx, req.(*BuyGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetGachaPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGachaPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetGachaPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetGachaPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetGachaPool(ctx, req.(*GetGachaPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GachaPull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GachaPullRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GachaPull(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GachaPull_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GachaPull(ctx, req.(*GachaPullRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetGachaHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGachaHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetGachaHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetGachaHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetGachaHistory(ctx, req.(*GetGachaHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetQuests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetQuests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetQuests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetQuests(ctx, req.(*GetQuestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ClaimQuestReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimQuestRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ClaimQuestReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ClaimQuestReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ClaimQuestReward(ctx, req.(*ClaimQuestRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -857,6 +1865,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UseItem",
 			Handler:    _UserService_UseItem_Handler,
 		},
+		{
+			MethodName: "ExpandInventory",
+			Handler:    _UserService_ExpandInventory_Handler,
+		},
+		{
+			MethodName: "SortInventory",
+			Handler:    _UserService_SortInventory_Handler,
+		},
+		{
+			MethodName: "GetBuffs",
+			Handler:    _UserService_GetBuffs_Handler,
+		},
 		{
 			MethodName: "GetEquipments",
 			Handler:    _UserService_GetEquipments_Handler,
@@ -869,6 +1889,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnequipItem",
 			Handler:    _UserService_UnequipItem_Handler,
 		},
+		{
+			MethodName: "EnhanceEquipment",
+			Handler:    _UserService_EnhanceEquipment_Handler,
+		},
+		{
+			MethodName: "RefineEquipment",
+			Handler:    _UserService_RefineEquipment_Handler,
+		},
 		{
 			MethodName: "GetUserInfo",
 			Handler:    _UserService_GetUserInfo_Handler,
@@ -905,6 +1933,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddPetExp",
 			Handler:    _UserService_AddPetExp_Handler,
 		},
+		{
+			MethodName: "EvolvePet",
+			Handler:    _UserService_EvolvePet_Handler,
+		},
+		{
+			MethodName: "LearnPetSkill",
+			Handler:    _UserService_LearnPetSkill_Handler,
+		},
+		{
+			MethodName: "ReleasePet",
+			Handler:    _UserService_ReleasePet_Handler,
+		},
 		{
 			MethodName: "GetMonthlySignInfo",
 			Handler:    _UserService_GetMonthlySignInfo_Handler,
@@ -917,6 +1957,86 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimMonthlySignReward",
 			Handler:    _UserService_ClaimMonthlySignReward_Handler,
 		},
+		{
+			MethodName: "MonthlySignMakeup",
+			Handler:    _UserService_MonthlySignMakeup_Handler,
+		},
+		{
+			MethodName: "GetMonthlySignHistory",
+			Handler:    _UserService_GetMonthlySignHistory_Handler,
+		},
+		{
+			MethodName: "AddUserExp",
+			Handler:    _UserService_AddUserExp_Handler,
+		},
+		{
+			MethodName: "GetMails",
+			Handler:    _UserService_GetMails_Handler,
+		},
+		{
+			MethodName: "ReadMail",
+			Handler:    _UserService_ReadMail_Handler,
+		},
+		{
+			MethodName: "ClaimMail",
+			Handler:    _UserService_ClaimMail_Handler,
+		},
+		{
+			MethodName: "DeleteMail",
+			Handler:    _UserService_DeleteMail_Handler,
+		},
+		{
+			MethodName: "SendMail",
+			Handler:    _UserService_SendMail_Handler,
+		},
+		{
+			MethodName: "SendSystemMail",
+			Handler:    _UserService_SendSystemMail_Handler,
+		},
+		{
+			MethodName: "SendBroadcastMail",
+			Handler:    _UserService_SendBroadcastMail_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _UserService_GetWallet_Handler,
+		},
+		{
+			MethodName: "GetCurrencyHistory",
+			Handler:    _UserService_GetCurrencyHistory_Handler,
+		},
+		{
+			MethodName: "ChangeCurrency",
+			Handler:    _UserService_ChangeCurrency_Handler,
+		},
+		{
+			MethodName: "GetShop",
+			Handler:    _UserService_GetShop_Handler,
+		},
+		{
+			MethodName: "BuyGoods",
+			Handler:    _UserService_BuyGoods_Handler,
+		},
+		{
+			MethodName: "GetGachaPool",
+			Handler:    _UserService_GetGachaPool_Handler,
+		},
+		{
+			MethodName: "GachaPull",
+			Handler:    _UserService_GachaPull_Handler,
+		},
+		{
+			MethodName: "GetGachaHistory",
+			Handler:    _UserService_GetGachaHistory_Handler,
+		},
+		{
+			MethodName: "GetQuests",
+			Handler:    _UserService_GetQuests_Handler,
+		},
+		{
+			MethodName: "ClaimQuestReward",
+			Handler:    _UserService_ClaimQuestReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/pb/user.proto",
@@ -21,12 +21,127 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 消息状态码
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_CODE_OK                  ErrorCode = 0 // 成功
+	ErrorCode_ERROR_CODE_BAD_REQUEST         ErrorCode = 1 // 请求格式错误
+	ErrorCode_ERROR_CODE_UNAUTHENTICATED     ErrorCode = 2 // 未鉴权
+	ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE ErrorCode = 3 // 服务不可用
+	ErrorCode_ERROR_CODE_INTERNAL            ErrorCode = 4 // 服务内部错误
+	ErrorCode_ERROR_CODE_TOO_MANY_REQUESTS   ErrorCode = 5 // 请求过多，稍后重试
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0: "ERROR_CODE_OK",
+		1: "ERROR_CODE_BAD_REQUEST",
+		2: "ERROR_CODE_UNAUTHENTICATED",
+		3: "ERROR_CODE_SERVICE_UNAVAILABLE",
+		4: "ERROR_CODE_INTERNAL",
+		5: "ERROR_CODE_TOO_MANY_REQUESTS",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_OK":                  0,
+		"ERROR_CODE_BAD_REQUEST":         1,
+		"ERROR_CODE_UNAUTHENTICATED":     2,
+		"ERROR_CODE_SERVICE_UNAVAILABLE": 3,
+		"ERROR_CODE_INTERNAL":            4,
+		"ERROR_CODE_TOO_MANY_REQUESTS":   5,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_pb_ws_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_internal_pb_ws_proto_enumTypes[0]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_internal_pb_ws_proto_rawDescGZIP(), []int{0}
+}
+
+// 推送目标类型
+type PushTarget int32
+
+const (
+	PushTarget_PUSH_TARGET_USER  PushTarget = 0 // 单个玩家
+	PushTarget_PUSH_TARGET_USERS PushTarget = 1 // 多个玩家
+	PushTarget_PUSH_TARGET_GUILD PushTarget = 2 // 帮派成员
+	PushTarget_PUSH_TARGET_ALL   PushTarget = 3 // 全部在线玩家
+)
+
+// Enum value maps for PushTarget.
+var (
+	PushTarget_name = map[int32]string{
+		0: "PUSH_TARGET_USER",
+		1: "PUSH_TARGET_USERS",
+		2: "PUSH_TARGET_GUILD",
+		3: "PUSH_TARGET_ALL",
+	}
+	PushTarget_value = map[string]int32{
+		"PUSH_TARGET_USER":  0,
+		"PUSH_TARGET_USERS": 1,
+		"PUSH_TARGET_GUILD": 2,
+		"PUSH_TARGET_ALL":   3,
+	}
+)
+
+func (x PushTarget) Enum() *PushTarget {
+	p := new(PushTarget)
+	*p = x
+	return p
+}
+
+func (x PushTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PushTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_pb_ws_proto_enumTypes[1].Descriptor()
+}
+
+func (PushTarget) Type() protoreflect.EnumType {
+	return &file_internal_pb_ws_proto_enumTypes[1]
+}
+
+func (x PushTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PushTarget.Descriptor instead.
+func (PushTarget) EnumDescriptor() ([]byte, []int) {
+	return file_internal_pb_ws_proto_rawDescGZIP(), []int{1}
+}
+
 // WebSocket 消息结构
 type WSMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"` // 服务名称
-	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`   // 方法名称
-	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"` // Protocol Buffers 序列化后的数据
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`                               // 服务名称
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`                                 // 方法名称
+	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`                               // Protocol Buffers 序列化后的数据
+	Seq           uint32                 `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`                                      // 客户端请求序号，响应原样带回，推送消息为0
+	Code          ErrorCode              `protobuf:"varint,5,opt,name=code,proto3,enum=ws.ErrorCode" json:"code,omitempty"`                  // 状态码
+	ErrorMessage  string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // 错误信息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,15 +197,256 @@ func (x *WSMessage) GetPayload() []byte {
 	return nil
 }
 
+func (x *WSMessage) GetSeq() uint32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *WSMessage) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_ERROR_CODE_OK
+}
+
+func (x *WSMessage) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// 鉴权请求（连接建立后需先发送，绑定连接身份）
+type AuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // 登录获得的JWT
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthRequest) Reset() {
+	*x = AuthRequest{}
+	mi := &file_internal_pb_ws_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthRequest) ProtoMessage() {}
+
+func (x *AuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_ws_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthRequest.ProtoReflect.Descriptor instead.
+func (*AuthRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_ws_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuthRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 鉴权响应
+type AuthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`             // 是否成功
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`              // 消息
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 绑定的用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthResponse) Reset() {
+	*x = AuthResponse{}
+	mi := &file_internal_pb_ws_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthResponse) ProtoMessage() {}
+
+func (x *AuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_ws_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return file_internal_pb_ws_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuthResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AuthResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 服务端推送事件（后端服务通过Redis发布，网关投递给对应连接）
+type PushEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        PushTarget             `protobuf:"varint,1,opt,name=target,proto3,enum=ws.PushTarget" json:"target,omitempty"`      // 推送目标类型
+	UserIds       []int64                `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // 目标玩家ID列表（帮派推送时为帮派成员）
+	GuildId       int64                  `protobuf:"varint,3,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`        // 帮派ID
+	Service       string                 `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`                        // 事件来源服务
+	Event         string                 `protobuf:"bytes,5,opt,name=event,proto3" json:"event,omitempty"`                            // 事件名称
+	Payload       []byte                 `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`                        // Protocol Buffers 序列化后的事件数据
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushEvent) Reset() {
+	*x = PushEvent{}
+	mi := &file_internal_pb_ws_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushEvent) ProtoMessage() {}
+
+func (x *PushEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_ws_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushEvent.ProtoReflect.Descriptor instead.
+func (*PushEvent) Descriptor() ([]byte, []int) {
+	return file_internal_pb_ws_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PushEvent) GetTarget() PushTarget {
+	if x != nil {
+		return x.Target
+	}
+	return PushTarget_PUSH_TARGET_USER
+}
+
+func (x *PushEvent) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *PushEvent) GetGuildId() int64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *PushEvent) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *PushEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *PushEvent) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
 var File_internal_pb_ws_proto protoreflect.FileDescriptor
 
 const file_internal_pb_ws_proto_rawDesc = "" +
 	"\n" +
-	"\x14internal/pb/ws.proto\x12\x02ws\"W\n" +
+	"\x14internal/pb/ws.proto\x12\x02ws\"\xb1\x01\n" +
 	"\tWSMessage\x12\x18\n" +
 	"\aservice\x18\x01 \x01(\tR\aservice\x12\x16\n" +
 	"\x06method\x18\x02 \x01(\tR\x06method\x12\x18\n" +
-	"\apayload\x18\x03 \x01(\fR\apayloadB\rZ\vinternal/pbb\x06proto3"
+	"\apayload\x18\x03 \x01(\fR\apayload\x12\x10\n" +
+	"\x03seq\x18\x04 \x01(\rR\x03seq\x12!\n" +
+	"\x04code\x18\x05 \x01(\x0e2\r.ws.ErrorCodeR\x04code\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"#\n" +
+	"\vAuthRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"[\n" +
+	"\fAuthResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\"\xb3\x01\n" +
+	"\tPushEvent\x12&\n" +
+	"\x06target\x18\x01 \x01(\x0e2\x0e.ws.PushTargetR\x06target\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\x03R\auserIds\x12\x19\n" +
+	"\bguild_id\x18\x03 \x01(\x03R\aguildId\x12\x18\n" +
+	"\aservice\x18\x04 \x01(\tR\aservice\x12\x14\n" +
+	"\x05event\x18\x05 \x01(\tR\x05event\x12\x18\n" +
+	"\apayload\x18\x06 \x01(\fR\apayload*\xb9\x01\n" +
+	"\tErrorCode\x12\x11\n" +
+	"\rERROR_CODE_OK\x10\x00\x12\x1a\n" +
+	"\x16ERROR_CODE_BAD_REQUEST\x10\x01\x12\x1e\n" +
+	"\x1aERROR_CODE_UNAUTHENTICATED\x10\x02\x12\"\n" +
+	"\x1eERROR_CODE_SERVICE_UNAVAILABLE\x10\x03\x12\x17\n" +
+	"\x13ERROR_CODE_INTERNAL\x10\x04\x12 \n" +
+	"\x1cERROR_CODE_TOO_MANY_REQUESTS\x10\x05*e\n" +
+	"\n" +
+	"PushTarget\x12\x14\n" +
+	"\x10PUSH_TARGET_USER\x10\x00\x12\x15\n" +
+	"\x11PUSH_TARGET_USERS\x10\x01\x12\x15\n" +
+	"\x11PUSH_TARGET_GUILD\x10\x02\x12\x13\n" +
+	"\x0fPUSH_TARGET_ALL\x10\x03B\rZ\vinternal/pbb\x06proto3"
 
 var (
 	file_internal_pb_ws_proto_rawDescOnce sync.Once
@@ -104,16 +460,24 @@ func file_internal_pb_ws_proto_rawDescGZIP() []byte {
 	return file_internal_pb_ws_proto_rawDescData
 }
 
-var file_internal_pb_ws_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_internal_pb_ws_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_internal_pb_ws_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_internal_pb_ws_proto_goTypes = []any{
-	(*WSMessage)(nil), // 0: ws.WSMessage
+	(ErrorCode)(0),       // 0: ws.ErrorCode
+	(PushTarget)(0),      // 1: ws.PushTarget
+	(*WSMessage)(nil),    // 2: ws.WSMessage
+	(*AuthRequest)(nil),  // 3: ws.AuthRequest
+	(*AuthResponse)(nil), // 4: ws.AuthResponse
+	(*PushEvent)(nil),    // 5: ws.PushEvent
 }
 var file_internal_pb_ws_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: ws.WSMessage.code:type_name -> ws.ErrorCode
+	1, // 1: ws.PushEvent.target:type_name -> ws.PushTarget
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_internal_pb_ws_proto_init() }
@@ -126,13 +490,14 @@ func file_internal_pb_ws_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_pb_ws_proto_rawDesc), len(file_internal_pb_ws_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_internal_pb_ws_proto_goTypes,
 		DependencyIndexes: file_internal_pb_ws_proto_depIdxs,
+		EnumInfos:         file_internal_pb_ws_proto_enumTypes,
 		MessageInfos:      file_internal_pb_ws_proto_msgTypes,
 	}.Build()
 	File_internal_pb_ws_proto = out.File
@@ -0,0 +1,106 @@
+package push
+
+import (
+	"context"
+	"fmt"
+
+	"github.xubinbest.com/go-game-server/internal/cache"
+	"github.xubinbest.com/go-game-server/internal/pb"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Channel 推送事件的Redis发布订阅频道，所有网关实例都会订阅
+	Channel = "ws_push"
+
+	// MethodPrefix 推送消息的方法名前缀，客户端据此区分推送与请求响应
+	MethodPrefix = "push."
+)
+
+// 推送事件名称
+const (
+	EventChatMessage  = "chatMessage"
+	EventMatchFound   = "matchFound"
+	EventMatchTimeout = "matchTimeout"
+	EventGameTick     = "gameTick"
+	EventNewMail      = "newMail"
+)
+
+// Publisher 推送事件发布器，供后端服务向在线玩家推送消息
+type Publisher struct {
+	cacheClient cache.Cache
+	service     string
+}
+
+// NewPublisher 创建推送发布器，service为事件来源服务名
+func NewPublisher(cacheClient cache.Cache, service string) *Publisher {
+	return &Publisher{
+		cacheClient: cacheClient,
+		service:     service,
+	}
+}
+
+// PushToUser 推送给单个玩家
+func (p *Publisher) PushToUser(ctx context.Context, userID int64, event string, msg proto.Message) error {
+	return p.publish(ctx, &pb.PushEvent{
+		Target:  pb.PushTarget_PUSH_TARGET_USER,
+		UserIds: []int64{userID},
+	}, event, msg)
+}
+
+// PushToUsers 推送给多个玩家
+func (p *Publisher) PushToUsers(ctx context.Context, userIDs []int64, event string, msg proto.Message) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return p.publish(ctx, &pb.PushEvent{
+		Target:  pb.PushTarget_PUSH_TARGET_USERS,
+		UserIds: userIDs,
+	}, event, msg)
+}
+
+// PushToGuild 推送给帮派成员，成员列表由调用方（帮派所属服务）提供
+func (p *Publisher) PushToGuild(ctx context.Context, guildID int64, memberIDs []int64, event string, msg proto.Message) error {
+	if len(memberIDs) == 0 {
+		return nil
+	}
+	return p.publish(ctx, &pb.PushEvent{
+		Target:  pb.PushTarget_PUSH_TARGET_GUILD,
+		GuildId: guildID,
+		UserIds: memberIDs,
+	}, event, msg)
+}
+
+// PushToAll 推送给全部在线玩家
+func (p *Publisher) PushToAll(ctx context.Context, event string, msg proto.Message) error {
+	return p.publish(ctx, &pb.PushEvent{
+		Target: pb.PushTarget_PUSH_TARGET_ALL,
+	}, event, msg)
+}
+
+func (p *Publisher) publish(ctx context.Context, ev *pb.PushEvent, event string, msg proto.Message) error {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal push payload: %w", err)
+	}
+
+	ev.Service = p.service
+	ev.Event = event
+	ev.Payload = payload
+
+	data, err := proto.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal push event: %w", err)
+	}
+
+	if err := p.cacheClient.Publish(ctx, Channel, data); err != nil {
+		return fmt.Errorf("failed to publish push event: %w", err)
+	}
+	return nil
+}
+
+// MethodName 获取推送事件对应的WSMessage方法名
+func MethodName(event string) string {
+	return MethodPrefix + event
+}
@@ -239,3 +239,10 @@ func (cs *CacheService) InvalidateGuildApplicationsCache(ctx context.Context, gu
 	key := fmt.Sprintf("%s%d", strategy.KeyPrefix, guildID)
 	return cs.cacheManager.Invalidate(ctx, key)
 }
+
+// InvalidateInventoryCache 失效用户背包缓存，帮派捐献扣除物品后调用
+func (cs *CacheService) InvalidateInventoryCache(ctx context.Context, userID int64) error {
+	strategy := cache.Strategies["user_inventory"]
+	key := fmt.Sprintf("%s%d", strategy.KeyPrefix, userID)
+	return cs.cacheManager.Invalidate(ctx, key)
+}
@@ -2,16 +2,35 @@ package social
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/push"
 	"github.xubinbest.com/go-game-server/internal/utils"
 
 	"go.uber.org/zap"
 )
 
+// 聊天频道
+const (
+	ChatChannelWorld   = 1 // 世界
+	ChatChannelGuild   = 2 // 帮派
+	ChatChannelPrivate = 3 // 私聊
+)
+
 // 发送世界消息
 func (h *Handler) SendChatMessage(ctx context.Context, req *pb.SendChatMessageRequest) (*pb.SendChatMessageResponse, error) {
+	targetID := req.TargetId
+	// 帮派频道只允许帮派成员发言，消息统一记录到发送者所在的帮派
+	if req.Channel == ChatChannelGuild {
+		guildID, err := h.resolveChatGuild(ctx, req.SenderId, req.TargetId)
+		if err != nil {
+			return nil, err
+		}
+		targetID = guildID
+	}
+
 	msgID, err := h.sf.NextID()
 	if err != nil {
 		return nil, err
@@ -22,7 +41,7 @@ func (h *Handler) SendChatMessage(ctx context.Context, req *pb.SendChatMessageRe
 		Channel:    int32(req.Channel),
 		SenderId:   req.SenderId,
 		Content:    req.Content,
-		ReceiverId: req.TargetId,
+		ReceiverId: targetID,
 		Timestamp:  time.Now().Unix(),
 		ExtraData:  req.ExtraData,
 	}
@@ -33,14 +52,13 @@ func (h *Handler) SendChatMessage(ctx context.Context, req *pb.SendChatMessageRe
 		return nil, err
 	}
 
-	// 广播消息给所有在线玩家
+	// 广播消息给所有在线玩家，消息已存储，推送失败时玩家仍可通过历史消息获取，不返回错误以免客户端重发
 	if err := h.broadcastWorldMessage(ctx, msg); err != nil {
-		utils.Error("failed to broadcast chat message", zap.Error(err))
-		return nil, err
+		utils.Error("failed to broadcast chat message", zap.Int64("messageId", msgID), zap.Error(err))
 	}
 
 	// 失效聊天消息缓存
-	_ = h.invalidateChatMessagesCache(ctx, req.Channel, req.TargetId)
+	_ = h.invalidateChatMessagesCache(ctx, req.Channel, targetID)
 
 	return &pb.SendChatMessageResponse{
 		Success:   true,
@@ -62,12 +80,56 @@ func (h *Handler) GetChatMessages(ctx context.Context, req *pb.GetChatMessagesRe
 	}, nil
 }
 
-// 广播世界消息
+// 广播聊天消息，按频道推送给对应的在线玩家
 func (h *Handler) broadcastWorldMessage(ctx context.Context, msg *pb.ChatMessage) error {
-	// 实现消息广播逻辑
-	// 可以通过websocket或gRPC流发送给所有在线玩家
-	h.cacheClient.Publish(ctx, "chat_message_broadcast", msg)
-	return nil
+	switch msg.Channel {
+	case ChatChannelGuild:
+		return h.pushGuildMessage(ctx, msg)
+	case ChatChannelPrivate:
+		return h.pusher.PushToUsers(ctx, []int64{msg.SenderId, msg.ReceiverId}, push.EventChatMessage, msg)
+	default:
+		return h.pusher.PushToAll(ctx, push.EventChatMessage, msg)
+	}
+}
+
+// resolveChatGuild 确定帮派消息的目标帮派并校验发送者是该帮派成员
+// 未指定帮派时使用发送者所在的帮派
+func (h *Handler) resolveChatGuild(ctx context.Context, senderID, guildID int64) (int64, error) {
+	if guildID == 0 {
+		guilds, err := h.dbClient.GetUserGuilds(ctx, senderID)
+		if err != nil {
+			return 0, err
+		}
+		if len(guilds) == 0 {
+			return 0, errors.New("user not in any guild")
+		}
+		return guilds[0].ID, nil
+	}
+
+	inGuild, err := h.isUserInGuild(ctx, guildID, senderID)
+	if err != nil {
+		return 0, err
+	}
+	if !inGuild {
+		return 0, errors.New("user not in guild")
+	}
+	return guildID, nil
+}
+
+// 推送帮派消息给所有帮派成员，发送者的成员身份已在 SendChatMessage 中校验
+func (h *Handler) pushGuildMessage(ctx context.Context, msg *pb.ChatMessage) error {
+	guildID := msg.ReceiverId
+	members, err := h.dbClient.GetGuildMembers(ctx, guildID)
+	if err != nil {
+		return err
+	}
+
+	memberIDs := make([]int64, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.UserID)
+	}
+
+	return h.pusher.PushToGuild(ctx, guildID, memberIDs, push.EventChatMessage, msg)
 }
 
 // 失效聊天消息缓存
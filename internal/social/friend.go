@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/gameevent"
 	"github.xubinbest.com/go-game-server/internal/pb"
 	"github.xubinbest.com/go-game-server/internal/utils"
 
@@ -105,6 +106,7 @@ func (h *Handler) HandleFriendRequest(ctx context.Context, req *pb.HandleFriendR
 		// 失效相关缓存
 		_ = h.cacheService.InvalidateFriendsCache(ctx, request.FromUserID)
 		_ = h.cacheService.InvalidateFriendsCache(ctx, request.ToUserID)
+		h.emitFriendAdded(ctx, request.FromUserID, request.ToUserID)
 	case pb.HandleFriendRequestRequest_REJECT:
 		// 拒绝请求，不做任何操作
 	default:
@@ -141,7 +143,9 @@ func (h *Handler) BatchHandleFriendRequest(ctx context.Context, req *pb.BatchHan
 		switch action {
 		case pb.BatchHandleFriendRequestRequest_ACCEPT_ALL:
 			// 添加好友
-			_ = h.dbClient.AddFriend(ctx, request.FromUserID, request.ToUserID)
+			if err := h.dbClient.AddFriend(ctx, request.FromUserID, request.ToUserID); err == nil {
+				h.emitFriendAdded(ctx, request.FromUserID, request.ToUserID)
+			}
 			// 失效相关缓存
 			_ = h.cacheService.InvalidateFriendsCache(ctx, request.FromUserID)
 			_ = h.cacheService.InvalidateFriendsCache(ctx, request.ToUserID)
@@ -187,3 +191,10 @@ func (h *Handler) DeleteFriend(ctx context.Context, req *pb.DeleteFriendRequest)
 		Success: true,
 	}, nil
 }
+
+// emitFriendAdded 双方各发送一次新增好友事件
+func (h *Handler) emitFriendAdded(ctx context.Context, userIDs ...int64) {
+	for _, userID := range userIDs {
+		h.events.Emit(ctx, userID, gameevent.FriendAdded, 1)
+	}
+}
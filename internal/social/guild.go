@@ -5,11 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"unicode/utf8"
 
 	"github.xubinbest.com/go-game-server/internal/db/models"
 	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
 )
 
+// maxGuildAnnouncementLength 帮派公告的最大字数
+const maxGuildAnnouncementLength = 500
+
 // 检查用户是否在指定帮派中
 func (h *Handler) isUserInGuild(ctx context.Context, guildID, userID int64) (bool, error) {
 	member, err := h.dbClient.GetGuildMember(ctx, guildID, userID)
@@ -57,6 +64,12 @@ func (h *Handler) CreateGuild(ctx context.Context, req *pb.CreateGuildRequest) (
 		return nil, errors.New("guild name already exists")
 	}
 
+	// 成员上限由1级帮派配置决定
+	levelConfig := h.getGuildLevelConfig(1)
+	if levelConfig == nil {
+		return nil, errors.New("guild level config not found")
+	}
+
 	// 创建帮派对象
 	guildId, err := h.sf.NextID()
 	if err != nil {
@@ -70,7 +83,8 @@ func (h *Handler) CreateGuild(ctx context.Context, req *pb.CreateGuildRequest) (
 		Announcement: req.Announcement,
 		MasterID:     req.CreatorId,
 		CreatedAt:    now,
-		MaxMembers:   100, // 默认最大成员数
+		MaxMembers:   int32(levelConfig.MaxMembers),
+		Level:        1,
 	}
 
 	// 自动将创建者添加为帮派成员
@@ -112,7 +126,7 @@ func (h *Handler) GetGuildInfo(ctx context.Context, req *pb.GetGuildInfoRequest)
 	if guild == nil {
 		return nil, fmt.Errorf("guild not found")
 	}
-	pbGuild := guildToPb(guild)
+	pbGuild := h.guildToPb(guild)
 	return &pb.GetGuildInfoResponse{
 		Guild: pbGuild,
 	}, nil
@@ -183,11 +197,11 @@ func (h *Handler) ApplyToGuild(ctx context.Context, req *pb.ApplyToGuildRequest)
 
 func (h *Handler) InviteToGuild(ctx context.Context, req *pb.InviteToGuildRequest) (*pb.InviteToGuildResponse, error) {
 	// 检查邀请者是否有权限邀请
-	member, err := h.dbClient.GetGuildMember(ctx, req.GuildId, req.InviterId)
+	_, allowed, err := h.checkGuildPermission(ctx, req.GuildId, req.InviterId, GuildPermInvite)
 	if err != nil {
 		return nil, err
 	}
-	if member == nil || (member.Role != models.GuildRoleMaster && member.Role != models.GuildRoleViceMaster && member.Role != models.GuildRoleElder) {
+	if !allowed {
 		return nil, errors.New("no permission to invite")
 	}
 
@@ -209,6 +223,17 @@ func (h *Handler) InviteToGuild(ctx context.Context, req *pb.InviteToGuildReques
 		return nil, errors.New("invitee already in another guild")
 	}
 
+	// 检查是否已有该帮派未过期的邀请
+	pending, err := h.dbClient.GetUserPendingInvitations(ctx, req.InviteeId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check invitee invitations: %v", err)
+	}
+	for _, inv := range pending {
+		if inv.GuildID == req.GuildId {
+			return nil, errors.New("invitation already exists")
+		}
+	}
+
 	id, err := h.sf.NextID()
 	if err != nil {
 		return nil, err
@@ -238,9 +263,13 @@ func (h *Handler) GetGuildApplications(ctx context.Context, req *pb.GetGuildAppl
 		return nil, err
 	}
 
-	pbApps := make([]*pb.GuildApplication, len(applications))
-	for i, a := range applications {
-		pbApps[i] = guildApplicationToPb(a)
+	// 缓存中可能包含缓存后才过期的申请，返回前过滤掉
+	now := time.Now()
+	pbApps := make([]*pb.GuildApplication, 0, len(applications))
+	for _, a := range applications {
+		if a.ExpireTime.After(now) {
+			pbApps = append(pbApps, guildApplicationToPb(a))
+		}
 	}
 
 	return &pb.GetGuildApplicationsResponse{
@@ -249,7 +278,7 @@ func (h *Handler) GetGuildApplications(ctx context.Context, req *pb.GetGuildAppl
 }
 
 func (h *Handler) HandleGuildApplication(ctx context.Context, req *pb.HandleGuildApplicationRequest) (*pb.HandleGuildApplicationResponse, error) {
-	// 获取分布式锁
+	// 获取分布式锁 - 锁定申请，避免同一申请被重复处理
 	lockKey := fmt.Sprintf("guild:app:%d:lock", req.ApplicationId)
 	if err := h.cacheClient.Lock(ctx, lockKey, 10*time.Second, 5*time.Second); err != nil {
 		return nil, fmt.Errorf("failed to acquire lock: %v", err)
@@ -264,66 +293,88 @@ func (h *Handler) HandleGuildApplication(ctx context.Context, req *pb.HandleGuil
 	if app == nil {
 		return nil, errors.New("application not found")
 	}
+	if !app.ExpireTime.After(time.Now()) {
+		return nil, errors.New("application expired")
+	}
+	if req.Action != pb.HandleGuildApplicationRequest_ACCEPT {
+		return nil, errors.New("invalid action")
+	}
 
-	// 检查申请人是否已在帮派中
-	inGuild, err := h.isUserInGuild(ctx, app.GuildID, app.UserID)
+	// 2. 检查操作者是否有权限处理申请
+	_, allowed, err := h.checkGuildPermission(ctx, app.GuildID, req.OperatorId, GuildPermApprove)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check applicant membership: %v", err)
+		return nil, err
 	}
-	if inGuild {
-		return nil, errors.New("applicant already in guild")
+	if !allowed {
+		return nil, errors.New("no permission to handle application")
 	}
 
-	// 检查帮派成员数是否已达上限
-	count, err := h.dbClient.GetGuildMemberCount(ctx, app.GuildID)
+	// 获取分布式锁 - 与接受邀请共用申请人的入帮锁，避免同时加入多个帮派
+	userLockKey := fmt.Sprintf("guild:user:%d:join:lock", app.UserID)
+	if err := h.cacheClient.Lock(ctx, userLockKey, 10*time.Second, 5*time.Second); err != nil {
+		return nil, fmt.Errorf("failed to acquire lock: %v", err)
+	}
+	defer h.cacheClient.Unlock(ctx, userLockKey)
+
+	// 获取分布式锁 - 与接受邀请共用帮派的入帮锁，保证成员上限不会被并发入帮绕过
+	guildLockKey := fmt.Sprintf("guild:%d:join:lock", app.GuildID)
+	if err := h.cacheClient.Lock(ctx, guildLockKey, 10*time.Second, 5*time.Second); err != nil {
+		return nil, fmt.Errorf("failed to acquire lock: %v", err)
+	}
+	defer h.cacheClient.Unlock(ctx, guildLockKey)
+
+	// 3. 检查申请人是否已在帮派中
+	inOtherGuild, err := h.isUserInOtherGuild(ctx, app.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get guild member count: %v", err)
+		return nil, fmt.Errorf("failed to check applicant membership: %v", err)
+	}
+	if inOtherGuild {
+		return nil, errors.New("applicant already in a guild")
 	}
+
+	// 4. 检查帮派是否存在以及成员数是否已达上限
 	guild, err := h.dbClient.GetGuild(ctx, app.GuildID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get guild info: %v", err)
 	}
+	if guild == nil {
+		return nil, errors.New("guild not found")
+	}
+	count, err := h.dbClient.GetGuildMemberCount(ctx, app.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild member count: %v", err)
+	}
 	if count >= guild.MaxMembers {
 		return nil, errors.New("guild is full")
 	}
 
-	// 2. 检查操作者是否有权限处理申请
-	member, err := h.dbClient.GetGuildMember(ctx, app.GuildID, req.OperatorId)
+	// 5. 添加申请人为帮派成员
+	id, err := h.sf.NextID()
 	if err != nil {
 		return nil, err
 	}
-	if member == nil || (member.Role != models.GuildRoleMaster && member.Role != models.GuildRoleViceMaster && member.Role != models.GuildRoleElder) {
-		return nil, errors.New("no permission to handle application")
+	newMember := &models.GuildMember{
+		ID:        id,
+		GuildID:   app.GuildID,
+		UserID:    app.UserID,
+		Role:      models.GuildRoleMember,
+		JoinTime:  time.Now(),
+		LastLogin: time.Now(),
 	}
-
-	// 3. 更新申请状态
-	switch req.Action {
-	case pb.HandleGuildApplicationRequest_ACCEPT:
-		id, err := h.sf.NextID()
-		if err != nil {
-			return nil, err
-		}
-		// 如果接受申请，添加用户为帮派成员
-		newMember := &models.GuildMember{
-			ID:        id,
-			GuildID:   app.GuildID,
-			UserID:    app.UserID,
-			Role:      models.GuildRoleMember,
-			JoinTime:  time.Now(),
-			LastLogin: time.Now(),
-		}
-		if err := h.dbClient.AddGuildMember(ctx, newMember); err != nil {
-			return nil, err
-		}
-	default:
-		return nil, errors.New("invalid action")
+	if err := h.dbClient.AddGuildMember(ctx, newMember); err != nil {
+		return nil, err
 	}
 
-	// 4. 更新申请状态
+	// 6. 删除已处理的申请
 	if err := h.dbClient.DeleteGuildApplication(ctx, req.ApplicationId); err != nil {
 		return nil, err
 	}
 
+	// 7. 已加入帮派，其他帮派的邀请一并删除
+	if err := h.dbClient.DeleteUserGuildInvitations(ctx, app.UserID); err != nil {
+		utils.Error("Failed to delete user guild invitations", zap.Int64("userId", app.UserID), zap.Error(err))
+	}
+
 	// 失效相关缓存
 	_ = h.cacheService.InvalidateGuildApplicationsCache(ctx, app.GuildID)
 	_ = h.cacheService.InvalidateGuildMembersCache(ctx, app.GuildID)
@@ -342,11 +393,11 @@ func (h *Handler) KickGuildMember(ctx context.Context, req *pb.KickGuildMemberRe
 	defer h.cacheClient.Unlock(ctx, lockKey)
 
 	// 检查操作者是否有权限踢人
-	operator, err := h.dbClient.GetGuildMember(ctx, req.GuildId, req.OperatorId)
+	operator, allowed, err := h.checkGuildPermission(ctx, req.GuildId, req.OperatorId, GuildPermKick)
 	if err != nil {
 		return nil, err
 	}
-	if operator == nil || (operator.Role != models.GuildRoleMaster && operator.Role != models.GuildRoleViceMaster && operator.Role != models.GuildRoleElder) {
+	if !allowed {
 		return nil, errors.New("no permission to kick member")
 	}
 
@@ -359,7 +410,7 @@ func (h *Handler) KickGuildMember(ctx context.Context, req *pb.KickGuildMemberRe
 		return nil, errors.New("member not in guild")
 	}
 
-	// 检查被踢成员是否是帮主(帮主不能被踢)
+	// 检查被踢成员是否是帮主(帮主不能被踢)，且只能踢出职位比自己低的成员
 	member, err := h.dbClient.GetGuildMember(ctx, req.GuildId, req.MemberId)
 	if err != nil {
 		return nil, err
@@ -367,6 +418,9 @@ func (h *Handler) KickGuildMember(ctx context.Context, req *pb.KickGuildMemberRe
 	if member != nil && member.Role == models.GuildRoleMaster {
 		return nil, errors.New("cannot kick guild master")
 	}
+	if member != nil && !canManageGuildMember(operator.Role, member.Role) {
+		return nil, errors.New("no permission to kick member")
+	}
 
 	err = h.dbClient.RemoveGuildMember(ctx, req.GuildId, req.MemberId)
 	if err != nil {
@@ -382,38 +436,52 @@ func (h *Handler) KickGuildMember(ctx context.Context, req *pb.KickGuildMemberRe
 }
 
 func (h *Handler) ChangeMemberRole(ctx context.Context, req *pb.ChangeMemberRoleRequest) (*pb.ChangeMemberRoleResponse, error) {
-	// 获取分布式锁
-	lockKey := fmt.Sprintf("guild:%d:role:%d:lock", req.GuildId, req.MemberId)
+	// 获取分布式锁 - 锁定整个帮派的职位变动，保证职位人数上限不会被并发任命绕过
+	lockKey := fmt.Sprintf("guild:%d:role:lock", req.GuildId)
 	if err := h.cacheClient.Lock(ctx, lockKey, 10*time.Second, 5*time.Second); err != nil {
 		return nil, fmt.Errorf("failed to acquire lock: %v", err)
 	}
 	defer h.cacheClient.Unlock(ctx, lockKey)
 
-	// 检查操作者是否有权限修改成员职位
+	// 检查操作者是否在帮派中
 	operator, err := h.dbClient.GetGuildMember(ctx, req.GuildId, req.OperatorId)
 	if err != nil {
 		return nil, err
 	}
-	if operator == nil || (operator.Role != models.GuildRoleMaster && operator.Role != models.GuildRoleViceMaster) {
+	if operator == nil {
 		return nil, errors.New("no permission to change member role")
 	}
 
 	// 检查成员是否在帮派中
-	inGuild, err := h.isUserInGuild(ctx, req.GuildId, req.MemberId)
+	member, err := h.dbClient.GetGuildMember(ctx, req.GuildId, req.MemberId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check member status: %v", err)
 	}
-	if !inGuild {
+	if member == nil {
 		return nil, errors.New("member not in guild")
 	}
 
-	// 检查成员角色是否有效
-	if req.NewRole < pb.GuildRole_MEMBER || req.NewRole > pb.GuildRole_VICE_MASTER {
-		return nil, errors.New("invalid role")
+	// 只能调整职位比自己低的成员，且新职位在操作者可任命的范围内
+	newRole := guildRoleFromPb(req.NewRole)
+	if !canManageGuildMember(operator.Role, member.Role) || !h.canAppointGuildRole(operator.Role, newRole) {
+		return nil, errors.New("no permission to change member role")
+	}
+	if member.Role == newRole {
+		return &pb.ChangeMemberRoleResponse{
+			Success: true,
+		}, nil
+	}
+
+	// 检查新职位人数是否已达上限
+	full, err := h.isGuildRoleFull(ctx, req.GuildId, newRole)
+	if err != nil {
+		return nil, err
+	}
+	if full {
+		return nil, errors.New("role member limit reached")
 	}
 
-	// 直接使用pb.GuildRole值作为int
-	err = h.dbClient.UpdateGuildMemberRole(ctx, req.GuildId, req.MemberId, int(req.NewRole))
+	err = h.dbClient.UpdateGuildMemberRole(ctx, req.GuildId, req.MemberId, int(newRole))
 	if err != nil {
 		return nil, err
 	}
@@ -426,6 +494,41 @@ func (h *Handler) ChangeMemberRole(ctx context.Context, req *pb.ChangeMemberRole
 	}, nil
 }
 
+func (h *Handler) UpdateGuildAnnouncement(ctx context.Context, req *pb.UpdateGuildAnnouncementRequest) (*pb.UpdateGuildAnnouncementResponse, error) {
+	if utf8.RuneCountInString(req.Announcement) > maxGuildAnnouncementLength {
+		return nil, errors.New("announcement too long")
+	}
+
+	// 检查操作者是否有权限修改公告
+	_, allowed, err := h.checkGuildPermission(ctx, req.GuildId, req.OperatorId, GuildPermEditAnnouncement)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.New("no permission to edit announcement")
+	}
+
+	guild, err := h.dbClient.GetGuild(ctx, req.GuildId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild: %v", err)
+	}
+	if guild == nil {
+		return nil, errors.New("guild not found")
+	}
+
+	guild.Announcement = req.Announcement
+	if err := h.dbClient.UpdateGuild(ctx, guild); err != nil {
+		return nil, err
+	}
+
+	// 失效相关缓存
+	_ = h.cacheService.InvalidateGuildCache(ctx, guild.ID)
+
+	return &pb.UpdateGuildAnnouncementResponse{
+		Success: true,
+	}, nil
+}
+
 func (h *Handler) TransferGuildMaster(ctx context.Context, req *pb.TransferGuildMasterRequest) (*pb.TransferGuildMasterResponse, error) {
 	// 获取当前用户帮派信息
 	guild, err := h.dbClient.GetGuild(ctx, req.RoleId)
@@ -445,8 +548,8 @@ func (h *Handler) TransferGuildMaster(ctx context.Context, req *pb.TransferGuild
 		return nil, errors.New("only guild master can transfer master")
 	}
 
-	// 获取分布式锁 - 锁定整个帮派和两个用户
-	lockKey := fmt.Sprintf("guild:%d:transfer:%d:%d:lock", guild.ID, guild.MasterID, req.NewMasterId)
+	// 获取分布式锁 - 与修改成员职位共用帮派职位锁，保证职位人数上限
+	lockKey := fmt.Sprintf("guild:%d:role:lock", guild.ID)
 	if err := h.cacheClient.Lock(ctx, lockKey, 15*time.Second, 5*time.Second); err != nil {
 		return nil, fmt.Errorf("failed to acquire lock: %v", err)
 	}
@@ -470,8 +573,20 @@ func (h *Handler) TransferGuildMaster(ctx context.Context, req *pb.TransferGuild
 		return nil, errors.New("new master is already the guild master")
 	}
 
+	// 原帮主改任副帮主，副帮主人数已满时改任新帮主原来的职位
+	oldMasterRole := int32(models.GuildRoleViceMaster)
+	if newMaster != nil && newMaster.Role != models.GuildRoleViceMaster {
+		full, err := h.isGuildRoleFull(ctx, guild.ID, models.GuildRoleViceMaster)
+		if err != nil {
+			return nil, err
+		}
+		if full {
+			oldMasterRole = newMaster.Role
+		}
+	}
+
 	// 更新原帮主角色
-	err = h.dbClient.UpdateGuildMemberRole(ctx, guild.ID, guild.MasterID, models.GuildRoleViceMaster)
+	err = h.dbClient.UpdateGuildMemberRole(ctx, guild.ID, guild.MasterID, int(oldMasterRole))
 	if err != nil {
 		return nil, err
 	}
@@ -603,7 +718,7 @@ func (h *Handler) GetGuildList(ctx context.Context, req *pb.GetGuildListRequest)
 	// 转换为protobuf格式
 	pbGuilds := make([]*pb.GuildInfo, len(guilds))
 	for i, g := range guilds {
-		pbGuilds[i] = guildToPb(g)
+		pbGuilds[i] = h.guildToPb(g)
 	}
 
 	return &pb.GetGuildListResponse{
@@ -615,10 +730,14 @@ func (h *Handler) GetGuildList(ctx context.Context, req *pb.GetGuildListRequest)
 }
 
 // 类型转换函数
-func guildToPb(g *models.Guild) *pb.GuildInfo {
+func (h *Handler) guildToPb(g *models.Guild) *pb.GuildInfo {
 	if g == nil {
 		return nil
 	}
+	var nextLevelExp int64
+	if next := h.getGuildLevelConfig(g.Level + 1); next != nil {
+		nextLevelExp = int64(next.Exp)
+	}
 	return &pb.GuildInfo{
 		Id:           g.ID,
 		Name:         g.Name,
@@ -627,6 +746,10 @@ func guildToPb(g *models.Guild) *pb.GuildInfo {
 		CreatedAt:    g.CreatedAt.Unix(),
 		MasterId:     g.MasterID,
 		MaxMembers:   g.MaxMembers,
+		Level:        g.Level,
+		Exp:          g.Exp,
+		NextLevelExp: nextLevelExp,
+		PerkIds:      h.getGuildPerkIDs(g.Level),
 	}
 }
 
@@ -637,9 +760,10 @@ func guildMemberToPb(m *models.GuildMember) *pb.GuildMember {
 	return &pb.GuildMember{
 		UserId:         m.UserID,
 		Username:       "", // 需要从用户服务获取
-		Role:           pb.GuildRole(m.Role),
+		Role:           guildRoleToPb(m.Role),
 		JoinTime:       m.JoinTime.Unix(),
 		LastActiveTime: m.LastLogin.Unix(),
+		Contribution:   m.Contribution,
 	}
 }
 
@@ -0,0 +1,218 @@
+package social
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// CurrencyReasonGuildDonate 帮派捐献的货币变动原因
+const CurrencyReasonGuildDonate = "guild_donate"
+
+const (
+	defaultGuildDonationLimit = 50
+	maxGuildDonationLimit     = 100
+)
+
+var errGuildDonateLimitReached = errors.New("guild donate limit reached")
+
+// guildDonateMessage 将捐献失败的业务错误转换为提示消息
+func guildDonateMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, interfaces.ErrGuildMemberNotFound):
+		return "不是该帮派成员", true
+	case errors.Is(err, errGuildDonateLimitReached):
+		return "今日捐献次数已用完", true
+	case errors.Is(err, interfaces.ErrInsufficientCurrency):
+		return "货币不足", true
+	case errors.Is(err, interfaces.ErrInsufficientItems):
+		return "物品不足", true
+	}
+	return "", false
+}
+
+// getGuildDonateConfig 获取捐献项配置
+func (h *Handler) getGuildDonateConfig(donateID int64) *designconfig.GuildDonateData {
+	configs, _ := h.configManager.GetConfig("guild_donate").([]designconfig.GuildDonateData)
+	for i := range configs {
+		if int64(configs[i].ID) == donateID {
+			return &configs[i]
+		}
+	}
+	return nil
+}
+
+// getGuildLevelConfig 获取指定帮派等级的配置
+func (h *Handler) getGuildLevelConfig(level int32) *designconfig.GuildLevelData {
+	configs, _ := h.configManager.GetConfig("guild_level").([]designconfig.GuildLevelData)
+	for i := range configs {
+		if int32(configs[i].Level) == level {
+			return &configs[i]
+		}
+	}
+	return nil
+}
+
+// getGuildLevelByExp 获取累计经验可以达到的最高等级配置
+func (h *Handler) getGuildLevelByExp(exp int64) *designconfig.GuildLevelData {
+	configs, _ := h.configManager.GetConfig("guild_level").([]designconfig.GuildLevelData)
+	var best *designconfig.GuildLevelData
+	for i := range configs {
+		if int64(configs[i].Exp) <= exp && (best == nil || configs[i].Level > best.Level) {
+			best = &configs[i]
+		}
+	}
+	return best
+}
+
+// getGuildPerkIDs 获取帮派等级已解锁的福利ID
+func (h *Handler) getGuildPerkIDs(level int32) []int64 {
+	configs, _ := h.configManager.GetConfig("guild_perk").([]designconfig.GuildPerkData)
+	var perkIDs []int64
+	for _, perk := range configs {
+		if int32(perk.UnlockLevel) <= level {
+			perkIDs = append(perkIDs, int64(perk.ID))
+		}
+	}
+	return perkIDs
+}
+
+// DonateToGuild 帮派捐献
+// 扣除消耗、累加成员贡献和帮派经验、帮派升级以及记录捐献在同一事务中完成
+func (h *Handler) DonateToGuild(ctx context.Context, req *pb.DonateToGuildRequest) (*pb.DonateToGuildResponse, error) {
+	if req.UserId == 0 || req.GuildId == 0 {
+		return nil, errors.New("invalid request")
+	}
+
+	donate := h.getGuildDonateConfig(req.DonateId)
+	if donate == nil {
+		return &pb.DonateToGuildResponse{Success: false, Message: "捐献项不存在"}, nil
+	}
+
+	orderID, err := h.sf.NextID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := h.clock.Now()
+	dayStart := h.clock.DayStart(now)
+
+	var count int32
+	var guildExp int64
+	var guildLevel int32
+	err = h.dbClient.Transaction(ctx, func(ctx context.Context, tx interfaces.Tx) error {
+		guilds := tx.Guilds()
+
+		// 先累加贡献锁定成员，再统计今日次数，同一成员的并发捐献不会超出上限
+		if err := guilds.AddGuildMemberContribution(ctx, req.GuildId, req.UserId, int64(donate.Contribution)); err != nil {
+			return err
+		}
+		var err error
+		count, err = guilds.CountUserGuildDonations(ctx, req.UserId, int64(donate.ID), dayStart)
+		if err != nil {
+			return err
+		}
+		if donate.DailyLimit > 0 && count >= int32(donate.DailyLimit) {
+			return errGuildDonateLimitReached
+		}
+
+		err = h.costs.Consume(ctx, tx, req.UserId, donate.Cost, CurrencyReasonGuildDonate,
+			fmt.Sprintf("guild_donate:%d", donate.ID), "guild_donate:"+strconv.FormatInt(orderID, 10))
+		if err != nil {
+			return err
+		}
+
+		guildExp, err = guilds.AddGuildExp(ctx, req.GuildId, int64(donate.Exp))
+		if err != nil {
+			return err
+		}
+		if level := h.getGuildLevelByExp(guildExp); level != nil {
+			guildLevel = int32(level.Level)
+			if err := guilds.UpgradeGuildLevel(ctx, req.GuildId, guildLevel, int32(level.MaxMembers)); err != nil {
+				return err
+			}
+		}
+
+		return guilds.CreateGuildDonation(ctx, &models.GuildDonation{
+			GuildID:      req.GuildId,
+			UserID:       req.UserId,
+			DonateID:     int64(donate.ID),
+			Exp:          int64(donate.Exp),
+			Contribution: int64(donate.Contribution),
+			Time:         now,
+		})
+	})
+	if err != nil {
+		if msg, ok := guildDonateMessage(err); ok {
+			return &pb.DonateToGuildResponse{Success: false, Message: "捐献失败: " + msg}, nil
+		}
+		utils.Error("DonateToGuild error", zap.Int64("userId", req.UserId), zap.Int64("guildId", req.GuildId), zap.Error(err))
+		return nil, fmt.Errorf("failed to donate to guild")
+	}
+
+	// 失效相关缓存
+	_ = h.cacheService.InvalidateGuildCache(ctx, req.GuildId)
+	_ = h.cacheService.InvalidateGuildMembersCache(ctx, req.GuildId)
+	_ = h.cacheService.InvalidateInventoryCache(ctx, req.UserId)
+
+	remaining := int32(-1)
+	if donate.DailyLimit > 0 {
+		remaining = int32(donate.DailyLimit) - count - 1
+	}
+
+	return &pb.DonateToGuildResponse{
+		Success:        true,
+		Message:        "捐献成功",
+		GuildLevel:     guildLevel,
+		GuildExp:       guildExp,
+		RemainingTimes: remaining,
+	}, nil
+}
+
+// GetGuildDonations 获取帮派最近的捐献记录
+func (h *Handler) GetGuildDonations(ctx context.Context, req *pb.GetGuildDonationsRequest) (*pb.GetGuildDonationsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultGuildDonationLimit
+	}
+	if limit > maxGuildDonationLimit {
+		limit = maxGuildDonationLimit
+	}
+
+	donations, err := h.dbClient.GetGuildDonations(ctx, req.GuildId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	pbDonations := make([]*pb.GuildDonation, len(donations))
+	for i, d := range donations {
+		pbDonations[i] = guildDonationToPb(d)
+	}
+
+	return &pb.GetGuildDonationsResponse{
+		Donations: pbDonations,
+	}, nil
+}
+
+func guildDonationToPb(d *models.GuildDonation) *pb.GuildDonation {
+	if d == nil {
+		return nil
+	}
+	return &pb.GuildDonation{
+		Id:           d.ID,
+		UserId:       d.UserID,
+		DonateId:     d.DonateID,
+		Exp:          d.Exp,
+		Contribution: d.Contribution,
+		Time:         d.Time.Unix(),
+	}
+}
@@ -0,0 +1,151 @@
+package social
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// GetUserGuildInvitations 获取用户收到的未过期邀请
+func (h *Handler) GetUserGuildInvitations(ctx context.Context, req *pb.GetUserGuildInvitationsRequest) (*pb.GetUserGuildInvitationsResponse, error) {
+	invitations, err := h.dbClient.GetUserPendingInvitations(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	pbInvitations := make([]*pb.GuildInvitation, 0, len(invitations))
+	for _, inv := range invitations {
+		guild, err := h.cacheService.GetGuildWithCache(ctx, inv.GuildID, func() (*models.Guild, error) {
+			return h.dbClient.GetGuild(ctx, inv.GuildID)
+		})
+		if err != nil {
+			return nil, err
+		}
+		// 帮派已解散的邀请不再展示
+		if guild == nil {
+			continue
+		}
+		pbInvitations = append(pbInvitations, guildInvitationToPb(inv, guild))
+	}
+
+	return &pb.GetUserGuildInvitationsResponse{
+		Invitations: pbInvitations,
+	}, nil
+}
+
+func (h *Handler) HandleGuildInvitation(ctx context.Context, req *pb.HandleGuildInvitationRequest) (*pb.HandleGuildInvitationResponse, error) {
+	// 获取分布式锁 - 锁定被邀请者的入帮锁（与审批申请共用），避免同时加入多个帮派
+	lockKey := fmt.Sprintf("guild:user:%d:join:lock", req.UserId)
+	if err := h.cacheClient.Lock(ctx, lockKey, 10*time.Second, 5*time.Second); err != nil {
+		return nil, fmt.Errorf("failed to acquire lock: %v", err)
+	}
+	defer h.cacheClient.Unlock(ctx, lockKey)
+
+	// 1. 获取邀请信息，只能处理发给自己的邀请
+	inv, err := h.dbClient.GetGuildInvitation(ctx, req.InvitationId)
+	if err != nil {
+		return nil, err
+	}
+	if inv == nil || inv.UserID != req.UserId {
+		return nil, errors.New("invitation not found")
+	}
+	if !inv.ExpireTime.After(time.Now()) {
+		return nil, errors.New("invitation expired")
+	}
+
+	switch req.Action {
+	case pb.HandleGuildInvitationRequest_REJECT:
+		if err := h.dbClient.DeleteGuildInvitation(ctx, inv.ID); err != nil {
+			return nil, err
+		}
+		return &pb.HandleGuildInvitationResponse{
+			Success: true,
+		}, nil
+	case pb.HandleGuildInvitationRequest_ACCEPT:
+	default:
+		return nil, errors.New("invalid action")
+	}
+
+	// 获取分布式锁 - 锁定帮派的入帮锁（与审批申请共用），保证成员上限不会被并发入帮绕过
+	guildLockKey := fmt.Sprintf("guild:%d:join:lock", inv.GuildID)
+	if err := h.cacheClient.Lock(ctx, guildLockKey, 10*time.Second, 5*time.Second); err != nil {
+		return nil, fmt.Errorf("failed to acquire lock: %v", err)
+	}
+	defer h.cacheClient.Unlock(ctx, guildLockKey)
+
+	// 2. 检查用户是否已在帮派中
+	inOtherGuild, err := h.isUserInOtherGuild(ctx, inv.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if inOtherGuild {
+		return nil, errors.New("user already in another guild")
+	}
+
+	// 3. 检查帮派是否存在以及成员数是否已达上限
+	guild, err := h.dbClient.GetGuild(ctx, inv.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild info: %v", err)
+	}
+	if guild == nil {
+		return nil, errors.New("guild not found")
+	}
+	count, err := h.dbClient.GetGuildMemberCount(ctx, inv.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild member count: %v", err)
+	}
+	if count >= guild.MaxMembers {
+		return nil, errors.New("guild is full")
+	}
+
+	// 4. 加入帮派
+	id, err := h.sf.NextID()
+	if err != nil {
+		return nil, err
+	}
+	newMember := &models.GuildMember{
+		ID:        id,
+		GuildID:   inv.GuildID,
+		UserID:    inv.UserID,
+		Role:      models.GuildRoleMember,
+		JoinTime:  time.Now(),
+		LastLogin: time.Now(),
+	}
+	if err := h.dbClient.AddGuildMember(ctx, newMember); err != nil {
+		return nil, err
+	}
+
+	// 5. 已加入帮派，其他帮派的邀请一并删除
+	if err := h.dbClient.DeleteUserGuildInvitations(ctx, inv.UserID); err != nil {
+		utils.Error("Failed to delete user guild invitations", zap.Int64("userId", inv.UserID), zap.Error(err))
+	}
+
+	// 失效相关缓存
+	_ = h.cacheService.InvalidateGuildMembersCache(ctx, inv.GuildID)
+
+	return &pb.HandleGuildInvitationResponse{
+		Success: true,
+		GuildId: inv.GuildID,
+	}, nil
+}
+
+func guildInvitationToPb(inv *models.GuildInvitation, guild *models.Guild) *pb.GuildInvitation {
+	if inv == nil {
+		return nil
+	}
+	return &pb.GuildInvitation{
+		Id:         inv.ID,
+		GuildId:    inv.GuildID,
+		GuildName:  guild.Name,
+		InviterId:  inv.InviterID,
+		InviteTime: inv.Time.Unix(),
+		ExpireTime: inv.ExpireTime.Unix(),
+	}
+}
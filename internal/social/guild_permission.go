@@ -0,0 +1,102 @@
+package social
+
+import (
+	"context"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/pb"
+)
+
+// 帮派操作权限，与 guild_role 配置表的 permissions 一致
+const (
+	GuildPermInvite           = "invite"
+	GuildPermApprove          = "approve"
+	GuildPermKick             = "kick"
+	GuildPermEditAnnouncement = "edit_announcement"
+)
+
+// getGuildRoleConfig 获取职位的权限配置
+func (h *Handler) getGuildRoleConfig(role int32) *designconfig.GuildRoleData {
+	configs, _ := h.configManager.GetConfig("guild_role").([]designconfig.GuildRoleData)
+	for i := range configs {
+		if int32(configs[i].Role) == role {
+			return &configs[i]
+		}
+	}
+	return nil
+}
+
+// hasGuildPermission 检查职位是否拥有指定权限，职位没有配置时视为没有任何权限
+func (h *Handler) hasGuildPermission(role int32, permission string) bool {
+	roleConfig := h.getGuildRoleConfig(role)
+	if roleConfig == nil {
+		return false
+	}
+	for _, p := range roleConfig.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// checkGuildPermission 检查用户在帮派中的职位是否拥有指定权限，同时返回该用户的成员信息
+func (h *Handler) checkGuildPermission(ctx context.Context, guildID, userID int64, permission string) (*models.GuildMember, bool, error) {
+	member, err := h.dbClient.GetGuildMember(ctx, guildID, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	if member == nil {
+		return nil, false, nil
+	}
+	return member, h.hasGuildPermission(member.Role, permission), nil
+}
+
+// canManageGuildMember 操作者职位必须高于目标成员，才能对其踢出或调整职位
+func canManageGuildMember(operatorRole, targetRole int32) bool {
+	return operatorRole < targetRole
+}
+
+// canAppointGuildRole 检查职位能否任命成员为 newRole
+// 帮主只能通过转让产生，任命的职位不能高于配置的 promote_up_to，也不能与操作者同级或更高
+func (h *Handler) canAppointGuildRole(operatorRole, newRole int32) bool {
+	if newRole <= models.GuildRoleMaster || newRole > models.GuildRoleApprentice {
+		return false
+	}
+	roleConfig := h.getGuildRoleConfig(operatorRole)
+	if roleConfig == nil || roleConfig.PromoteUpTo <= 0 {
+		return false
+	}
+	return newRole >= int32(roleConfig.PromoteUpTo) && canManageGuildMember(operatorRole, newRole)
+}
+
+// isGuildRoleFull 检查帮派中指定职位的人数是否已达配置上限
+func (h *Handler) isGuildRoleFull(ctx context.Context, guildID int64, role int32) (bool, error) {
+	roleConfig := h.getGuildRoleConfig(role)
+	if roleConfig == nil || roleConfig.MaxCount <= 0 {
+		return false, nil
+	}
+
+	members, err := h.dbClient.GetGuildMembers(ctx, guildID)
+	if err != nil {
+		return false, err
+	}
+	var count int
+	for _, m := range members {
+		if m.Role == role {
+			count++
+		}
+	}
+	return count >= roleConfig.MaxCount, nil
+}
+
+// guildRoleToPb 转换帮派职位，pb.GuildRole 从0开始，models 中的职位从1开始
+func guildRoleToPb(role int32) pb.GuildRole {
+	return pb.GuildRole(role - 1)
+}
+
+// guildRoleFromPb 将 pb.GuildRole 转换为 models 中的职位
+func guildRoleFromPb(role pb.GuildRole) int32 {
+	return int32(role) + 1
+}
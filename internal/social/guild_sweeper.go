@@ -0,0 +1,65 @@
+package social
+
+import (
+	"context"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// guildSweepInterval 清理过期帮派申请和邀请的间隔
+	guildSweepInterval = 10 * time.Minute
+)
+
+// GuildSweeper 定期清理过期的帮派申请和邀请
+// 多个实例同时清理时删除可以重复执行，不需要加锁
+type GuildSweeper struct {
+	dbClient db.Database
+}
+
+// NewGuildSweeper 创建帮派过期数据清理任务
+func NewGuildSweeper(dbClient db.Database) *GuildSweeper {
+	return &GuildSweeper{
+		dbClient: dbClient,
+	}
+}
+
+// Start 启动后台任务，启动时先清理一次
+func (s *GuildSweeper) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(guildSweepInterval)
+		defer ticker.Stop()
+
+		s.sweep(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.sweep(ctx)
+			}
+		}
+	}()
+}
+
+func (s *GuildSweeper) sweep(ctx context.Context) {
+	now := time.Now()
+
+	applications, err := s.dbClient.DeleteExpiredGuildApplications(ctx, now)
+	if err != nil {
+		utils.Error("Failed to delete expired guild applications", zap.Error(err))
+	} else if applications > 0 {
+		utils.Info("Deleted expired guild applications", zap.Int64("count", applications))
+	}
+
+	invitations, err := s.dbClient.DeleteExpiredGuildInvitations(ctx, now)
+	if err != nil {
+		utils.Error("Failed to delete expired guild invitations", zap.Error(err))
+	} else if invitations > 0 {
+		utils.Info("Deleted expired guild invitations", zap.Int64("count", invitations))
+	}
+}
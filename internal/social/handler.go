@@ -1,11 +1,18 @@
 package social
 
 import (
+	"fmt"
+
 	"github.xubinbest.com/go-game-server/internal/cache"
 	"github.xubinbest.com/go-game-server/internal/common"
 	"github.xubinbest.com/go-game-server/internal/config"
 	"github.xubinbest.com/go-game-server/internal/db"
 	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/gameclock"
+	"github.xubinbest.com/go-game-server/internal/gameevent"
+	"github.xubinbest.com/go-game-server/internal/itemcost"
+	"github.xubinbest.com/go-game-server/internal/mq"
+	"github.xubinbest.com/go-game-server/internal/push"
 	"github.xubinbest.com/go-game-server/internal/snowflake"
 )
 
@@ -17,6 +24,10 @@ type Handler struct {
 	cfg           *config.Config
 	sf            *snowflake.Snowflake
 	configManager *designconfig.DesignConfigManager
+	costs         *itemcost.Costs
+	pusher        *push.Publisher
+	events        *gameevent.Emitter
+	clock         *gameclock.GameClock // 游戏时钟，帮派捐献等每日重置的玩法使用
 }
 
 func NewHandler(dbClient db.Database, cacheClient cache.Cache, cacheManager *cache.CacheManager, sf *snowflake.Snowflake, cfg *config.Config, configManager *designconfig.DesignConfigManager) (*Handler, error) {
@@ -24,6 +35,11 @@ func NewHandler(dbClient db.Database, cacheClient cache.Cache, cacheManager *cac
 		return nil, err
 	}
 
+	clock, err := gameclock.New(cfg.GameClock)
+	if err != nil {
+		return nil, fmt.Errorf("invalid game clock config: %w", err)
+	}
+
 	cacheService := NewCacheService(cacheManager)
 
 	return &Handler{
@@ -34,5 +50,9 @@ func NewHandler(dbClient db.Database, cacheClient cache.Cache, cacheManager *cac
 		cfg:           cfg,
 		sf:            sf,
 		configManager: configManager,
+		costs:         itemcost.New(configManager),
+		pusher:        push.NewPublisher(cacheClient, "social"),
+		events:        gameevent.NewEmitter(mq.NewKafkaFactory(&cfg.KafkaConfigs), sf),
+		clock:         clock,
 	}, nil
 }
@@ -22,13 +22,18 @@ type SocialHandler interface {
 	GetGuildMembers(ctx context.Context, req *pb.GetGuildMembersRequest) (*pb.GetGuildMembersResponse, error)
 	ApplyToGuild(ctx context.Context, req *pb.ApplyToGuildRequest) (*pb.ApplyToGuildResponse, error)
 	InviteToGuild(ctx context.Context, req *pb.InviteToGuildRequest) (*pb.InviteToGuildResponse, error)
+	GetUserGuildInvitations(ctx context.Context, req *pb.GetUserGuildInvitationsRequest) (*pb.GetUserGuildInvitationsResponse, error)
+	HandleGuildInvitation(ctx context.Context, req *pb.HandleGuildInvitationRequest) (*pb.HandleGuildInvitationResponse, error)
 	GetGuildApplications(ctx context.Context, req *pb.GetGuildApplicationsRequest) (*pb.GetGuildApplicationsResponse, error)
 	HandleGuildApplication(ctx context.Context, req *pb.HandleGuildApplicationRequest) (*pb.HandleGuildApplicationResponse, error)
 	KickGuildMember(ctx context.Context, req *pb.KickGuildMemberRequest) (*pb.KickGuildMemberResponse, error)
 	ChangeMemberRole(ctx context.Context, req *pb.ChangeMemberRoleRequest) (*pb.ChangeMemberRoleResponse, error)
+	UpdateGuildAnnouncement(ctx context.Context, req *pb.UpdateGuildAnnouncementRequest) (*pb.UpdateGuildAnnouncementResponse, error)
 	TransferGuildMaster(ctx context.Context, req *pb.TransferGuildMasterRequest) (*pb.TransferGuildMasterResponse, error)
 	DisbandGuild(ctx context.Context, req *pb.DisbandGuildRequest) (*pb.DisbandGuildResponse, error)
 	LeaveGuild(ctx context.Context, req *pb.LeaveGuildRequest) (*pb.LeaveGuildResponse, error)
+	DonateToGuild(ctx context.Context, req *pb.DonateToGuildRequest) (*pb.DonateToGuildResponse, error)
+	GetGuildDonations(ctx context.Context, req *pb.GetGuildDonationsRequest) (*pb.GetGuildDonationsResponse, error)
 
 	// 聊天相关
 	SendChatMessage(ctx context.Context, req *pb.SendChatMessageRequest) (*pb.SendChatMessageResponse, error)
@@ -22,6 +22,10 @@ func NewSocialGRPCServer(dbClient db.Database, cacheClient cache.Cache, sf *snow
 	if err != nil {
 		return nil, err
 	}
+
+	// 后台清理过期的帮派申请和邀请
+	NewGuildSweeper(dbClient).Start(context.Background())
+
 	return &SocialGRPCServer{
 		UnimplementedSocialServiceServer: pb.UnimplementedSocialServiceServer{},
 		handler:                          handler,
@@ -82,6 +86,14 @@ func (s *SocialGRPCServer) HandleGuildApplication(ctx context.Context, req *pb.H
 	return s.handler.HandleGuildApplication(ctx, req)
 }
 
+func (s *SocialGRPCServer) GetUserGuildInvitations(ctx context.Context, req *pb.GetUserGuildInvitationsRequest) (*pb.GetUserGuildInvitationsResponse, error) {
+	return s.handler.GetUserGuildInvitations(ctx, req)
+}
+
+func (s *SocialGRPCServer) HandleGuildInvitation(ctx context.Context, req *pb.HandleGuildInvitationRequest) (*pb.HandleGuildInvitationResponse, error) {
+	return s.handler.HandleGuildInvitation(ctx, req)
+}
+
 func (s *SocialGRPCServer) KickGuildMember(ctx context.Context, req *pb.KickGuildMemberRequest) (*pb.KickGuildMemberResponse, error) {
 	return s.handler.KickGuildMember(ctx, req)
 }
@@ -90,6 +102,10 @@ func (s *SocialGRPCServer) ChangeMemberRole(ctx context.Context, req *pb.ChangeM
 	return s.handler.ChangeMemberRole(ctx, req)
 }
 
+func (s *SocialGRPCServer) UpdateGuildAnnouncement(ctx context.Context, req *pb.UpdateGuildAnnouncementRequest) (*pb.UpdateGuildAnnouncementResponse, error) {
+	return s.handler.UpdateGuildAnnouncement(ctx, req)
+}
+
 func (s *SocialGRPCServer) TransferGuildMaster(ctx context.Context, req *pb.TransferGuildMasterRequest) (*pb.TransferGuildMasterResponse, error) {
 	return s.handler.TransferGuildMaster(ctx, req)
 }
@@ -102,6 +118,14 @@ func (s *SocialGRPCServer) LeaveGuild(ctx context.Context, req *pb.LeaveGuildReq
 	return s.handler.LeaveGuild(ctx, req)
 }
 
+func (s *SocialGRPCServer) DonateToGuild(ctx context.Context, req *pb.DonateToGuildRequest) (*pb.DonateToGuildResponse, error) {
+	return s.handler.DonateToGuild(ctx, req)
+}
+
+func (s *SocialGRPCServer) GetGuildDonations(ctx context.Context, req *pb.GetGuildDonationsRequest) (*pb.GetGuildDonationsResponse, error) {
+	return s.handler.GetGuildDonations(ctx, req)
+}
+
 // 聊天相关接口
 func (s *SocialGRPCServer) SendChatMessage(ctx context.Context, req *pb.SendChatMessageRequest) (*pb.SendChatMessageResponse, error) {
 	return s.handler.SendChatMessage(ctx, req)
@@ -1,5 +1,41 @@
 package social
 
-import "github.xubinbest.com/go-game-server/internal/designconfig"
+import (
+	"reflect"
 
-var Tables = []designconfig.Tables{}
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// 所有用到的配置表
+var Tables = []designconfig.Tables{
+	{
+		DataId:    "currency.csv",
+		TableName: "currency",
+		DataType:  reflect.TypeOf(designconfig.CurrencyData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "guild_level.csv",
+		TableName: "guild_level",
+		DataType:  reflect.TypeOf(designconfig.GuildLevelData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "guild_perk.csv",
+		TableName: "guild_perk",
+		DataType:  reflect.TypeOf(designconfig.GuildPerkData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "guild_role.csv",
+		TableName: "guild_role",
+		DataType:  reflect.TypeOf(designconfig.GuildRoleData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "guild_donate.csv",
+		TableName: "guild_donate",
+		DataType:  reflect.TypeOf(designconfig.GuildDonateData{}),
+		Group:     designconfig.BaseGroup,
+	},
+}
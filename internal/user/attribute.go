@@ -0,0 +1,69 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/attribute"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// CombatPowerLeaderboard 战力排行榜名称
+const CombatPowerLeaderboard = "combat_power"
+
+// computeUserAttributes 汇总玩家属性并计算战力
+func (h *Handler) computeUserAttributes(ctx context.Context, userID int64) (*attribute.Attributes, error) {
+	user, err := h.dbClient.GetUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	return h.attributes.Compute(ctx, user)
+}
+
+// getUserAttributes 获取玩家属性（带缓存），缓存中的增益加成已过期时重新计算
+func (h *Handler) getUserAttributes(ctx context.Context, userID int64) (*attribute.Attributes, error) {
+	attrs, err := h.cachedUserAttributes(ctx, userID)
+	if err != nil || attrs == nil || attrs.ExpireAt == 0 || time.Now().Unix() < attrs.ExpireAt {
+		return attrs, err
+	}
+	return h.recomputeUserAttributes(ctx, userID)
+}
+
+// cachedUserAttributes 从缓存获取玩家属性，未命中时重新计算
+func (h *Handler) cachedUserAttributes(ctx context.Context, userID int64) (*attribute.Attributes, error) {
+	return h.cacheService.GetUserAttributesWithCache(ctx, userID, func() (*attribute.Attributes, error) {
+		return h.computeUserAttributes(ctx, userID)
+	})
+}
+
+// recomputeUserAttributes 失效缓存后重新计算属性，并更新战力排行榜
+func (h *Handler) recomputeUserAttributes(ctx context.Context, userID int64) (*attribute.Attributes, error) {
+	if err := h.cacheService.InvalidateUserAttributesCache(ctx, userID); err != nil {
+		utils.Error("Failed to invalidate attributes cache", zap.Int64("userId", userID), zap.Error(err))
+	}
+
+	attrs, err := h.cachedUserAttributes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.leaderboard.ReportScore(ctx, CombatPowerLeaderboard, strconv.FormatInt(userID, 10), attrs.CombatPower); err != nil {
+		utils.Error("Failed to report combat power", zap.Int64("userId", userID), zap.Error(err))
+	}
+	return attrs, nil
+}
+
+// refreshUserAttributes 等级、装备、卡牌、宠物或增益变化后重新计算属性，并更新战力排行榜
+// 计算失败只记录日志，不影响触发变化的主流程
+func (h *Handler) refreshUserAttributes(ctx context.Context, userID int64) {
+	if _, err := h.recomputeUserAttributes(ctx, userID); err != nil {
+		utils.Error("Failed to compute user attributes", zap.Int64("userId", userID), zap.Error(err))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.xubinbest.com/go-game-server/internal/attribute"
 	"github.xubinbest.com/go-game-server/internal/cache"
 	"github.xubinbest.com/go-game-server/internal/db/models"
 	"github.xubinbest.com/go-game-server/internal/utils"
@@ -73,6 +74,13 @@ func (cs *CacheService) UpdateUserWithCache(ctx context.Context, user *models.Us
 	return cs.cacheManager.Invalidate(ctx, key)
 }
 
+// InvalidateUserInfoCache 失效用户信息缓存
+func (cs *CacheService) InvalidateUserInfoCache(ctx context.Context, userID int64) error {
+	strategy := cache.Strategies["user_info"]
+	key := fmt.Sprintf("%s%d", strategy.KeyPrefix, userID)
+	return cs.cacheManager.Invalidate(ctx, key)
+}
+
 // GetInventoryWithCache 带缓存的获取背包
 func (cs *CacheService) GetInventoryWithCache(ctx context.Context, userID int64, getFunc func() (*models.Inventory, error)) (*models.Inventory, error) {
 	strategy := cache.Strategies["user_inventory"]
@@ -330,3 +338,46 @@ func (cs *CacheService) InvalidateMonthlySignRewardCache(ctx context.Context, us
 	key := fmt.Sprintf("%s%d", strategy.KeyPrefix, userID)
 	return cs.cacheManager.Invalidate(ctx, key)
 }
+
+// GetUserAttributesWithCache 带缓存的获取用户属性
+func (cs *CacheService) GetUserAttributesWithCache(ctx context.Context, userID int64, getFunc func() (*attribute.Attributes, error)) (*attribute.Attributes, error) {
+	strategy := cache.Strategies["user_attributes"]
+	key := fmt.Sprintf("%s%d", strategy.KeyPrefix, userID)
+
+	data, err := cs.cacheManager.GetOrSet(ctx, key, strategy, func() (interface{}, error) {
+		return getFunc()
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, nil
+	}
+
+	// 如果是直接计算返回的数据
+	if attrs, ok := data.(*attribute.Attributes); ok {
+		return attrs, nil
+	}
+
+	// 如果是从缓存返回的JSON数据
+	if jsonData, ok := data.([]byte); ok {
+		var attrs attribute.Attributes
+		if err := json.Unmarshal(jsonData, &attrs); err == nil {
+			return &attrs, nil
+		}
+		// 如果反序列化失败，记录错误并返回nil
+		utils.Error("failed to unmarshal attributes from cache", zap.Error(err))
+		return nil, fmt.Errorf("invalid attributes data format")
+	}
+
+	return nil, fmt.Errorf("invalid attributes data format")
+}
+
+// InvalidateUserAttributesCache 失效用户属性缓存
+func (cs *CacheService) InvalidateUserAttributesCache(ctx context.Context, userID int64) error {
+	strategy := cache.Strategies["user_attributes"]
+	key := fmt.Sprintf("%s%d", strategy.KeyPrefix, userID)
+	return cs.cacheManager.Invalidate(ctx, key)
+}
@@ -3,11 +3,15 @@ package user
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 
+	"github.xubinbest.com/go-game-server/internal/attribute"
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
 	"github.xubinbest.com/go-game-server/internal/db/models"
 	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/gameevent"
 	"github.xubinbest.com/go-game-server/internal/pb"
 	"github.xubinbest.com/go-game-server/internal/utils"
 
@@ -65,25 +69,17 @@ func (h *Handler) getCardLevelTemplate(cardID int64, level int32) (*designconfig
 	return nil, fmt.Errorf("card level template not found: card_id=%d, level=%d", cardID, level)
 }
 
-// checkAndConsumeItems 检查并消耗物品
-func (h *Handler) checkAndConsumeItems(ctx context.Context, userID int64, costs []designconfig.BaseItemCost) error {
-	for _, cost := range costs {
-		// 检查物品是否足够
-		hasEnough, err := h.dbClient.HasEnoughItems(ctx, userID, int64(cost.ItemId), int32(cost.Count))
-		if err != nil {
-			return fmt.Errorf("failed to check item count: %w", err)
-		}
-		if !hasEnough {
-			return fmt.Errorf("insufficient items: item_id=%d, required=%d", cost.ItemId, cost.Count)
-		}
-
-		// 消耗物品
-		err = h.dbClient.RemoveItem(ctx, userID, int64(cost.ItemId), int32(cost.Count))
-		if err != nil {
-			return fmt.Errorf("failed to consume item: %w", err)
-		}
-	}
-	return nil
+// cardUpgradeMessage 将激活、升级、升星事务返回的业务错误转换为提示信息
+func cardUpgradeMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, interfaces.ErrCardChanged):
+		return "操作过于频繁，请稍后再试", true
+	case errors.Is(err, interfaces.ErrInsufficientCurrency):
+		return "货币不足", true
+	case errors.Is(err, interfaces.ErrInsufficientItems):
+		return "物品不足", true
+	}
+	return "", false
 }
 
 // GetUserCards 获取玩家所有卡牌信息（带缓存）
@@ -131,7 +127,8 @@ func (h *Handler) GetUserCards(ctx context.Context, req *pb.GetUserCardsRequest)
 	}
 
 	return &pb.GetUserCardsResponse{
-		Cards: pbCards,
+		Cards:   pbCards,
+		Bonuses: toPBCardSetBonuses(attribute.ActiveCardSets(h.getCardSets(), cards)),
 	}, nil
 }
 
@@ -164,33 +161,32 @@ func (h *Handler) ActivateCard(ctx context.Context, req *pb.ActivateCardRequest)
 		return &pb.ActivateCardResponse{Success: false, Message: "卡牌模板不存在"}, nil
 	}
 
-	// 检查并消耗激活所需物品
-	err = h.checkAndConsumeItems(ctx, userID, template.Cost)
-	if err != nil {
-		utils.Error("Failed to consume activation items", zap.Error(err))
-		return &pb.ActivateCardResponse{Success: false, Message: fmt.Sprintf("激活失败: %v", err)}, nil
-	}
-
-	// 创建卡牌数据（激活）
+	// 扣除激活消耗和创建卡牌在同一事务中完成
 	card := &models.Card{
 		UserID:     userID,
 		TemplateID: templateID,
 		Level:      1,
 		Star:       0,
 	}
-	err = h.dbClient.CreateCard(ctx, card)
+	err = h.dbClient.Transaction(ctx, func(ctx context.Context, tx interfaces.Tx) error {
+		err := h.consumeCosts(ctx, tx, userID, template.Cost, CurrencyReasonCardActivate,
+			fmt.Sprintf("card_template:%d", templateID), fmt.Sprintf("card_activate:%d", templateID))
+		if err != nil {
+			return err
+		}
+		return tx.Cards().CreateCard(ctx, card)
+	})
 	if err != nil {
-		utils.Error("CreateCard error", zap.Error(err))
-		return nil, fmt.Errorf("failed to create card")
+		if msg, ok := cardUpgradeMessage(err); ok {
+			return &pb.ActivateCardResponse{Success: false, Message: "激活失败: " + msg}, nil
+		}
+		utils.Error("ActivateCard error", zap.Int64("userId", userID), zap.Int64("templateId", templateID), zap.Error(err))
+		return nil, fmt.Errorf("failed to activate card")
 	}
 
-	// 失效卡牌缓存
-	err = h.cacheService.InvalidateUserCardsCache(ctx, userID)
-	if err != nil {
-		utils.Error("Failed to invalidate cards cache", zap.Error(err))
-	}
+	unlocked := h.afterCardChanged(ctx, userID, nil, card)
 
-	return &pb.ActivateCardResponse{Success: true, Message: "卡牌激活成功"}, nil
+	return &pb.ActivateCardResponse{Success: true, Message: "卡牌激活成功", UnlockedBonuses: unlocked}, nil
 }
 
 // UpgradeCard 卡牌升级
@@ -222,28 +218,29 @@ func (h *Handler) UpgradeCard(ctx context.Context, req *pb.UpgradeCardRequest) (
 		return &pb.UpgradeCardResponse{Success: false, Message: "升级模板不存在"}, nil
 	}
 
-	// 检查并消耗升级所需物品
-	err = h.checkAndConsumeItems(ctx, userID, levelTemplate.Cost)
+	// 扣除升级消耗和升级卡牌在同一事务中完成，以当前等级为条件更新，并发升级只有一个生效
+	err = h.dbClient.Transaction(ctx, func(ctx context.Context, tx interfaces.Tx) error {
+		err := h.consumeCosts(ctx, tx, userID, levelTemplate.Cost, CurrencyReasonCardUpgrade,
+			fmt.Sprintf("card:%d", cardID), fmt.Sprintf("card_upgrade:%d:%d", cardID, card.Level))
+		if err != nil {
+			return err
+		}
+		return tx.Cards().UpgradeCard(ctx, userID, cardID, card.Level+1)
+	})
 	if err != nil {
-		utils.Error("Failed to consume upgrade items", zap.Error(err))
-		return &pb.UpgradeCardResponse{Success: false, Message: fmt.Sprintf("升级失败: %v", err)}, nil
+		if msg, ok := cardUpgradeMessage(err); ok {
+			return &pb.UpgradeCardResponse{Success: false, Message: "升级失败: " + msg}, nil
+		}
+		utils.Error("UpgradeCard error", zap.Int64("userId", userID), zap.Int64("cardId", cardID), zap.Error(err))
+		return nil, fmt.Errorf("failed to upgrade card")
 	}
 
-	// 升级卡牌
+	before := *card
 	card.Level++
-	err = h.dbClient.UpdateCard(ctx, card)
-	if err != nil {
-		utils.Error("UpdateCard error", zap.Error(err))
-		return nil, fmt.Errorf("failed to update card")
-	}
+	unlocked := h.afterCardChanged(ctx, userID, &before, card)
+	h.events.Emit(ctx, userID, gameevent.CardUpgrade, 1)
 
-	// 失效卡牌缓存
-	err = h.cacheService.InvalidateUserCardsCache(ctx, userID)
-	if err != nil {
-		utils.Error("Failed to invalidate cards cache", zap.Error(err))
-	}
-
-	return &pb.UpgradeCardResponse{Success: true, Message: "卡牌升级成功"}, nil
+	return &pb.UpgradeCardResponse{Success: true, Message: "卡牌升级成功", UnlockedBonuses: unlocked}, nil
 }
 
 // UpgradeCardStar 卡牌升星
@@ -275,26 +272,26 @@ func (h *Handler) UpgradeCardStar(ctx context.Context, req *pb.UpgradeCardStarRe
 		return &pb.UpgradeCardStarResponse{Success: false, Message: "升星模板不存在"}, nil
 	}
 
-	// 检查并消耗升星所需物品
-	err = h.checkAndConsumeItems(ctx, userID, starTemplate.Cost)
+	// 扣除升星消耗和升星卡牌在同一事务中完成，以当前星级为条件更新，并发升星只有一个生效
+	err = h.dbClient.Transaction(ctx, func(ctx context.Context, tx interfaces.Tx) error {
+		err := h.consumeCosts(ctx, tx, userID, starTemplate.Cost, CurrencyReasonCardStar,
+			fmt.Sprintf("card:%d", cardID), fmt.Sprintf("card_star:%d:%d", cardID, card.Star))
+		if err != nil {
+			return err
+		}
+		return tx.Cards().UpgradeCardStar(ctx, userID, cardID, card.Star+1)
+	})
 	if err != nil {
-		utils.Error("Failed to consume star upgrade items", zap.Error(err))
-		return &pb.UpgradeCardStarResponse{Success: false, Message: fmt.Sprintf("升星失败: %v", err)}, nil
+		if msg, ok := cardUpgradeMessage(err); ok {
+			return &pb.UpgradeCardStarResponse{Success: false, Message: "升星失败: " + msg}, nil
+		}
+		utils.Error("UpgradeCardStar error", zap.Int64("userId", userID), zap.Int64("cardId", cardID), zap.Error(err))
+		return nil, fmt.Errorf("failed to upgrade card star")
 	}
 
-	// 升星卡牌
+	before := *card
 	card.Star++
-	err = h.dbClient.UpdateCard(ctx, card)
-	if err != nil {
-		utils.Error("UpdateCard error", zap.Error(err))
-		return nil, fmt.Errorf("failed to update card")
-	}
-
-	// 失效卡牌缓存
-	err = h.cacheService.InvalidateUserCardsCache(ctx, userID)
-	if err != nil {
-		utils.Error("Failed to invalidate cards cache", zap.Error(err))
-	}
+	unlocked := h.afterCardChanged(ctx, userID, &before, card)
 
-	return &pb.UpgradeCardStarResponse{Success: true, Message: "卡牌升星成功"}, nil
+	return &pb.UpgradeCardStarResponse{Success: true, Message: "卡牌升星成功", UnlockedBonuses: unlocked}, nil
 }
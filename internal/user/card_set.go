@@ -0,0 +1,86 @@
+package user
+
+import (
+	"context"
+
+	"github.xubinbest.com/go-game-server/internal/attribute"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// getCardSets 从内存配置中获取所有卡牌羁绊加成
+func (h *Handler) getCardSets() []designconfig.CardSetData {
+	sets, _ := h.configManager.GetConfig("card_set").([]designconfig.CardSetData)
+	return sets
+}
+
+// afterCardChanged 卡牌激活、升级或升星后重新计算羁绊加成和玩家属性，返回本次新生效的羁绊加成
+// before 为变化前的卡牌，激活时为 nil；after 为变化后的卡牌
+func (h *Handler) afterCardChanged(ctx context.Context, userID int64, before *models.Card, after *models.Card) []*pb.CardSetBonus {
+	// 失效卡牌缓存，激活、升级和升星都会消耗物品，一并失效背包缓存
+	if err := h.cacheService.InvalidateUserCardsCache(ctx, userID); err != nil {
+		utils.Error("Failed to invalidate cards cache", zap.Error(err))
+	}
+	if err := h.cacheService.InvalidateInventoryCache(ctx, userID); err != nil {
+		utils.Error("Failed to invalidate inventory cache", zap.Error(err))
+	}
+	h.refreshUserAttributes(ctx, userID)
+
+	sets := h.getCardSets()
+	if len(sets) == 0 {
+		return nil
+	}
+	cards, err := h.dbClient.GetUserCards(ctx, userID)
+	if err != nil {
+		utils.Error("GetUserCards error", zap.Int64("userId", userID), zap.Error(err))
+		return nil
+	}
+
+	// 将本次变化的卡牌还原为变化前的状态，得到变化前生效的羁绊加成
+	previous := make([]*models.Card, 0, len(cards))
+	for _, card := range cards {
+		if card.TemplateID != after.TemplateID {
+			previous = append(previous, card)
+		} else if before != nil {
+			previous = append(previous, before)
+		}
+	}
+	wasActive := make(map[int]bool)
+	for _, set := range attribute.ActiveCardSets(sets, previous) {
+		wasActive[set.ID] = true
+	}
+
+	var unlocked []designconfig.CardSetData
+	for _, set := range attribute.ActiveCardSets(sets, cards) {
+		if !wasActive[set.ID] {
+			unlocked = append(unlocked, set)
+			utils.Info("Card set bonus unlocked", zap.Int64("userId", userID), zap.Int("setId", set.SetId), zap.Int("bonusId", set.ID))
+		}
+	}
+	return toPBCardSetBonuses(unlocked)
+}
+
+// toPBCardSetBonuses 转换卡牌羁绊加成
+func toPBCardSetBonuses(sets []designconfig.CardSetData) []*pb.CardSetBonus {
+	result := make([]*pb.CardSetBonus, 0, len(sets))
+	for _, set := range sets {
+		cards := make([]int64, 0, len(set.Cards))
+		for _, templateID := range set.Cards {
+			cards = append(cards, int64(templateID))
+		}
+		result = append(result, &pb.CardSetBonus{
+			Id:         int64(set.ID),
+			SetId:      int64(set.SetId),
+			Name:       set.Name,
+			Cards:      cards,
+			Level:      int32(set.Level),
+			Star:       int32(set.Star),
+			Properties: attributeJSON(set.Attribute),
+		})
+	}
+	return result
+}
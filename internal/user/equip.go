@@ -2,10 +2,29 @@ package user
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand"
+	"strconv"
 
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
 	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/itemcost"
 	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// ItemTypeEquipment 装备的物品类型，子类型即可穿戴的槽位，与 item 配置表一致
+const ItemTypeEquipment = 1
+
+// 装备强化和洗练的货币变动原因
+const (
+	CurrencyReasonEquipEnhance = "equip_enhance"
+	CurrencyReasonEquipRefine  = "equip_refine"
 )
 
 // GetEquipments 获取用户装备信息（带缓存）
@@ -23,6 +42,11 @@ func (h *Handler) GetEquipments(ctx context.Context, req *pb.GetEquipmentsReques
 		return nil, fmt.Errorf("failed to get equipments: %w", err)
 	}
 
+	enhances, err := h.getEquipmentEnhances(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get equipment enhances: %w", err)
+	}
+
 	result := make([]*pb.Equipment, 0, len(equipments))
 	for _, e := range equipments {
 		// 从内存配置中获取模板数据
@@ -32,13 +56,20 @@ func (h *Handler) GetEquipments(ctx context.Context, req *pb.GetEquipmentsReques
 			continue
 		}
 
-		result = append(result, &pb.Equipment{
+		enhance := enhances[e.ItemID]
+		equipment := &pb.Equipment{
 			Id:         e.ID,
 			TemplateId: e.TemplateID,
 			Slot:       e.Slot,
 			Name:       template.Name,
-			Properties: fmt.Sprintf(`{"atk":%d,"def":%d,"hpMax":%d}`, template.Attribute.Atk, template.Attribute.Def, template.Attribute.HpMax),
-		})
+			Properties: attributeJSON(h.attributes.EquipmentAttribute(template.Attribute, enhance)),
+			ItemId:     e.ItemID,
+		}
+		if enhance != nil {
+			equipment.EnhanceLevel = enhance.Level
+			equipment.Affixes = h.toPBEquipmentAffixes(enhance.Affixes)
+		}
+		result = append(result, equipment)
 	}
 
 	return &pb.GetEquipmentsResponse{
@@ -53,7 +84,21 @@ func (h *Handler) EquipItem(ctx context.Context, req *pb.EquipItemRequest) (*pb.
 		return nil, fmt.Errorf("invalid user id")
 	}
 
-	// 检查物品是否存在且属于该用户
+	// 检查物品是否存在、是否为装备以及装备类型与槽位是否匹配
+	item, template, err := h.findEquipmentItem(ctx, userID, req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return &pb.EquipItemResponse{Success: false, Message: "装备不存在"}, nil
+	}
+	if item.Equipped {
+		return &pb.EquipItemResponse{Success: false, Message: "该装备已穿戴"}, nil
+	}
+	if int32(template.Subtype) != req.Slot {
+		return &pb.EquipItemResponse{Success: false, Message: "装备类型与槽位不匹配"}, nil
+	}
+
 	if err := h.dbClient.EquipItem(ctx, userID, req.ItemId, req.Slot); err != nil {
 		return &pb.EquipItemResponse{
 			Success: false,
@@ -62,11 +107,12 @@ func (h *Handler) EquipItem(ctx context.Context, req *pb.EquipItemRequest) (*pb.
 	}
 
 	// 失效装备缓存
-	err := h.cacheService.InvalidateUserEquipmentsCache(ctx, userID)
+	err = h.cacheService.InvalidateUserEquipmentsCache(ctx, userID)
 	if err != nil {
 		// 记录错误但不影响主流程
-		fmt.Printf("Failed to invalidate equipments cache: %v", err)
+		utils.Error("Failed to invalidate equipments cache", zap.Int64("userId", userID), zap.Error(err))
 	}
+	h.refreshUserAttributes(ctx, userID)
 
 	return &pb.EquipItemResponse{
 		Success: true,
@@ -82,6 +128,9 @@ func (h *Handler) UnequipItem(ctx context.Context, req *pb.UnequipItemRequest) (
 	}
 
 	if err := h.dbClient.UnequipItem(ctx, userID, req.Slot); err != nil {
+		if errors.Is(err, interfaces.ErrInventoryFull) {
+			return &pb.UnequipItemResponse{Success: false, Message: "背包已满，无法卸下装备"}, nil
+		}
 		return &pb.UnequipItemResponse{
 			Success: false,
 			Message: fmt.Sprintf("failed to unequip item: %v", err),
@@ -92,11 +141,379 @@ func (h *Handler) UnequipItem(ctx context.Context, req *pb.UnequipItemRequest) (
 	err := h.cacheService.InvalidateUserEquipmentsCache(ctx, userID)
 	if err != nil {
 		// 记录错误但不影响主流程
-		fmt.Printf("Failed to invalidate equipments cache: %v", err)
+		utils.Error("Failed to invalidate equipments cache", zap.Int64("userId", userID), zap.Error(err))
 	}
+	h.refreshUserAttributes(ctx, userID)
 
 	return &pb.UnequipItemResponse{
 		Success: true,
 		Message: "Item unequipped successfully",
 	}, nil
 }
+
+// EnhanceEquipment 强化装备，按 equip_enhance 配置表扣除消耗并按成功率判定，失败时消耗照常扣除、等级不变
+func (h *Handler) EnhanceEquipment(ctx context.Context, req *pb.EnhanceEquipmentRequest) (*pb.EnhanceEquipmentResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	idempotencyKey, ok := requestKey("equip_enhance", req.RequestId)
+	if !ok {
+		return &pb.EnhanceEquipmentResponse{Success: false, Message: "请求ID无效"}, nil
+	}
+
+	// 客户端未收到响应而重试时等级或配置可能已变化，需在校验之前返回成功，避免把已完成的操作提示为失败
+	processed, err := h.dbClient.IsRequestProcessed(ctx, userID, idempotencyKey)
+	if err != nil {
+		utils.Error("IsRequestProcessed error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to enhance equipment")
+	}
+	if processed {
+		return h.processedEnhanceResponse(ctx, userID, req.ItemId)
+	}
+
+	item, _, err := h.findEquipmentItem(ctx, userID, req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return &pb.EnhanceEquipmentResponse{Success: false, Message: "装备不存在"}, nil
+	}
+
+	enhances, err := h.getEquipmentEnhances(ctx, userID)
+	if err != nil {
+		utils.Error("GetEquipmentEnhances error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get equipment enhances")
+	}
+	var level int32
+	if enhance := enhances[item.ID]; enhance != nil {
+		level = enhance.Level
+	}
+
+	config, err := h.getEquipEnhanceConfig(level + 1)
+	if err != nil {
+		return &pb.EnhanceEquipmentResponse{Success: false, Message: "已达到最大强化等级"}, nil
+	}
+
+	enhanced := rand.Intn(10000) < config.Rate
+	toLevel := level
+	if enhanced {
+		toLevel++
+	}
+
+	changes, costItems := h.costs.Split(config.Cost, -1)
+	order := &models.EquipmentUpgradeOrder{
+		UserID:          userID,
+		ItemID:          item.ID,
+		TemplateID:      item.TemplateID,
+		FromLevel:       level,
+		ToLevel:         toLevel,
+		CurrencyChanges: itemcost.MergeCurrencyChanges(changes),
+		CostItems:       costItems,
+		Reason:          CurrencyReasonEquipEnhance,
+		Source:          "equipment:" + strconv.FormatInt(item.ID, 10),
+		IdempotencyKey:  idempotencyKey,
+	}
+	if _, err := h.dbClient.UpgradeEquipment(ctx, order); err != nil {
+		if errors.Is(err, interfaces.ErrDuplicateRequest) {
+			return h.processedEnhanceResponse(ctx, userID, item.ID)
+		}
+		if message, ok := equipmentUpgradeMessage(err); ok {
+			return &pb.EnhanceEquipmentResponse{Success: false, Message: message}, nil
+		}
+		utils.Error("UpgradeEquipment error", zap.Int64("userId", userID), zap.Int64("itemId", item.ID), zap.Error(err))
+		return nil, fmt.Errorf("failed to enhance equipment")
+	}
+	h.afterEquipmentUpgraded(ctx, userID, item, len(costItems) > 0)
+
+	utils.Info("Equipment enhanced", zap.Int64("userId", userID), zap.Int64("itemId", item.ID),
+		zap.Bool("enhanced", enhanced), zap.Int32("level", toLevel))
+	message := "强化失败"
+	if enhanced {
+		message = "强化成功"
+	}
+	return &pb.EnhanceEquipmentResponse{
+		Success:  true,
+		Message:  message,
+		Enhanced: enhanced,
+		Level:    toLevel,
+		Cost:     h.toPBItems(config.Cost),
+	}, nil
+}
+
+// RefineEquipment 洗练装备，按装备品质读取 equip_refine 配置表，从 equip_affix 配置表按权重抽取不重复的词条替换原有词条
+func (h *Handler) RefineEquipment(ctx context.Context, req *pb.RefineEquipmentRequest) (*pb.RefineEquipmentResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	idempotencyKey, ok := requestKey("equip_refine", req.RequestId)
+	if !ok {
+		return &pb.RefineEquipmentResponse{Success: false, Message: "请求ID无效"}, nil
+	}
+
+	// 客户端未收到响应而重试时等级或配置可能已变化，需在校验之前返回成功，避免把已完成的操作提示为失败
+	processed, err := h.dbClient.IsRequestProcessed(ctx, userID, idempotencyKey)
+	if err != nil {
+		utils.Error("IsRequestProcessed error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to refine equipment")
+	}
+	if processed {
+		return h.processedRefineResponse(ctx, userID, req.ItemId)
+	}
+
+	item, template, err := h.findEquipmentItem(ctx, userID, req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return &pb.RefineEquipmentResponse{Success: false, Message: "装备不存在"}, nil
+	}
+
+	config, err := h.getEquipRefineConfig(template.Color)
+	if err != nil {
+		return &pb.RefineEquipmentResponse{Success: false, Message: "该装备无法洗练"}, nil
+	}
+	affixes := h.rollEquipmentAffixes(config.AffixCount)
+	if len(affixes) == 0 {
+		utils.Error("Equipment affix config not found", zap.Int("color", template.Color))
+		return nil, fmt.Errorf("failed to refine equipment")
+	}
+
+	enhances, err := h.getEquipmentEnhances(ctx, userID)
+	if err != nil {
+		utils.Error("GetEquipmentEnhances error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get equipment enhances")
+	}
+	var level int32
+	if enhance := enhances[item.ID]; enhance != nil {
+		level = enhance.Level
+	}
+
+	changes, costItems := h.costs.Split(config.Cost, -1)
+	order := &models.EquipmentUpgradeOrder{
+		UserID:          userID,
+		ItemID:          item.ID,
+		TemplateID:      item.TemplateID,
+		FromLevel:       level,
+		ToLevel:         level,
+		Affixes:         affixes,
+		CurrencyChanges: itemcost.MergeCurrencyChanges(changes),
+		CostItems:       costItems,
+		Reason:          CurrencyReasonEquipRefine,
+		Source:          "equipment:" + strconv.FormatInt(item.ID, 10),
+		IdempotencyKey:  idempotencyKey,
+	}
+	if _, err := h.dbClient.UpgradeEquipment(ctx, order); err != nil {
+		if errors.Is(err, interfaces.ErrDuplicateRequest) {
+			return h.processedRefineResponse(ctx, userID, item.ID)
+		}
+		if message, ok := equipmentUpgradeMessage(err); ok {
+			return &pb.RefineEquipmentResponse{Success: false, Message: message}, nil
+		}
+		utils.Error("UpgradeEquipment error", zap.Int64("userId", userID), zap.Int64("itemId", item.ID), zap.Error(err))
+		return nil, fmt.Errorf("failed to refine equipment")
+	}
+	h.afterEquipmentUpgraded(ctx, userID, item, len(costItems) > 0)
+
+	utils.Info("Equipment refined", zap.Int64("userId", userID), zap.Int64("itemId", item.ID), zap.Int("affixes", len(affixes)))
+	return &pb.RefineEquipmentResponse{
+		Success: true,
+		Message: "洗练成功",
+		Affixes: h.toPBEquipmentAffixes(affixes),
+		Cost:    h.toPBItems(config.Cost),
+	}, nil
+}
+
+// findEquipmentItem 从背包中查找装备实例，物品不存在或不是装备时返回 nil
+func (h *Handler) findEquipmentItem(ctx context.Context, userID int64, itemID int64) (*models.InventoryItem, *designconfig.ItemData, error) {
+	inventory, err := h.dbClient.GetInventory(ctx, userID)
+	if err != nil {
+		utils.Error("GetInventory error", zap.Error(err))
+		return nil, nil, fmt.Errorf("failed to get inventory")
+	}
+
+	for _, item := range inventory.Items {
+		if item.ID != itemID {
+			continue
+		}
+		template, err := h.getItemTemplate(item.TemplateID)
+		if err != nil || template.Type != ItemTypeEquipment {
+			return nil, nil, nil
+		}
+		return item, template, nil
+	}
+	return nil, nil, nil
+}
+
+// equipmentUpgradeMessage 将强化和洗练的业务错误转换为提示信息
+func equipmentUpgradeMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, interfaces.ErrEquipmentNotFound):
+		return "装备不存在", true
+	case errors.Is(err, interfaces.ErrEquipmentChanged):
+		return "操作过于频繁，请稍后再试", true
+	case errors.Is(err, interfaces.ErrInsufficientCurrency):
+		return "货币不足", true
+	case errors.Is(err, interfaces.ErrInsufficientItems):
+		return "物品不足", true
+	}
+	return "", false
+}
+
+// processedEnhanceResponse 已处理过的强化请求返回成功，随机的强化结果无法重现，只返回装备当前的强化等级
+func (h *Handler) processedEnhanceResponse(ctx context.Context, userID int64, itemID int64) (*pb.EnhanceEquipmentResponse, error) {
+	enhances, err := h.getEquipmentEnhances(ctx, userID)
+	if err != nil {
+		utils.Error("GetEquipmentEnhances error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get equipment enhances")
+	}
+	resp := &pb.EnhanceEquipmentResponse{Success: true, Message: "请求已处理"}
+	if enhance := enhances[itemID]; enhance != nil {
+		resp.Level = enhance.Level
+	}
+	return resp, nil
+}
+
+// processedRefineResponse 已处理过的洗练请求返回成功和装备当前的词条
+func (h *Handler) processedRefineResponse(ctx context.Context, userID int64, itemID int64) (*pb.RefineEquipmentResponse, error) {
+	enhances, err := h.getEquipmentEnhances(ctx, userID)
+	if err != nil {
+		utils.Error("GetEquipmentEnhances error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get equipment enhances")
+	}
+	resp := &pb.RefineEquipmentResponse{Success: true, Message: "请求已处理"}
+	if enhance := enhances[itemID]; enhance != nil {
+		resp.Affixes = h.toPBEquipmentAffixes(enhance.Affixes)
+	}
+	return resp, nil
+}
+
+// afterEquipmentUpgraded 强化或洗练后失效相关缓存，已穿戴的装备需要重新计算玩家属性
+func (h *Handler) afterEquipmentUpgraded(ctx context.Context, userID int64, item *models.InventoryItem, costItems bool) {
+	if costItems {
+		if err := h.cacheService.InvalidateInventoryCache(ctx, userID); err != nil {
+			utils.Error("Failed to invalidate inventory cache", zap.Error(err))
+		}
+	}
+	if item.Equipped {
+		if err := h.cacheService.InvalidateUserEquipmentsCache(ctx, userID); err != nil {
+			utils.Error("Failed to invalidate equipments cache", zap.Error(err))
+		}
+		h.refreshUserAttributes(ctx, userID)
+	}
+}
+
+// getEquipmentEnhances 获取玩家装备实例的强化记录，以背包物品实例ID为键
+func (h *Handler) getEquipmentEnhances(ctx context.Context, userID int64) (map[int64]*models.EquipmentEnhance, error) {
+	enhances, err := h.dbClient.GetEquipmentEnhances(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]*models.EquipmentEnhance, len(enhances))
+	for _, enhance := range enhances {
+		result[enhance.ItemID] = enhance
+	}
+	return result, nil
+}
+
+// rollEquipmentAffixes 按权重抽取 count 个不重复的词条，词条配置不足时全部抽取
+func (h *Handler) rollEquipmentAffixes(count int) []models.EquipmentAffix {
+	configs, _ := h.configManager.GetConfig("equip_affix").([]designconfig.EquipAffixData)
+	pool := make([]designconfig.EquipAffixData, 0, len(configs))
+	for _, config := range configs {
+		if config.Weight > 0 {
+			pool = append(pool, config)
+		}
+	}
+
+	var affixes []models.EquipmentAffix
+	for len(affixes) < count && len(pool) > 0 {
+		var totalWeight int
+		for _, config := range pool {
+			totalWeight += config.Weight
+		}
+
+		roll := rand.Intn(totalWeight)
+		for i, config := range pool {
+			if roll < config.Weight {
+				affixes = append(affixes, models.EquipmentAffix{
+					AffixID:   int64(config.ID),
+					Attribute: config.Attribute,
+				})
+				pool = append(pool[:i], pool[i+1:]...)
+				break
+			}
+			roll -= config.Weight
+		}
+	}
+	return affixes
+}
+
+// getEquipEnhanceConfig 获取强化到 level 级的配置
+func (h *Handler) getEquipEnhanceConfig(level int32) (*designconfig.EquipEnhanceData, error) {
+	configs, ok := h.configManager.GetConfig("equip_enhance").([]designconfig.EquipEnhanceData)
+	if !ok {
+		return nil, fmt.Errorf("equip enhance config not found")
+	}
+	for _, config := range configs {
+		if int32(config.Level) == level {
+			return &config, nil
+		}
+	}
+	return nil, fmt.Errorf("equip enhance config not found: %d", level)
+}
+
+// getEquipRefineConfig 获取指定品质装备的洗练配置
+func (h *Handler) getEquipRefineConfig(color int) (*designconfig.EquipRefineData, error) {
+	configs, ok := h.configManager.GetConfig("equip_refine").([]designconfig.EquipRefineData)
+	if !ok {
+		return nil, fmt.Errorf("equip refine config not found")
+	}
+	for _, config := range configs {
+		if config.ID == color {
+			return &config, nil
+		}
+	}
+	return nil, fmt.Errorf("equip refine config not found: %d", color)
+}
+
+// getEquipAffixTemplate 获取词条配置
+func (h *Handler) getEquipAffixTemplate(affixID int64) (*designconfig.EquipAffixData, error) {
+	configs, ok := h.configManager.GetConfig("equip_affix").([]designconfig.EquipAffixData)
+	if !ok {
+		return nil, fmt.Errorf("equip affix config not found")
+	}
+	for _, config := range configs {
+		if int64(config.ID) == affixID {
+			return &config, nil
+		}
+	}
+	return nil, fmt.Errorf("equip affix config not found: %d", affixID)
+}
+
+// toPBEquipmentAffixes 转换装备词条，词条属性使用洗练时保存的数值
+func (h *Handler) toPBEquipmentAffixes(affixes []models.EquipmentAffix) []*pb.EquipmentAffix {
+	result := make([]*pb.EquipmentAffix, 0, len(affixes))
+	for _, affix := range affixes {
+		pbAffix := &pb.EquipmentAffix{
+			AffixId:    affix.AffixID,
+			Properties: attributeJSON(affix.Attribute),
+		}
+		if template, err := h.getEquipAffixTemplate(affix.AffixID); err == nil {
+			pbAffix.Name = template.Name
+		}
+		result = append(result, pbAffix)
+	}
+	return result
+}
+
+// attributeJSON 将属性转换为JSON字符串
+func attributeJSON(attr designconfig.Attribute) string {
+	data, err := json.Marshal(attr)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
@@ -0,0 +1,522 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/itemcost"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// CurrencyReasonGacha 抽卡的货币变动原因
+	CurrencyReasonGacha = "gacha"
+	// gachaTenPull 十连抽取次数
+	gachaTenPull = 10
+	// maxGachaRetries 并发抽取导致保底计数变化时的最大重试次数
+	maxGachaRetries = 3
+	// defaultGachaPageSize 抽卡记录默认每页数量
+	defaultGachaPageSize = 20
+	// maxGachaPageSize 抽卡记录每页最大数量
+	maxGachaPageSize = 100
+)
+
+// gachaRoll 单次抽取结果
+type gachaRoll struct {
+	drop       designconfig.GachaDropData
+	pityBefore int32
+}
+
+// getGachaPoolTemplate 从内存配置中获取卡池模板
+func (h *Handler) getGachaPoolTemplate(poolID int64) (*designconfig.GachaPoolData, error) {
+	pools := h.configManager.GetConfig("gacha_pool")
+	if pools == nil {
+		return nil, fmt.Errorf("gacha pool config not found")
+	}
+
+	poolsSlice := reflect.ValueOf(pools)
+	for i := 0; i < poolsSlice.Len(); i++ {
+		pool := poolsSlice.Index(i).Interface().(designconfig.GachaPoolData)
+		if int64(pool.ID) == poolID {
+			return &pool, nil
+		}
+	}
+	return nil, fmt.Errorf("gacha pool template not found: %d", poolID)
+}
+
+// getGachaDrops 获取卡池下的所有掉落配置
+func (h *Handler) getGachaDrops(poolID int64) ([]designconfig.GachaDropData, error) {
+	drops, ok := h.configManager.GetConfig("gacha_drop").([]designconfig.GachaDropData)
+	if !ok {
+		return nil, fmt.Errorf("gacha drop config not found")
+	}
+
+	var result []designconfig.GachaDropData
+	for _, drop := range drops {
+		if int64(drop.PoolID) == poolID && drop.Weight > 0 {
+			result = append(result, drop)
+		}
+	}
+	return result, nil
+}
+
+// rollGacha 按卡池配置和保底计数连续抽取 times 次，返回每次结果和抽取后的保底计数
+// 相同的种子、保底计数和配置得到相同结果，审计时可据此重放
+func rollGacha(seed int64, pool *designconfig.GachaPoolData, drops []designconfig.GachaDropData, pity int32, times int) ([]gachaRoll, int32) {
+	var rares, normals []designconfig.GachaDropData
+	rareWeight, normalWeight := 0, 0
+	for _, drop := range drops {
+		if drop.Rare == 1 {
+			rares = append(rares, drop)
+			rareWeight += drop.Weight
+		} else {
+			normals = append(normals, drop)
+			normalWeight += drop.Weight
+		}
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	rolls := make([]gachaRoll, 0, times)
+	for i := 0; i < times; i++ {
+		pull := int(pity) + 1
+		weight := rareWeight
+		if pool.SoftPityStart > 0 && pull >= pool.SoftPityStart {
+			weight += pool.SoftPityStep * (pull - pool.SoftPityStart + 1)
+		}
+
+		var rare bool
+		switch {
+		case rareWeight == 0:
+			rare = false
+		case normalWeight == 0:
+			rare = true
+		case pool.HardPity > 0 && pull >= pool.HardPity:
+			// 硬保底必出稀有
+			rare = true
+		default:
+			rare = rng.Intn(weight+normalWeight) < weight
+		}
+
+		group, total := normals, normalWeight
+		if rare {
+			group, total = rares, rareWeight
+		}
+		roll := rng.Intn(total)
+		picked := group[len(group)-1]
+		for _, drop := range group {
+			if roll < drop.Weight {
+				picked = drop
+				break
+			}
+			roll -= drop.Weight
+		}
+
+		rolls = append(rolls, gachaRoll{drop: picked, pityBefore: pity})
+		if rare {
+			pity = 0
+		} else {
+			pity++
+		}
+	}
+	return rolls, pity
+}
+
+// gachaRewardName 获取抽卡奖励的名称
+func (h *Handler) gachaRewardName(rewardType int, rewardID int64) string {
+	switch rewardType {
+	case models.GachaRewardCard:
+		if template, err := h.getCardTemplate(rewardID); err == nil {
+			return template.Name
+		}
+	case models.GachaRewardPet:
+		if template, err := h.getPetTemplate(rewardID); err == nil {
+			return template.Name
+		}
+	default:
+		if template, err := h.getItemTemplate(rewardID); err == nil {
+			return template.Name
+		}
+		if currency, err := h.getCurrencyTemplate(rewardID); err == nil {
+			return currency.Name
+		}
+	}
+	return ""
+}
+
+// GetGachaPool 获取卡池信息、掉落概率公示和玩家当前保底计数
+func (h *Handler) GetGachaPool(ctx context.Context, req *pb.GetGachaPoolRequest) (*pb.GetGachaPoolResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	pool, err := h.getGachaPoolTemplate(req.PoolId)
+	if err != nil {
+		return nil, fmt.Errorf("gacha pool not found")
+	}
+	drops, err := h.getGachaDrops(req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	pity, err := h.dbClient.GetGachaPity(ctx, userID, req.PoolId)
+	if err != nil {
+		utils.Error("GetGachaPity error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get gacha pity")
+	}
+
+	totalWeight := 0
+	for _, drop := range drops {
+		totalWeight += drop.Weight
+	}
+
+	resp := &pb.GetGachaPoolResponse{
+		PoolId:        int64(pool.ID),
+		Name:          pool.Name,
+		Cost:          h.toPBItems(pool.Cost),
+		TenCost:       h.toPBItems(pool.TenCost),
+		SoftPityStart: int32(pool.SoftPityStart),
+		HardPity:      int32(pool.HardPity),
+		Rates:         make([]*pb.GachaDropRate, 0, len(drops)),
+	}
+	if pity != nil {
+		resp.PityCount = pity.PityCount
+	}
+	for _, drop := range drops {
+		resp.Rates = append(resp.Rates, &pb.GachaDropRate{
+			DropId:     int64(drop.ID),
+			RewardType: int32(drop.RewardType),
+			RewardId:   int64(drop.RewardID),
+			Name:       h.gachaRewardName(drop.RewardType, int64(drop.RewardID)),
+			Count:      int32(drop.Count),
+			IsRare:     drop.Rare == 1,
+			Rate:       int32(drop.Weight * 10000 / totalWeight),
+		})
+	}
+	return resp, nil
+}
+
+// GachaPull 抽卡，支持单抽和十连
+// 消耗、奖励、审计记录和保底计数在同一事务中写入，已拥有的卡牌转换为激活材料
+func (h *Handler) GachaPull(ctx context.Context, req *pb.GachaPullRequest) (*pb.GachaPullResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	if req.Times != 1 && req.Times != gachaTenPull {
+		return &pb.GachaPullResponse{Success: false, Message: "抽取次数无效"}, nil
+	}
+	idempotencyKey, ok := requestKey("gacha", req.RequestId)
+	if !ok {
+		return &pb.GachaPullResponse{Success: false, Message: "请求ID无效"}, nil
+	}
+
+	pool, err := h.getGachaPoolTemplate(req.PoolId)
+	if err != nil {
+		return &pb.GachaPullResponse{Success: false, Message: "卡池不存在"}, nil
+	}
+	// 客户端未收到响应而重试时返回已抽到的奖励，避免把已完成的抽卡提示为失败
+	processed, err := h.dbClient.IsRequestProcessed(ctx, userID, idempotencyKey)
+	if err != nil {
+		utils.Error("IsRequestProcessed error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to pull gacha")
+	}
+	if processed {
+		return h.processedGachaPullResponse(ctx, userID, int64(pool.ID), idempotencyKey)
+	}
+
+	drops, err := h.getGachaDrops(req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	if len(drops) == 0 {
+		return &pb.GachaPullResponse{Success: false, Message: "卡池未开放"}, nil
+	}
+
+	cost := pool.Cost
+	if req.Times == gachaTenPull {
+		cost = pool.TenCost
+		if len(cost) == 0 {
+			cost = scaleItemCosts(pool.Cost, gachaTenPull)
+		}
+	}
+
+	cards, err := h.dbClient.GetUserCards(ctx, userID)
+	if err != nil {
+		utils.Error("GetUserCards error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get user cards")
+	}
+
+	var order *models.GachaOrder
+	var rewards []*pb.GachaReward
+	for attempt := 0; attempt < maxGachaRetries; attempt++ {
+		order, rewards, err = h.buildGachaOrder(ctx, userID, idempotencyKey, pool, drops, cost, int(req.Times), cards)
+		if err != nil {
+			utils.Error("Failed to build gacha order", zap.Int64("userId", userID), zap.Error(err))
+			return nil, fmt.Errorf("failed to pull gacha")
+		}
+		err = h.dbClient.Pull(ctx, order)
+		if !errors.Is(err, interfaces.ErrGachaPityChanged) {
+			break
+		}
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, interfaces.ErrDuplicateRequest):
+			return h.processedGachaPullResponse(ctx, userID, int64(pool.ID), idempotencyKey)
+		case errors.Is(err, interfaces.ErrInsufficientCurrency):
+			return &pb.GachaPullResponse{Success: false, Message: "货币不足"}, nil
+		case errors.Is(err, interfaces.ErrInsufficientItems):
+			return &pb.GachaPullResponse{Success: false, Message: "物品不足"}, nil
+		case errors.Is(err, interfaces.ErrGachaPityChanged):
+			return &pb.GachaPullResponse{Success: false, Message: "操作过于频繁，请稍后再试"}, nil
+		}
+		utils.Error("Gacha pull error", zap.Int64("userId", userID), zap.Int64("poolId", req.PoolId), zap.Error(err))
+		return nil, fmt.Errorf("failed to pull gacha")
+	}
+
+	// 失效相关缓存
+	if err := h.cacheService.InvalidateInventoryCache(ctx, userID); err != nil {
+		utils.Error("Failed to invalidate inventory cache", zap.Error(err))
+	}
+	if len(order.Cards) > 0 {
+		if err := h.cacheService.InvalidateUserCardsCache(ctx, userID); err != nil {
+			utils.Error("Failed to invalidate cards cache", zap.Error(err))
+		}
+		h.refreshUserAttributes(ctx, userID)
+	}
+	if len(order.Pets) > 0 {
+		if err := h.cacheService.InvalidateUserPetsCache(ctx, userID); err != nil {
+			utils.Error("Failed to invalidate pets cache", zap.Error(err))
+		}
+	}
+	h.notifyOverflowMail(ctx, order.OverflowMail)
+
+	batchID := order.Records[0].BatchID
+	utils.Info("Gacha pulled", zap.Int64("userId", userID), zap.Int64("poolId", req.PoolId),
+		zap.Int64("batchId", batchID), zap.Int32("times", req.Times), zap.Int32("pity", order.PityAfter))
+	return &pb.GachaPullResponse{
+		Success:   true,
+		Message:   "抽取成功",
+		BatchId:   batchID,
+		Rewards:   rewards,
+		PityCount: order.PityAfter,
+	}, nil
+}
+
+// buildGachaOrder 读取保底计数并抽取，组装需要在事务中写入的消耗、奖励和审计记录
+func (h *Handler) buildGachaOrder(ctx context.Context, userID int64, idempotencyKey string, pool *designconfig.GachaPoolData, drops []designconfig.GachaDropData,
+	cost []designconfig.BaseItemCost, times int, ownedCards []*models.Card) (*models.GachaOrder, []*pb.GachaReward, error) {
+	pity, err := h.dbClient.GetGachaPity(ctx, userID, int64(pool.ID))
+	if err != nil {
+		return nil, nil, err
+	}
+	if pity == nil {
+		pity = &models.GachaPity{UserID: userID, PoolID: int64(pool.ID)}
+	}
+
+	batchID, err := h.sf.NextID()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate batch id: %w", err)
+	}
+	seed := rand.Int63()
+	rolls, pityAfter := rollGacha(seed, pool, drops, pity.PityCount, times)
+
+	changes, costItems := h.costs.Split(cost, -1)
+	order := &models.GachaOrder{
+		UserID:         userID,
+		PoolID:         int64(pool.ID),
+		PityBefore:     pity.PityCount,
+		TotalBefore:    pity.TotalPulls,
+		PityAfter:      pityAfter,
+		CostItems:      costItems,
+		Inventory:      h.inventoryRules(),
+		OverflowMail:   newOverflowMail("抽卡奖励"),
+		Reason:         CurrencyReasonGacha,
+		Source:         "gacha_pool:" + strconv.Itoa(pool.ID),
+		IdempotencyKey: idempotencyKey,
+	}
+
+	owned := make(map[int64]bool, len(ownedCards))
+	for _, card := range ownedCards {
+		owned[card.TemplateID] = true
+	}
+
+	now := time.Now().Unix()
+	rewards := make([]*pb.GachaReward, 0, len(rolls))
+	for i, roll := range rolls {
+		drop := roll.drop
+		recordID, err := h.sf.NextID()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate record id: %w", err)
+		}
+		record := &models.GachaRecord{
+			ID:         recordID,
+			UserID:     userID,
+			PoolID:     int64(pool.ID),
+			BatchID:    batchID,
+			Seed:       seed,
+			PullIndex:  int32(i),
+			PityBefore: roll.pityBefore,
+			DropID:     int64(drop.ID),
+			RewardType: int32(drop.RewardType),
+			RewardID:   int64(drop.RewardID),
+			Count:      int32(drop.Count),
+			IsRare:     drop.Rare == 1,
+			RequestKey: idempotencyKey,
+			CreatedAt:  now,
+		}
+
+		switch drop.RewardType {
+		case models.GachaRewardCard:
+			if !owned[record.RewardID] {
+				owned[record.RewardID] = true
+				order.Cards = append(order.Cards, &models.Card{
+					UserID:     userID,
+					TemplateID: record.RewardID,
+					Level:      1,
+					Star:       0,
+				})
+				break
+			}
+			// 已拥有的卡牌转换为激活材料
+			template, err := h.getCardTemplate(record.RewardID)
+			if err != nil {
+				return nil, nil, err
+			}
+			converted := scaleItemCosts(template.Cost, drop.Count)
+			gainChanges, gainItems := h.costs.Split(converted, 1)
+			changes = append(changes, gainChanges...)
+			order.Items = append(order.Items, gainItems...)
+			record.Converted = true
+		case models.GachaRewardPet:
+			template, err := h.getPetTemplate(record.RewardID)
+			if err != nil {
+				return nil, nil, err
+			}
+			for j := 0; j < drop.Count; j++ {
+				order.Pets = append(order.Pets, &models.Pet{
+					UserID:     userID,
+					TemplateID: record.RewardID,
+					Name:       template.Name,
+					Level:      1,
+				})
+			}
+		default:
+			gain := []designconfig.BaseItemCost{{ItemId: drop.RewardID, Count: drop.Count}}
+			gainChanges, gainItems := h.costs.Split(gain, 1)
+			changes = append(changes, gainChanges...)
+			order.Items = append(order.Items, gainItems...)
+		}
+
+		order.Records = append(order.Records, record)
+		rewards = append(rewards, h.toPBGachaReward(record))
+	}
+	order.CurrencyChanges = itemcost.MergeCurrencyChanges(changes)
+
+	return order, rewards, nil
+}
+
+// toPBGachaReward 转换抽卡奖励，重复卡牌按卡牌激活消耗折算为材料
+func (h *Handler) toPBGachaReward(record *models.GachaRecord) *pb.GachaReward {
+	reward := &pb.GachaReward{
+		DropId:     record.DropID,
+		RewardType: record.RewardType,
+		RewardId:   record.RewardID,
+		Name:       h.gachaRewardName(int(record.RewardType), record.RewardID),
+		Count:      record.Count,
+		IsRare:     record.IsRare,
+		Converted:  record.Converted,
+	}
+	if record.Converted {
+		if template, err := h.getCardTemplate(record.RewardID); err == nil {
+			reward.ConvertedItems = h.toPBItems(scaleItemCosts(template.Cost, int(record.Count)))
+		}
+	}
+	return reward
+}
+
+// processedGachaPullResponse 已处理过的抽卡请求返回成功，奖励从该请求写入的审计记录中读取，保底计数取当前值
+func (h *Handler) processedGachaPullResponse(ctx context.Context, userID int64, poolID int64, idempotencyKey string) (*pb.GachaPullResponse, error) {
+	records, err := h.dbClient.GetGachaRecordsByRequest(ctx, userID, idempotencyKey)
+	if err != nil {
+		utils.Error("GetGachaRecordsByRequest error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get gacha records")
+	}
+	pity, err := h.dbClient.GetGachaPity(ctx, userID, poolID)
+	if err != nil {
+		utils.Error("GetGachaPity error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get gacha pity")
+	}
+
+	resp := &pb.GachaPullResponse{
+		Success: true,
+		Message: "请求已处理",
+		Rewards: make([]*pb.GachaReward, 0, len(records)),
+	}
+	for _, record := range records {
+		resp.BatchId = record.BatchID
+		resp.Rewards = append(resp.Rewards, h.toPBGachaReward(record))
+	}
+	if pity != nil {
+		resp.PityCount = pity.PityCount
+	}
+	return resp, nil
+}
+
+// GetGachaHistory 分页获取抽卡记录
+func (h *Handler) GetGachaHistory(ctx context.Context, req *pb.GetGachaHistoryRequest) (*pb.GetGachaHistoryResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultGachaPageSize
+	}
+	if limit > maxGachaPageSize {
+		limit = maxGachaPageSize
+	}
+
+	// 多取一条用于判断是否还有下一页
+	records, err := h.dbClient.GetGachaRecords(ctx, userID, req.PoolId, req.BeforeId, limit+1)
+	if err != nil {
+		utils.Error("GetGachaRecords error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get gacha history")
+	}
+
+	resp := &pb.GetGachaHistoryResponse{}
+	if len(records) > limit {
+		records = records[:limit]
+		resp.HasMore = true
+	}
+	for _, record := range records {
+		resp.Records = append(resp.Records, &pb.GachaRecord{
+			Id:         record.ID,
+			BatchId:    record.BatchID,
+			PoolId:     record.PoolID,
+			DropId:     record.DropID,
+			RewardType: record.RewardType,
+			RewardId:   record.RewardID,
+			Name:       h.gachaRewardName(int(record.RewardType), record.RewardID),
+			Count:      record.Count,
+			IsRare:     record.IsRare,
+			Converted:  record.Converted,
+			PityBefore: record.PityBefore,
+			CreatedAt:  record.CreatedAt,
+		})
+	}
+	return resp, nil
+}
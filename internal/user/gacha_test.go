@@ -0,0 +1,127 @@
+package user
+
+import (
+	"slices"
+	"testing"
+
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// 稀有权重远低于普通，未触发保底时几乎不会出稀有
+var testGachaDrops = []designconfig.GachaDropData{
+	{ID: 1, PoolID: 1, RewardID: 1001, Count: 1, Weight: 1_000_000},
+	{ID: 2, PoolID: 1, RewardID: 2001, Count: 1, Weight: 1, Rare: 1},
+}
+
+func TestRollGachaHardPity(t *testing.T) {
+	pool := &designconfig.GachaPoolData{ID: 1, HardPity: 10}
+	tests := []struct {
+		name      string
+		pity      int32
+		times     int
+		wantRares []int
+		wantPity  int32
+	}{
+		{
+			name:     "below hard pity",
+			times:    9,
+			wantPity: 9,
+		},
+		{
+			name:      "tenth pull is rare",
+			times:     10,
+			wantRares: []int{9},
+			wantPity:  0,
+		},
+		{
+			name:      "pity resets after a rare",
+			times:     25,
+			wantRares: []int{9, 19},
+			wantPity:  5,
+		},
+		{
+			name:      "carried over pity",
+			pity:      8,
+			times:     3,
+			wantRares: []int{1},
+			wantPity:  1,
+		},
+		{
+			name:      "pity beyond hard pity after a config change",
+			pity:      30,
+			times:     1,
+			wantRares: []int{0},
+			wantPity:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rolls, pity := rollGacha(42, pool, testGachaDrops, tt.pity, tt.times)
+			if len(rolls) != tt.times {
+				t.Fatalf("rollGacha() returned %d rolls, want %d", len(rolls), tt.times)
+			}
+			var rares []int
+			wantBefore := tt.pity
+			for i, roll := range rolls {
+				if roll.pityBefore != wantBefore {
+					t.Errorf("roll %d pityBefore = %d, want %d", i, roll.pityBefore, wantBefore)
+				}
+				if roll.drop.Rare == 1 {
+					rares = append(rares, i)
+					wantBefore = 0
+				} else {
+					wantBefore++
+				}
+			}
+			if !slices.Equal(rares, tt.wantRares) {
+				t.Errorf("rare rolls = %v, want %v", rares, tt.wantRares)
+			}
+			if pity != tt.wantPity {
+				t.Errorf("pity after = %d, want %d", pity, tt.wantPity)
+			}
+		})
+	}
+}
+
+func TestRollGachaSoftPity(t *testing.T) {
+	// 稀有基础概率 1%，第 50 抽起每抽增加 60 权重
+	drops := []designconfig.GachaDropData{
+		{ID: 1, PoolID: 1, RewardID: 1001, Count: 1, Weight: 990},
+		{ID: 2, PoolID: 1, RewardID: 2001, Count: 1, Weight: 10, Rare: 1},
+	}
+	pool := &designconfig.GachaPoolData{ID: 1, SoftPityStart: 50, SoftPityStep: 60, HardPity: 90}
+	tests := []struct {
+		name     string
+		pity     int32
+		min, max float64
+	}{
+		{name: "before soft pity", pity: 48, min: 0.005, max: 0.02},     // 10/1000
+		{name: "first soft pity pull", pity: 49, min: 0.05, max: 0.085}, // 70/1060
+		{name: "deep soft pity", pity: 79, min: 0.63, max: 0.68},        // 1870/2860，权重随抽数线性增加
+		{name: "hard pity", pity: 89, min: 1, max: 1},
+	}
+	const seeds = 20000
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rares := 0
+			for seed := int64(1); seed <= seeds; seed++ {
+				rolls, _ := rollGacha(seed, pool, drops, tt.pity, 1)
+				if rolls[0].drop.Rare == 1 {
+					rares++
+				}
+			}
+			if rate := float64(rares) / seeds; rate < tt.min || rate > tt.max {
+				t.Errorf("rare rate = %.4f, want in [%.3f, %.3f]", rate, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestRollGachaDeterministic(t *testing.T) {
+	pool := &designconfig.GachaPoolData{ID: 1, SoftPityStart: 5, SoftPityStep: 100_000, HardPity: 10}
+	a, pityA := rollGacha(7, pool, testGachaDrops, 3, 10)
+	b, pityB := rollGacha(7, pool, testGachaDrops, 3, 10)
+	if !slices.Equal(a, b) || pityA != pityB {
+		t.Errorf("rollGacha() with the same seed differs: %v/%d vs %v/%d", a, pityA, b, pityB)
+	}
+}
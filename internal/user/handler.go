@@ -2,13 +2,21 @@ package user
 
 import (
 	"context"
+	"fmt"
 
+	"github.xubinbest.com/go-game-server/internal/attribute"
 	"github.xubinbest.com/go-game-server/internal/cache"
 	"github.xubinbest.com/go-game-server/internal/common"
 	"github.xubinbest.com/go-game-server/internal/config"
 	"github.xubinbest.com/go-game-server/internal/db"
 	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/gameclock"
+	"github.xubinbest.com/go-game-server/internal/gameevent"
+	"github.xubinbest.com/go-game-server/internal/itemcost"
+	"github.xubinbest.com/go-game-server/internal/leaderboard"
+	"github.xubinbest.com/go-game-server/internal/mq"
 	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/push"
 	"github.xubinbest.com/go-game-server/internal/snowflake"
 )
 
@@ -20,6 +28,13 @@ type Handler struct {
 	cfg           *config.Config
 	sf            *snowflake.Snowflake
 	configManager *designconfig.DesignConfigManager
+	attributes    *attribute.Aggregator
+	costs         *itemcost.Costs
+	leaderboard   *leaderboard.Leaderboard
+	kafkaFactory  *mq.KafkaFactory
+	pusher        *push.Publisher
+	events        *gameevent.Emitter
+	clock         *gameclock.GameClock // 游戏时钟，每日、每周、每月重置的玩法统一使用
 }
 
 func NewHandler(dbClient db.Database, cacheClient cache.Cache, cacheManager *cache.CacheManager, sf *snowflake.Snowflake, cfg *config.Config, configManager *designconfig.DesignConfigManager) (*Handler, error) {
@@ -27,7 +42,13 @@ func NewHandler(dbClient db.Database, cacheClient cache.Cache, cacheManager *cac
 		return nil, err
 	}
 
+	clock, err := gameclock.New(cfg.GameClock)
+	if err != nil {
+		return nil, fmt.Errorf("invalid game clock config: %w", err)
+	}
+
 	cacheService := NewCacheService(cacheManager)
+	kafkaFactory := mq.NewKafkaFactory(&cfg.KafkaConfigs)
 
 	return &Handler{
 		dbClient:      dbClient,
@@ -37,6 +58,13 @@ func NewHandler(dbClient db.Database, cacheClient cache.Cache, cacheManager *cac
 		cfg:           cfg,
 		sf:            sf,
 		configManager: configManager,
+		attributes:    attribute.NewAggregator(dbClient, configManager),
+		costs:         itemcost.New(configManager),
+		leaderboard:   leaderboard.NewLeaderboard(cacheClient),
+		kafkaFactory:  kafkaFactory,
+		pusher:        push.NewPublisher(cacheClient, "user"),
+		events:        gameevent.NewEmitter(kafkaFactory, sf),
+		clock:         clock,
 	}, nil
 }
 
@@ -45,7 +73,7 @@ func NewHandler(dbClient db.Database, cacheClient cache.Cache, cacheManager *cac
 func (h *Handler) GetMonthlySignInfo(ctx context.Context, req *pb.GetMonthlySignInfoRequest) (*pb.GetMonthlySignInfoResponse, error) {
 	// 这里需要将db.Database转换为interfaces.UserDatabase
 	// 由于架构限制，这里简化处理，实际实现中需要正确的类型转换
-	monthlySignService := NewMonthlySignService(h.dbClient, h.configManager, h.cacheClient, h.cacheService)
+	monthlySignService := NewMonthlySignService(h.dbClient, h.dbClient, h, h.configManager, h.cacheClient, h.cacheService, h.clock)
 	info, err := monthlySignService.GetMonthlySignInfo(ctx, req.UserId)
 	if err != nil {
 		return nil, err
@@ -60,14 +88,38 @@ func (h *Handler) GetMonthlySignInfo(ctx context.Context, req *pb.GetMonthlySign
 func (h *Handler) MonthlySign(ctx context.Context, req *pb.MonthlySignRequest) (*pb.MonthlySignResponse, error) {
 	// 这里需要将db.Database转换为interfaces.UserDatabase
 	// 由于架构限制，这里简化处理，实际实现中需要正确的类型转换
-	monthlySignService := NewMonthlySignService(h.dbClient, h.configManager, h.cacheClient, h.cacheService)
-	return monthlySignService.MonthlySign(ctx, req.UserId)
+	monthlySignService := NewMonthlySignService(h.dbClient, h.dbClient, h, h.configManager, h.cacheClient, h.cacheService, h.clock)
+	resp, err := monthlySignService.MonthlySign(ctx, req.UserId)
+	if err == nil && resp.Success {
+		h.events.Emit(ctx, req.UserId, gameevent.Sign, 1)
+	}
+	return resp, err
 }
 
 // ClaimMonthlySignReward 领取月签到累计奖励
 func (h *Handler) ClaimMonthlySignReward(ctx context.Context, req *pb.ClaimMonthlySignRewardRequest) (*pb.ClaimMonthlySignRewardResponse, error) {
 	// 这里需要将db.Database转换为interfaces.UserDatabase
 	// 由于架构限制，这里简化处理，实际实现中需要正确的类型转换
-	monthlySignService := NewMonthlySignService(h.dbClient, h.configManager, h.cacheClient, h.cacheService)
+	monthlySignService := NewMonthlySignService(h.dbClient, h.dbClient, h, h.configManager, h.cacheClient, h.cacheService, h.clock)
 	return monthlySignService.ClaimMonthlySignReward(ctx, req.UserId, req.Days)
 }
+
+// MonthlySignMakeup 月签到补签
+func (h *Handler) MonthlySignMakeup(ctx context.Context, req *pb.MonthlySignMakeupRequest) (*pb.MonthlySignMakeupResponse, error) {
+	if req.UserId == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	monthlySignService := NewMonthlySignService(h.dbClient, h.dbClient, h, h.configManager, h.cacheClient, h.cacheService, h.clock)
+	return monthlySignService.MonthlySignMakeup(ctx, req.UserId, req.Day)
+}
+
+// GetMonthlySignHistory 获取历史月份签到记录
+func (h *Handler) GetMonthlySignHistory(ctx context.Context, req *pb.GetMonthlySignHistoryRequest) (*pb.GetMonthlySignHistoryResponse, error) {
+	if req.UserId == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	monthlySignService := NewMonthlySignService(h.dbClient, h.dbClient, h, h.configManager, h.cacheClient, h.cacheService, h.clock)
+	return monthlySignService.GetMonthlySignHistory(ctx, req.UserId, int(req.Limit))
+}
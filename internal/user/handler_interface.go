@@ -14,9 +14,14 @@ type UserHandler interface {
 	AddItem(ctx context.Context, req *pb.AddItemRequest) (*pb.AddItemResponse, error)
 	RemoveItem(ctx context.Context, req *pb.RemoveItemRequest) (*pb.RemoveItemResponse, error)
 	UseItem(ctx context.Context, req *pb.UseItemRequest) (*pb.UseItemResponse, error)
+	ExpandInventory(ctx context.Context, req *pb.ExpandInventoryRequest) (*pb.ExpandInventoryResponse, error)
+	SortInventory(ctx context.Context, req *pb.SortInventoryRequest) (*pb.SortInventoryResponse, error)
+	GetBuffs(ctx context.Context, req *pb.GetBuffsRequest) (*pb.GetBuffsResponse, error)
 	GetEquipments(ctx context.Context, req *pb.GetEquipmentsRequest) (*pb.GetEquipmentsResponse, error)
 	EquipItem(ctx context.Context, req *pb.EquipItemRequest) (*pb.EquipItemResponse, error)
 	UnequipItem(ctx context.Context, req *pb.UnequipItemRequest) (*pb.UnequipItemResponse, error)
+	EnhanceEquipment(ctx context.Context, req *pb.EnhanceEquipmentRequest) (*pb.EnhanceEquipmentResponse, error)
+	RefineEquipment(ctx context.Context, req *pb.RefineEquipmentRequest) (*pb.RefineEquipmentResponse, error)
 	GetUserInfo(ctx context.Context, req *pb.GetUserInfoRequest) (*pb.GetUserInfoResponse, error)
 	GetUserCards(ctx context.Context, req *pb.GetUserCardsRequest) (*pb.GetUserCardsResponse, error)
 	ActivateCard(ctx context.Context, req *pb.ActivateCardRequest) (*pb.ActivateCardResponse, error)
@@ -27,8 +32,37 @@ type UserHandler interface {
 	AddPet(ctx context.Context, req *pb.AddPetRequest) (*pb.AddPetResponse, error)
 	SetPetBattleStatus(ctx context.Context, req *pb.SetPetBattleStatusRequest) (*pb.SetPetBattleStatusResponse, error)
 	AddPetExp(ctx context.Context, req *pb.AddPetExpRequest) (*pb.AddPetExpResponse, error)
+	EvolvePet(ctx context.Context, req *pb.EvolvePetRequest) (*pb.EvolvePetResponse, error)
+	LearnPetSkill(ctx context.Context, req *pb.LearnPetSkillRequest) (*pb.LearnPetSkillResponse, error)
+	ReleasePet(ctx context.Context, req *pb.ReleasePetRequest) (*pb.ReleasePetResponse, error)
 	// 月签到相关方法
 	GetMonthlySignInfo(ctx context.Context, req *pb.GetMonthlySignInfoRequest) (*pb.GetMonthlySignInfoResponse, error)
 	MonthlySign(ctx context.Context, req *pb.MonthlySignRequest) (*pb.MonthlySignResponse, error)
 	ClaimMonthlySignReward(ctx context.Context, req *pb.ClaimMonthlySignRewardRequest) (*pb.ClaimMonthlySignRewardResponse, error)
+	MonthlySignMakeup(ctx context.Context, req *pb.MonthlySignMakeupRequest) (*pb.MonthlySignMakeupResponse, error)
+	GetMonthlySignHistory(ctx context.Context, req *pb.GetMonthlySignHistoryRequest) (*pb.GetMonthlySignHistoryResponse, error)
+	// 等级相关方法
+	AddUserExp(ctx context.Context, req *pb.AddUserExpRequest) (*pb.AddUserExpResponse, error)
+	// 邮件相关方法
+	GetMails(ctx context.Context, req *pb.GetMailsRequest) (*pb.GetMailsResponse, error)
+	ReadMail(ctx context.Context, req *pb.ReadMailRequest) (*pb.ReadMailResponse, error)
+	ClaimMail(ctx context.Context, req *pb.ClaimMailRequest) (*pb.ClaimMailResponse, error)
+	DeleteMail(ctx context.Context, req *pb.DeleteMailRequest) (*pb.DeleteMailResponse, error)
+	SendMail(ctx context.Context, req *pb.SendMailRequest) (*pb.SendMailResponse, error)
+	SendSystemMail(ctx context.Context, req *pb.SendSystemMailRequest) (*pb.SendSystemMailResponse, error)
+	SendBroadcastMail(ctx context.Context, req *pb.SendBroadcastMailRequest) (*pb.SendBroadcastMailResponse, error)
+	// 货币相关方法
+	GetWallet(ctx context.Context, req *pb.GetWalletRequest) (*pb.GetWalletResponse, error)
+	GetCurrencyHistory(ctx context.Context, req *pb.GetCurrencyHistoryRequest) (*pb.GetCurrencyHistoryResponse, error)
+	ChangeCurrency(ctx context.Context, req *pb.ChangeCurrencyRequest) (*pb.ChangeCurrencyResponse, error)
+	// 商店相关方法
+	GetShop(ctx context.Context, req *pb.GetShopRequest) (*pb.GetShopResponse, error)
+	BuyGoods(ctx context.Context, req *pb.BuyGoodsRequest) (*pb.BuyGoodsResponse, error)
+	// 抽卡相关方法
+	GetGachaPool(ctx context.Context, req *pb.GetGachaPoolRequest) (*pb.GetGachaPoolResponse, error)
+	GachaPull(ctx context.Context, req *pb.GachaPullRequest) (*pb.GachaPullResponse, error)
+	GetGachaHistory(ctx context.Context, req *pb.GetGachaHistoryRequest) (*pb.GetGachaHistoryResponse, error)
+	// 任务和成就相关方法
+	GetQuests(ctx context.Context, req *pb.GetQuestsRequest) (*pb.GetQuestsResponse, error)
+	ClaimQuestReward(ctx context.Context, req *pb.ClaimQuestRewardRequest) (*pb.ClaimQuestRewardResponse, error)
 }
@@ -2,9 +2,12 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
 	"github.xubinbest.com/go-game-server/internal/db/models"
 	"github.xubinbest.com/go-game-server/internal/designconfig"
 	"github.xubinbest.com/go-game-server/internal/pb"
@@ -13,6 +16,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// CurrencyReasonInventoryExpand 背包扩容的货币变动原因
+const CurrencyReasonInventoryExpand = "inventory_expand"
+
 // getItemTemplate 从内存配置中获取物品模板
 func (h *Handler) getItemTemplate(templateID int64) (*designconfig.ItemData, error) {
 	items := h.configManager.GetConfig("item")
@@ -32,7 +38,7 @@ func (h *Handler) getItemTemplate(templateID int64) (*designconfig.ItemData, err
 
 // getEquipmentTemplate 从内存配置中获取装备模板
 func (h *Handler) getEquipmentTemplate(templateID int64) (*designconfig.EquipmentData, error) {
-	equipments := h.configManager.GetConfig("equip")
+	equipments := h.configManager.GetConfig("equipment")
 	if equipments == nil {
 		return nil, fmt.Errorf("equipment config not found")
 	}
@@ -63,34 +69,7 @@ func (h *Handler) GetInventory(ctx context.Context, req *pb.GetInventoryRequest)
 		return nil, fmt.Errorf("failed to get inventory")
 	}
 
-	pbItems := make([]*pb.Item, 0, len(inventory.Items))
-	for _, item := range inventory.Items {
-		// 从内存配置中获取模板数据
-		template, err := h.getItemTemplate(item.TemplateID)
-		if err != nil {
-			utils.Error("Failed to get item template", zap.Int64("template_id", item.TemplateID), zap.Error(err))
-			continue
-		}
-
-		pbItems = append(pbItems, &pb.Item{
-			ItemId:     item.ID,
-			TemplateId: item.TemplateID,
-			Name:       template.Name,
-			Count:      item.Count,
-			Type:       int32(template.Type),
-			SubType:    int32(template.Subtype),
-			Color:      int32(template.Color),
-			Stack:      int32(template.Stack),
-			Equipped:   item.Equipped,
-		})
-	}
-
-	return &pb.GetInventoryResponse{
-		Inventory: &pb.Inventory{
-			Items:    pbItems,
-			Capacity: inventory.Capacity,
-		},
-	}, nil
+	return &pb.GetInventoryResponse{Inventory: h.toPBInventory(inventory)}, nil
 }
 
 // AddItem 添加物品（带缓存失效）
@@ -111,7 +90,10 @@ func (h *Handler) AddItem(ctx context.Context, req *pb.AddItemRequest) (*pb.AddI
 			return nil, fmt.Errorf("invalid template id: %v", err)
 		}
 
-		err = h.dbClient.AddItemByTemplate(ctx, userID, tplID, count)
+		err = h.dbClient.AddItemByTemplate(ctx, userID, tplID, count, h.inventoryRules())
+		if errors.Is(err, interfaces.ErrInventoryFull) {
+			return &pb.AddItemResponse{Success: false}, nil
+		}
 		if err != nil {
 			utils.Error("AddItemByTemplate error", zap.Error(err))
 			return nil, fmt.Errorf("failed to add item by template: %v", err)
@@ -157,30 +139,205 @@ func (h *Handler) RemoveItem(ctx context.Context, req *pb.RemoveItemRequest) (*p
 	return &pb.RemoveItemResponse{Success: true}, nil
 }
 
-// UseItem 使用物品（带缓存失效）
-func (h *Handler) UseItem(ctx context.Context, req *pb.UseItemRequest) (*pb.UseItemResponse, error) {
+// ExpandInventory 背包扩容，每次扩容的格子数和消耗读取 inventory_expand 配置表
+// 按扩容次数条件更新容量和扣除消耗在同一事务中完成，并发请求只有一个生效，消耗不足时容量不变
+func (h *Handler) ExpandInventory(ctx context.Context, req *pb.ExpandInventoryRequest) (*pb.ExpandInventoryResponse, error) {
 	userID := req.UserId
 	if userID == 0 {
 		return nil, fmt.Errorf("invalid user id")
 	}
 
-	itemID := req.ItemId
-	count := req.Count
-	if count <= 0 {
-		return nil, fmt.Errorf("invalid count")
+	inventory, err := h.dbClient.GetInventory(ctx, userID)
+	if err != nil {
+		utils.Error("GetInventory error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get inventory")
 	}
 
-	err := h.dbClient.RemoveItem(ctx, userID, itemID, count)
+	expandTimes := inventory.ExpandTimes + 1
+	expand, err := h.getInventoryExpandConfig(int(expandTimes))
 	if err != nil {
-		utils.Error("UseItem error", zap.Error(err))
-		return nil, fmt.Errorf("failed to use item: %v", err)
+		return &pb.ExpandInventoryResponse{Success: false, Message: "背包已达到最大容量"}, nil
 	}
 
-	// 失效背包缓存
-	err = h.cacheService.InvalidateInventoryCache(ctx, userID)
+	var capacity *models.InventoryCapacity
+	key := fmt.Sprintf("inventory_expand:%d", expandTimes)
+	err = h.dbClient.Transaction(ctx, func(ctx context.Context, tx interfaces.Tx) error {
+		var err error
+		if capacity, err = tx.ExpandInventory(ctx, userID, inventory.ExpandTimes, int32(expand.Slots)); err != nil {
+			return err
+		}
+		return h.consumeCosts(ctx, tx, userID, expand.Cost, CurrencyReasonInventoryExpand, key, key)
+	})
 	if err != nil {
+		switch {
+		case errors.Is(err, interfaces.ErrInventoryExpandConflict):
+			return &pb.ExpandInventoryResponse{Success: false, Message: "操作过于频繁，请稍后再试"}, nil
+		case errors.Is(err, interfaces.ErrInsufficientCurrency), errors.Is(err, interfaces.ErrInsufficientItems):
+			return &pb.ExpandInventoryResponse{Success: false, Message: "扩容消耗不足"}, nil
+		}
+		utils.Error("ExpandInventory error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to expand inventory")
+	}
+
+	// 失效背包缓存
+	if err := h.cacheService.InvalidateInventoryCache(ctx, userID); err != nil {
+		utils.Error("Failed to invalidate inventory cache", zap.Error(err))
+	}
+
+	utils.Info("Inventory expanded", zap.Int64("userId", userID), zap.Int32("expandTimes", capacity.ExpandTimes),
+		zap.Int32("capacity", capacity.Capacity))
+	return &pb.ExpandInventoryResponse{
+		Success:  true,
+		Message:  "扩容成功",
+		Capacity: capacity.Capacity,
+		Cost:     h.toPBItems(expand.Cost),
+	}, nil
+}
+
+// SortInventory 整理背包，合并未叠满的同类物品并按物品类型重新排列格子
+func (h *Handler) SortInventory(ctx context.Context, req *pb.SortInventoryRequest) (*pb.SortInventoryResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	if err := h.dbClient.SortInventory(ctx, userID, h.inventoryRules()); err != nil {
+		utils.Error("SortInventory error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to sort inventory")
+	}
+
+	// 失效背包缓存
+	if err := h.cacheService.InvalidateInventoryCache(ctx, userID); err != nil {
 		utils.Error("Failed to invalidate inventory cache", zap.Error(err))
 	}
 
-	return &pb.UseItemResponse{Success: true}, nil
+	inventory, err := h.dbClient.GetInventory(ctx, userID)
+	if err != nil {
+		utils.Error("GetInventory error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get inventory")
+	}
+
+	return &pb.SortInventoryResponse{
+		Success:   true,
+		Message:   "整理成功",
+		Inventory: h.toPBInventory(inventory),
+	}, nil
+}
+
+// getInventoryExpandConfig 获取第 expandTimes 次扩容的配置
+func (h *Handler) getInventoryExpandConfig(expandTimes int) (*designconfig.InventoryExpandData, error) {
+	configs, ok := h.configManager.GetConfig("inventory_expand").([]designconfig.InventoryExpandData)
+	if !ok {
+		return nil, fmt.Errorf("inventory expand config not found")
+	}
+	for _, config := range configs {
+		if config.ID == expandTimes {
+			return &config, nil
+		}
+	}
+	return nil, fmt.Errorf("inventory expand config not found: %d", expandTimes)
+}
+
+// inventoryRules 根据 item 配置表生成背包叠加和整理规则
+// 整理时按物品类型、子类型排列，同一子类型中品质高的排在前面
+func (h *Handler) inventoryRules() models.InventoryRules {
+	items, _ := h.configManager.GetConfig("item").([]designconfig.ItemData)
+	sorted := append([]designconfig.ItemData(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Subtype != b.Subtype {
+			return a.Subtype < b.Subtype
+		}
+		if a.Color != b.Color {
+			return a.Color > b.Color
+		}
+		return a.ID < b.ID
+	})
+
+	rules := models.InventoryRules{
+		Stacks: make(map[int64]int32, len(sorted)),
+		Ranks:  make(map[int64]int32, len(sorted)),
+	}
+	for i, item := range sorted {
+		rules.Stacks[int64(item.ID)] = int32(item.Stack)
+		rules.Ranks[int64(item.ID)] = int32(i)
+	}
+	return rules
+}
+
+// newOverflowMail 创建背包放不下时补发物品的系统邮件，收件人和附件由数据库层在发放事务中填入
+func newOverflowMail(source string) *models.Mail {
+	return &models.Mail{
+		Type:       models.MailTypeSystem,
+		SenderName: systemMailSender,
+		Title:      "背包已满",
+		Content:    fmt.Sprintf("背包空间不足，%s中未能放入背包的物品已通过邮件发放，请整理背包后领取。", source),
+		ExpireAt:   mailExpireAt(0),
+	}
+}
+
+// notifyOverflowMail 发放事务中实际创建了补发邮件时通知玩家
+func (h *Handler) notifyOverflowMail(ctx context.Context, mail *models.Mail) {
+	if mail == nil || mail.ID == 0 {
+		return
+	}
+	h.notifyNewMails(ctx, []*models.Mail{mail})
+}
+
+// toPBInventory 将背包数据转换为协议背包，补充模板信息
+func (h *Handler) toPBInventory(inventory *models.Inventory) *pb.Inventory {
+	pbItems := make([]*pb.Item, 0, len(inventory.Items))
+	for _, item := range inventory.Items {
+		// 从内存配置中获取模板数据
+		template, err := h.getItemTemplate(item.TemplateID)
+		if err != nil {
+			utils.Error("Failed to get item template", zap.Int64("template_id", item.TemplateID), zap.Error(err))
+			continue
+		}
+
+		pbItems = append(pbItems, &pb.Item{
+			ItemId:     item.ID,
+			TemplateId: item.TemplateID,
+			Name:       template.Name,
+			Count:      item.Count,
+			Type:       int32(template.Type),
+			SubType:    int32(template.Subtype),
+			Color:      int32(template.Color),
+			Stack:      int32(template.Stack),
+			Equipped:   item.Equipped,
+			Position:   item.Position,
+		})
+	}
+
+	return &pb.Inventory{
+		Items:       pbItems,
+		Capacity:    inventory.Capacity,
+		Used:        inventory.UsedSlots(),
+		ExpandTimes: inventory.ExpandTimes,
+	}
+}
+
+// toPBItems 将配置中的物品（或货币）列表转换为协议物品，补充模板信息
+func (h *Handler) toPBItems(costs []designconfig.BaseItemCost) []*pb.Item {
+	items := make([]*pb.Item, 0, len(costs))
+	for _, cost := range costs {
+		item := &pb.Item{
+			TemplateId: int64(cost.ItemId),
+			Count:      int32(cost.Count),
+		}
+		if template, err := h.getItemTemplate(int64(cost.ItemId)); err == nil {
+			item.Name = template.Name
+			item.Type = int32(template.Type)
+			item.SubType = int32(template.Subtype)
+			item.Color = int32(template.Color)
+			item.Stack = int32(template.Stack)
+		} else if currency, err := h.getCurrencyTemplate(int64(cost.ItemId)); err == nil {
+			item.Name = currency.Name
+		}
+		items = append(items, item)
+	}
+	return items
 }
@@ -0,0 +1,417 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/itemcost"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// CurrencyReasonItemUse 使用道具的货币变动原因
+const CurrencyReasonItemUse = "item_use"
+
+// 可使用道具的类型和子类型，与 item 配置表一致
+const (
+	ItemTypeConsumable = 3 // 消耗品
+
+	ItemSubtypeChest    = 1 // 宝箱
+	ItemSubtypeExp      = 2 // 经验药水
+	ItemSubtypeCurrency = 3 // 货币袋
+	ItemSubtypePetEgg   = 4 // 宠物蛋
+	ItemSubtypeBuff     = 5 // 增益药水
+)
+
+const (
+	// maxItemUseCount 单次使用道具的最大数量
+	maxItemUseCount = 99
+	// maxItemUseRetries 并发修改等级经验时的最大重试次数
+	maxItemUseRetries = 3
+)
+
+// itemUse 一次道具使用的效果汇总，由各效果填充后组装为数据库订单
+type itemUse struct {
+	userID  int64
+	config  *designconfig.ItemUseData
+	count   int
+	rewards []designconfig.BaseItemCost // 获得的货币和物品
+	exp     int32
+	pets    []*models.Pet
+	buffs   []*models.BuffGrant
+}
+
+// itemEffect 道具使用效果，根据 item_use 配置把使用 count 个道具的效果写入 itemUse
+type itemEffect func(h *Handler, use *itemUse) error
+
+// itemEffectKey 道具效果注册键，对应 item 配置表的 type 和 subtype
+type itemEffectKey struct {
+	Type    int
+	Subtype int
+}
+
+// itemEffects 道具效果注册表
+// 新增已有效果的道具只需配置 item 和 item_use 表，新增效果类型时在这里注册
+var itemEffects = map[itemEffectKey]itemEffect{
+	{ItemTypeConsumable, ItemSubtypeChest}:    chestEffect,
+	{ItemTypeConsumable, ItemSubtypeExp}:      expEffect,
+	{ItemTypeConsumable, ItemSubtypeCurrency}: rewardEffect,
+	{ItemTypeConsumable, ItemSubtypePetEgg}:   petEggEffect,
+	{ItemTypeConsumable, ItemSubtypeBuff}:     buffEffect,
+}
+
+// chestEffect 打开宝箱，每个宝箱从掉落组中按权重抽取一项
+func chestEffect(h *Handler, use *itemUse) error {
+	drops := h.getItemDrops(use.config.DropID)
+	var totalWeight int
+	for _, drop := range drops {
+		totalWeight += drop.Weight
+	}
+	if totalWeight <= 0 {
+		return fmt.Errorf("item drop not found: %d", use.config.DropID)
+	}
+
+	for i := 0; i < use.count; i++ {
+		roll := rand.Intn(totalWeight)
+		for _, drop := range drops {
+			if roll < drop.Weight {
+				use.rewards = mergeItemCosts(use.rewards, designconfig.BaseItemCost{ItemId: drop.ItemID, Count: drop.Count})
+				break
+			}
+			roll -= drop.Weight
+		}
+	}
+	return nil
+}
+
+// expEffect 增加玩家经验
+func expEffect(h *Handler, use *itemUse) error {
+	if use.config.Exp <= 0 {
+		return fmt.Errorf("invalid item exp: %d", use.config.ID)
+	}
+	use.exp += int32(use.config.Exp * use.count)
+	return nil
+}
+
+// rewardEffect 直接发放配置的货币或物品
+func rewardEffect(h *Handler, use *itemUse) error {
+	if len(use.config.Rewards) == 0 {
+		return fmt.Errorf("invalid item rewards: %d", use.config.ID)
+	}
+	for _, reward := range scaleItemCosts(use.config.Rewards, use.count) {
+		use.rewards = mergeItemCosts(use.rewards, reward)
+	}
+	return nil
+}
+
+// petEggEffect 孵化宠物，每个宠物蛋孵化一只
+func petEggEffect(h *Handler, use *itemUse) error {
+	template, err := h.getPetTemplate(int64(use.config.PetID))
+	if err != nil {
+		return err
+	}
+	for i := 0; i < use.count; i++ {
+		use.pets = append(use.pets, &models.Pet{
+			UserID:     use.userID,
+			TemplateID: int64(template.ID),
+			Name:       template.Name,
+			Level:      1,
+		})
+	}
+	return nil
+}
+
+// buffEffect 获得增益，多个道具的持续时间累加
+func buffEffect(h *Handler, use *itemUse) error {
+	if _, err := h.getBuffTemplate(int64(use.config.BuffID)); err != nil {
+		return err
+	}
+	if use.config.Duration <= 0 {
+		return fmt.Errorf("invalid buff duration: %d", use.config.ID)
+	}
+	use.buffs = append(use.buffs, &models.BuffGrant{
+		BuffID:   int64(use.config.BuffID),
+		Duration: int64(use.config.Duration) * int64(use.count),
+	})
+	return nil
+}
+
+// UseItem 使用道具，按道具的 type 和 subtype 查找效果，扣除道具和发放效果在同一事务中完成
+func (h *Handler) UseItem(ctx context.Context, req *pb.UseItemRequest) (*pb.UseItemResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	count := req.Count
+	if count <= 0 {
+		return nil, fmt.Errorf("invalid count")
+	}
+	if count > maxItemUseCount {
+		return &pb.UseItemResponse{Success: false, Message: fmt.Sprintf("单次最多使用%d个", maxItemUseCount)}, nil
+	}
+	idempotencyKey, ok := requestKey("item_use", req.RequestId)
+	if !ok {
+		return &pb.UseItemResponse{Success: false, Message: "请求ID无效"}, nil
+	}
+
+	// 客户端未收到响应而重试时物品可能已用完，需在校验背包之前返回成功，避免把已完成的使用提示为失败
+	processed, err := h.dbClient.IsRequestProcessed(ctx, userID, idempotencyKey)
+	if err != nil {
+		utils.Error("IsRequestProcessed error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to use item")
+	}
+	if processed {
+		return h.processedUseItemResponse(ctx, userID)
+	}
+
+	inventory, err := h.dbClient.GetInventory(ctx, userID)
+	if err != nil {
+		utils.Error("GetInventory error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get inventory")
+	}
+	var item *models.InventoryItem
+	for _, it := range inventory.Items {
+		if it.ID == req.ItemId {
+			item = it
+			break
+		}
+	}
+	if item == nil || item.Equipped {
+		return &pb.UseItemResponse{Success: false, Message: "物品不存在"}, nil
+	}
+	if item.Count < count {
+		return &pb.UseItemResponse{Success: false, Message: "物品数量不足"}, nil
+	}
+
+	template, err := h.getItemTemplate(item.TemplateID)
+	if err != nil {
+		return &pb.UseItemResponse{Success: false, Message: "物品不存在"}, nil
+	}
+	effect, ok := itemEffects[itemEffectKey{Type: template.Type, Subtype: template.Subtype}]
+	if !ok {
+		return &pb.UseItemResponse{Success: false, Message: "该物品无法使用"}, nil
+	}
+	config, err := h.getItemUseConfig(item.TemplateID)
+	if err != nil {
+		return &pb.UseItemResponse{Success: false, Message: "该物品无法使用"}, nil
+	}
+
+	use := &itemUse{userID: userID, config: config, count: int(count)}
+	if err := effect(h, use); err != nil {
+		utils.Error("Failed to apply item effect", zap.Int64("userId", userID), zap.Int64("templateId", item.TemplateID), zap.Error(err))
+		return nil, fmt.Errorf("failed to use item")
+	}
+	if use.exp, err = h.buffExp(ctx, userID, use.exp); err != nil {
+		utils.Error("Failed to apply buff exp", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to use item")
+	}
+
+	changes, items := h.costs.Split(use.rewards, 1)
+	order := &models.ItemUseOrder{
+		UserID:          userID,
+		ItemID:          item.ID,
+		TemplateID:      item.TemplateID,
+		Count:           count,
+		CurrencyChanges: itemcost.MergeCurrencyChanges(changes),
+		Items:           items,
+		Inventory:       h.inventoryRules(),
+		OverflowMail:    newOverflowMail("使用" + template.Name),
+		Pets:            use.pets,
+		Buffs:           use.buffs,
+		Reason:          CurrencyReasonItemUse,
+		Source:          "item:" + strconv.FormatInt(item.TemplateID, 10),
+		IdempotencyKey:  idempotencyKey,
+	}
+
+	for attempt := 0; attempt < maxItemUseRetries; attempt++ {
+		if use.exp > 0 {
+			if order.Level, err = h.buildLevelChange(ctx, userID, use.exp); err != nil {
+				utils.Error("Failed to build level change", zap.Int64("userId", userID), zap.Error(err))
+				return nil, fmt.Errorf("failed to use item")
+			}
+		}
+		err = h.dbClient.UseItem(ctx, order)
+		if !errors.Is(err, interfaces.ErrUserLevelChanged) {
+			break
+		}
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, interfaces.ErrDuplicateRequest):
+			return h.processedUseItemResponse(ctx, userID)
+		case errors.Is(err, interfaces.ErrInsufficientItems):
+			return &pb.UseItemResponse{Success: false, Message: "物品数量不足"}, nil
+		case errors.Is(err, interfaces.ErrUserLevelChanged):
+			return &pb.UseItemResponse{Success: false, Message: "操作过于频繁，请稍后再试"}, nil
+		}
+		utils.Error("UseItem error", zap.Int64("userId", userID), zap.Int64("itemId", req.ItemId), zap.Error(err))
+		return nil, fmt.Errorf("failed to use item")
+	}
+
+	// 失效相关缓存
+	if err := h.cacheService.InvalidateInventoryCache(ctx, userID); err != nil {
+		utils.Error("Failed to invalidate inventory cache", zap.Error(err))
+	}
+	if len(order.Pets) > 0 {
+		if err := h.cacheService.InvalidateUserPetsCache(ctx, userID); err != nil {
+			utils.Error("Failed to invalidate pets cache", zap.Error(err))
+		}
+	}
+	if order.Level != nil {
+		h.afterLevelExpChanged(ctx, userID, models.EffectiveUserLevel(order.Level.OldLevel), order.Level.NewLevel, ExpSourceItem)
+	}
+	if len(order.Buffs) > 0 {
+		h.refreshUserAttributes(ctx, userID)
+	}
+	h.notifyOverflowMail(ctx, order.OverflowMail)
+
+	utils.Info("Item used", zap.Int64("userId", userID), zap.Int64("templateId", item.TemplateID), zap.Int32("count", count))
+	resp := &pb.UseItemResponse{
+		Success: true,
+		Message: "使用成功",
+		Rewards: h.toPBItems(use.rewards),
+		Exp:     use.exp,
+	}
+	if order.Level != nil {
+		resp.Level = order.Level.NewLevel
+	}
+	for _, pet := range order.Pets {
+		resp.Pets = append(resp.Pets, &pb.Pet{
+			Id:         pet.ID,
+			TemplateId: pet.TemplateID,
+			Name:       pet.Name,
+			Level:      pet.Level,
+		})
+	}
+	for _, buff := range order.Buffs {
+		resp.Buffs = append(resp.Buffs, h.toPBBuff(buff.BuffID, buff.ExpireAt))
+	}
+	return resp, nil
+}
+
+// processedUseItemResponse 已处理过的使用请求返回成功，随机奖励无法重现，只返回当前等级
+func (h *Handler) processedUseItemResponse(ctx context.Context, userID int64) (*pb.UseItemResponse, error) {
+	user, err := h.dbClient.GetUser(ctx, userID)
+	if err != nil || user == nil {
+		utils.Error("GetUser error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get user")
+	}
+	return &pb.UseItemResponse{
+		Success: true,
+		Message: "请求已处理",
+		Level:   models.EffectiveUserLevel(user.Level),
+	}, nil
+}
+
+// GetBuffs 获取玩家生效中的增益
+func (h *Handler) GetBuffs(ctx context.Context, req *pb.GetBuffsRequest) (*pb.GetBuffsResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	buffs, err := h.dbClient.GetUserBuffs(ctx, userID, time.Now().Unix())
+	if err != nil {
+		utils.Error("GetUserBuffs error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get buffs")
+	}
+
+	resp := &pb.GetBuffsResponse{Buffs: make([]*pb.Buff, 0, len(buffs))}
+	for _, buff := range buffs {
+		resp.Buffs = append(resp.Buffs, h.toPBBuff(buff.BuffID, buff.ExpireAt))
+	}
+	return resp, nil
+}
+
+// buildLevelChange 读取玩家当前等级经验，计算增加经验后的变化
+func (h *Handler) buildLevelChange(ctx context.Context, userID int64, exp int32) (*models.UserLevelChange, error) {
+	levels, ok := h.configManager.GetConfig("level").([]designconfig.LevelData)
+	if !ok || len(levels) == 0 {
+		return nil, fmt.Errorf("level config not found")
+	}
+
+	user, err := h.dbClient.GetUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user not found: %d", userID)
+	}
+
+	newLevel, newExp := applyExp(levels, user.Level, user.Exp, exp)
+	return &models.UserLevelChange{
+		OldLevel: user.Level,
+		OldExp:   user.Exp,
+		NewLevel: newLevel,
+		NewExp:   newExp,
+	}, nil
+}
+
+// getItemUseConfig 获取道具的使用配置
+func (h *Handler) getItemUseConfig(templateID int64) (*designconfig.ItemUseData, error) {
+	configs, ok := h.configManager.GetConfig("item_use").([]designconfig.ItemUseData)
+	if !ok {
+		return nil, fmt.Errorf("item use config not found")
+	}
+	for _, config := range configs {
+		if int64(config.ID) == templateID {
+			return &config, nil
+		}
+	}
+	return nil, fmt.Errorf("item use config not found: %d", templateID)
+}
+
+// getItemDrops 获取掉落组的所有掉落项
+func (h *Handler) getItemDrops(dropID int) []designconfig.ItemDropData {
+	configs, _ := h.configManager.GetConfig("item_drop").([]designconfig.ItemDropData)
+	var drops []designconfig.ItemDropData
+	for _, drop := range configs {
+		if drop.DropID == dropID && drop.Weight > 0 {
+			drops = append(drops, drop)
+		}
+	}
+	return drops
+}
+
+// getBuffTemplate 从内存配置中获取增益模板
+func (h *Handler) getBuffTemplate(buffID int64) (*designconfig.BuffData, error) {
+	buffs, ok := h.configManager.GetConfig("buff").([]designconfig.BuffData)
+	if !ok {
+		return nil, fmt.Errorf("buff config not found")
+	}
+	for _, buff := range buffs {
+		if int64(buff.ID) == buffID {
+			return &buff, nil
+		}
+	}
+	return nil, fmt.Errorf("buff template not found: %d", buffID)
+}
+
+func (h *Handler) toPBBuff(buffID int64, expireAt int64) *pb.Buff {
+	buff := &pb.Buff{BuffId: buffID, ExpireAt: expireAt}
+	if template, err := h.getBuffTemplate(buffID); err == nil {
+		buff.Name = template.Name
+	}
+	return buff
+}
+
+// mergeItemCosts 将物品累加到列表中，同一物品合并为一项
+func mergeItemCosts(costs []designconfig.BaseItemCost, add designconfig.BaseItemCost) []designconfig.BaseItemCost {
+	for i := range costs {
+		if costs[i].ItemId == add.ItemId {
+			costs[i].Count += add.Count
+			return costs
+		}
+	}
+	return append(costs, add)
+}
@@ -0,0 +1,197 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/mq"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// 经验来源
+const (
+	ExpSourceBattle      = "battle"
+	ExpSourceQuest       = "quest"
+	ExpSourceMonthlySign = "monthly_sign"
+	ExpSourceItem        = "item"
+)
+
+// maxAddExpRetries 并发修改等级经验时的最大重试次数
+const maxAddExpRetries = 3
+
+// LevelUpEvent 玩家升级事件，发送到Kafka供其他系统消费
+type LevelUpEvent struct {
+	UserID    int64  `json:"user_id"`
+	OldLevel  int32  `json:"old_level"`
+	NewLevel  int32  `json:"new_level"`
+	Source    string `json:"source"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// applyExp 根据等级配置计算加经验后的等级和经验
+// 配置中每级的exp为升到下一级所需经验，支持一次连升多级；达到最高等级后多余经验作废
+func applyExp(levels []designconfig.LevelData, level, exp, add int32) (int32, int32) {
+	level = models.EffectiveUserLevel(level)
+	required := make(map[int32]int32, len(levels))
+	var maxLevel int32
+	for _, l := range levels {
+		required[int32(l.Level)] = int32(l.Exp)
+		if int32(l.Level) > maxLevel {
+			maxLevel = int32(l.Level)
+		}
+	}
+
+	exp += add
+	for level < maxLevel {
+		need, ok := required[level]
+		if !ok || need <= 0 || exp < need {
+			break
+		}
+		level++
+		exp -= need
+	}
+	if level >= maxLevel {
+		level = maxLevel
+		exp = 0
+	}
+	return level, exp
+}
+
+// applyExpRate 按万分比加成计算实际获得的经验
+func applyExpRate(exp int32, rate int) int32 {
+	total := int64(exp) + int64(exp)*int64(rate)/10000
+	if total > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	if total < 0 {
+		return 0
+	}
+	return int32(total)
+}
+
+// buffExp 按玩家生效中增益的经验加成计算实际获得的经验，多个增益的加成相加
+func (h *Handler) buffExp(ctx context.Context, userID int64, exp int32) (int32, error) {
+	if exp <= 0 {
+		return exp, nil
+	}
+	buffs, err := h.dbClient.GetUserBuffs(ctx, userID, time.Now().Unix())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get buffs: %w", err)
+	}
+	rate := 0
+	for _, buff := range buffs {
+		if template, err := h.getBuffTemplate(buff.BuffID); err == nil {
+			rate += template.ExpRate
+		}
+	}
+	return applyExpRate(exp, rate), nil
+}
+
+// AddExp 增加玩家经验并处理升级，供战斗、任务、签到等系统调用，生效中的增益按配置加成经验
+func (h *Handler) AddExp(ctx context.Context, userID int64, exp int32, source string) (*pb.AddUserExpResponse, error) {
+	configData := h.configManager.GetConfig("level")
+	levels, ok := configData.([]designconfig.LevelData)
+	if !ok || len(levels) == 0 {
+		return nil, fmt.Errorf("level config not found")
+	}
+	exp, err := h.buffExp(ctx, userID, exp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < maxAddExpRetries; i++ {
+		user, err := h.dbClient.GetUser(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		if user == nil {
+			return &pb.AddUserExpResponse{Success: false, Message: "用户不存在"}, nil
+		}
+
+		newLevel, newExp := applyExp(levels, user.Level, user.Exp, exp)
+		updated, err := h.dbClient.UpdateUserLevelExp(ctx, userID, user.Level, user.Exp, newLevel, newExp)
+		if err != nil {
+			return nil, err
+		}
+		if !updated {
+			// 等级经验已被其他请求修改，重新读取后重试
+			continue
+		}
+
+		oldLevel := models.EffectiveUserLevel(user.Level)
+		h.afterLevelExpChanged(ctx, userID, oldLevel, newLevel, source)
+
+		levelsGained := newLevel - oldLevel
+
+		utils.Info("User exp added", zap.Int64("userId", userID), zap.Int32("exp", exp), zap.String("source", source),
+			zap.Int32("level", newLevel), zap.Int32("levelsGained", levelsGained))
+		return &pb.AddUserExpResponse{
+			Success:      true,
+			Message:      "经验增加成功",
+			Level:        newLevel,
+			Exp:          newExp,
+			LevelsGained: levelsGained,
+		}, nil
+	}
+
+	return &pb.AddUserExpResponse{Success: false, Message: "操作频繁，请稍后重试"}, nil
+}
+
+// afterLevelExpChanged 等级经验写入后失效玩家信息缓存，升级时刷新属性并发送升级事件
+func (h *Handler) afterLevelExpChanged(ctx context.Context, userID int64, oldLevel, newLevel int32, source string) {
+	if err := h.cacheService.InvalidateUserInfoCache(ctx, userID); err != nil {
+		utils.Error("Failed to invalidate user info cache", zap.Error(err))
+	}
+
+	if newLevel > oldLevel {
+		h.refreshUserAttributes(ctx, userID)
+		h.publishLevelUp(ctx, &LevelUpEvent{
+			UserID:    userID,
+			OldLevel:  oldLevel,
+			NewLevel:  newLevel,
+			Source:    source,
+			Timestamp: time.Now().Unix(),
+		})
+	}
+}
+
+// AddUserExp 增加玩家经验
+func (h *Handler) AddUserExp(ctx context.Context, req *pb.AddUserExpRequest) (*pb.AddUserExpResponse, error) {
+	if req.UserId == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	if req.Exp <= 0 {
+		return nil, fmt.Errorf("invalid exp value")
+	}
+
+	return h.AddExp(ctx, req.UserId, req.Exp, req.Source)
+}
+
+// publishLevelUp 发送升级事件，Kafka错误不影响升级流程
+func (h *Handler) publishLevelUp(ctx context.Context, event *LevelUpEvent) {
+	producer, err := h.kafkaFactory.GetProducer(mq.UserLevelUp)
+	if err != nil {
+		utils.Error("Failed to get level up producer", zap.Error(err))
+		return
+	}
+
+	value, err := json.Marshal(event)
+	if err != nil {
+		utils.Error("Failed to marshal level up event", zap.Error(err))
+		return
+	}
+
+	key := []byte(strconv.FormatInt(event.UserID, 10))
+	if err := producer.SendMessage(ctx, key, value); err != nil {
+		utils.Error("Failed to send level up event to kafka", zap.Error(err))
+	}
+}
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"math"
+	"testing"
+)
+
+func TestApplyExpRate(t *testing.T) {
+	tests := []struct {
+		name string
+		exp  int32
+		rate int
+		want int32
+	}{
+		{name: "no buff", exp: 500, rate: 0, want: 500},
+		{name: "double exp", exp: 500, rate: 10000, want: 1000},
+		{name: "stacked buffs", exp: 500, rate: 15000, want: 1250},
+		{name: "rounds down", exp: 3, rate: 5000, want: 4},
+		{name: "capped at int32", exp: math.MaxInt32 / 2, rate: 20000, want: math.MaxInt32},
+		{name: "negative rate never goes below zero", exp: 500, rate: -20000, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyExpRate(tt.exp, tt.rate); got != tt.want {
+				t.Errorf("applyExpRate(%d, %d) = %d, want %d", tt.exp, tt.rate, got, tt.want)
+			}
+		})
+	}
+}
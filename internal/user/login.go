@@ -7,6 +7,7 @@ import (
 
 	"github.xubinbest.com/go-game-server/internal/auth"
 	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/gameevent"
 	"github.xubinbest.com/go-game-server/internal/pb"
 	"github.xubinbest.com/go-game-server/internal/utils"
 
@@ -43,6 +44,8 @@ func (h *Handler) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.Re
 		Email:        email,
 		CreatedAt:    time.Now(),
 		Role:         "user",
+		Level:        models.InitialUserLevel,
+		Rating:       models.DefaultUserRating,
 	}
 	err = h.dbClient.CreateUser(ctx, user)
 	if err != nil {
@@ -80,6 +83,8 @@ func (h *Handler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginRes
 		return nil, fmt.Errorf("failed to store token")
 	}
 
+	h.events.Emit(ctx, user.ID, gameevent.Login, 1)
+
 	return &pb.LoginResponse{
 		UserId:    user.ID,
 		Token:     token,
@@ -0,0 +1,370 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/push"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultMailExpireDays 系统邮件和全服邮件默认有效天数
+	defaultMailExpireDays = 30
+	// playerMailExpireDays 玩家邮件有效天数
+	playerMailExpireDays = 7
+	// maxMailTitleLen 邮件标题最大字数
+	maxMailTitleLen = 30
+	// maxMailContentLen 邮件正文最大字数
+	maxMailContentLen = 500
+	// maxMailAttachments 单封邮件最多附件数
+	maxMailAttachments = 10
+	// systemMailSender 系统邮件发件人名称
+	systemMailSender = "系统"
+)
+
+// GetMails 获取邮件列表，拉取前先投递玩家尚未收到的全服邮件
+func (h *Handler) GetMails(ctx context.Context, req *pb.GetMailsRequest) (*pb.GetMailsResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	user, err := h.cacheService.GetUserInfoWithCache(ctx, userID, func() (*models.User, error) {
+		return h.dbClient.GetUser(ctx, userID)
+	})
+	if err != nil {
+		utils.Error("GetMails get user error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get user")
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	now := time.Now().Unix()
+	// 注册之前发出的全服邮件不投递给新玩家
+	if err := h.dbClient.SyncBroadcastMails(ctx, userID, user.CreatedAt.Unix(), now); err != nil {
+		utils.Error("Failed to sync broadcast mails", zap.Int64("userId", userID), zap.Error(err))
+	}
+
+	mails, err := h.dbClient.GetUserMails(ctx, userID, now)
+	if err != nil {
+		utils.Error("GetUserMails error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get mails")
+	}
+
+	resp := &pb.GetMailsResponse{Mails: make([]*pb.Mail, 0, len(mails))}
+	for _, mail := range mails {
+		resp.Mails = append(resp.Mails, h.toPBMail(mail))
+		if !mail.IsRead {
+			resp.UnreadCount++
+		}
+	}
+	return resp, nil
+}
+
+// ReadMail 阅读邮件并标记为已读
+func (h *Handler) ReadMail(ctx context.Context, req *pb.ReadMailRequest) (*pb.ReadMailResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	mail, err := h.dbClient.GetMail(ctx, userID, req.MailId)
+	if err != nil {
+		utils.Error("GetMail error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get mail")
+	}
+	if mail == nil {
+		return &pb.ReadMailResponse{Success: false, Message: "邮件不存在"}, nil
+	}
+	if mail.ExpireAt <= time.Now().Unix() {
+		return &pb.ReadMailResponse{Success: false, Message: "邮件已过期"}, nil
+	}
+
+	if !mail.IsRead {
+		if err := h.dbClient.MarkMailRead(ctx, userID, mail.ID); err != nil {
+			utils.Error("MarkMailRead error", zap.Error(err))
+			return nil, fmt.Errorf("failed to read mail")
+		}
+		mail.IsRead = true
+	}
+
+	return &pb.ReadMailResponse{
+		Success: true,
+		Message: "读取成功",
+		Mail:    h.toPBMail(mail),
+	}, nil
+}
+
+// ClaimMail 领取邮件附件，附件物品与领取标记在同一事务中写入，重复领取会被拒绝
+func (h *Handler) ClaimMail(ctx context.Context, req *pb.ClaimMailRequest) (*pb.ClaimMailResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	mail, err := h.dbClient.ClaimMailAttachments(ctx, userID, req.MailId, h.inventoryRules(), time.Now().Unix())
+	if err != nil {
+		switch {
+		case errors.Is(err, interfaces.ErrMailNotFound):
+			return &pb.ClaimMailResponse{Success: false, Message: "邮件不存在"}, nil
+		case errors.Is(err, interfaces.ErrMailExpired):
+			return &pb.ClaimMailResponse{Success: false, Message: "邮件已过期"}, nil
+		case errors.Is(err, interfaces.ErrMailNoAttachments):
+			return &pb.ClaimMailResponse{Success: false, Message: "邮件没有附件"}, nil
+		case errors.Is(err, interfaces.ErrMailAlreadyClaimed):
+			return &pb.ClaimMailResponse{Success: false, Message: "附件已领取"}, nil
+		case errors.Is(err, interfaces.ErrInventoryFull):
+			return &pb.ClaimMailResponse{Success: false, Message: "背包空间不足"}, nil
+		}
+		utils.Error("ClaimMailAttachments error", zap.Int64("userId", userID), zap.Int64("mailId", req.MailId), zap.Error(err))
+		return nil, fmt.Errorf("failed to claim mail")
+	}
+
+	// 失效背包缓存
+	if err := h.cacheService.InvalidateInventoryCache(ctx, userID); err != nil {
+		utils.Error("Failed to invalidate inventory cache", zap.Error(err))
+	}
+
+	utils.Info("Mail attachments claimed", zap.Int64("userId", userID), zap.Int64("mailId", mail.ID))
+	return &pb.ClaimMailResponse{
+		Success: true,
+		Message: "领取成功",
+		Rewards: h.toPBItems(mail.Attachments),
+	}, nil
+}
+
+// DeleteMail 删除邮件，附件未领取且未过期的邮件不能删除
+func (h *Handler) DeleteMail(ctx context.Context, req *pb.DeleteMailRequest) (*pb.DeleteMailResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	mail, err := h.dbClient.GetMail(ctx, userID, req.MailId)
+	if err != nil {
+		utils.Error("GetMail error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get mail")
+	}
+	if mail == nil {
+		return &pb.DeleteMailResponse{Success: false, Message: "邮件不存在"}, nil
+	}
+	if mail.HasAttachments() && !mail.IsClaimed && mail.ExpireAt > time.Now().Unix() {
+		return &pb.DeleteMailResponse{Success: false, Message: "请先领取附件"}, nil
+	}
+
+	if err := h.dbClient.DeleteMail(ctx, userID, mail.ID); err != nil {
+		utils.Error("DeleteMail error", zap.Error(err))
+		return nil, fmt.Errorf("failed to delete mail")
+	}
+
+	return &pb.DeleteMailResponse{Success: true, Message: "删除成功"}, nil
+}
+
+// SendMail 给其他玩家发送邮件，玩家邮件不带附件
+func (h *Handler) SendMail(ctx context.Context, req *pb.SendMailRequest) (*pb.SendMailResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	if req.ReceiverId == 0 || req.ReceiverId == userID {
+		return &pb.SendMailResponse{Success: false, Message: "收件人无效"}, nil
+	}
+	if msg := validateMailText(req.Title, req.Content); msg != "" {
+		return &pb.SendMailResponse{Success: false, Message: msg}, nil
+	}
+
+	sender, err := h.dbClient.GetUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if sender == nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	receiver, err := h.dbClient.GetUser(ctx, req.ReceiverId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receiver: %w", err)
+	}
+	if receiver == nil {
+		return &pb.SendMailResponse{Success: false, Message: "收件人不存在"}, nil
+	}
+
+	mail := &models.Mail{
+		UserID:     req.ReceiverId,
+		Type:       models.MailTypePlayer,
+		SenderID:   userID,
+		SenderName: sender.Username,
+		Title:      req.Title,
+		Content:    req.Content,
+		ExpireAt:   time.Now().AddDate(0, 0, playerMailExpireDays).Unix(),
+	}
+	if err := h.dbClient.CreateMails(ctx, []*models.Mail{mail}); err != nil {
+		utils.Error("CreateMails error", zap.Error(err))
+		return nil, fmt.Errorf("failed to send mail")
+	}
+
+	h.notifyNewMails(ctx, []*models.Mail{mail})
+	return &pb.SendMailResponse{Success: true, Message: "发送成功", MailId: mail.ID}, nil
+}
+
+// SendSystemMail 给指定玩家发送系统邮件，用于补偿、活动奖励等
+func (h *Handler) SendSystemMail(ctx context.Context, req *pb.SendSystemMailRequest) (*pb.SendSystemMailResponse, error) {
+	if len(req.UserIds) == 0 {
+		return &pb.SendSystemMailResponse{Success: false, Message: "收件人不能为空"}, nil
+	}
+	if msg := validateMailText(req.Title, req.Content); msg != "" {
+		return &pb.SendSystemMailResponse{Success: false, Message: msg}, nil
+	}
+	attachments, err := h.buildMailAttachments(req.Attachments)
+	if err != nil {
+		return &pb.SendSystemMailResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	expireAt := mailExpireAt(req.ExpireDays)
+	seen := make(map[int64]bool, len(req.UserIds))
+	mails := make([]*models.Mail, 0, len(req.UserIds))
+	for _, userID := range req.UserIds {
+		if userID == 0 || seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		mails = append(mails, &models.Mail{
+			UserID:      userID,
+			Type:        models.MailTypeSystem,
+			SenderName:  systemMailSender,
+			Title:       req.Title,
+			Content:     req.Content,
+			Attachments: attachments,
+			ExpireAt:    expireAt,
+		})
+	}
+
+	if err := h.dbClient.CreateMails(ctx, mails); err != nil {
+		utils.Error("CreateMails error", zap.Error(err))
+		return nil, fmt.Errorf("failed to send system mail")
+	}
+
+	utils.Info("System mail sent", zap.Int("count", len(mails)), zap.String("title", req.Title))
+	h.notifyNewMails(ctx, mails)
+	return &pb.SendSystemMailResponse{Success: true, Message: "发送成功"}, nil
+}
+
+// SendBroadcastMail 发送全服邮件，玩家下次拉取邮箱时投递
+func (h *Handler) SendBroadcastMail(ctx context.Context, req *pb.SendBroadcastMailRequest) (*pb.SendBroadcastMailResponse, error) {
+	if msg := validateMailText(req.Title, req.Content); msg != "" {
+		return &pb.SendBroadcastMailResponse{Success: false, Message: msg}, nil
+	}
+	attachments, err := h.buildMailAttachments(req.Attachments)
+	if err != nil {
+		return &pb.SendBroadcastMailResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	broadcast := &models.BroadcastMail{
+		Title:       req.Title,
+		Content:     req.Content,
+		Attachments: attachments,
+		ExpireAt:    mailExpireAt(req.ExpireDays),
+	}
+	if err := h.dbClient.CreateBroadcastMail(ctx, broadcast); err != nil {
+		utils.Error("CreateBroadcastMail error", zap.Error(err))
+		return nil, fmt.Errorf("failed to send broadcast mail")
+	}
+
+	utils.Info("Broadcast mail sent", zap.Int64("broadcastId", broadcast.ID), zap.String("title", req.Title))
+
+	// 通知在线玩家有新邮件，客户端收到后重新拉取邮件列表
+	notify := &pb.NewMailNotify{Mail: &pb.Mail{
+		Type:        models.MailTypeBroadcast,
+		SenderName:  systemMailSender,
+		Title:       broadcast.Title,
+		Attachments: h.toPBItems(broadcast.Attachments),
+		ExpireAt:    broadcast.ExpireAt,
+		CreatedAt:   broadcast.CreatedAt,
+	}}
+	if err := h.pusher.PushToAll(ctx, push.EventNewMail, notify); err != nil {
+		utils.Error("Failed to push broadcast mail", zap.Error(err))
+	}
+
+	return &pb.SendBroadcastMailResponse{Success: true, Message: "发送成功", BroadcastId: broadcast.ID}, nil
+}
+
+// notifyNewMails 通知在线收件人有新邮件，推送失败不影响发信
+func (h *Handler) notifyNewMails(ctx context.Context, mails []*models.Mail) {
+	for _, mail := range mails {
+		notify := &pb.NewMailNotify{Mail: h.toPBMail(mail)}
+		if err := h.pusher.PushToUser(ctx, mail.UserID, push.EventNewMail, notify); err != nil {
+			utils.Error("Failed to push new mail", zap.Int64("userId", mail.UserID), zap.Error(err))
+		}
+	}
+}
+
+// buildMailAttachments 校验并转换邮件附件
+func (h *Handler) buildMailAttachments(attachments []*pb.MailAttachment) ([]designconfig.BaseItemCost, error) {
+	if len(attachments) > maxMailAttachments {
+		return nil, fmt.Errorf("附件数量不能超过%d个", maxMailAttachments)
+	}
+
+	items := make([]designconfig.BaseItemCost, 0, len(attachments))
+	for _, attachment := range attachments {
+		if attachment.Count <= 0 {
+			return nil, fmt.Errorf("附件数量无效")
+		}
+		if _, err := h.getItemTemplate(attachment.TemplateId); err != nil {
+			return nil, fmt.Errorf("附件物品不存在: %d", attachment.TemplateId)
+		}
+		items = append(items, designconfig.BaseItemCost{
+			ItemId: int(attachment.TemplateId),
+			Count:  int(attachment.Count),
+		})
+	}
+	return items, nil
+}
+
+// validateMailText 校验邮件标题和正文，返回错误提示，合法时返回空字符串
+func validateMailText(title, content string) string {
+	titleLen := utf8.RuneCountInString(title)
+	if titleLen == 0 {
+		return "标题不能为空"
+	}
+	if titleLen > maxMailTitleLen {
+		return fmt.Sprintf("标题不能超过%d个字", maxMailTitleLen)
+	}
+	if utf8.RuneCountInString(content) > maxMailContentLen {
+		return fmt.Sprintf("正文不能超过%d个字", maxMailContentLen)
+	}
+	return ""
+}
+
+// mailExpireAt 计算邮件过期时间，expireDays不大于0时使用默认有效天数
+func mailExpireAt(expireDays int32) int64 {
+	if expireDays <= 0 {
+		expireDays = defaultMailExpireDays
+	}
+	return time.Now().AddDate(0, 0, int(expireDays)).Unix()
+}
+
+func (h *Handler) toPBMail(mail *models.Mail) *pb.Mail {
+	return &pb.Mail{
+		MailId:      mail.ID,
+		Type:        mail.Type,
+		SenderId:    mail.SenderID,
+		SenderName:  mail.SenderName,
+		Title:       mail.Title,
+		Content:     mail.Content,
+		Attachments: h.toPBItems(mail.Attachments),
+		IsRead:      mail.IsRead,
+		IsClaimed:   mail.IsClaimed,
+		ExpireAt:    mail.ExpireAt,
+		CreatedAt:   mail.CreatedAt,
+	}
+}
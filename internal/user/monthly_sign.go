@@ -3,6 +3,7 @@ package user
 // 月签到功能实现
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,33 +11,68 @@ import (
 	"github.xubinbest.com/go-game-server/internal/db/interfaces"
 	"github.xubinbest.com/go-game-server/internal/db/models"
 	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/gameclock"
 	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// 签到货币变动原因
+const (
+	CurrencyReasonSignMakeup = "sign_makeup"
+	CurrencyReasonSignReward = "sign_reward"
 )
 
+const (
+	// defaultSignHistoryLimit 签到历史默认返回的月份数
+	defaultSignHistoryLimit = 12
+	// maxSignHistoryLimit 签到历史最多返回的月份数
+	maxSignHistoryLimit = 36
+)
+
+// signItemOperator 签到事务中扣除消耗、发放奖励的操作，由 Handler 实现
+type signItemOperator interface {
+	consumeCosts(ctx context.Context, tx interfaces.Tx, userID int64, costs []designconfig.BaseItemCost, reason, source, idempotencyKey string) error
+	grantRewards(ctx context.Context, tx interfaces.Tx, userID int64, rewards []designconfig.BaseItemCost, reason, source, idempotencyKey string, overflowMail *models.Mail) error
+	notifyOverflowMail(ctx context.Context, mail *models.Mail)
+}
+
 // MonthlySignService 月签到服务
 type MonthlySignService struct {
 	dbClient      interfaces.UserDatabase
+	unitOfWork    interfaces.UnitOfWork
+	items         signItemOperator
 	configManager *designconfig.DesignConfigManager
 	cache         cache.Cache
 	cacheService  *CacheService
+	clock         *gameclock.GameClock
 }
 
 // NewMonthlySignService 创建月签到服务
-func NewMonthlySignService(dbClient interfaces.UserDatabase, configManager *designconfig.DesignConfigManager, cache cache.Cache, cacheService *CacheService) *MonthlySignService {
+// 签到记录与消耗、奖励通过 unitOfWork 在同一事务中写入
+func NewMonthlySignService(dbClient interfaces.UserDatabase, unitOfWork interfaces.UnitOfWork, items signItemOperator, configManager *designconfig.DesignConfigManager, cache cache.Cache, cacheService *CacheService, clock *gameclock.GameClock) *MonthlySignService {
 	return &MonthlySignService{
 		dbClient:      dbClient,
+		unitOfWork:    unitOfWork,
+		items:         items,
 		configManager: configManager,
 		cache:         cache,
 		cacheService:  cacheService,
+		clock:         clock,
 	}
 }
 
+// signDate 获取 now 所属游戏日的年、月、日，重置时刻之前仍算作前一天
+func (s *MonthlySignService) signDate(now time.Time) (year, month, day int32) {
+	y, m, d := s.clock.Date(now)
+	return int32(y), int32(m), int32(d)
+}
+
 // GetMonthlySignInfo 获取月签到信息
 func (s *MonthlySignService) GetMonthlySignInfo(ctx context.Context, userID int64) (*pb.MonthlySignInfo, error) {
-	now := time.Now()
-	year := int32(now.Year())
-	month := int32(now.Month())
-	today := int32(now.Day())
+	now := s.clock.Now()
+	year, month, today := s.signDate(now)
 
 	// 使用缓存获取用户月签到记录
 	sign, err := s.cacheService.GetMonthlySignWithCache(ctx, userID, func() (*models.MonthlySign, error) {
@@ -46,8 +82,8 @@ func (s *MonthlySignService) GetMonthlySignInfo(ctx context.Context, userID int6
 		return nil, fmt.Errorf("failed to get monthly sign: %w", err)
 	}
 
-	// 如果没有签到记录，创建新的
-	if sign == nil {
+	// 如果没有签到记录或记录属于之前的月份，按本月未签到展示，归档在下次签到时完成
+	if sign == nil || sign.Year != year || sign.Month != month {
 		sign = &models.MonthlySign{
 			UserID:    userID,
 			Year:      year,
@@ -67,25 +103,32 @@ func (s *MonthlySignService) GetMonthlySignInfo(ctx context.Context, userID int6
 	// 获取已签到的日期列表（用于返回给客户端）
 	signDaysList := GetSetBits(sign.SignDays)
 
-	return &pb.MonthlySignInfo{
+	info := &pb.MonthlySignInfo{
 		Year:          year,
 		Month:         month,
 		SignDays:      signDaysList,
 		TotalSignDays: totalSignDays,
 		CanSignToday:  canSignToday,
 		Today:         today,
-	}, nil
+		MakeupCount:   sign.MakeupCount,
+		MakeupLimit:   int32(len(s.getMakeupConfigs())),
+	}
+
+	// 返回下一次补签的消耗
+	if makeup := s.getMakeupConfig(sign.MakeupCount + 1); makeup != nil {
+		info.MakeupCost = toSignItems(makeup.Cost)
+	}
+
+	return info, nil
 }
 
 // MonthlySign 执行月签到
 func (s *MonthlySignService) MonthlySign(ctx context.Context, userID int64) (*pb.MonthlySignResponse, error) {
-	now := time.Now()
-	year := int32(now.Year())
-	month := int32(now.Month())
-	today := int32(now.Day())
+	now := s.clock.Now()
+	year, month, today := s.signDate(now)
 
-	// 使用分布式锁确保并发安全
-	lockKey := fmt.Sprintf("monthly_sign:%d:%d:%d", userID, year, month)
+	// 使用分布式锁确保并发安全，锁按玩家区分，跨月前后的签到和补签也互斥
+	lockKey := signLockKey(userID)
 	err := s.cache.Lock(ctx, lockKey, 30*time.Second, 5*time.Second)
 	if err != nil {
 		return &pb.MonthlySignResponse{
@@ -95,10 +138,8 @@ func (s *MonthlySignService) MonthlySign(ctx context.Context, userID int64) (*pb
 	}
 	defer s.cache.Unlock(ctx, lockKey)
 
-	// 使用缓存获取用户月签到记录
-	sign, err := s.cacheService.GetMonthlySignWithCache(ctx, userID, func() (*models.MonthlySign, error) {
-		return s.dbClient.GetMonthlySign(ctx, userID)
-	})
+	// 获取本月签到记录，跨月时先归档上月记录
+	sign, err := s.currentMonthSign(ctx, userID, now)
 	if err != nil {
 		return &pb.MonthlySignResponse{
 			Success: false,
@@ -106,19 +147,6 @@ func (s *MonthlySignService) MonthlySign(ctx context.Context, userID int64) (*pb
 		}, err
 	}
 
-	// 如果没有签到记录，创建新的
-	if sign == nil {
-		sign = &models.MonthlySign{
-			UserID:       userID,
-			Year:         year,
-			Month:        month,
-			SignDays:     0, // 位图初始化为0
-			LastSignTime: time.Time{},
-			CreatedAt:    now,
-			UpdatedAt:    now,
-		}
-	}
-
 	// 检查是否可以签到
 	if !s.canSignToday(sign) {
 		return &pb.MonthlySignResponse{
@@ -127,17 +155,33 @@ func (s *MonthlySignService) MonthlySign(ctx context.Context, userID int64) (*pb
 		}, nil
 	}
 
+	// 获取签到奖励
+	rewards, err := s.getSignRewards(today)
+	if err != nil {
+		return &pb.MonthlySignResponse{
+			Success: false,
+			Message: "获取签到奖励失败",
+		}, err
+	}
+
 	// 添加今日签到（使用位运算）
 	sign.SignDays = SetBit(sign.SignDays, today)
 	sign.LastSignTime = now
 	sign.UpdatedAt = now
 
-	// 保存签到记录
-	err = s.dbClient.CreateOrUpdateMonthlySign(ctx, sign)
+	// 保存签到记录和发放奖励在同一事务中完成
+	mail := newOverflowMail("签到奖励")
+	err = s.unitOfWork.Transaction(ctx, func(ctx context.Context, tx interfaces.Tx) error {
+		if err := tx.Users().CreateOrUpdateMonthlySign(ctx, sign); err != nil {
+			return err
+		}
+		return s.giveRewards(ctx, tx, userID, rewards, fmt.Sprintf("monthly_sign_day:%d", today),
+			fmt.Sprintf("monthly_sign:%d:%d:%d", year, month, today), mail)
+	})
 	if err != nil {
 		return &pb.MonthlySignResponse{
 			Success: false,
-			Message: "保存签到记录失败",
+			Message: "签到失败",
 		}, err
 	}
 
@@ -145,48 +189,24 @@ func (s *MonthlySignService) MonthlySign(ctx context.Context, userID int64) (*pb
 	err = s.cacheService.InvalidateMonthlySignCache(ctx, userID)
 	if err != nil {
 		// 缓存失效失败不影响业务逻辑，只记录日志
-		fmt.Printf("failed to invalidate monthly sign cache: %v\n", err)
-	}
-
-	return s.handleSignSuccess(ctx, userID, today)
-}
-
-// handleSignSuccess 处理签到成功后的逻辑
-func (s *MonthlySignService) handleSignSuccess(ctx context.Context, userID int64, today int32) (*pb.MonthlySignResponse, error) {
-
-	// 获取签到奖励
-	rewards, err := s.getSignRewards(today)
-	if err != nil {
-		return &pb.MonthlySignResponse{
-			Success: false,
-			Message: "获取签到奖励失败",
-		}, err
-	}
-
-	// 发放奖励到背包
-	err = s.giveRewards(ctx, userID, rewards)
-	if err != nil {
-		return &pb.MonthlySignResponse{
-			Success: false,
-			Message: "发放奖励失败",
-		}, err
+		utils.Error("Failed to invalidate monthly sign cache", zap.Int64("userId", userID), zap.Error(err))
 	}
+	s.afterRewardsGranted(ctx, userID, mail)
 
 	return &pb.MonthlySignResponse{
 		Success: true,
 		Message: "签到成功",
-		Rewards: rewards,
+		Rewards: toSignItems(rewards),
 	}, nil
 }
 
 // ClaimMonthlySignReward 领取月签到累计奖励
 func (s *MonthlySignService) ClaimMonthlySignReward(ctx context.Context, userID int64, days int32) (*pb.ClaimMonthlySignRewardResponse, error) {
-	now := time.Now()
-	year := int32(now.Year())
-	month := int32(now.Month())
+	now := s.clock.Now()
+	year, month, _ := s.signDate(now)
 
 	// 使用分布式锁确保并发安全
-	lockKey := fmt.Sprintf("monthly_sign_reward:%d:%d:%d", userID, year, month)
+	lockKey := fmt.Sprintf("monthly_sign_reward:%d", userID)
 	err := s.cache.Lock(ctx, lockKey, 30*time.Second, 5*time.Second)
 	if err != nil {
 		return &pb.ClaimMonthlySignRewardResponse{
@@ -207,7 +227,7 @@ func (s *MonthlySignService) ClaimMonthlySignReward(ctx context.Context, userID
 		}, err
 	}
 
-	if sign == nil {
+	if sign == nil || sign.Year != year || sign.Month != month {
 		return &pb.ClaimMonthlySignRewardResponse{
 			Success: false,
 			Message: "本月未签到",
@@ -246,6 +266,13 @@ func (s *MonthlySignService) ClaimMonthlySignReward(ctx context.Context, userID
 		}
 	}
 
+	// 奖励记录属于之前的月份时重置领取位图
+	if reward.Year != year || reward.Month != month {
+		reward.Year = year
+		reward.Month = month
+		reward.RewardDays = 0
+	}
+
 	// 检查是否已经领取过该天数的奖励
 	if s.hasClaimedReward(reward, days) {
 		return &pb.ClaimMonthlySignRewardResponse{
@@ -263,24 +290,23 @@ func (s *MonthlySignService) ClaimMonthlySignReward(ctx context.Context, userID
 		}, err
 	}
 
-	// 发放奖励到背包
-	err = s.giveRewards(ctx, userID, rewards)
-	if err != nil {
-		return &pb.ClaimMonthlySignRewardResponse{
-			Success: false,
-			Message: "发放奖励失败",
-		}, err
-	}
-
 	// 记录已领取的奖励（使用位运算）
 	reward.RewardDays = SetBit(reward.RewardDays, days)
 	reward.UpdatedAt = now
 
-	err = s.dbClient.CreateOrUpdateMonthlySignReward(ctx, reward)
+	// 保存领取记录和发放奖励在同一事务中完成
+	mail := newOverflowMail("签到累计奖励")
+	err = s.unitOfWork.Transaction(ctx, func(ctx context.Context, tx interfaces.Tx) error {
+		if err := tx.Users().CreateOrUpdateMonthlySignReward(ctx, reward); err != nil {
+			return err
+		}
+		return s.giveRewards(ctx, tx, userID, rewards, fmt.Sprintf("monthly_sign_cumulative:%d", days),
+			fmt.Sprintf("monthly_sign_cumulative:%d:%d:%d", year, month, days), mail)
+	})
 	if err != nil {
 		return &pb.ClaimMonthlySignRewardResponse{
 			Success: false,
-			Message: "保存奖励记录失败",
+			Message: "领取奖励失败",
 		}, err
 	}
 
@@ -288,22 +314,227 @@ func (s *MonthlySignService) ClaimMonthlySignReward(ctx context.Context, userID
 	err = s.cacheService.InvalidateMonthlySignRewardCache(ctx, userID)
 	if err != nil {
 		// 缓存失效失败不影响业务逻辑，只记录日志
-		fmt.Printf("failed to invalidate monthly sign reward cache: %v\n", err)
+		utils.Error("Failed to invalidate monthly sign reward cache", zap.Int64("userId", userID), zap.Error(err))
 	}
+	s.afterRewardsGranted(ctx, userID, mail)
 
 	return &pb.ClaimMonthlySignRewardResponse{
 		Success: true,
 		Message: "领取奖励成功",
-		Rewards: rewards,
+		Rewards: toSignItems(rewards),
+	}, nil
+}
+
+// MonthlySignMakeup 补签本月今日之前漏签的日期
+// 扣除补签消耗、保存签到记录和发放奖励在同一事务中完成，幂等键按补签日期生成，同一日期重试时货币不会被重复扣除
+func (s *MonthlySignService) MonthlySignMakeup(ctx context.Context, userID int64, day int32) (*pb.MonthlySignMakeupResponse, error) {
+	now := s.clock.Now()
+	_, _, today := s.signDate(now)
+
+	if day < 1 || day >= today {
+		return &pb.MonthlySignMakeupResponse{
+			Success: false,
+			Message: "只能补签本月今日之前的日期",
+		}, nil
+	}
+
+	// 与签到使用同一把锁，补签与签到、跨月归档互斥
+	lockKey := signLockKey(userID)
+	err := s.cache.Lock(ctx, lockKey, 30*time.Second, 5*time.Second)
+	if err != nil {
+		return &pb.MonthlySignMakeupResponse{
+			Success: false,
+			Message: "获取签到锁失败",
+		}, err
+	}
+	defer s.cache.Unlock(ctx, lockKey)
+
+	// 获取本月签到记录，跨月时先归档上月记录
+	sign, err := s.currentMonthSign(ctx, userID, now)
+	if err != nil {
+		return &pb.MonthlySignMakeupResponse{
+			Success: false,
+			Message: "获取签到信息失败",
+		}, err
+	}
+
+	if HasBit(sign.SignDays, day) {
+		return &pb.MonthlySignMakeupResponse{
+			Success: false,
+			Message: "该日期已签到",
+		}, nil
+	}
+
+	// 补签次数上限即配置表行数，第N次补签使用第N行的消耗
+	makeup := s.getMakeupConfig(sign.MakeupCount + 1)
+	if makeup == nil {
+		return &pb.MonthlySignMakeupResponse{
+			Success: false,
+			Message: "本月补签次数已用完",
+		}, nil
+	}
+
+	rewards, err := s.getSignRewards(day)
+	if err != nil {
+		return &pb.MonthlySignMakeupResponse{
+			Success: false,
+			Message: "获取签到奖励失败",
+		}, err
+	}
+
+	// 记录补签日期（使用位运算）
+	sign.SignDays = SetBit(sign.SignDays, day)
+	sign.MakeupCount++
+	sign.UpdatedAt = now
+
+	mail := newOverflowMail("补签奖励")
+	source := fmt.Sprintf("monthly_sign_day:%d", day)
+	err = s.unitOfWork.Transaction(ctx, func(ctx context.Context, tx interfaces.Tx) error {
+		err := s.items.consumeCosts(ctx, tx, userID, makeup.Cost, CurrencyReasonSignMakeup, source,
+			fmt.Sprintf("monthly_sign_makeup:%d:%d:%d", sign.Year, sign.Month, day))
+		if err != nil {
+			return err
+		}
+		if err := tx.Users().CreateOrUpdateMonthlySign(ctx, sign); err != nil {
+			return err
+		}
+		return s.giveRewards(ctx, tx, userID, rewards, source,
+			fmt.Sprintf("monthly_sign:%d:%d:%d", sign.Year, sign.Month, day), mail)
+	})
+	if err != nil {
+		if msg, ok := signCostMessage(err); ok {
+			return &pb.MonthlySignMakeupResponse{
+				Success: false,
+				Message: "补签失败: " + msg,
+			}, nil
+		}
+		return &pb.MonthlySignMakeupResponse{
+			Success: false,
+			Message: "补签失败",
+		}, err
+	}
+
+	// 失效签到缓存
+	err = s.cacheService.InvalidateMonthlySignCache(ctx, userID)
+	if err != nil {
+		// 缓存失效失败不影响业务逻辑，只记录日志
+		utils.Error("Failed to invalidate monthly sign cache", zap.Int64("userId", userID), zap.Error(err))
+	}
+	s.afterRewardsGranted(ctx, userID, mail)
+
+	return &pb.MonthlySignMakeupResponse{
+		Success: true,
+		Message: "补签成功",
+		Rewards: toSignItems(rewards),
+		Cost:    toSignItems(makeup.Cost),
 	}, nil
 }
 
+// GetMonthlySignHistory 获取历史月份签到记录
+func (s *MonthlySignService) GetMonthlySignHistory(ctx context.Context, userID int64, limit int) (*pb.GetMonthlySignHistoryResponse, error) {
+	if limit <= 0 {
+		limit = defaultSignHistoryLimit
+	}
+	if limit > maxSignHistoryLimit {
+		limit = maxSignHistoryLimit
+	}
+
+	archives, err := s.dbClient.GetMonthlySignArchives(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monthly sign archives: %w", err)
+	}
+
+	// 当前签到记录尚未归档时（玩家跨月后还没有签到），同样作为一条历史返回
+	sign, err := s.cacheService.GetMonthlySignWithCache(ctx, userID, func() (*models.MonthlySign, error) {
+		return s.dbClient.GetMonthlySign(ctx, userID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monthly sign: %w", err)
+	}
+
+	resp := &pb.GetMonthlySignHistoryResponse{}
+	year, month, _ := s.signDate(s.clock.Now())
+	if sign != nil && !IsEmpty(sign.SignDays) && (sign.Year != year || sign.Month != month) {
+		if len(archives) == 0 || archives[0].Year != sign.Year || archives[0].Month != sign.Month {
+			resp.History = append(resp.History, toSignHistory(sign.Year, sign.Month, sign.SignDays, sign.MakeupCount))
+		}
+	}
+	for _, archive := range archives {
+		if len(resp.History) >= limit {
+			break
+		}
+		resp.History = append(resp.History, toSignHistory(archive.Year, archive.Month, archive.SignDays, archive.MakeupCount))
+	}
+
+	return resp, nil
+}
+
+// signLockKey 月签到锁，按玩家加锁而不区分月份，避免月末跨月前后的两次写入互相覆盖
+func signLockKey(userID int64) string {
+	return fmt.Sprintf("monthly_sign:%d", userID)
+}
+
+// currentMonthSign 获取本月签到记录，需在持有签到锁时调用
+// 记录属于之前的月份时，归档旧记录并重置为本月，归档与重置在同一事务中完成
+func (s *MonthlySignService) currentMonthSign(ctx context.Context, userID int64, now time.Time) (*models.MonthlySign, error) {
+	year, month, _ := s.signDate(now)
+
+	// 使用缓存获取用户月签到记录
+	sign, err := s.cacheService.GetMonthlySignWithCache(ctx, userID, func() (*models.MonthlySign, error) {
+		return s.dbClient.GetMonthlySign(ctx, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 如果没有签到记录，创建新的
+	if sign == nil {
+		return &models.MonthlySign{
+			UserID:       userID,
+			Year:         year,
+			Month:        month,
+			SignDays:     0, // 位图初始化为0
+			LastSignTime: time.Time{},
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}, nil
+	}
+
+	if sign.Year == year && sign.Month == month {
+		return sign, nil
+	}
+
+	archive := &models.MonthlySignArchive{
+		UserID:      userID,
+		Year:        sign.Year,
+		Month:       sign.Month,
+		SignDays:    sign.SignDays,
+		MakeupCount: sign.MakeupCount,
+		CreatedAt:   now,
+	}
+	sign.Year = year
+	sign.Month = month
+	sign.SignDays = 0
+	sign.MakeupCount = 0
+	sign.UpdatedAt = now
+
+	if err := s.dbClient.RolloverMonthlySign(ctx, archive, sign); err != nil {
+		return nil, err
+	}
+
+	// 失效签到缓存
+	err = s.cacheService.InvalidateMonthlySignCache(ctx, userID)
+	if err != nil {
+		// 缓存失效失败不影响业务逻辑，只记录日志
+		utils.Error("Failed to invalidate monthly sign cache", zap.Int64("userId", userID), zap.Error(err))
+	}
+
+	return sign, nil
+}
+
 // canSignToday 检查今日是否可以签到
 func (s *MonthlySignService) canSignToday(sign *models.MonthlySign) bool {
-	now := time.Now()
-	year := int32(now.Year())
-	month := int32(now.Month())
-	today := int32(now.Day())
+	year, month, today := s.signDate(s.clock.Now())
 
 	// 如果年份或月份不匹配，则可以签到
 	if sign.Year != year || sign.Month != month {
@@ -320,7 +551,7 @@ func (s *MonthlySignService) hasClaimedReward(reward *models.MonthlySignReward,
 }
 
 // getSignRewards 获取签到奖励
-func (s *MonthlySignService) getSignRewards(day int32) ([]*pb.Item, error) {
+func (s *MonthlySignService) getSignRewards(day int32) ([]designconfig.BaseItemCost, error) {
 	// 从配置表获取签到奖励
 	configData := s.configManager.GetConfig("monthly_sign")
 	if configData == nil {
@@ -345,23 +576,54 @@ func (s *MonthlySignService) getSignRewards(day int32) ([]*pb.Item, error) {
 		return nil, fmt.Errorf("未找到第%d天的签到配置", day)
 	}
 
-	// 转换为协议格式
-	var rewards []*pb.Item
-	for _, reward := range signData.Reward {
-		item := &pb.Item{
-			ItemId:     0, // 这里需要生成物品实例ID
-			TemplateId: int64(reward.ItemId),
-			Count:      int32(reward.Count),
-			// 其他字段需要从物品模板获取
+	return signData.Reward, nil
+}
+
+// getMakeupConfigs 获取补签配置，配置缺失时视为不允许补签
+func (s *MonthlySignService) getMakeupConfigs() []designconfig.MonthlySignMakeupData {
+	makeups, ok := s.configManager.GetConfig("monthly_sign_makeup").([]designconfig.MonthlySignMakeupData)
+	if !ok {
+		return nil
+	}
+	return makeups
+}
+
+// getMakeupConfig 获取本月第n次补签的配置，超过补签次数上限时返回nil
+func (s *MonthlySignService) getMakeupConfig(n int32) *designconfig.MonthlySignMakeupData {
+	makeups := s.getMakeupConfigs()
+	for i := range makeups {
+		if makeups[i].ID == int(n) {
+			return &makeups[i]
 		}
-		rewards = append(rewards, item)
 	}
+	return nil
+}
+
+// toSignItems 将配置中的物品列表转换为协议格式
+func toSignItems(costs []designconfig.BaseItemCost) []*pb.Item {
+	items := make([]*pb.Item, 0, len(costs))
+	for _, cost := range costs {
+		items = append(items, &pb.Item{
+			TemplateId: int64(cost.ItemId),
+			Count:      int32(cost.Count),
+		})
+	}
+	return items
+}
 
-	return rewards, nil
+// toSignHistory 将某月的签到位图转换为历史记录
+func toSignHistory(year, month, signDays, makeupCount int32) *pb.MonthlySignHistory {
+	return &pb.MonthlySignHistory{
+		Year:          year,
+		Month:         month,
+		SignDays:      GetSetBits(signDays),
+		TotalSignDays: CountBits(signDays),
+		MakeupCount:   makeupCount,
+	}
 }
 
 // getCumulativeRewards 获取累计奖励
-func (s *MonthlySignService) getCumulativeRewards(days int32) ([]*pb.Item, error) {
+func (s *MonthlySignService) getCumulativeRewards(days int32) ([]designconfig.BaseItemCost, error) {
 	// 从配置表获取累计奖励
 	configData := s.configManager.GetConfig("monthly_sign_cumulative")
 	if configData == nil {
@@ -386,32 +648,30 @@ func (s *MonthlySignService) getCumulativeRewards(days int32) ([]*pb.Item, error
 		return nil, fmt.Errorf("未找到%d天累计奖励配置", days)
 	}
 
-	// 转换为协议格式
-	var rewards []*pb.Item
-	for _, reward := range cumulativeData.Reward {
-		item := &pb.Item{
-			ItemId:     0, // 这里需要生成物品实例ID
-			TemplateId: int64(reward.ItemId),
-			Count:      int32(reward.Count),
-			// 其他字段需要从物品模板获取
-		}
-		rewards = append(rewards, item)
-	}
-
-	return rewards, nil
+	return cumulativeData.Reward, nil
 }
 
-// giveRewards 发放奖励到背包
-func (s *MonthlySignService) giveRewards(ctx context.Context, userID int64, rewards []*pb.Item) error {
-	// 这里需要调用背包服务来添加物品
-	// 由于当前架构限制，这里简化处理
-	// 实际实现中应该调用背包服务的AddItem方法
+// giveRewards 在签到事务中发放奖励，背包放不下的物品通过 mail 补发
+func (s *MonthlySignService) giveRewards(ctx context.Context, tx interfaces.Tx, userID int64, rewards []designconfig.BaseItemCost, source, idempotencyKey string, mail *models.Mail) error {
+	return s.items.grantRewards(ctx, tx, userID, rewards, CurrencyReasonSignReward, source, idempotencyKey, mail)
+}
 
-	for _, item := range rewards {
-		// 调用背包服务添加物品
-		// 这里需要实现具体的背包操作逻辑
-		_ = item // 避免未使用变量警告
+// afterRewardsGranted 奖励发放事务提交后失效背包缓存，产生补发邮件时通知玩家
+func (s *MonthlySignService) afterRewardsGranted(ctx context.Context, userID int64, mail *models.Mail) {
+	if err := s.cacheService.InvalidateInventoryCache(ctx, userID); err != nil {
+		// 缓存失效失败不影响业务逻辑，只记录日志
+		utils.Error("Failed to invalidate inventory cache", zap.Int64("userId", userID), zap.Error(err))
 	}
+	s.items.notifyOverflowMail(ctx, mail)
+}
 
-	return nil
+// signCostMessage 将补签事务返回的消耗不足错误转换为提示信息
+func signCostMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, interfaces.ErrInsufficientCurrency):
+		return "货币不足", true
+	case errors.Is(err, interfaces.ErrInsufficientItems):
+		return "物品不足", true
+	}
+	return "", false
 }
@@ -3,11 +3,14 @@ package user
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
 	"github.xubinbest.com/go-game-server/internal/db/models"
 	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/gameevent"
 	"github.xubinbest.com/go-game-server/internal/pb"
 	"github.xubinbest.com/go-game-server/internal/utils"
 
@@ -64,6 +67,12 @@ func (h *Handler) GetUserPets(ctx context.Context, req *pb.GetUserPetsRequest) (
 		return nil, fmt.Errorf("failed to get user pets")
 	}
 
+	skills, err := h.getPetSkills(ctx, userID)
+	if err != nil {
+		utils.Error("GetPetSkills error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get pet skills")
+	}
+
 	pbPets := make([]*pb.Pet, 0, len(pets))
 	for _, pet := range pets {
 		// 从内存配置中获取模板数据
@@ -86,8 +95,11 @@ func (h *Handler) GetUserPets(ctx context.Context, req *pb.GetUserPetsRequest) (
 			Name:       pet.Name,
 			Level:      pet.Level,
 			Exp:        pet.Exp,
-			IsBattle:   pet.IsBattle,
+			IsBattle:   pet.InBattle(),
 			Properties: string(propertiesJSON),
+			Star:       pet.Star,
+			BattleSlot: pet.BattleSlot,
+			Skills:     h.toPBPetSkills(skills[pet.ID]),
 		})
 	}
 
@@ -122,7 +134,6 @@ func (h *Handler) AddPet(ctx context.Context, req *pb.AddPetRequest) (*pb.AddPet
 		Name:       template.Name,
 		Level:      1,
 		Exp:        0,
-		IsBattle:   false,
 	}
 
 	// 生成宠物ID
@@ -149,7 +160,7 @@ func (h *Handler) AddPet(ctx context.Context, req *pb.AddPetRequest) (*pb.AddPet
 	return &pb.AddPetResponse{Success: true, Message: "宠物添加成功"}, nil
 }
 
-// SetPetBattleStatus 设置宠物出战状态
+// SetPetBattleStatus 设置宠物出战状态，出战位按 pet_battle_slot 配置表随玩家等级解锁，出战位上原有的宠物会被替换下来
 func (h *Handler) SetPetBattleStatus(ctx context.Context, req *pb.SetPetBattleStatusRequest) (*pb.SetPetBattleStatusResponse, error) {
 	userID := req.UserId
 	petID := req.PetId
@@ -172,8 +183,29 @@ func (h *Handler) SetPetBattleStatus(ctx context.Context, req *pb.SetPetBattleSt
 		return &pb.SetPetBattleStatusResponse{Success: false, Message: "宠物不存在或不属于该用户"}, nil
 	}
 
-	// 设置出战状态
-	err = h.dbClient.SetPetBattleStatus(ctx, userID, petID, isBattle)
+	// 出战时检查出战位是否已解锁，休战时清空出战位
+	var slot int32
+	if isBattle {
+		slot = req.Slot
+		if slot == 0 {
+			slot = 1
+		}
+		slotConfig, err := h.getPetBattleSlotConfig(slot)
+		if err != nil {
+			return &pb.SetPetBattleStatusResponse{Success: false, Message: "出战位不存在"}, nil
+		}
+		user, err := h.dbClient.GetUser(ctx, userID)
+		if err != nil || user == nil {
+			utils.Error("GetUser error", zap.Int64("userId", userID), zap.Error(err))
+			return nil, fmt.Errorf("failed to get user")
+		}
+		if models.EffectiveUserLevel(user.Level) < int32(slotConfig.Level) {
+			return &pb.SetPetBattleStatusResponse{Success: false, Message: fmt.Sprintf("出战位%d需要玩家等级达到%d级", slot, slotConfig.Level)}, nil
+		}
+	}
+
+	// 设置出战位
+	err = h.dbClient.SetPetBattleSlot(ctx, userID, petID, slot)
 	if err != nil {
 		utils.Error("SetPetBattleStatus error", zap.Error(err))
 		return nil, fmt.Errorf("failed to set pet battle status")
@@ -184,6 +216,7 @@ func (h *Handler) SetPetBattleStatus(ctx context.Context, req *pb.SetPetBattleSt
 	if err != nil {
 		utils.Error("Failed to invalidate pets cache", zap.Error(err))
 	}
+	h.refreshUserAttributes(ctx, userID)
 
 	statusText := "出战"
 	if !isBattle {
@@ -209,35 +242,60 @@ func (h *Handler) AddPetExp(ctx context.Context, req *pb.AddPetExpRequest) (*pb.
 		return nil, fmt.Errorf("invalid exp value")
 	}
 
-	// 检查宠物是否存在并属于该用户
-	pet, err := h.dbClient.GetPet(ctx, petID)
-	if err != nil {
-		utils.Error("GetPet error", zap.Error(err))
-		return &pb.AddPetExpResponse{Success: false, Message: "宠物不存在"}, nil
-	}
-	if pet == nil || pet.UserID != userID {
-		return &pb.AddPetExpResponse{Success: false, Message: "宠物不存在或不属于该用户"}, nil
-	}
-
-	// 业务层处理加经验和升级
-	pet.Exp += exp
-	for {
-		levelTemplate, err := h.getPetLevelTemplate(pet.TemplateID, pet.Level)
-		if err != nil {
-			break // 没有下一级配置，停止升级
-		}
-		if pet.Exp >= int32(levelTemplate.Exp) {
-			pet.Level++
-			pet.Exp -= int32(levelTemplate.Exp)
-		} else {
+	// 读取宠物、计算升级和写回在同一事务中完成
+	// 以读取时的等级和经验为条件更新，等级经验已被其他请求修改时重新读取后重试
+	var pet *models.Pet
+	var oldLevel int32
+	var err error
+	for i := 0; i < maxAddExpRetries; i++ {
+		err = h.dbClient.Transaction(ctx, func(ctx context.Context, tx interfaces.Tx) error {
+			current, err := tx.Pets().GetPet(ctx, petID)
+			if err != nil {
+				return err
+			}
+			if current == nil || current.UserID != userID {
+				return interfaces.ErrPetNotFound
+			}
+
+			// 业务层处理加经验和升级
+			level, petExp := current.Level, current.Exp+exp
+			for {
+				levelTemplate, err := h.getPetLevelTemplate(current.TemplateID, level)
+				if err != nil {
+					break // 没有下一级配置，停止升级
+				}
+				if petExp >= int32(levelTemplate.Exp) {
+					level++
+					petExp -= int32(levelTemplate.Exp)
+				} else {
+					break
+				}
+			}
+
+			updated, err := tx.Pets().UpdatePetLevelExp(ctx, petID, current.Level, current.Exp, level, petExp)
+			if err != nil {
+				return err
+			}
+			if !updated {
+				return interfaces.ErrPetChanged
+			}
+
+			pet, oldLevel = current, current.Level
+			pet.Level, pet.Exp = level, petExp
+			return nil
+		})
+		if !errors.Is(err, interfaces.ErrPetChanged) {
 			break
 		}
 	}
-
-	// 更新宠物数据
-	err = h.dbClient.UpdatePet(ctx, pet)
 	if err != nil {
-		utils.Error("UpdatePet error", zap.Error(err))
+		switch {
+		case errors.Is(err, interfaces.ErrPetNotFound):
+			return &pb.AddPetExpResponse{Success: false, Message: "宠物不存在或不属于该用户"}, nil
+		case errors.Is(err, interfaces.ErrPetChanged):
+			return &pb.AddPetExpResponse{Success: false, Message: "操作频繁，请稍后重试"}, nil
+		}
+		utils.Error("AddPetExp error", zap.Int64("userId", userID), zap.Int64("petId", petID), zap.Error(err))
 		return nil, fmt.Errorf("failed to update pet")
 	}
 
@@ -246,6 +304,10 @@ func (h *Handler) AddPetExp(ctx context.Context, req *pb.AddPetExpRequest) (*pb.
 	if err != nil {
 		utils.Error("Failed to invalidate pets cache", zap.Error(err))
 	}
+	if pet.InBattle() {
+		h.refreshUserAttributes(ctx, userID)
+	}
+	h.events.Emit(ctx, userID, gameevent.PetLevelUp, int64(pet.Level-oldLevel))
 
 	return &pb.AddPetExpResponse{Success: true, Message: "经验增加成功"}, nil
 }
@@ -0,0 +1,434 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/itemcost"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// 宠物养成的货币变动原因
+const (
+	CurrencyReasonPetEvolve  = "pet_evolve"
+	CurrencyReasonPetSkill   = "pet_skill"
+	CurrencyReasonPetRelease = "pet_release"
+)
+
+// EvolvePet 宠物升星，按 pet_star 配置表消耗同模板的材料宠物、货币和道具
+func (h *Handler) EvolvePet(ctx context.Context, req *pb.EvolvePetRequest) (*pb.EvolvePetResponse, error) {
+	userID := req.UserId
+	petID := req.PetId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	if petID == 0 {
+		return nil, fmt.Errorf("invalid pet id")
+	}
+	idempotencyKey, ok := requestKey("pet_evolve", req.RequestId)
+	if !ok {
+		return &pb.EvolvePetResponse{Success: false, Message: "请求ID无效"}, nil
+	}
+
+	// 客户端未收到响应而重试时宠物状态已变化，需在校验之前返回成功，避免把已完成的升星提示为失败
+	processed, err := h.dbClient.IsRequestProcessed(ctx, userID, idempotencyKey)
+	if err != nil {
+		utils.Error("IsRequestProcessed error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to evolve pet")
+	}
+	if processed {
+		return h.processedEvolvePetResponse(ctx, petID)
+	}
+
+	pet, err := h.dbClient.GetPet(ctx, petID)
+	if err != nil {
+		utils.Error("GetPet error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get pet")
+	}
+	if pet == nil || pet.UserID != userID {
+		return &pb.EvolvePetResponse{Success: false, Message: "宠物不存在或不属于该用户"}, nil
+	}
+
+	config, err := h.getPetStarTemplate(pet.TemplateID, pet.Star+1)
+	if err != nil {
+		return &pb.EvolvePetResponse{Success: false, Message: "已达到最大星级"}, nil
+	}
+
+	// 去重后的材料宠物数量必须与配置一致，且不能包含升星的宠物本身
+	materials := make([]int64, 0, len(req.MaterialPetIds))
+	seen := make(map[int64]bool, len(req.MaterialPetIds))
+	for _, id := range req.MaterialPetIds {
+		if id == petID {
+			return &pb.EvolvePetResponse{Success: false, Message: "不能消耗升星的宠物本身"}, nil
+		}
+		if !seen[id] {
+			seen[id] = true
+			materials = append(materials, id)
+		}
+	}
+	if len(materials) != config.DupCount {
+		return &pb.EvolvePetResponse{Success: false, Message: fmt.Sprintf("需要%d只同名宠物作为材料", config.DupCount)}, nil
+	}
+
+	changes, costItems := h.costs.Split(config.Cost, -1)
+	order := &models.PetEvolveOrder{
+		UserID:          userID,
+		PetID:           petID,
+		FromStar:        pet.Star,
+		ToStar:          pet.Star + 1,
+		MaterialPetIDs:  materials,
+		CurrencyChanges: itemcost.MergeCurrencyChanges(changes),
+		CostItems:       costItems,
+		Reason:          CurrencyReasonPetEvolve,
+		Source:          "pet:" + strconv.FormatInt(petID, 10),
+		IdempotencyKey:  idempotencyKey,
+	}
+	if err := h.dbClient.EvolvePet(ctx, order); err != nil {
+		if errors.Is(err, interfaces.ErrDuplicateRequest) {
+			return h.processedEvolvePetResponse(ctx, petID)
+		}
+		if message, ok := petGrowthMessage(err); ok {
+			return &pb.EvolvePetResponse{Success: false, Message: message}, nil
+		}
+		utils.Error("EvolvePet error", zap.Int64("userId", userID), zap.Int64("petId", petID), zap.Error(err))
+		return nil, fmt.Errorf("failed to evolve pet")
+	}
+	h.afterPetChanged(ctx, userID, len(costItems) > 0, pet.InBattle())
+
+	utils.Info("Pet evolved", zap.Int64("userId", userID), zap.Int64("petId", petID), zap.Int32("star", order.ToStar))
+	return &pb.EvolvePetResponse{
+		Success: true,
+		Message: "升星成功",
+		Star:    order.ToStar,
+		Cost:    h.toPBItems(config.Cost),
+	}, nil
+}
+
+// LearnPetSkill 学习宠物技能，技能槽按 pet_skill_slot 配置表随宠物星级解锁，新技能替换技能槽上原有的技能
+func (h *Handler) LearnPetSkill(ctx context.Context, req *pb.LearnPetSkillRequest) (*pb.LearnPetSkillResponse, error) {
+	userID := req.UserId
+	petID := req.PetId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	if petID == 0 {
+		return nil, fmt.Errorf("invalid pet id")
+	}
+	idempotencyKey, ok := requestKey("pet_skill", req.RequestId)
+	if !ok {
+		return &pb.LearnPetSkillResponse{Success: false, Message: "请求ID无效"}, nil
+	}
+
+	// 客户端未收到响应而重试时宠物状态已变化，需在校验之前返回成功，避免把已完成的学习技能提示为失败
+	processed, err := h.dbClient.IsRequestProcessed(ctx, userID, idempotencyKey)
+	if err != nil {
+		utils.Error("IsRequestProcessed error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to learn pet skill")
+	}
+	if processed {
+		return &pb.LearnPetSkillResponse{Success: true, Message: "请求已处理"}, nil
+	}
+
+	pet, err := h.dbClient.GetPet(ctx, petID)
+	if err != nil {
+		utils.Error("GetPet error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get pet")
+	}
+	if pet == nil || pet.UserID != userID {
+		return &pb.LearnPetSkillResponse{Success: false, Message: "宠物不存在或不属于该用户"}, nil
+	}
+
+	slotConfig, err := h.getPetSkillSlotConfig(req.Slot)
+	if err != nil {
+		return &pb.LearnPetSkillResponse{Success: false, Message: "技能槽不存在"}, nil
+	}
+	if pet.Star < int32(slotConfig.Star) {
+		return &pb.LearnPetSkillResponse{Success: false, Message: fmt.Sprintf("技能槽%d需要宠物达到%d星", req.Slot, slotConfig.Star)}, nil
+	}
+	skill, err := h.getPetSkillTemplate(req.SkillId)
+	if err != nil {
+		return &pb.LearnPetSkillResponse{Success: false, Message: "技能不存在"}, nil
+	}
+
+	skills, err := h.getPetSkills(ctx, userID)
+	if err != nil {
+		utils.Error("GetPetSkills error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get pet skills")
+	}
+	var fromSkillID int64
+	for _, learned := range skills[petID] {
+		if learned.SkillID == req.SkillId {
+			return &pb.LearnPetSkillResponse{Success: false, Message: "该技能已学会"}, nil
+		}
+		if learned.Slot == req.Slot {
+			fromSkillID = learned.SkillID
+		}
+	}
+
+	changes, costItems := h.costs.Split(skill.Cost, -1)
+	order := &models.PetSkillOrder{
+		UserID:          userID,
+		PetID:           petID,
+		Slot:            req.Slot,
+		FromSkillID:     fromSkillID,
+		SkillID:         req.SkillId,
+		CurrencyChanges: itemcost.MergeCurrencyChanges(changes),
+		CostItems:       costItems,
+		Reason:          CurrencyReasonPetSkill,
+		Source:          "pet_skill:" + strconv.Itoa(skill.ID),
+		IdempotencyKey:  idempotencyKey,
+	}
+	if err := h.dbClient.LearnPetSkill(ctx, order); err != nil {
+		if errors.Is(err, interfaces.ErrDuplicateRequest) {
+			return &pb.LearnPetSkillResponse{Success: true, Message: "请求已处理"}, nil
+		}
+		if message, ok := petGrowthMessage(err); ok {
+			return &pb.LearnPetSkillResponse{Success: false, Message: message}, nil
+		}
+		utils.Error("LearnPetSkill error", zap.Int64("userId", userID), zap.Int64("petId", petID), zap.Error(err))
+		return nil, fmt.Errorf("failed to learn pet skill")
+	}
+	h.afterPetChanged(ctx, userID, len(costItems) > 0, pet.InBattle())
+
+	utils.Info("Pet skill learned", zap.Int64("userId", userID), zap.Int64("petId", petID),
+		zap.Int32("slot", req.Slot), zap.Int64("skillId", req.SkillId))
+	return &pb.LearnPetSkillResponse{
+		Success: true,
+		Message: "技能学习成功",
+		Cost:    h.toPBItems(skill.Cost),
+	}, nil
+}
+
+// ReleasePet 放生宠物，按宠物品质和星级从 pet_release 配置表返还资源，出战中的宠物不能放生
+func (h *Handler) ReleasePet(ctx context.Context, req *pb.ReleasePetRequest) (*pb.ReleasePetResponse, error) {
+	userID := req.UserId
+	petID := req.PetId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	if petID == 0 {
+		return nil, fmt.Errorf("invalid pet id")
+	}
+	idempotencyKey, ok := requestKey("pet_release", req.RequestId)
+	if !ok {
+		return &pb.ReleasePetResponse{Success: false, Message: "请求ID无效"}, nil
+	}
+
+	// 客户端未收到响应而重试时宠物状态已变化，需在校验之前返回成功，避免把已完成的放生提示为失败
+	processed, err := h.dbClient.IsRequestProcessed(ctx, userID, idempotencyKey)
+	if err != nil {
+		utils.Error("IsRequestProcessed error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to release pet")
+	}
+	if processed {
+		return &pb.ReleasePetResponse{Success: true, Message: "请求已处理"}, nil
+	}
+
+	pet, err := h.dbClient.GetPet(ctx, petID)
+	if err != nil {
+		utils.Error("GetPet error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get pet")
+	}
+	if pet == nil || pet.UserID != userID {
+		return &pb.ReleasePetResponse{Success: false, Message: "宠物不存在或不属于该用户"}, nil
+	}
+	if pet.InBattle() {
+		return &pb.ReleasePetResponse{Success: false, Message: "出战中的宠物不能放生"}, nil
+	}
+
+	template, err := h.getPetTemplate(pet.TemplateID)
+	if err != nil {
+		return &pb.ReleasePetResponse{Success: false, Message: "宠物模板不存在"}, nil
+	}
+	config, err := h.getPetReleaseConfig(template.Color, pet.Star)
+	if err != nil {
+		return &pb.ReleasePetResponse{Success: false, Message: "该宠物无法放生"}, nil
+	}
+
+	changes, items := h.costs.Split(config.Rewards, 1)
+	order := &models.PetReleaseOrder{
+		UserID:          userID,
+		PetID:           petID,
+		FromStar:        pet.Star,
+		CurrencyChanges: itemcost.MergeCurrencyChanges(changes),
+		Items:           items,
+		Inventory:       h.inventoryRules(),
+		OverflowMail:    newOverflowMail("放生" + pet.Name),
+		Reason:          CurrencyReasonPetRelease,
+		Source:          "pet:" + strconv.FormatInt(petID, 10),
+		IdempotencyKey:  idempotencyKey,
+	}
+	if err := h.dbClient.ReleasePet(ctx, order); err != nil {
+		if errors.Is(err, interfaces.ErrDuplicateRequest) {
+			return &pb.ReleasePetResponse{Success: true, Message: "请求已处理"}, nil
+		}
+		if message, ok := petGrowthMessage(err); ok {
+			return &pb.ReleasePetResponse{Success: false, Message: message}, nil
+		}
+		utils.Error("ReleasePet error", zap.Int64("userId", userID), zap.Int64("petId", petID), zap.Error(err))
+		return nil, fmt.Errorf("failed to release pet")
+	}
+	h.afterPetChanged(ctx, userID, len(items) > 0, false)
+	h.notifyOverflowMail(ctx, order.OverflowMail)
+
+	utils.Info("Pet released", zap.Int64("userId", userID), zap.Int64("petId", petID), zap.Int32("star", pet.Star))
+	return &pb.ReleasePetResponse{
+		Success: true,
+		Message: "放生成功",
+		Rewards: h.toPBItems(config.Rewards),
+	}, nil
+}
+
+// processedEvolvePetResponse 已处理过的升星请求返回成功和宠物当前的星级
+func (h *Handler) processedEvolvePetResponse(ctx context.Context, petID int64) (*pb.EvolvePetResponse, error) {
+	pet, err := h.dbClient.GetPet(ctx, petID)
+	if err != nil {
+		utils.Error("GetPet error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get pet")
+	}
+	resp := &pb.EvolvePetResponse{Success: true, Message: "请求已处理"}
+	if pet != nil {
+		resp.Star = pet.Star
+	}
+	return resp, nil
+}
+
+// petGrowthMessage 将宠物养成的业务错误转换为提示信息
+func petGrowthMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, interfaces.ErrPetNotFound):
+		return "宠物不存在或出战中", true
+	case errors.Is(err, interfaces.ErrPetChanged):
+		return "操作过于频繁，请稍后再试", true
+	case errors.Is(err, interfaces.ErrPetSkillLearned):
+		return "该技能已学会", true
+	case errors.Is(err, interfaces.ErrInsufficientCurrency):
+		return "货币不足", true
+	case errors.Is(err, interfaces.ErrInsufficientItems):
+		return "物品不足", true
+	}
+	return "", false
+}
+
+// afterPetChanged 宠物养成后失效相关缓存，出战宠物变化时重新计算玩家属性
+func (h *Handler) afterPetChanged(ctx context.Context, userID int64, inventoryChanged bool, inBattle bool) {
+	if err := h.cacheService.InvalidateUserPetsCache(ctx, userID); err != nil {
+		utils.Error("Failed to invalidate pets cache", zap.Error(err))
+	}
+	if inventoryChanged {
+		if err := h.cacheService.InvalidateInventoryCache(ctx, userID); err != nil {
+			utils.Error("Failed to invalidate inventory cache", zap.Error(err))
+		}
+	}
+	if inBattle {
+		h.refreshUserAttributes(ctx, userID)
+	}
+}
+
+// getPetSkills 获取玩家宠物的技能，以宠物ID为键
+func (h *Handler) getPetSkills(ctx context.Context, userID int64) (map[int64][]*models.PetSkill, error) {
+	skills, err := h.dbClient.GetPetSkills(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64][]*models.PetSkill)
+	for _, skill := range skills {
+		result[skill.PetID] = append(result[skill.PetID], skill)
+	}
+	return result, nil
+}
+
+// toPBPetSkills 转换宠物技能
+func (h *Handler) toPBPetSkills(skills []*models.PetSkill) []*pb.PetSkill {
+	result := make([]*pb.PetSkill, 0, len(skills))
+	for _, skill := range skills {
+		pbSkill := &pb.PetSkill{
+			Slot:    skill.Slot,
+			SkillId: skill.SkillID,
+		}
+		if template, err := h.getPetSkillTemplate(skill.SkillID); err == nil {
+			pbSkill.Name = template.Name
+		}
+		result = append(result, pbSkill)
+	}
+	return result
+}
+
+// getPetStarTemplate 获取宠物升到 star 星的配置
+func (h *Handler) getPetStarTemplate(templateID int64, star int32) (*designconfig.PetStarData, error) {
+	configs, ok := h.configManager.GetConfig("pet_star").([]designconfig.PetStarData)
+	if !ok {
+		return nil, fmt.Errorf("pet star config not found")
+	}
+	for _, config := range configs {
+		if int64(config.PetId) == templateID && int32(config.Star) == star {
+			return &config, nil
+		}
+	}
+	return nil, fmt.Errorf("pet star config not found: pet_id=%d, star=%d", templateID, star)
+}
+
+// getPetSkillTemplate 获取宠物技能配置
+func (h *Handler) getPetSkillTemplate(skillID int64) (*designconfig.PetSkillData, error) {
+	configs, ok := h.configManager.GetConfig("pet_skill").([]designconfig.PetSkillData)
+	if !ok {
+		return nil, fmt.Errorf("pet skill config not found")
+	}
+	for _, config := range configs {
+		if int64(config.ID) == skillID {
+			return &config, nil
+		}
+	}
+	return nil, fmt.Errorf("pet skill config not found: %d", skillID)
+}
+
+// getPetSkillSlotConfig 获取宠物技能槽配置
+func (h *Handler) getPetSkillSlotConfig(slot int32) (*designconfig.PetSkillSlotData, error) {
+	configs, ok := h.configManager.GetConfig("pet_skill_slot").([]designconfig.PetSkillSlotData)
+	if !ok {
+		return nil, fmt.Errorf("pet skill slot config not found")
+	}
+	for _, config := range configs {
+		if int32(config.ID) == slot {
+			return &config, nil
+		}
+	}
+	return nil, fmt.Errorf("pet skill slot config not found: %d", slot)
+}
+
+// getPetBattleSlotConfig 获取宠物出战位配置
+func (h *Handler) getPetBattleSlotConfig(slot int32) (*designconfig.PetBattleSlotData, error) {
+	configs, ok := h.configManager.GetConfig("pet_battle_slot").([]designconfig.PetBattleSlotData)
+	if !ok {
+		return nil, fmt.Errorf("pet battle slot config not found")
+	}
+	for _, config := range configs {
+		if int32(config.ID) == slot {
+			return &config, nil
+		}
+	}
+	return nil, fmt.Errorf("pet battle slot config not found: %d", slot)
+}
+
+// getPetReleaseConfig 获取指定品质和星级宠物的放生返还配置
+func (h *Handler) getPetReleaseConfig(color int, star int32) (*designconfig.PetReleaseData, error) {
+	configs, ok := h.configManager.GetConfig("pet_release").([]designconfig.PetReleaseData)
+	if !ok {
+		return nil, fmt.Errorf("pet release config not found")
+	}
+	for _, config := range configs {
+		if config.Color == color && int32(config.Star) == star {
+			return &config, nil
+		}
+	}
+	return nil, fmt.Errorf("pet release config not found: color=%d, star=%d", color, star)
+}
@@ -0,0 +1,269 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/gameevent"
+	"github.xubinbest.com/go-game-server/internal/itemcost"
+	"github.xubinbest.com/go-game-server/internal/mq"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// CurrencyReasonQuest 任务奖励的货币变动原因
+const CurrencyReasonQuest = "quest"
+
+const (
+	// questProgressRetries 推进任务进度失败后的最大重试次数
+	questProgressRetries = 5
+	// questProgressRetryDelay 首次重试前的等待时间，之后每次翻倍
+	questProgressRetryDelay = 200 * time.Millisecond
+)
+
+// getQuestTemplate 从内存配置中获取任务模板
+func (h *Handler) getQuestTemplate(questID int64) (*designconfig.QuestData, error) {
+	quests := h.configManager.GetConfig("quest")
+	if quests == nil {
+		return nil, fmt.Errorf("quest config not found")
+	}
+
+	questsSlice := reflect.ValueOf(quests)
+	for i := 0; i < questsSlice.Len(); i++ {
+		quest := questsSlice.Index(i).Interface().(designconfig.QuestData)
+		if int64(quest.ID) == questID {
+			return &quest, nil
+		}
+	}
+	return nil, fmt.Errorf("quest template not found: %d", questID)
+}
+
+// getQuestTemplates 获取所有任务模板
+func (h *Handler) getQuestTemplates() ([]designconfig.QuestData, error) {
+	quests, ok := h.configManager.GetConfig("quest").([]designconfig.QuestData)
+	if !ok {
+		return nil, fmt.Errorf("quest config not found")
+	}
+	return quests, nil
+}
+
+// questPeriodStart 获取任务重置周期的起始时间，每日任务为当前游戏日的重置时刻，每周任务为本周一的重置时刻，成就为0
+func (h *Handler) questPeriodStart(questType int, now time.Time) int64 {
+	switch questType {
+	case models.QuestTypeDaily:
+		return h.clock.DayStart(now).Unix()
+	case models.QuestTypeWeekly:
+		return h.clock.WeekStart(now).Unix()
+	default:
+		return 0
+	}
+}
+
+// questResetAt 获取任务的下次重置时间，成就返回0
+func (h *Handler) questResetAt(questType int, now time.Time) int64 {
+	switch questType {
+	case models.QuestTypeDaily:
+		return h.clock.NextDayStart(now).Unix()
+	case models.QuestTypeWeekly:
+		return h.clock.NextWeekStart(now).Unix()
+	default:
+		return 0
+	}
+}
+
+// StartQuestEventConsumer 启动游戏事件消费者，按事件推进任务和成就进度
+func (h *Handler) StartQuestEventConsumer(ctx context.Context) error {
+	consumer, err := h.kafkaFactory.GetConsumer(mq.GameplayEvent)
+	if err != nil {
+		return fmt.Errorf("failed to create gameplay event consumer: %w", err)
+	}
+
+	go func() {
+		err := consumer.ConsumeMessages(ctx, func(msg mq.Message) error {
+			event, err := gameevent.Decode(msg)
+			if err != nil {
+				utils.Error("Failed to unmarshal gameplay event", zap.Error(err))
+				return err
+			}
+			return h.handleGameplayEvent(ctx, event)
+		})
+		if err != nil {
+			utils.Error("Gameplay event consumer error", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// questEventKey 任务进度的事件去重键，同一事件对每个任务只计一次，旧版本发送的事件没有事件ID时不去重
+func questEventKey(event *gameevent.Event, questID int) string {
+	if event.EventID == 0 {
+		return ""
+	}
+	return fmt.Sprintf("quest_event:%d:%d", event.EventID, questID)
+}
+
+// handleGameplayEvent 推进订阅该事件的所有任务进度
+// 消费者处理失败后仍会提交位点，失败的任务在这里按退避间隔原地重试，
+// 重试时按事件ID跳过已推进的任务，Kafka重复投递的事件同样不会重复计数
+func (h *Handler) handleGameplayEvent(ctx context.Context, event *gameevent.Event) error {
+	if event.UserID == 0 || event.Count <= 0 {
+		return nil
+	}
+
+	quests, err := h.getQuestTemplates()
+	if err != nil {
+		return err
+	}
+	var pending []designconfig.QuestData
+	for _, quest := range quests {
+		if quest.Event == string(event.Type) {
+			pending = append(pending, quest)
+		}
+	}
+
+	// 按事件发生时间归属周期，跨重置时刻延迟消费的事件不会计入新周期
+	at := time.Unix(event.Timestamp, 0)
+	delay := questProgressRetryDelay
+	for attempt := 0; ; attempt++ {
+		pending = h.addQuestProgresses(ctx, event, pending, at)
+		if len(pending) == 0 {
+			return nil
+		}
+		if attempt == questProgressRetries {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to add progress for %d quests: %w", len(pending), ctx.Err())
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+
+	utils.Error("Dropped quest progress after retries", zap.Int64("userId", event.UserID), zap.Int64("eventId", event.EventID),
+		zap.String("event", string(event.Type)), zap.Int64("count", event.Count), zap.Int("quests", len(pending)))
+	return fmt.Errorf("failed to add progress for %d quests after %d retries", len(pending), questProgressRetries)
+}
+
+// addQuestProgresses 推进一组任务的进度，返回推进失败需要重试的任务
+func (h *Handler) addQuestProgresses(ctx context.Context, event *gameevent.Event, quests []designconfig.QuestData, at time.Time) []designconfig.QuestData {
+	var failed []designconfig.QuestData
+	for _, quest := range quests {
+		err := h.dbClient.AddQuestProgress(ctx, event.UserID, int64(quest.ID),
+			h.questPeriodStart(quest.Type, at), event.Count, int64(quest.Target), questEventKey(event, quest.ID))
+		if errors.Is(err, interfaces.ErrDuplicateRequest) {
+			continue
+		}
+		if err != nil {
+			utils.Error("AddQuestProgress error", zap.Int64("userId", event.UserID), zap.Int64("eventId", event.EventID),
+				zap.Int("questId", quest.ID), zap.Error(err))
+			failed = append(failed, quest)
+		}
+	}
+	return failed
+}
+
+// GetQuests 获取任务和成就列表及当前周期进度
+func (h *Handler) GetQuests(ctx context.Context, req *pb.GetQuestsRequest) (*pb.GetQuestsResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	quests, err := h.getQuestTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	progresses, err := h.dbClient.GetQuestProgresses(ctx, userID)
+	if err != nil {
+		utils.Error("GetQuestProgresses error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get quest progresses")
+	}
+	progressMap := make(map[int64]*models.QuestProgress, len(progresses))
+	for _, progress := range progresses {
+		progressMap[progress.QuestID] = progress
+	}
+
+	now := h.clock.Now()
+	resp := &pb.GetQuestsResponse{Quests: make([]*pb.Quest, 0, len(quests))}
+	for _, quest := range quests {
+		if req.Type != 0 && int32(quest.Type) != req.Type {
+			continue
+		}
+		progress := progressMap[int64(quest.ID)]
+		periodStart := h.questPeriodStart(quest.Type, now)
+		resp.Quests = append(resp.Quests, &pb.Quest{
+			QuestId:  int64(quest.ID),
+			Name:     quest.Name,
+			Type:     int32(quest.Type),
+			Event:    quest.Event,
+			Progress: progress.ProgressIn(periodStart),
+			Target:   int64(quest.Target),
+			Claimed:  progress.ClaimedIn(periodStart),
+			Rewards:  h.toPBItems(quest.Rewards),
+			ResetAt:  h.questResetAt(quest.Type, now),
+		})
+	}
+	return resp, nil
+}
+
+// ClaimQuestReward 领取任务奖励，每个周期只能领取一次
+func (h *Handler) ClaimQuestReward(ctx context.Context, req *pb.ClaimQuestRewardRequest) (*pb.ClaimQuestRewardResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	quest, err := h.getQuestTemplate(req.QuestId)
+	if err != nil {
+		return &pb.ClaimQuestRewardResponse{Success: false, Message: "任务不存在"}, nil
+	}
+
+	periodStart := h.questPeriodStart(quest.Type, h.clock.Now())
+	changes, items := h.costs.Split(quest.Rewards, 1)
+	claim := &models.QuestClaim{
+		UserID:          userID,
+		QuestID:         int64(quest.ID),
+		PeriodStart:     periodStart,
+		Target:          int64(quest.Target),
+		CurrencyChanges: itemcost.MergeCurrencyChanges(changes),
+		Items:           items,
+		Inventory:       h.inventoryRules(),
+		OverflowMail:    newOverflowMail("任务奖励"),
+		Reason:          CurrencyReasonQuest,
+		Source:          fmt.Sprintf("quest:%d", quest.ID),
+		IdempotencyKey:  fmt.Sprintf("quest:%d:%d", quest.ID, periodStart),
+	}
+	if err := h.dbClient.ClaimQuestReward(ctx, claim); err != nil {
+		switch {
+		case errors.Is(err, interfaces.ErrQuestNotCompleted):
+			return &pb.ClaimQuestRewardResponse{Success: false, Message: "任务未完成"}, nil
+		case errors.Is(err, interfaces.ErrQuestAlreadyClaimed):
+			return &pb.ClaimQuestRewardResponse{Success: false, Message: "奖励已领取"}, nil
+		}
+		utils.Error("ClaimQuestReward error", zap.Int64("userId", userID), zap.Int64("questId", req.QuestId), zap.Error(err))
+		return nil, fmt.Errorf("failed to claim quest reward")
+	}
+
+	// 失效背包缓存
+	if err := h.cacheService.InvalidateInventoryCache(ctx, userID); err != nil {
+		utils.Error("Failed to invalidate inventory cache", zap.Error(err))
+	}
+	h.notifyOverflowMail(ctx, claim.OverflowMail)
+
+	return &pb.ClaimQuestRewardResponse{
+		Success: true,
+		Message: "领取成功",
+		Rewards: h.toPBItems(quest.Rewards),
+	}, nil
+}
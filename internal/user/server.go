@@ -9,6 +9,9 @@ import (
 	"github.xubinbest.com/go-game-server/internal/designconfig"
 	"github.xubinbest.com/go-game-server/internal/pb"
 	"github.xubinbest.com/go-game-server/internal/snowflake"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
 )
 
 type UserGRPCServer struct {
@@ -22,6 +25,11 @@ func NewUserGRPCServer(dbClient db.Database, cacheClient cache.Cache, sf *snowfl
 	if err != nil {
 		return nil, err
 	}
+
+	// 任务进度由游戏事件异步推进，消费者启动失败不影响其他功能
+	if err := handler.StartQuestEventConsumer(context.Background()); err != nil {
+		utils.Error("Failed to start quest event consumer", zap.Error(err))
+	}
 	return &UserGRPCServer{
 		UnimplementedUserServiceServer: pb.UnimplementedUserServiceServer{},
 		handler:                        handler,
@@ -52,6 +60,18 @@ func (s *UserGRPCServer) UseItem(ctx context.Context, req *pb.UseItemRequest) (*
 	return s.handler.UseItem(ctx, req)
 }
 
+func (s *UserGRPCServer) ExpandInventory(ctx context.Context, req *pb.ExpandInventoryRequest) (*pb.ExpandInventoryResponse, error) {
+	return s.handler.ExpandInventory(ctx, req)
+}
+
+func (s *UserGRPCServer) SortInventory(ctx context.Context, req *pb.SortInventoryRequest) (*pb.SortInventoryResponse, error) {
+	return s.handler.SortInventory(ctx, req)
+}
+
+func (s *UserGRPCServer) GetBuffs(ctx context.Context, req *pb.GetBuffsRequest) (*pb.GetBuffsResponse, error) {
+	return s.handler.GetBuffs(ctx, req)
+}
+
 func (s *UserGRPCServer) GetEquipments(ctx context.Context, req *pb.GetEquipmentsRequest) (*pb.GetEquipmentsResponse, error) {
 	return s.handler.GetEquipments(ctx, req)
 }
@@ -64,6 +84,14 @@ func (s *UserGRPCServer) UnequipItem(ctx context.Context, req *pb.UnequipItemReq
 	return s.handler.UnequipItem(ctx, req)
 }
 
+func (s *UserGRPCServer) EnhanceEquipment(ctx context.Context, req *pb.EnhanceEquipmentRequest) (*pb.EnhanceEquipmentResponse, error) {
+	return s.handler.EnhanceEquipment(ctx, req)
+}
+
+func (s *UserGRPCServer) RefineEquipment(ctx context.Context, req *pb.RefineEquipmentRequest) (*pb.RefineEquipmentResponse, error) {
+	return s.handler.RefineEquipment(ctx, req)
+}
+
 func (s *UserGRPCServer) GetUserInfo(ctx context.Context, req *pb.GetUserInfoRequest) (*pb.GetUserInfoResponse, error) {
 	return s.handler.GetUserInfo(ctx, req)
 }
@@ -102,6 +130,18 @@ func (s *UserGRPCServer) AddPetExp(ctx context.Context, req *pb.AddPetExpRequest
 	return s.handler.AddPetExp(ctx, req)
 }
 
+func (s *UserGRPCServer) EvolvePet(ctx context.Context, req *pb.EvolvePetRequest) (*pb.EvolvePetResponse, error) {
+	return s.handler.EvolvePet(ctx, req)
+}
+
+func (s *UserGRPCServer) LearnPetSkill(ctx context.Context, req *pb.LearnPetSkillRequest) (*pb.LearnPetSkillResponse, error) {
+	return s.handler.LearnPetSkill(ctx, req)
+}
+
+func (s *UserGRPCServer) ReleasePet(ctx context.Context, req *pb.ReleasePetRequest) (*pb.ReleasePetResponse, error) {
+	return s.handler.ReleasePet(ctx, req)
+}
+
 // 月签到相关方法
 func (s *UserGRPCServer) GetMonthlySignInfo(ctx context.Context, req *pb.GetMonthlySignInfoRequest) (*pb.GetMonthlySignInfoResponse, error) {
 	return s.handler.GetMonthlySignInfo(ctx, req)
@@ -114,3 +154,83 @@ func (s *UserGRPCServer) MonthlySign(ctx context.Context, req *pb.MonthlySignReq
 func (s *UserGRPCServer) ClaimMonthlySignReward(ctx context.Context, req *pb.ClaimMonthlySignRewardRequest) (*pb.ClaimMonthlySignRewardResponse, error) {
 	return s.handler.ClaimMonthlySignReward(ctx, req)
 }
+
+func (s *UserGRPCServer) MonthlySignMakeup(ctx context.Context, req *pb.MonthlySignMakeupRequest) (*pb.MonthlySignMakeupResponse, error) {
+	return s.handler.MonthlySignMakeup(ctx, req)
+}
+
+func (s *UserGRPCServer) GetMonthlySignHistory(ctx context.Context, req *pb.GetMonthlySignHistoryRequest) (*pb.GetMonthlySignHistoryResponse, error) {
+	return s.handler.GetMonthlySignHistory(ctx, req)
+}
+
+func (s *UserGRPCServer) AddUserExp(ctx context.Context, req *pb.AddUserExpRequest) (*pb.AddUserExpResponse, error) {
+	return s.handler.AddUserExp(ctx, req)
+}
+
+func (s *UserGRPCServer) GetMails(ctx context.Context, req *pb.GetMailsRequest) (*pb.GetMailsResponse, error) {
+	return s.handler.GetMails(ctx, req)
+}
+
+func (s *UserGRPCServer) ReadMail(ctx context.Context, req *pb.ReadMailRequest) (*pb.ReadMailResponse, error) {
+	return s.handler.ReadMail(ctx, req)
+}
+
+func (s *UserGRPCServer) ClaimMail(ctx context.Context, req *pb.ClaimMailRequest) (*pb.ClaimMailResponse, error) {
+	return s.handler.ClaimMail(ctx, req)
+}
+
+func (s *UserGRPCServer) DeleteMail(ctx context.Context, req *pb.DeleteMailRequest) (*pb.DeleteMailResponse, error) {
+	return s.handler.DeleteMail(ctx, req)
+}
+
+func (s *UserGRPCServer) SendMail(ctx context.Context, req *pb.SendMailRequest) (*pb.SendMailResponse, error) {
+	return s.handler.SendMail(ctx, req)
+}
+
+func (s *UserGRPCServer) SendSystemMail(ctx context.Context, req *pb.SendSystemMailRequest) (*pb.SendSystemMailResponse, error) {
+	return s.handler.SendSystemMail(ctx, req)
+}
+
+func (s *UserGRPCServer) SendBroadcastMail(ctx context.Context, req *pb.SendBroadcastMailRequest) (*pb.SendBroadcastMailResponse, error) {
+	return s.handler.SendBroadcastMail(ctx, req)
+}
+
+func (s *UserGRPCServer) GetWallet(ctx context.Context, req *pb.GetWalletRequest) (*pb.GetWalletResponse, error) {
+	return s.handler.GetWallet(ctx, req)
+}
+
+func (s *UserGRPCServer) GetCurrencyHistory(ctx context.Context, req *pb.GetCurrencyHistoryRequest) (*pb.GetCurrencyHistoryResponse, error) {
+	return s.handler.GetCurrencyHistory(ctx, req)
+}
+
+func (s *UserGRPCServer) ChangeCurrency(ctx context.Context, req *pb.ChangeCurrencyRequest) (*pb.ChangeCurrencyResponse, error) {
+	return s.handler.ChangeCurrency(ctx, req)
+}
+
+func (s *UserGRPCServer) GetShop(ctx context.Context, req *pb.GetShopRequest) (*pb.GetShopResponse, error) {
+	return s.handler.GetShop(ctx, req)
+}
+
+func (s *UserGRPCServer) BuyGoods(ctx context.Context, req *pb.BuyGoodsRequest) (*pb.BuyGoodsResponse, error) {
+	return s.handler.BuyGoods(ctx, req)
+}
+
+func (s *UserGRPCServer) GetGachaPool(ctx context.Context, req *pb.GetGachaPoolRequest) (*pb.GetGachaPoolResponse, error) {
+	return s.handler.GetGachaPool(ctx, req)
+}
+
+func (s *UserGRPCServer) GachaPull(ctx context.Context, req *pb.GachaPullRequest) (*pb.GachaPullResponse, error) {
+	return s.handler.GachaPull(ctx, req)
+}
+
+func (s *UserGRPCServer) GetGachaHistory(ctx context.Context, req *pb.GetGachaHistoryRequest) (*pb.GetGachaHistoryResponse, error) {
+	return s.handler.GetGachaHistory(ctx, req)
+}
+
+func (s *UserGRPCServer) GetQuests(ctx context.Context, req *pb.GetQuestsRequest) (*pb.GetQuestsResponse, error) {
+	return s.handler.GetQuests(ctx, req)
+}
+
+func (s *UserGRPCServer) ClaimQuestReward(ctx context.Context, req *pb.ClaimQuestRewardRequest) (*pb.ClaimQuestRewardResponse, error) {
+	return s.handler.ClaimQuestReward(ctx, req)
+}
@@ -0,0 +1,281 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/itemcost"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// CurrencyReasonShopBuy 商店购买的货币变动原因
+	CurrencyReasonShopBuy = "shop_buy"
+	// maxBuyQuantity 单次购买的最大数量
+	maxBuyQuantity = 99
+)
+
+// getShopTemplate 从内存配置中获取商店模板
+func (h *Handler) getShopTemplate(shopID int64) (*designconfig.ShopData, error) {
+	shops := h.configManager.GetConfig("shop")
+	if shops == nil {
+		return nil, fmt.Errorf("shop config not found")
+	}
+
+	shopsSlice := reflect.ValueOf(shops)
+	for i := 0; i < shopsSlice.Len(); i++ {
+		shop := shopsSlice.Index(i).Interface().(designconfig.ShopData)
+		if int64(shop.ID) == shopID {
+			return &shop, nil
+		}
+	}
+	return nil, fmt.Errorf("shop template not found: %d", shopID)
+}
+
+// getShopGoodsTemplate 从内存配置中获取商品模板
+func (h *Handler) getShopGoodsTemplate(goodsID int64) (*designconfig.ShopGoodsData, error) {
+	goodsList := h.configManager.GetConfig("shop_goods")
+	if goodsList == nil {
+		return nil, fmt.Errorf("shop goods config not found")
+	}
+
+	goodsSlice := reflect.ValueOf(goodsList)
+	for i := 0; i < goodsSlice.Len(); i++ {
+		goods := goodsSlice.Index(i).Interface().(designconfig.ShopGoodsData)
+		if int64(goods.ID) == goodsID {
+			return &goods, nil
+		}
+	}
+	return nil, fmt.Errorf("shop goods template not found: %d", goodsID)
+}
+
+// getShopGoodsList 获取商店下的所有商品模板
+func (h *Handler) getShopGoodsList(shopID int64) ([]designconfig.ShopGoodsData, error) {
+	goodsList, ok := h.configManager.GetConfig("shop_goods").([]designconfig.ShopGoodsData)
+	if !ok {
+		return nil, fmt.Errorf("shop goods config not found")
+	}
+
+	var result []designconfig.ShopGoodsData
+	for _, goods := range goodsList {
+		if int64(goods.ShopID) == shopID {
+			result = append(result, goods)
+		}
+	}
+	return result, nil
+}
+
+// shopPeriodStart 获取限购周期的起始时间，每日限购为当前游戏日的重置时刻，每周限购为本周一的重置时刻，其余为0
+func (h *Handler) shopPeriodStart(limitType int, now time.Time) int64 {
+	switch limitType {
+	case models.ShopLimitDaily:
+		return h.clock.DayStart(now).Unix()
+	case models.ShopLimitWeekly:
+		return h.clock.WeekStart(now).Unix()
+	default:
+		return 0
+	}
+}
+
+// shopResetAt 获取限购计数的下次重置时间，不重置的商品返回0
+func (h *Handler) shopResetAt(limitType int, now time.Time) int64 {
+	switch limitType {
+	case models.ShopLimitDaily:
+		return h.clock.NextDayStart(now).Unix()
+	case models.ShopLimitWeekly:
+		return h.clock.NextWeekStart(now).Unix()
+	default:
+		return 0
+	}
+}
+
+// scaleItemCosts 按购买数量放大物品列表
+func scaleItemCosts(costs []designconfig.BaseItemCost, quantity int) []designconfig.BaseItemCost {
+	scaled := make([]designconfig.BaseItemCost, 0, len(costs))
+	for _, cost := range costs {
+		scaled = append(scaled, designconfig.BaseItemCost{ItemId: cost.ItemId, Count: cost.Count * quantity})
+	}
+	return scaled
+}
+
+// GetShop 获取商店商品列表及玩家在当前限购周期内的购买次数
+func (h *Handler) GetShop(ctx context.Context, req *pb.GetShopRequest) (*pb.GetShopResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	shop, err := h.getShopTemplate(req.ShopId)
+	if err != nil {
+		return nil, fmt.Errorf("shop not found")
+	}
+	goodsList, err := h.getShopGoodsList(req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	goodsIDs := make([]int64, 0, len(goodsList))
+	for _, goods := range goodsList {
+		goodsIDs = append(goodsIDs, int64(goods.ID))
+	}
+	purchases, err := h.dbClient.GetShopPurchases(ctx, userID, goodsIDs)
+	if err != nil {
+		utils.Error("GetShopPurchases error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get shop purchases")
+	}
+	purchaseMap := make(map[int64]*models.ShopPurchase, len(purchases))
+	for _, purchase := range purchases {
+		purchaseMap[purchase.GoodsID] = purchase
+	}
+
+	now := h.clock.Now()
+	resp := &pb.GetShopResponse{
+		ShopId: int64(shop.ID),
+		Name:   shop.Name,
+		Goods:  make([]*pb.ShopGoods, 0, len(goodsList)),
+	}
+	for _, goods := range goodsList {
+		item := &pb.ShopGoods{
+			GoodsId:    int64(goods.ID),
+			Items:      h.toPBItems(goods.Goods),
+			Price:      h.toPBItems(goods.Price),
+			LimitType:  int32(goods.LimitType),
+			LimitCount: int32(goods.LimitCount),
+			ResetAt:    h.shopResetAt(goods.LimitType, now),
+		}
+		if goods.LimitType != models.ShopLimitNone {
+			item.BoughtCount = purchaseMap[int64(goods.ID)].CountIn(h.shopPeriodStart(goods.LimitType, now))
+		}
+		resp.Goods = append(resp.Goods, item)
+	}
+	return resp, nil
+}
+
+// BuyGoods 购买商品，扣除价格、发放商品和累加限购计数在同一事务中完成
+func (h *Handler) BuyGoods(ctx context.Context, req *pb.BuyGoodsRequest) (*pb.BuyGoodsResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	if req.Quantity <= 0 || req.Quantity > maxBuyQuantity {
+		return &pb.BuyGoodsResponse{Success: false, Message: "购买数量无效"}, nil
+	}
+	idempotencyKey, ok := requestKey("shop_buy", req.RequestId)
+	if !ok {
+		return &pb.BuyGoodsResponse{Success: false, Message: "请求ID无效"}, nil
+	}
+
+	goods, err := h.getShopGoodsTemplate(req.GoodsId)
+	if err != nil {
+		return &pb.BuyGoodsResponse{Success: false, Message: "商品不存在"}, nil
+	}
+
+	limitCount := int32(0)
+	if goods.LimitType != models.ShopLimitNone {
+		limitCount = int32(goods.LimitCount)
+		if req.Quantity > limitCount {
+			return &pb.BuyGoodsResponse{Success: false, Message: "超出限购次数"}, nil
+		}
+	}
+
+	price := scaleItemCosts(goods.Price, int(req.Quantity))
+	gains := scaleItemCosts(goods.Goods, int(req.Quantity))
+	periodStart := h.shopPeriodStart(goods.LimitType, h.clock.Now())
+
+	// 客户端未收到响应而重试时返回成功结果，避免把已完成的购买提示为失败
+	processed, err := h.dbClient.IsRequestProcessed(ctx, userID, idempotencyKey)
+	if err != nil {
+		utils.Error("IsRequestProcessed error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to buy goods")
+	}
+	if processed {
+		return h.processedBuyGoodsResponse(ctx, userID, goods, gains, periodStart)
+	}
+
+	// 价格和商品中的货币走钱包流水，其余走背包
+	costChanges, costItems := h.costs.Split(price, -1)
+	gainChanges, gainItems := h.costs.Split(gains, 1)
+
+	order := &models.ShopOrder{
+		UserID:          userID,
+		GoodsID:         int64(goods.ID),
+		Quantity:        req.Quantity,
+		LimitCount:      limitCount,
+		PeriodStart:     periodStart,
+		CurrencyChanges: itemcost.MergeCurrencyChanges(append(costChanges, gainChanges...)),
+		CostItems:       costItems,
+		GainItems:       gainItems,
+		Inventory:       h.inventoryRules(),
+		Reason:          CurrencyReasonShopBuy,
+		Source:          "shop_goods:" + strconv.Itoa(goods.ID),
+		IdempotencyKey:  idempotencyKey,
+	}
+	purchase, err := h.dbClient.Purchase(ctx, order)
+	if err != nil {
+		switch {
+		case errors.Is(err, interfaces.ErrDuplicateRequest):
+			return h.processedBuyGoodsResponse(ctx, userID, goods, gains, periodStart)
+		case errors.Is(err, interfaces.ErrPurchaseLimitReached):
+			return &pb.BuyGoodsResponse{Success: false, Message: "超出限购次数"}, nil
+		case errors.Is(err, interfaces.ErrInsufficientCurrency):
+			return &pb.BuyGoodsResponse{Success: false, Message: "货币不足"}, nil
+		case errors.Is(err, interfaces.ErrInsufficientItems):
+			return &pb.BuyGoodsResponse{Success: false, Message: "物品不足"}, nil
+		case errors.Is(err, interfaces.ErrInventoryFull):
+			return &pb.BuyGoodsResponse{Success: false, Message: "背包空间不足"}, nil
+		}
+		utils.Error("Purchase error", zap.Int64("userId", userID), zap.Int64("goodsId", req.GoodsId), zap.Error(err))
+		return nil, fmt.Errorf("failed to buy goods")
+	}
+
+	// 失效背包缓存
+	if err := h.cacheService.InvalidateInventoryCache(ctx, userID); err != nil {
+		utils.Error("Failed to invalidate inventory cache", zap.Error(err))
+	}
+
+	utils.Info("Goods purchased", zap.Int64("userId", userID), zap.Int64("goodsId", req.GoodsId), zap.Int32("quantity", req.Quantity))
+	resp := &pb.BuyGoodsResponse{
+		Success: true,
+		Message: "购买成功",
+		Rewards: h.toPBItems(gains),
+	}
+	if goods.LimitType != models.ShopLimitNone {
+		resp.BoughtCount = purchase.CountIn(order.PeriodStart)
+	}
+	return resp, nil
+}
+
+// processedBuyGoodsResponse 已处理过的购买请求返回成功，获得的物品按请求重新计算，已购买次数取当前值
+func (h *Handler) processedBuyGoodsResponse(ctx context.Context, userID int64, goods *designconfig.ShopGoodsData,
+	gains []designconfig.BaseItemCost, periodStart int64) (*pb.BuyGoodsResponse, error) {
+	resp := &pb.BuyGoodsResponse{
+		Success: true,
+		Message: "请求已处理",
+		Rewards: h.toPBItems(gains),
+	}
+	if goods.LimitType == models.ShopLimitNone {
+		return resp, nil
+	}
+
+	purchases, err := h.dbClient.GetShopPurchases(ctx, userID, []int64{int64(goods.ID)})
+	if err != nil {
+		utils.Error("GetShopPurchases error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get shop purchases")
+	}
+	for _, purchase := range purchases {
+		if purchase.GoodsID == int64(goods.ID) {
+			resp.BoughtCount = purchase.CountIn(periodStart)
+		}
+	}
+	return resp, nil
+}
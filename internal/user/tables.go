@@ -14,6 +14,12 @@ var Tables = []designconfig.Tables{
 		DataType:  reflect.TypeOf(designconfig.ItemData{}),
 		Group:     designconfig.BaseGroup,
 	},
+	{
+		DataId:    "inventory_expand.csv",
+		TableName: "inventory_expand",
+		DataType:  reflect.TypeOf(designconfig.InventoryExpandData{}),
+		Group:     designconfig.BaseGroup,
+	},
 	{
 		DataId:    "level.csv",
 		TableName: "level",
@@ -26,6 +32,24 @@ var Tables = []designconfig.Tables{
 		DataType:  reflect.TypeOf(designconfig.EquipmentData{}),
 		Group:     designconfig.BaseGroup,
 	},
+	{
+		DataId:    "equip_enhance.csv",
+		TableName: "equip_enhance",
+		DataType:  reflect.TypeOf(designconfig.EquipEnhanceData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "equip_refine.csv",
+		TableName: "equip_refine",
+		DataType:  reflect.TypeOf(designconfig.EquipRefineData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "equip_affix.csv",
+		TableName: "equip_affix",
+		DataType:  reflect.TypeOf(designconfig.EquipAffixData{}),
+		Group:     designconfig.BaseGroup,
+	},
 	{
 		DataId:    "pet.csv",
 		TableName: "pet",
@@ -38,6 +62,36 @@ var Tables = []designconfig.Tables{
 		DataType:  reflect.TypeOf(designconfig.PetLevelData{}),
 		Group:     designconfig.BaseGroup,
 	},
+	{
+		DataId:    "pet_star.csv",
+		TableName: "pet_star",
+		DataType:  reflect.TypeOf(designconfig.PetStarData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "pet_skill.csv",
+		TableName: "pet_skill",
+		DataType:  reflect.TypeOf(designconfig.PetSkillData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "pet_skill_slot.csv",
+		TableName: "pet_skill_slot",
+		DataType:  reflect.TypeOf(designconfig.PetSkillSlotData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "pet_battle_slot.csv",
+		TableName: "pet_battle_slot",
+		DataType:  reflect.TypeOf(designconfig.PetBattleSlotData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "pet_release.csv",
+		TableName: "pet_release",
+		DataType:  reflect.TypeOf(designconfig.PetReleaseData{}),
+		Group:     designconfig.BaseGroup,
+	},
 	{
 		DataId:    "card.csv",
 		TableName: "card",
@@ -56,6 +110,12 @@ var Tables = []designconfig.Tables{
 		DataType:  reflect.TypeOf(designconfig.CardLevelData{}),
 		Group:     designconfig.BaseGroup,
 	},
+	{
+		DataId:    "card_set.csv",
+		TableName: "card_set",
+		DataType:  reflect.TypeOf(designconfig.CardSetData{}),
+		Group:     designconfig.BaseGroup,
+	},
 	{
 		DataId:    "monthly_sign.csv",
 		TableName: "monthly_sign",
@@ -68,4 +128,64 @@ var Tables = []designconfig.Tables{
 		DataType:  reflect.TypeOf(designconfig.MonthlySignCumulativeData{}),
 		Group:     designconfig.BaseGroup,
 	},
+	{
+		DataId:    "monthly_sign_makeup.csv",
+		TableName: "monthly_sign_makeup",
+		DataType:  reflect.TypeOf(designconfig.MonthlySignMakeupData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "currency.csv",
+		TableName: "currency",
+		DataType:  reflect.TypeOf(designconfig.CurrencyData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "shop.csv",
+		TableName: "shop",
+		DataType:  reflect.TypeOf(designconfig.ShopData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "shop_goods.csv",
+		TableName: "shop_goods",
+		DataType:  reflect.TypeOf(designconfig.ShopGoodsData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "gacha_pool.csv",
+		TableName: "gacha_pool",
+		DataType:  reflect.TypeOf(designconfig.GachaPoolData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "gacha_drop.csv",
+		TableName: "gacha_drop",
+		DataType:  reflect.TypeOf(designconfig.GachaDropData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "quest.csv",
+		TableName: "quest",
+		DataType:  reflect.TypeOf(designconfig.QuestData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "item_use.csv",
+		TableName: "item_use",
+		DataType:  reflect.TypeOf(designconfig.ItemUseData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "item_drop.csv",
+		TableName: "item_drop",
+		DataType:  reflect.TypeOf(designconfig.ItemDropData{}),
+		Group:     designconfig.BaseGroup,
+	},
+	{
+		DataId:    "buff.csv",
+		TableName: "buff",
+		DataType:  reflect.TypeOf(designconfig.BuffData{}),
+		Group:     designconfig.BaseGroup,
+	},
 }
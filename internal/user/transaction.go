@@ -0,0 +1,19 @@
+package user
+
+import (
+	"context"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+)
+
+// consumeCosts 在事务中扣除配置的消耗，货币或物品不足时返回对应错误，由事务整体回滚
+func (h *Handler) consumeCosts(ctx context.Context, tx interfaces.Tx, userID int64, costs []designconfig.BaseItemCost, reason, source, idempotencyKey string) error {
+	return h.costs.Consume(ctx, tx, userID, costs, reason, source, idempotencyKey)
+}
+
+// grantRewards 在事务中发放配置的奖励，背包放不下的物品通过 overflowMail 补发
+func (h *Handler) grantRewards(ctx context.Context, tx interfaces.Tx, userID int64, rewards []designconfig.BaseItemCost, reason, source, idempotencyKey string, overflowMail *models.Mail) error {
+	return h.costs.Grant(ctx, tx, userID, rewards, h.inventoryRules(), reason, source, idempotencyKey, overflowMail)
+}
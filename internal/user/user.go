@@ -27,9 +27,20 @@ func (h *Handler) GetUserInfo(ctx context.Context, req *pb.GetUserInfoRequest) (
 		return nil, fmt.Errorf("user not found")
 	}
 
+	attrs, err := h.getUserAttributes(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user attributes: %w", err)
+	}
+
 	return &pb.GetUserInfoResponse{
 		UserId: user.ID,
 		Name:   user.Username,
-		Level:  user.Level,
+		Level:  models.EffectiveUserLevel(user.Level),
+		Attributes: &pb.UserAttributes{
+			Atk:   attrs.Atk,
+			Def:   attrs.Def,
+			HpMax: attrs.HpMax,
+		},
+		CombatPower: attrs.CombatPower,
 	}, nil
 }
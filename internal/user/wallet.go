@@ -0,0 +1,197 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.xubinbest.com/go-game-server/internal/db/interfaces"
+	"github.xubinbest.com/go-game-server/internal/db/models"
+	"github.xubinbest.com/go-game-server/internal/designconfig"
+	"github.xubinbest.com/go-game-server/internal/itemcost"
+	"github.xubinbest.com/go-game-server/internal/pb"
+	"github.xubinbest.com/go-game-server/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// 货币ID，与 currency 配置表一致
+const (
+	CurrencyGold    = 3000000001
+	CurrencyDiamond = 3000000002
+)
+
+// 货币变动原因
+const (
+	CurrencyReasonCardActivate = "card_activate"
+	CurrencyReasonCardUpgrade  = "card_upgrade"
+	CurrencyReasonCardStar     = "card_star"
+)
+
+const (
+	// defaultLedgerPageSize 货币流水默认每页数量
+	defaultLedgerPageSize = 20
+	// maxLedgerPageSize 货币流水每页最大数量
+	maxLedgerPageSize = 100
+	// maxRequestIDLength 客户端请求ID的最大长度，加上操作前缀后不超过幂等键的长度限制
+	maxRequestIDLength = 64
+)
+
+// requestKey 由操作类型和客户端请求ID生成幂等键，请求ID为空或过长时返回false
+// 幂等键按玩家隔离，同一玩家重试同一请求时得到相同的键，订单只会处理一次
+func requestKey(operation, requestID string) (string, bool) {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return "", false
+	}
+	return operation + ":" + requestID, true
+}
+
+// getCurrencyTemplate 从内存配置中获取货币模板
+func (h *Handler) getCurrencyTemplate(currencyID int64) (*designconfig.CurrencyData, error) {
+	currencies := h.configManager.GetConfig("currency")
+	if currencies == nil {
+		return nil, fmt.Errorf("currency config not found")
+	}
+
+	currenciesSlice := reflect.ValueOf(currencies)
+	for i := 0; i < currenciesSlice.Len(); i++ {
+		currency := currenciesSlice.Index(i).Interface().(designconfig.CurrencyData)
+		if int64(currency.ID) == currencyID {
+			return &currency, nil
+		}
+	}
+	return nil, fmt.Errorf("currency template not found: %d", currencyID)
+}
+
+// ChangeCurrencies 变更玩家货币并记录流水，供商店、抽卡等系统调用
+// 相同 idempotencyKey 的重复调用只生效一次，余额不足时返回 interfaces.ErrInsufficientCurrency
+func (h *Handler) ChangeCurrencies(ctx context.Context, userID int64, changes []models.CurrencyChange, reason, source, idempotencyKey string) ([]*models.CurrencyLedger, error) {
+	merged := itemcost.MergeCurrencyChanges(changes)
+	if len(merged) == 0 {
+		return nil, nil
+	}
+	for _, change := range merged {
+		if !h.costs.IsCurrency(change.CurrencyID) {
+			return nil, fmt.Errorf("invalid currency id: %d", change.CurrencyID)
+		}
+	}
+
+	ledgers, err := h.dbClient.ChangeCurrencies(ctx, userID, merged, reason, source, idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Info("Currency changed", zap.Int64("userId", userID), zap.String("reason", reason),
+		zap.String("source", source), zap.String("idempotencyKey", idempotencyKey), zap.Int("entries", len(ledgers)))
+	return ledgers, nil
+}
+
+// GetWallet 获取玩家所有货币余额，未持有的货币余额为0
+func (h *Handler) GetWallet(ctx context.Context, req *pb.GetWalletRequest) (*pb.GetWalletResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	currencies, ok := h.configManager.GetConfig("currency").([]designconfig.CurrencyData)
+	if !ok {
+		return nil, fmt.Errorf("currency config not found")
+	}
+
+	wallet, err := h.dbClient.GetWallet(ctx, userID)
+	if err != nil {
+		utils.Error("GetWallet error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get wallet")
+	}
+	balances := make(map[int64]int64, len(wallet))
+	for _, currency := range wallet {
+		balances[currency.CurrencyID] = currency.Balance
+	}
+
+	resp := &pb.GetWalletResponse{Balances: make([]*pb.CurrencyBalance, 0, len(currencies))}
+	for _, currency := range currencies {
+		resp.Balances = append(resp.Balances, &pb.CurrencyBalance{
+			CurrencyId: int64(currency.ID),
+			Name:       currency.Name,
+			Balance:    balances[int64(currency.ID)],
+		})
+	}
+	return resp, nil
+}
+
+// GetCurrencyHistory 分页获取货币流水
+func (h *Handler) GetCurrencyHistory(ctx context.Context, req *pb.GetCurrencyHistoryRequest) (*pb.GetCurrencyHistoryResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultLedgerPageSize
+	}
+	if limit > maxLedgerPageSize {
+		limit = maxLedgerPageSize
+	}
+
+	// 多取一条用于判断是否还有下一页
+	ledgers, err := h.dbClient.GetCurrencyLedgers(ctx, userID, req.CurrencyId, req.BeforeId, limit+1)
+	if err != nil {
+		utils.Error("GetCurrencyLedgers error", zap.Error(err))
+		return nil, fmt.Errorf("failed to get currency history")
+	}
+
+	resp := &pb.GetCurrencyHistoryResponse{}
+	if len(ledgers) > limit {
+		ledgers = ledgers[:limit]
+		resp.HasMore = true
+	}
+	for _, ledger := range ledgers {
+		resp.Entries = append(resp.Entries, &pb.CurrencyLedgerEntry{
+			Id:           ledger.ID,
+			CurrencyId:   ledger.CurrencyID,
+			Amount:       ledger.Amount,
+			BalanceAfter: ledger.BalanceAfter,
+			Reason:       ledger.Reason,
+			Source:       ledger.Source,
+			CreatedAt:    ledger.CreatedAt,
+		})
+	}
+	return resp, nil
+}
+
+// ChangeCurrency 变更玩家货币
+func (h *Handler) ChangeCurrency(ctx context.Context, req *pb.ChangeCurrencyRequest) (*pb.ChangeCurrencyResponse, error) {
+	userID := req.UserId
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	if req.Amount == 0 {
+		return &pb.ChangeCurrencyResponse{Success: false, Message: "变动数量不能为0"}, nil
+	}
+	if req.Reason == "" || req.IdempotencyKey == "" {
+		return &pb.ChangeCurrencyResponse{Success: false, Message: "变动原因和幂等键不能为空"}, nil
+	}
+	if !h.costs.IsCurrency(req.CurrencyId) {
+		return &pb.ChangeCurrencyResponse{Success: false, Message: "货币不存在"}, nil
+	}
+
+	changes := []models.CurrencyChange{{CurrencyID: req.CurrencyId, Amount: req.Amount}}
+	ledgers, err := h.ChangeCurrencies(ctx, userID, changes, req.Reason, req.Source, req.IdempotencyKey)
+	if err != nil {
+		if errors.Is(err, interfaces.ErrInsufficientCurrency) {
+			return &pb.ChangeCurrencyResponse{Success: false, Message: "货币不足"}, nil
+		}
+		utils.Error("ChangeCurrency error", zap.Int64("userId", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to change currency")
+	}
+
+	resp := &pb.ChangeCurrencyResponse{Success: true, Message: "变更成功"}
+	for _, ledger := range ledgers {
+		if ledger.CurrencyID == req.CurrencyId {
+			resp.Balance = ledger.BalanceAfter
+		}
+	}
+	return resp, nil
+}
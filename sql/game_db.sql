@@ -69,13 +69,31 @@ CREATE TABLE `friends`  (
 DROP TABLE IF EXISTS `guild_applications`;
 CREATE TABLE `guild_applications`  (
   `id` bigint NOT NULL,
-  `guild_id` bigint NULL DEFAULT NULL,
-  `user_id` bigint NULL DEFAULT NULL,
-  `apply_time` datetime NULL DEFAULT NULL,
-  `status` int NULL DEFAULT NULL,
+  `guild_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL,
+  `time` datetime NULL DEFAULT NULL,
+  `expire_time` datetime NOT NULL, -- 过期时间，过期后由后台任务清理
   PRIMARY KEY (`id`) USING BTREE,
   INDEX `idx_guild_id` (`guild_id`),
-  INDEX `idx_user_id` (`user_id`)
+  INDEX `idx_user_id` (`user_id`),
+  INDEX `idx_expire_time` (`expire_time`)
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for guild_donations
+-- ----------------------------
+DROP TABLE IF EXISTS `guild_donations`;
+CREATE TABLE `guild_donations`  (
+  `id` bigint NOT NULL,
+  `guild_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL,
+  `donate_id` bigint NOT NULL, -- 捐献项ID（guild_donate配置表）
+  `exp` bigint NOT NULL, -- 增加的帮派经验
+  `contribution` bigint NOT NULL, -- 增加的个人贡献
+  `time` datetime NOT NULL,
+  PRIMARY KEY (`id`) USING BTREE,
+  INDEX `idx_guild_time` (`guild_id`, `time`),
+  INDEX `idx_user_donate` (`user_id`, `donate_id`, `time`)
 ) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
 
 -- ----------------------------
@@ -85,15 +103,15 @@ DROP TABLE IF EXISTS `guild_invitations`;
 CREATE TABLE `guild_invitations`  (
   `id` bigint NOT NULL,
   `guild_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL, -- 被邀请者ID
   `inviter_id` bigint NOT NULL,
-  `invitee_id` bigint NOT NULL,
-  `status` int NOT NULL,
-  `created_at` datetime NOT NULL,
-  `expire_time` datetime NOT NULL,
+  `time` datetime NULL DEFAULT NULL,
+  `expire_time` datetime NOT NULL, -- 过期时间，过期后由后台任务清理
   PRIMARY KEY (`id`) USING BTREE,
   INDEX `idx_guild_id` (`guild_id`),
+  INDEX `idx_user_id` (`user_id`),
   INDEX `idx_inviter_id` (`inviter_id`),
-  INDEX `idx_invitee_id` (`invitee_id`)
+  INDEX `idx_expire_time` (`expire_time`)
 ) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
 
 -- ----------------------------
@@ -107,6 +125,7 @@ CREATE TABLE `guild_members`  (
   `role` int NULL DEFAULT NULL,
   `join_time` datetime NULL DEFAULT NULL,
   `last_login` datetime NULL DEFAULT NULL,
+  `contribution` bigint NOT NULL DEFAULT 0, -- 累计贡献
   PRIMARY KEY (`id`) USING BTREE,
   INDEX `idx_guild_id` (`guild_id`),
   INDEX `idx_user_id` (`user_id`)
@@ -123,7 +142,9 @@ CREATE TABLE `guilds`  (
   `announcement` varchar(255) NOT NULL,
   `master_id` bigint NOT NULL,
   `created_at` datetime NOT NULL,
-  `max_members` int NOT NULL,
+  `max_members` int NOT NULL, -- 成员上限，由帮派等级决定
+  `level` int NOT NULL DEFAULT 1, -- 帮派等级
+  `exp` bigint NOT NULL DEFAULT 0, -- 累计经验
   `version` int NOT NULL,
   PRIMARY KEY (`id`) USING BTREE
 ) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
@@ -141,6 +162,7 @@ CREATE TABLE `users`  (
   `password_hash` varchar(255) NOT NULL,
   `salt` varchar(255) NOT NULL,
   `role` varchar(255) NOT NULL,
+  `rating` int NOT NULL DEFAULT 1000,
   `created_at` datetime NOT NULL,
   `updated_at` datetime NOT NULL,
   PRIMARY KEY (`id`) USING BTREE,
@@ -160,6 +182,7 @@ CREATE TABLE IF NOT EXISTS inventory_items (
     user_id BIGINT NOT NULL,                  -- 用户ID
     template_id BIGINT NOT NULL,              -- 策划配置表模板ID
     count INT NOT NULL DEFAULT 1,             -- 数量
+    position INT NOT NULL DEFAULT 0,          -- 格子序号
     equipped BOOLEAN NOT NULL DEFAULT FALSE,  -- 是否已装备
     created_at BIGINT NOT NULL,               -- 创建时间
     updated_at BIGINT NOT NULL,               -- 更新时间
@@ -171,6 +194,18 @@ CREATE TABLE IF NOT EXISTS inventory_items (
     INDEX `idx_updated_at` (`updated_at`)
 ) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
 
+-- ----------------------------
+-- Table structure for inventory_capacities
+-- ----------------------------
+DROP TABLE IF EXISTS `inventory_capacities`;
+CREATE TABLE IF NOT EXISTS inventory_capacities (
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    capacity INT NOT NULL,                    -- 背包容量
+    expand_times INT NOT NULL DEFAULT 0,      -- 已扩容次数
+    updated_at BIGINT NOT NULL,               -- 更新时间
+    PRIMARY KEY (`user_id`) USING BTREE
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
 -- ----------------------------
 -- Table structure for equipments
 -- ----------------------------
@@ -178,6 +213,7 @@ DROP TABLE IF EXISTS `equipments`;
 CREATE TABLE IF NOT EXISTS equipments (
     id BIGINT NOT NULL,                       -- 装备实例ID（雪花算法生成）
     user_id BIGINT NOT NULL,                  -- 用户ID
+    item_id BIGINT NOT NULL DEFAULT 0,        -- 穿戴的背包物品实例ID
     template_id BIGINT NOT NULL,              -- 策划配置表模板ID
     slot INT NOT NULL,                        -- 装备槽位
     created_at BIGINT NOT NULL,               -- 创建时间
@@ -191,6 +227,20 @@ CREATE TABLE IF NOT EXISTS equipments (
     INDEX `idx_updated_at` (`updated_at`)
 ) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
 
+-- ----------------------------
+-- Table structure for equipment_enhances
+-- ----------------------------
+DROP TABLE IF EXISTS `equipment_enhances`;
+CREATE TABLE IF NOT EXISTS equipment_enhances (
+    item_id BIGINT NOT NULL,                  -- 装备的背包物品实例ID
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    level INT NOT NULL DEFAULT 0,             -- 强化等级
+    affixes TEXT,                             -- 洗练词条（JSON）
+    updated_at BIGINT NOT NULL,               -- 更新时间
+    PRIMARY KEY (`item_id`) USING BTREE,
+    INDEX `idx_user_id` (`user_id`)
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
 -- ----------------------------
 -- Table structure for user_cards
 -- ----------------------------
@@ -205,8 +255,8 @@ CREATE TABLE IF NOT EXISTS user_cards (
     updated_at BIGINT NOT NULL,               -- 更新时间
     PRIMARY KEY (`id`) USING BTREE,
     INDEX `idx_user_id` (`user_id`),
+    UNIQUE KEY `uk_user_template` (`user_id`, `template_id`),
     INDEX `idx_template_id` (`template_id`),
-    INDEX `idx_user_template` (`user_id`, `template_id`),
     INDEX `idx_created_at` (`created_at`),
     INDEX `idx_updated_at` (`updated_at`)
 ) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
@@ -222,19 +272,34 @@ CREATE TABLE IF NOT EXISTS pets (
     name VARCHAR(255) NOT NULL,               -- 宠物名称
     level INT NOT NULL DEFAULT 1,             -- 宠物等级
     exp INT NOT NULL DEFAULT 0,               -- 当前经验
-    is_battle BOOLEAN NOT NULL DEFAULT FALSE, -- 是否出战
+    star INT NOT NULL DEFAULT 0,              -- 星级
+    battle_slot INT NOT NULL DEFAULT 0,       -- 出战位，0表示未出战
     created_at DATETIME NOT NULL,             -- 创建时间
     updated_at DATETIME NOT NULL,             -- 更新时间
     PRIMARY KEY (`id`) USING BTREE,
     INDEX `idx_user_id` (`user_id`),
     INDEX `idx_template_id` (`template_id`),
     INDEX `idx_user_template` (`user_id`, `template_id`),
-    INDEX `idx_is_battle` (`is_battle`),
-    INDEX `idx_user_battle` (`user_id`, `is_battle`),
+    INDEX `idx_battle_slot` (`battle_slot`),
+    INDEX `idx_user_battle` (`user_id`, `battle_slot`),
     INDEX `idx_created_at` (`created_at`),
     INDEX `idx_updated_at` (`updated_at`)
 ) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
 
+-- ----------------------------
+-- Table structure for pet_skills
+-- ----------------------------
+DROP TABLE IF EXISTS `pet_skills`;
+CREATE TABLE IF NOT EXISTS pet_skills (
+    pet_id BIGINT NOT NULL,                   -- 宠物实例ID
+    slot INT NOT NULL,                        -- 技能槽序号
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    skill_id BIGINT NOT NULL DEFAULT 0,       -- 技能ID，0表示空槽
+    updated_at BIGINT NOT NULL,               -- 更新时间
+    PRIMARY KEY (`pet_id`, `slot`) USING BTREE,
+    INDEX `idx_user_id` (`user_id`)
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
 -- ----------------------------
 -- Table structure for monthly_signs
 -- ----------------------------
@@ -244,6 +309,7 @@ CREATE TABLE IF NOT EXISTS monthly_signs (
     year INT NOT NULL,                         -- 年份
     month INT NOT NULL,                        -- 月份
     sign_days INT NOT NULL DEFAULT 0,          -- 已签到的日期位图（bitmap）
+    makeup_count INT NOT NULL DEFAULT 0,       -- 本月已补签次数
     last_sign_time DATETIME NOT NULL,          -- 最后签到时间
     created_at DATETIME NOT NULL,              -- 创建时间
     updated_at DATETIME NOT NULL,              -- 更新时间
@@ -270,4 +336,180 @@ CREATE TABLE IF NOT EXISTS monthly_sign_rewards (
     INDEX `idx_updated_at` (`updated_at`)
 ) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
 
+-- ----------------------------
+-- Table structure for monthly_sign_archives
+-- ----------------------------
+DROP TABLE IF EXISTS `monthly_sign_archives`;
+CREATE TABLE IF NOT EXISTS monthly_sign_archives (
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    year INT NOT NULL,                         -- 年份
+    month INT NOT NULL,                        -- 月份
+    sign_days INT NOT NULL DEFAULT 0,          -- 当月已签到的日期位图（bitmap）
+    makeup_count INT NOT NULL DEFAULT 0,       -- 当月补签次数
+    created_at DATETIME NOT NULL,              -- 归档时间
+    PRIMARY KEY (`user_id`, `year`, `month`) USING BTREE
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for mails
+-- ----------------------------
+DROP TABLE IF EXISTS `mails`;
+CREATE TABLE IF NOT EXISTS mails (
+    id BIGINT NOT NULL,                       -- 邮件ID（雪花算法生成）
+    user_id BIGINT NOT NULL,                  -- 收件人用户ID
+    broadcast_id BIGINT NULL DEFAULT NULL,    -- 来源全服邮件ID，非全服邮件为NULL
+    type INT NOT NULL,                        -- 邮件类型：1系统 2全服 3玩家
+    sender_id BIGINT NOT NULL DEFAULT 0,      -- 发件人用户ID，系统邮件为0
+    sender_name VARCHAR(50) NOT NULL,         -- 发件人名称
+    title VARCHAR(100) NOT NULL,              -- 标题
+    content TEXT,                             -- 正文
+    attachments TEXT,                         -- 附件（JSON格式的物品列表）
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,   -- 是否已读
+    is_claimed BOOLEAN NOT NULL DEFAULT FALSE, -- 附件是否已领取
+    is_deleted BOOLEAN NOT NULL DEFAULT FALSE, -- 是否已删除
+    expire_at BIGINT NOT NULL,                -- 过期时间
+    created_at BIGINT NOT NULL,               -- 创建时间
+    updated_at BIGINT NOT NULL,               -- 更新时间
+    PRIMARY KEY (`id`) USING BTREE,
+    INDEX `idx_user_id` (`user_id`),
+    UNIQUE KEY `uk_user_broadcast` (`user_id`, `broadcast_id`),
+    INDEX `idx_expire_at` (`expire_at`)
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for broadcast_mails
+-- ----------------------------
+DROP TABLE IF EXISTS `broadcast_mails`;
+CREATE TABLE IF NOT EXISTS broadcast_mails (
+    id BIGINT NOT NULL,                       -- 全服邮件ID（雪花算法生成）
+    title VARCHAR(100) NOT NULL,              -- 标题
+    content TEXT,                             -- 正文
+    attachments TEXT,                         -- 附件（JSON格式的物品列表）
+    expire_at BIGINT NOT NULL,                -- 过期时间
+    created_at BIGINT NOT NULL,               -- 创建时间
+    PRIMARY KEY (`id`) USING BTREE,
+    INDEX `idx_expire_at` (`expire_at`),
+    INDEX `idx_created_at` (`created_at`)
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for user_currencies
+-- ----------------------------
+DROP TABLE IF EXISTS `user_currencies`;
+CREATE TABLE IF NOT EXISTS user_currencies (
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    currency_id BIGINT NOT NULL,              -- 货币ID（currency配置表）
+    balance BIGINT NOT NULL DEFAULT 0,        -- 余额
+    updated_at BIGINT NOT NULL,               -- 更新时间
+    PRIMARY KEY (`user_id`, `currency_id`) USING BTREE
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for currency_ledgers
+-- ----------------------------
+DROP TABLE IF EXISTS `currency_ledgers`;
+CREATE TABLE IF NOT EXISTS currency_ledgers (
+    id BIGINT NOT NULL,                       -- 流水ID（雪花算法生成）
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    currency_id BIGINT NOT NULL,              -- 货币ID
+    amount BIGINT NOT NULL,                   -- 变动数量，负数为扣除
+    balance_after BIGINT NOT NULL,            -- 变动后余额
+    reason VARCHAR(50) NOT NULL,              -- 变动原因
+    source VARCHAR(100) NOT NULL,             -- 关联来源
+    idempotency_key VARCHAR(100) NOT NULL,    -- 幂等键
+    created_at BIGINT NOT NULL,               -- 创建时间
+    PRIMARY KEY (`id`) USING BTREE,
+    INDEX `idx_user_id` (`user_id`),
+    UNIQUE KEY `uk_user_currency_key` (`user_id`, `currency_id`, `idempotency_key`),
+    INDEX `idx_created_at` (`created_at`)
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for processed_requests
+-- ----------------------------
+DROP TABLE IF EXISTS `processed_requests`;
+CREATE TABLE IF NOT EXISTS processed_requests (
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    request_key VARCHAR(100) NOT NULL,        -- 请求键，由操作类型和客户端请求ID组成
+    created_at BIGINT NOT NULL,               -- 处理时间
+    PRIMARY KEY (`user_id`, `request_key`) USING BTREE
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for shop_purchases
+-- ----------------------------
+DROP TABLE IF EXISTS `shop_purchases`;
+CREATE TABLE IF NOT EXISTS shop_purchases (
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    goods_id BIGINT NOT NULL,                 -- 商品ID（shop_goods配置表）
+    count INT NOT NULL DEFAULT 0,             -- 当前限购周期内的购买次数
+    period_start BIGINT NOT NULL DEFAULT 0,   -- 计数所属限购周期的起始时间
+    updated_at BIGINT NOT NULL,               -- 更新时间
+    PRIMARY KEY (`user_id`, `goods_id`) USING BTREE
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for gacha_pities
+-- ----------------------------
+DROP TABLE IF EXISTS `gacha_pities`;
+CREATE TABLE IF NOT EXISTS gacha_pities (
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    pool_id BIGINT NOT NULL,                  -- 卡池ID（gacha_pool配置表）
+    pity_count INT NOT NULL DEFAULT 0,        -- 距上次抽中稀有的连续次数
+    total_pulls BIGINT NOT NULL DEFAULT 0,    -- 累计抽取次数
+    updated_at BIGINT NOT NULL,               -- 更新时间
+    PRIMARY KEY (`user_id`, `pool_id`) USING BTREE
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for gacha_records
+-- ----------------------------
+DROP TABLE IF EXISTS `gacha_records`;
+CREATE TABLE IF NOT EXISTS gacha_records (
+    id BIGINT NOT NULL,                       -- 记录ID
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    pool_id BIGINT NOT NULL,                  -- 卡池ID
+    batch_id BIGINT NOT NULL,                 -- 批次ID，同一次单抽或十连相同
+    seed BIGINT NOT NULL,                     -- 随机种子
+    pull_index INT NOT NULL,                  -- 批次内序号
+    pity_before INT NOT NULL,                 -- 抽取前的保底计数
+    drop_id BIGINT NOT NULL,                  -- 掉落ID（gacha_drop配置表）
+    reward_type INT NOT NULL,                 -- 奖励类型：1物品 2卡牌 3宠物
+    reward_id BIGINT NOT NULL,                -- 奖励模板ID
+    count INT NOT NULL,                       -- 奖励数量
+    is_rare BOOLEAN NOT NULL DEFAULT FALSE,   -- 是否稀有
+    converted BOOLEAN NOT NULL DEFAULT FALSE, -- 重复卡牌是否已转换为激活材料
+    created_at BIGINT NOT NULL,               -- 创建时间
+    PRIMARY KEY (`id`) USING BTREE,
+    INDEX `idx_user_pool`(`user_id`, `pool_id`) USING BTREE,
+    INDEX `idx_gacha_records_batch_id`(`batch_id`) USING BTREE
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for quest_progresses
+-- ----------------------------
+DROP TABLE IF EXISTS `quest_progresses`;
+CREATE TABLE IF NOT EXISTS quest_progresses (
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    quest_id BIGINT NOT NULL,                 -- 任务ID（quest配置表）
+    progress BIGINT NOT NULL DEFAULT 0,       -- 当前周期内的进度
+    period_start BIGINT NOT NULL DEFAULT 0,   -- 进度所属重置周期的起始时间，成就为0
+    claimed BOOLEAN NOT NULL DEFAULT FALSE,   -- 当前周期奖励是否已领取
+    updated_at BIGINT NOT NULL,               -- 更新时间
+    PRIMARY KEY (`user_id`, `quest_id`) USING BTREE
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
+-- ----------------------------
+-- Table structure for user_buffs
+-- ----------------------------
+DROP TABLE IF EXISTS `user_buffs`;
+CREATE TABLE IF NOT EXISTS user_buffs (
+    user_id BIGINT NOT NULL,                  -- 用户ID
+    buff_id BIGINT NOT NULL,                  -- 增益ID（buff配置表）
+    expire_at BIGINT NOT NULL,                -- 过期时间
+    updated_at BIGINT NOT NULL,               -- 更新时间
+    PRIMARY KEY (`user_id`, `buff_id`) USING BTREE,
+    INDEX `idx_user_expire`(`user_id`, `expire_at`) USING BTREE
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = Dynamic;
+
 SET FOREIGN_KEY_CHECKS = 1;